@@ -0,0 +1,112 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// readArchive returns the zip file at the path or packs Markdown files of the directory at the path into a zip archive.
+func readArchive(p string) ([]byte, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return os.ReadFile(p)
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	err = filepath.WalkDir(p, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || filepath.Ext(name) != ".md" {
+			return err
+		}
+
+		rel, err := filepath.Rel(p, name)
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+
+		w, err := archive.Create(filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(data)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pack directory: %w", err)
+	}
+
+	if err = archive.Close(); err != nil {
+		return nil, fmt.Errorf("close archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeArchive saves the zip archive at the path ending with ".zip" or unpacks it into the directory at the path.
+func writeArchive(p string, data []byte) error {
+	if strings.HasSuffix(p, ".zip") {
+		return os.WriteFile(p, data, 0o644)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return fmt.Errorf("open archive: %w", err)
+	}
+
+	if err = os.MkdirAll(p, 0o755); err != nil {
+		return err
+	}
+
+	for _, file := range archive.File {
+		// names are slugs, but an archive is never trusted to stay inside the directory
+		name := path.Clean(file.Name)
+		if !fs.ValidPath(name) {
+			return fmt.Errorf("invalid file name %q in archive", file.Name)
+		}
+
+		if err = extractFile(file, filepath.Join(p, filepath.FromSlash(name))); err != nil {
+			return fmt.Errorf("extract %s: %w", file.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func extractFile(file *zip.File, dest string) error {
+	r, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if err = os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+
+	w, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	_, err = io.Copy(w, r)
+	return err
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// importResult is the response of the import endpoint.
+type importResult struct {
+	DryRun bool           `json:"dryRun"`
+	Counts map[string]int `json:"counts"`
+	Files  []struct {
+		Name   string `json:"name"`
+		Slug   string `json:"slug"`
+		Status string `json:"status"`
+		Error  string `json:"error"`
+	} `json:"files"`
+}
+
+// client calls the admin endpoints of the service.
+type client struct {
+	serviceURL *url.URL
+	authToken  string
+	http       *http.Client
+}
+
+func newClient(config *appConfig) *client {
+	return &client{
+		serviceURL: config.serviceURL,
+		authToken:  config.authToken,
+		http:       &http.Client{Timeout: 10 * time.Minute},
+	}
+}
+
+func (c *client) importArticles(archive []byte, dryRun bool) (*importResult, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+
+	part, err := form.CreateFormFile("file", "articles.zip")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = part.Write(archive); err != nil {
+		return nil, err
+	}
+
+	if err = form.Close(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{"dryRun": {strconv.FormatBool(dryRun)}}
+	req, err := c.newRequest(http.MethodPost, "/admin/articles/import?"+query.Encode(), &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	data, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &importResult{}
+	if err = json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) exportArticles() ([]byte, error) {
+	req, err := c.newRequest(http.MethodGet, "/admin/articles/export", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req)
+}
+
+func (c *client) newRequest(method, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, c.serviceURL.JoinPath().String()+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.authToken)
+	return req, nil
+}
+
+func (c *client) do(req *http.Request) ([]byte, error) {
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		var errRes struct {
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(data, &errRes)
+		return nil, fmt.Errorf("service responded with %s: %s", res.Status, errRes.Message)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"net/url"
+	"os"
+
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type appConfig struct {
+	serviceURL *url.URL
+	authToken  string
+
+	logger log.Logger
+}
+
+func getAppConfig(logger log.Logger) *appConfig {
+	c := &appConfig{logger: logger}
+	c.initServiceURL()
+	c.initAuthToken()
+	return c
+}
+
+func (c *appConfig) initServiceURL() {
+	rawURL := os.Getenv("SERVICE_URL")
+	if rawURL == "" {
+		rawURL = "http://127.0.0.1:8080"
+	}
+
+	serviceURL, err := url.Parse(rawURL)
+	if err != nil {
+		c.logger.Panic().Err(err).Msg("SERVICE_URL is invalid")
+	}
+
+	c.serviceURL = serviceURL
+}
+
+// initAuthToken reads the access token of an admin, the one returned by /auth/login.
+func (c *appConfig) initAuthToken() {
+	if c.authToken = os.Getenv("AUTH_TOKEN"); c.authToken == "" {
+		c.logger.Panic().Msg("AUTH_TOKEN is required")
+	}
+}
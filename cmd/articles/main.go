@@ -0,0 +1,102 @@
+// Command articles imports and exports articles as Markdown files with YAML front matter.
+//
+//	articles import [-dry-run] <archive.zip|directory>
+//	articles export <archive.zip|directory>
+//
+// It calls the admin endpoints of a running service at SERVICE_URL with the access token of an admin in AUTH_TOKEN.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/art-es/yet-another-service/internal/driver/zerolog"
+)
+
+func main() {
+	logger := zerolog.NewLogger()
+
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command, args := os.Args[1], os.Args[2:]
+
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "check files and report what would be imported without saving anything")
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		usage()
+	}
+	path := flags.Arg(0)
+
+	config := getAppConfig(logger)
+	c := newClient(config)
+
+	switch command {
+	case "import":
+		if err := runImport(c, path, *dryRun); err != nil {
+			logger.Panic().Err(err).Msg("import error")
+		}
+	case "export":
+		if err := runExport(c, path); err != nil {
+			logger.Panic().Err(err).Msg("export error")
+		}
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage:\n  articles import [-dry-run] <archive.zip|directory>\n  articles export <archive.zip|directory>")
+	os.Exit(2)
+}
+
+func runImport(c *client, path string, dryRun bool) error {
+	archive, err := readArchive(path)
+	if err != nil {
+		return fmt.Errorf("read archive: %w", err)
+	}
+
+	result, err := c.importArticles(archive, dryRun)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range result.Files {
+		if file.Error != "" {
+			fmt.Printf("%-9s %s: %s\n", file.Status, file.Name, file.Error)
+			continue
+		}
+
+		fmt.Printf("%-9s %s (%s)\n", file.Status, file.Name, file.Slug)
+	}
+
+	fmt.Printf("\ncreated: %d, updated: %d, unchanged: %d, failed: %d\n",
+		result.Counts["created"], result.Counts["updated"], result.Counts["unchanged"], result.Counts["failed"])
+
+	if result.DryRun {
+		fmt.Println("dry run, nothing was saved")
+	}
+
+	if result.Counts["failed"] > 0 {
+		os.Exit(1)
+	}
+
+	return nil
+}
+
+func runExport(c *client, path string) error {
+	archive, err := c.exportArticles()
+	if err != nil {
+		return err
+	}
+
+	if err = writeArchive(path, archive); err != nil {
+		return fmt.Errorf("write archive: %w", err)
+	}
+
+	return nil
+}
@@ -37,6 +37,7 @@ func getAppConfig(logger log.Logger) *appConfig {
 	c := &appConfig{logger: logger}
 	c.initAppEnv()
 	c.initPostgresURL()
+	c.initMailing()
 	c.initSMTP()
 	c.initMaxRetries()
 	return c
@@ -78,12 +79,47 @@ func (c *appConfig) initMailing() {
 	}
 
 	processTimeout, _ := strconv.Atoi(os.Getenv("MAILING_PROCESS_TIMEOUT"))
-	if processTimeout < 0 {
-		processTimeout = 300
+	if processTimeout < 1 {
+		processTimeout = 30000
+	}
+
+	workers, _ := strconv.Atoi(os.Getenv("MAILING_WORKERS"))
+	if workers < 1 {
+		workers = 4
+	}
+
+	batchSize, _ := strconv.Atoi(os.Getenv("MAILING_BATCH_SIZE"))
+	if batchSize < 1 {
+		batchSize = 20
+	}
+
+	lease, _ := strconv.Atoi(os.Getenv("MAILING_LEASE"))
+	if lease < 1 {
+		lease = 60000
+	}
+
+	maxAttempts, _ := strconv.Atoi(os.Getenv("MAILING_MAX_ATTEMPTS"))
+	if maxAttempts < 1 {
+		maxAttempts = 5
+	}
+
+	retryDelay, _ := strconv.Atoi(os.Getenv("MAILING_RETRY_DELAY"))
+	if retryDelay < 1 {
+		retryDelay = 60000
+	}
+
+	// a lease expiring mid-process would let another instance send the same mails
+	if lease <= processTimeout {
+		c.logger.Panic().Msg("MAILING_LEASE must exceed MAILING_PROCESS_TIMEOUT")
 	}
 
 	c.mailing.Delay = time.Duration(delay) * time.Millisecond
 	c.mailing.ProcessTimeout = time.Duration(processTimeout) * time.Millisecond
+	c.mailing.Workers = workers
+	c.mailing.BatchSize = batchSize
+	c.mailing.Lease = time.Duration(lease) * time.Millisecond
+	c.mailing.MaxAttempts = maxAttempts
+	c.mailing.RetryDelay = time.Duration(retryDelay) * time.Millisecond
 }
 
 func (c *appConfig) initSMTP() {
@@ -111,4 +147,7 @@ func (c *appConfig) initMaxRetries() {
 	if saveMailMaxRetries < 1 {
 		saveMailMaxRetries = 3
 	}
+
+	c.maxProcessRetries = processMaxRetries
+	c.maxSaveMailRetries = saveMailMaxRetries
 }
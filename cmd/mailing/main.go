@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/art-es/yet-another-service/internal/app/mailing"
@@ -27,7 +28,7 @@ func main() {
 
 	mailingService := mailing.NewService(config.mailing, processRetrier, saveMailRetrier, mailStorage, smtpService, logger)
 
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, os.Kill)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	if err := mailingService.Run(ctx); err != nil {
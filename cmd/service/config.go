@@ -5,9 +5,14 @@ import (
 	"net/url"
 	"os"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/art-es/yet-another-service/internal/core/locale"
 	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/worker"
+	"github.com/art-es/yet-another-service/internal/storage/s3"
 )
 
 const (
@@ -20,6 +25,11 @@ var availableAppEnv = []string{
 	appEnvLocal, appEnvStaging, appEnvProd,
 }
 
+const (
+	mediaStorageLocal = "local"
+	mediaStorageS3    = "s3"
+)
+
 type appConfig struct {
 	appEnv                    string
 	postgresURL               string
@@ -28,7 +38,48 @@ type appConfig struct {
 	userActivationURL         url.URL
 	userPasswordRecoveryURL   url.URL
 	articleCacheTimeout       time.Duration
+	articleCacheStaleTimeout  time.Duration
+	articleCacheEmptyTimeout  time.Duration
 	articleEnrichCacheTimeout time.Duration
+	articleCacheLockTimeout   time.Duration
+	articleLocalCacheSize     int64
+	articleLocalCacheTimeout  time.Duration
+	articleCacheWriter        worker.PoolConfig
+	shutdownTimeout           time.Duration
+	siteURL                   url.URL
+	feedSize                  int
+	feedCacheTimeout          time.Duration
+	sitemapFileSize           int
+	sitemapCacheTimeout       time.Duration
+	sitemapRefresher          worker.PoolConfig
+	timelineSize              int
+	timelineCacheTimeout      time.Duration
+	timelineFanOutThreshold   int
+	timelineFanOut            worker.PoolConfig
+	relatedSize               int
+	relatedCacheTimeout       time.Duration
+	relatedRefresher          worker.PoolConfig
+	commentEditWindow         time.Duration
+	reactionFlushInterval     time.Duration
+	viewFlushInterval         time.Duration
+	articleRevisionRetention  int
+	articleExcerptLength      int
+	articleDefaultLocale      string
+	articleImportMaxSize      int64
+	mediaURL                  url.URL
+	mediaStorage              string
+	mediaDir                  string
+	mediaS3                   s3.BlobStorageConfig
+	mediaMaxSize              int64
+	mediaThumbnailWidths      []int
+	mediaOrphanTTL            time.Duration
+	mediaCollectInterval      time.Duration
+	webhookTimeout            time.Duration
+	webhookDispatchInterval   time.Duration
+	webhookMaxAttempts        int
+	webhookRetryDelay         time.Duration
+	webhookMaxRetryDelay      time.Duration
+	webhookDisableAfter       int
 
 	logger log.Logger
 }
@@ -40,6 +91,24 @@ func getAppConfig(logger log.Logger) *appConfig {
 	c.initJWTSecret()
 	c.initUserActivationURL()
 	c.initUserPasswordRecoveryURL()
+	c.initCommentEditWindow()
+	c.initReactionFlushInterval()
+	c.initViewFlushInterval()
+	c.initArticleRevisionRetention()
+	c.initArticleExcerptLength()
+	c.initArticleDefaultLocale()
+	c.initArticleImportMaxSize()
+	c.initArticleCache()
+	c.initArticleLocalCache()
+	c.initArticleCacheWriter()
+	c.initShutdownTimeout()
+	c.initSiteURL()
+	c.initFeed()
+	c.initSitemap()
+	c.initTimeline()
+	c.initRelated()
+	c.initMedia()
+	c.initWebhook()
 	return c
 }
 
@@ -133,3 +202,401 @@ func (c *appConfig) initUserPasswordRecoveryURL() {
 
 	c.userPasswordRecoveryURL = *u
 }
+
+func (c *appConfig) initCommentEditWindow() {
+	seconds, _ := strconv.Atoi(os.Getenv("COMMENT_EDIT_WINDOW"))
+	if seconds < 1 {
+		seconds = 900
+	}
+
+	c.commentEditWindow = time.Duration(seconds) * time.Second
+}
+
+func (c *appConfig) initReactionFlushInterval() {
+	interval, _ := strconv.Atoi(os.Getenv("REACTION_FLUSH_INTERVAL"))
+	if interval < 100 {
+		interval = 5000
+	}
+
+	c.reactionFlushInterval = time.Duration(interval) * time.Millisecond
+}
+
+func (c *appConfig) initViewFlushInterval() {
+	interval, _ := strconv.Atoi(os.Getenv("VIEW_FLUSH_INTERVAL"))
+	if interval < 100 {
+		interval = 10000
+	}
+
+	c.viewFlushInterval = time.Duration(interval) * time.Millisecond
+}
+
+func (c *appConfig) initArticleRevisionRetention() {
+	retention, err := strconv.Atoi(os.Getenv("ARTICLE_REVISION_RETENTION"))
+	if err != nil || retention < 0 {
+		retention = 50
+	}
+
+	c.articleRevisionRetention = retention
+}
+
+func (c *appConfig) initArticleExcerptLength() {
+	length, _ := strconv.Atoi(os.Getenv("ARTICLE_EXCERPT_LENGTH"))
+	if length < 1 {
+		length = 280
+	}
+
+	c.articleExcerptLength = length
+}
+
+func (c *appConfig) initArticleDefaultLocale() {
+	tag := os.Getenv("ARTICLE_DEFAULT_LOCALE")
+	if tag == "" {
+		tag = "en"
+	}
+
+	canonical, err := locale.Canonical(tag)
+	if err != nil {
+		c.logger.Panic().Msg("ARTICLE_DEFAULT_LOCALE has invalid language tag")
+	}
+
+	c.articleDefaultLocale = canonical
+}
+
+func (c *appConfig) initArticleImportMaxSize() {
+	sizeMB, _ := strconv.Atoi(os.Getenv("ARTICLE_IMPORT_MAX_SIZE_MB"))
+	if sizeMB < 1 {
+		sizeMB = 50
+	}
+
+	c.articleImportMaxSize = int64(sizeMB) << 20
+}
+
+func (c *appConfig) initArticleCache() {
+	timeout, _ := strconv.Atoi(os.Getenv("ARTICLE_CACHE_TIMEOUT"))
+	if timeout < 1 {
+		timeout = 60
+	}
+
+	staleTimeout, err := strconv.Atoi(os.Getenv("ARTICLE_CACHE_STALE_TIMEOUT"))
+	if err != nil || staleTimeout < 0 {
+		staleTimeout = 300
+	}
+
+	// zero disables caching of empty pages
+	emptyTimeout, err := strconv.Atoi(os.Getenv("ARTICLE_CACHE_EMPTY_TIMEOUT"))
+	if err != nil || emptyTimeout < 0 {
+		emptyTimeout = 5
+	}
+
+	writeTimeout, _ := strconv.Atoi(os.Getenv("ARTICLE_CACHE_WRITE_TIMEOUT"))
+	if writeTimeout < 1 {
+		writeTimeout = 1000
+	}
+
+	lockTimeout, _ := strconv.Atoi(os.Getenv("ARTICLE_CACHE_LOCK_TIMEOUT"))
+	if lockTimeout < 1 {
+		lockTimeout = 3000
+	}
+
+	c.articleCacheTimeout = time.Duration(timeout) * time.Second
+	c.articleCacheStaleTimeout = time.Duration(staleTimeout) * time.Second
+	c.articleCacheEmptyTimeout = time.Duration(emptyTimeout) * time.Second
+	c.articleEnrichCacheTimeout = time.Duration(writeTimeout) * time.Millisecond
+	c.articleCacheLockTimeout = time.Duration(lockTimeout) * time.Millisecond
+}
+
+func (c *appConfig) initArticleLocalCache() {
+	sizeMB, _ := strconv.Atoi(os.Getenv("ARTICLE_LOCAL_CACHE_SIZE_MB"))
+	if sizeMB < 1 {
+		sizeMB = 64
+	}
+
+	timeout, _ := strconv.Atoi(os.Getenv("ARTICLE_LOCAL_CACHE_TIMEOUT"))
+	if timeout < 1 {
+		timeout = 5000
+	}
+
+	c.articleLocalCacheSize = int64(sizeMB) << 20
+	c.articleLocalCacheTimeout = time.Duration(timeout) * time.Millisecond
+}
+
+func (c *appConfig) initArticleCacheWriter() {
+	workers, _ := strconv.Atoi(os.Getenv("ARTICLE_CACHE_WRITERS"))
+	if workers < 1 {
+		workers = 4
+	}
+
+	queueSize, _ := strconv.Atoi(os.Getenv("ARTICLE_CACHE_QUEUE_SIZE"))
+	if queueSize < 1 {
+		queueSize = 256
+	}
+
+	policy := os.Getenv("ARTICLE_CACHE_QUEUE_POLICY")
+	if policy == "" {
+		policy = worker.PolicyDrop
+	}
+
+	if !slices.Contains(worker.Policies, policy) {
+		c.logger.Panic().
+			Str("value", policy).
+			Str("available_values", fmt.Sprintf("%v", worker.Policies)).
+			Msg("ARTICLE_CACHE_QUEUE_POLICY has unavailable value")
+	}
+
+	c.articleCacheWriter = worker.PoolConfig{
+		Name:      "article_cache_writer",
+		Workers:   workers,
+		QueueSize: queueSize,
+		Policy:    policy,
+	}
+}
+
+func (c *appConfig) initShutdownTimeout() {
+	seconds, _ := strconv.Atoi(os.Getenv("SHUTDOWN_TIMEOUT"))
+	if seconds < 1 {
+		seconds = 15
+	}
+
+	c.shutdownTimeout = time.Duration(seconds) * time.Second
+}
+
+func (c *appConfig) initSiteURL() {
+	rawURL := os.Getenv("SITE_URL")
+
+	if rawURL == "" {
+		if c.appEnv != appEnvLocal {
+			c.logger.Panic().Msg("SITE_URL is required")
+		}
+
+		rawURL = "http://127.0.0.1"
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		c.logger.Panic().Msg("SITE_URL has invalid URL")
+	}
+
+	c.siteURL = *u
+}
+
+func (c *appConfig) initFeed() {
+	size, _ := strconv.Atoi(os.Getenv("FEED_SIZE"))
+	if size < 1 || size > 100 {
+		size = 20
+	}
+
+	timeout, _ := strconv.Atoi(os.Getenv("FEED_CACHE_TIMEOUT"))
+	if timeout < 1 {
+		timeout = 600
+	}
+
+	c.feedSize = size
+	c.feedCacheTimeout = time.Duration(timeout) * time.Second
+}
+
+func (c *appConfig) initSitemap() {
+	// sitemaps.org allows at most 50 000 URLs and 50 MB in a sitemap file, files are split by elements,
+	// a URL with its alternate language links, so translated articles fit the limits too
+	size, _ := strconv.Atoi(os.Getenv("SITEMAP_FILE_SIZE"))
+	if size < 1 || size > 50000 {
+		size = 50000
+	}
+
+	timeout, _ := strconv.Atoi(os.Getenv("SITEMAP_CACHE_TIMEOUT"))
+	if timeout < 1 {
+		timeout = 86400
+	}
+
+	c.sitemapFileSize = size
+	c.sitemapCacheTimeout = time.Duration(timeout) * time.Second
+	// files are regenerated one by one, a refresh that doesn't fit into the queue waits for the cache timeout
+	c.sitemapRefresher = worker.PoolConfig{
+		Name:      "sitemap_refresher",
+		Workers:   1,
+		QueueSize: 1024,
+		Policy:    worker.PolicyDrop,
+	}
+}
+
+func (c *appConfig) initTimeline() {
+	size, _ := strconv.Atoi(os.Getenv("TIMELINE_SIZE"))
+	if size < 1 {
+		size = 800
+	}
+
+	timeout, _ := strconv.Atoi(os.Getenv("TIMELINE_CACHE_TIMEOUT"))
+	if timeout < 1 {
+		timeout = 604800
+	}
+
+	threshold, err := strconv.Atoi(os.Getenv("TIMELINE_FANOUT_THRESHOLD"))
+	if err != nil || threshold < 0 {
+		threshold = 10000
+	}
+
+	c.timelineSize = size
+	c.timelineCacheTimeout = time.Duration(timeout) * time.Second
+	c.timelineFanOutThreshold = threshold
+	// a dropped article would never show up in pushed timelines, so publishing waits for a free slot
+	c.timelineFanOut = worker.PoolConfig{
+		Name:      "timeline_fanout",
+		Workers:   4,
+		QueueSize: 1024,
+		Policy:    worker.PolicyBlock,
+	}
+}
+
+func (c *appConfig) initRelated() {
+	size, _ := strconv.Atoi(os.Getenv("RELATED_ARTICLES_SIZE"))
+	if size < 1 {
+		size = 5
+	}
+
+	timeout, _ := strconv.Atoi(os.Getenv("RELATED_ARTICLES_CACHE_TIMEOUT"))
+	if timeout < 1 {
+		timeout = 86400
+	}
+
+	c.relatedSize = size
+	c.relatedCacheTimeout = time.Duration(timeout) * time.Second
+	// a dropped refresh leaves the ranking missing, so it's ranked on the next read
+	c.relatedRefresher = worker.PoolConfig{
+		Name:      "related_refresher",
+		Workers:   2,
+		QueueSize: 1024,
+		Policy:    worker.PolicyDrop,
+	}
+}
+
+func (c *appConfig) initMedia() {
+	c.mediaURL = *c.siteURL.JoinPath("media")
+	if rawURL := os.Getenv("MEDIA_URL"); rawURL != "" {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			c.logger.Panic().Msg("MEDIA_URL has invalid URL")
+		}
+
+		c.mediaURL = *u
+	}
+
+	c.mediaStorage = os.Getenv("MEDIA_STORAGE")
+	if c.mediaStorage == "" {
+		c.mediaStorage = mediaStorageLocal
+	}
+
+	switch c.mediaStorage {
+	case mediaStorageLocal:
+		c.mediaDir = os.Getenv("MEDIA_DIR")
+		if c.mediaDir == "" {
+			c.mediaDir = "var/media"
+		}
+	case mediaStorageS3:
+		c.initMediaS3()
+	default:
+		c.logger.Panic().
+			Str("value", c.mediaStorage).
+			Msg("MEDIA_STORAGE has unavailable value")
+	}
+
+	sizeMB, _ := strconv.Atoi(os.Getenv("MEDIA_MAX_SIZE_MB"))
+	if sizeMB < 1 {
+		sizeMB = 10
+	}
+
+	c.mediaThumbnailWidths = []int{320, 960}
+	if rawWidths := os.Getenv("MEDIA_THUMBNAIL_WIDTHS"); rawWidths != "" {
+		c.mediaThumbnailWidths = nil
+
+		for _, rawWidth := range strings.Split(rawWidths, ",") {
+			width, _ := strconv.Atoi(strings.TrimSpace(rawWidth))
+			if width < 1 {
+				c.logger.Panic().
+					Str("value", rawWidths).
+					Msg("MEDIA_THUMBNAIL_WIDTHS has invalid width")
+			}
+
+			c.mediaThumbnailWidths = append(c.mediaThumbnailWidths, width)
+		}
+	}
+
+	ttl, _ := strconv.Atoi(os.Getenv("MEDIA_ORPHAN_TTL"))
+	if ttl < 1 {
+		ttl = 86400
+	}
+
+	interval, _ := strconv.Atoi(os.Getenv("MEDIA_COLLECT_INTERVAL"))
+	if interval < 1 {
+		interval = 3600
+	}
+
+	c.mediaMaxSize = int64(sizeMB) << 20
+	c.mediaOrphanTTL = time.Duration(ttl) * time.Second
+	c.mediaCollectInterval = time.Duration(interval) * time.Second
+}
+
+func (c *appConfig) initWebhook() {
+	timeout, _ := strconv.Atoi(os.Getenv("WEBHOOK_TIMEOUT"))
+	if timeout < 1 {
+		timeout = 10
+	}
+
+	interval, _ := strconv.Atoi(os.Getenv("WEBHOOK_DISPATCH_INTERVAL"))
+	if interval < 1 {
+		interval = 5
+	}
+
+	maxAttempts, _ := strconv.Atoi(os.Getenv("WEBHOOK_MAX_ATTEMPTS"))
+	if maxAttempts < 1 {
+		maxAttempts = 8
+	}
+
+	retryDelay, _ := strconv.Atoi(os.Getenv("WEBHOOK_RETRY_DELAY"))
+	if retryDelay < 1 {
+		retryDelay = 30
+	}
+
+	maxRetryDelay, _ := strconv.Atoi(os.Getenv("WEBHOOK_MAX_RETRY_DELAY"))
+	if maxRetryDelay < 1 {
+		maxRetryDelay = 21600
+	}
+
+	disableAfter, _ := strconv.Atoi(os.Getenv("WEBHOOK_DISABLE_AFTER"))
+	if disableAfter < 1 {
+		disableAfter = 15
+	}
+
+	c.webhookTimeout = time.Duration(timeout) * time.Second
+	c.webhookDispatchInterval = time.Duration(interval) * time.Second
+	c.webhookMaxAttempts = maxAttempts
+	c.webhookRetryDelay = time.Duration(retryDelay) * time.Second
+	c.webhookMaxRetryDelay = time.Duration(maxRetryDelay) * time.Second
+	c.webhookDisableAfter = disableAfter
+}
+
+func (c *appConfig) initMediaS3() {
+	rawEndpoint := os.Getenv("MEDIA_S3_ENDPOINT")
+	if rawEndpoint == "" {
+		c.logger.Panic().Msg("MEDIA_S3_ENDPOINT is required")
+	}
+
+	endpoint, err := url.Parse(rawEndpoint)
+	if err != nil {
+		c.logger.Panic().Msg("MEDIA_S3_ENDPOINT has invalid URL")
+	}
+
+	c.mediaS3 = s3.BlobStorageConfig{
+		Endpoint:  *endpoint,
+		Region:    os.Getenv("MEDIA_S3_REGION"),
+		Bucket:    os.Getenv("MEDIA_S3_BUCKET"),
+		AccessKey: os.Getenv("MEDIA_S3_ACCESS_KEY"),
+		SecretKey: os.Getenv("MEDIA_S3_SECRET_KEY"),
+	}
+
+	if c.mediaS3.Region == "" {
+		c.mediaS3.Region = "us-east-1"
+	}
+
+	if c.mediaS3.Bucket == "" {
+		c.logger.Panic().Msg("MEDIA_S3_BUCKET is required")
+	}
+}
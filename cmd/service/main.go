@@ -1,9 +1,33 @@
 package main
 
 import (
+	"context"
+	"expvar"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/art-es/yet-another-service/internal/app/blog/article"
+	"github.com/art-es/yet-another-service/internal/app/blog/author"
+	"github.com/art-es/yet-another-service/internal/app/blog/coauthor"
+	"github.com/art-es/yet-another-service/internal/app/blog/comment"
+	"github.com/art-es/yet-another-service/internal/app/blog/editor"
+	"github.com/art-es/yet-another-service/internal/app/blog/feed"
+	"github.com/art-es/yet-another-service/internal/app/blog/follow"
+	"github.com/art-es/yet-another-service/internal/app/blog/media"
+	"github.com/art-es/yet-another-service/internal/app/blog/moderation"
+	"github.com/art-es/yet-another-service/internal/app/blog/reaction"
+	readinglist "github.com/art-es/yet-another-service/internal/app/blog/reading_list"
+	"github.com/art-es/yet-another-service/internal/app/blog/related"
+	"github.com/art-es/yet-another-service/internal/app/blog/series"
+	"github.com/art-es/yet-another-service/internal/app/blog/sitemap"
+	"github.com/art-es/yet-another-service/internal/app/blog/timeline"
+	"github.com/art-es/yet-another-service/internal/app/blog/transfer"
+	"github.com/art-es/yet-another-service/internal/app/blog/view"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
 
 	"github.com/art-es/yet-another-service/internal/app/auth/login"
 	"github.com/art-es/yet-another-service/internal/app/auth/logout"
@@ -11,16 +35,27 @@ import (
 	authtoken "github.com/art-es/yet-another-service/internal/app/auth/token"
 	useractivation "github.com/art-es/yet-another-service/internal/app/user/activation"
 	passwordrecovery "github.com/art-es/yet-another-service/internal/app/user/password_recovery"
+	"github.com/art-es/yet-another-service/internal/app/user/role"
+	"github.com/art-es/yet-another-service/internal/app/webhook"
+	"github.com/art-es/yet-another-service/internal/core/lifecycle"
 	"github.com/art-es/yet-another-service/internal/core/mail"
 	"github.com/art-es/yet-another-service/internal/driver/bcrypt"
+	feedrenderer "github.com/art-es/yet-another-service/internal/driver/feed"
 	"github.com/art-es/yet-another-service/internal/driver/gin"
+	"github.com/art-es/yet-another-service/internal/driver/imaging"
 	"github.com/art-es/yet-another-service/internal/driver/jwt"
+	"github.com/art-es/yet-another-service/internal/driver/markdown"
 	"github.com/art-es/yet-another-service/internal/driver/postgres"
 	"github.com/art-es/yet-another-service/internal/driver/redis"
+	sitemaprenderer "github.com/art-es/yet-another-service/internal/driver/sitemap"
 	validatord "github.com/art-es/yet-another-service/internal/driver/validator"
+	webhookdriver "github.com/art-es/yet-another-service/internal/driver/webhook"
 	"github.com/art-es/yet-another-service/internal/driver/zerolog"
+	"github.com/art-es/yet-another-service/internal/storage/filesystem"
+	memstorage "github.com/art-es/yet-another-service/internal/storage/memory"
 	pqstorage "github.com/art-es/yet-another-service/internal/storage/postgres"
 	rdstorage "github.com/art-es/yet-another-service/internal/storage/redis"
+	"github.com/art-es/yet-another-service/internal/storage/s3"
 	useractivatetp "github.com/art-es/yet-another-service/internal/transport/handler/auth/activate"
 	forgotpasswordtp "github.com/art-es/yet-another-service/internal/transport/handler/auth/forgot_password"
 	logintp "github.com/art-es/yet-another-service/internal/transport/handler/auth/login"
@@ -28,19 +63,81 @@ import (
 	recoverpasswordtp "github.com/art-es/yet-another-service/internal/transport/handler/auth/recover_password"
 	refreshtokentp "github.com/art-es/yet-another-service/internal/transport/handler/auth/refresh"
 	signuptp "github.com/art-es/yet-another-service/internal/transport/handler/auth/signup"
+	articleauthorcreatetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/article_author_create"
+	articleauthordeletetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/article_author_delete"
+	articleauthorsgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/article_authors_get"
+	articlecreatetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/article_create"
+	articlegettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/article_get"
+	articleinvitationaccepttp "github.com/art-es/yet-another-service/internal/transport/handler/blog/article_invitation_accept"
+	articletranslationdeletetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/article_translation_delete"
+	articletranslationputtp "github.com/art-es/yet-another-service/internal/transport/handler/blog/article_translation_put"
+	articleupdatetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/article_update"
+	articlesexporttp "github.com/art-es/yet-another-service/internal/transport/handler/blog/articles_export"
 	articlesgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/articles_get"
+	articlesimporttp "github.com/art-es/yet-another-service/internal/transport/handler/blog/articles_import"
+	authorgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/author_get"
+	commentcreatetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/comment_create"
+	commentdeletetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/comment_delete"
+	commentupdatetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/comment_update"
+	commentsgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/comments_get"
+	feedgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/feed_get"
+	followdeletetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/follow_delete"
+	followputtp "github.com/art-es/yet-another-service/internal/transport/handler/blog/follow_put"
+	followsgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/follows_get"
+	mediagettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/media_get"
+	mediauploadtp "github.com/art-es/yet-another-service/internal/transport/handler/blog/media_upload"
+	moderationactioncreatetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/moderation_action_create"
+	moderationactionsgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/moderation_actions_get"
+	moderationreportputtp "github.com/art-es/yet-another-service/internal/transport/handler/blog/moderation_report_put"
+	moderationreportsgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/moderation_reports_get"
+	reactiondeletetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/reaction_delete"
+	reactionputtp "github.com/art-es/yet-another-service/internal/transport/handler/blog/reaction_put"
+	readinglistarticledeletetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/reading_list_article_delete"
+	readinglistarticleputtp "github.com/art-es/yet-another-service/internal/transport/handler/blog/reading_list_article_put"
+	readinglistcreatetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/reading_list_create"
+	readinglistgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/reading_list_get"
+	readinglistorderputtp "github.com/art-es/yet-another-service/internal/transport/handler/blog/reading_list_order_put"
+	readinglistsharingputtp "github.com/art-es/yet-another-service/internal/transport/handler/blog/reading_list_sharing_put"
+	readinglistsgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/reading_lists_get"
+	relatedarticlesgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/related_articles_get"
+	reportcreatetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/report_create"
+	revisionrestoretp "github.com/art-es/yet-another-service/internal/transport/handler/blog/revision_restore"
+	revisionsdifftp "github.com/art-es/yet-another-service/internal/transport/handler/blog/revisions_diff"
+	revisionsgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/revisions_get"
+	seriesarticledeletetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/series_article_delete"
+	seriesarticleputtp "github.com/art-es/yet-another-service/internal/transport/handler/blog/series_article_put"
+	seriescreatetp "github.com/art-es/yet-another-service/internal/transport/handler/blog/series_create"
+	seriesgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/series_get"
+	seriesorderputtp "github.com/art-es/yet-another-service/internal/transport/handler/blog/series_order_put"
+	sitemapgettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/sitemap_get"
+	timelinegettp "github.com/art-es/yet-another-service/internal/transport/handler/blog/timeline_get"
+	debugvarstp "github.com/art-es/yet-another-service/internal/transport/handler/debug/vars"
+	webhookcreatetp "github.com/art-es/yet-another-service/internal/transport/handler/webhook/webhook_create"
+	webhookdeletetp "github.com/art-es/yet-another-service/internal/transport/handler/webhook/webhook_delete"
+	webhookdeliveriesgettp "github.com/art-es/yet-another-service/internal/transport/handler/webhook/webhook_deliveries_get"
+	webhookdeliveryredelivertp "github.com/art-es/yet-another-service/internal/transport/handler/webhook/webhook_delivery_redeliver"
+	webhookupdatetp "github.com/art-es/yet-another-service/internal/transport/handler/webhook/webhook_update"
+	webhooksgettp "github.com/art-es/yet-another-service/internal/transport/handler/webhook/webhooks_get"
+	"github.com/art-es/yet-another-service/internal/transport/middleware/authorized"
 )
 
 func main() {
 	logger := zerolog.NewLogger()
 	config := getAppConfig(logger)
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	// Drivers
 	pqDB := postgres.Connect(config.postgresURL)
 	rdDB := redis.Connect(config.redisAddr)
 	validator := validatord.New()
 	hashService := bcrypt.NewHashService()
 	jwtService := jwt.NewService(config.jwtSecret, logger)
+	markdownRenderer := markdown.NewRenderer()
+	feedRenderer := feedrenderer.NewRenderer()
+	sitemapRenderer := sitemaprenderer.NewRenderer()
+	imageProcessor := imaging.NewProcessor(config.mediaThumbnailWidths)
 
 	// Data Layer
 	userStorage := pqstorage.NewUserStorage(pqDB)
@@ -50,22 +147,85 @@ func main() {
 	authTokenBlackListStorage := rdstorage.NewAuthTokenBlackListStorage(rdDB)
 	articleStorage := pqstorage.NewArticleStorage(pqDB)
 	articleAuthorStorage := pqstorage.NewArticleAuthorStorage(pqDB)
-	articleCache := rdstorage.NewArticleCache(rdDB, logger, config.articleCacheTimeout, config.articleEnrichCacheTimeout)
+	articleRevisionStorage := pqstorage.NewArticleRevisionStorage(pqDB)
+	commentStorage := pqstorage.NewCommentStorage(pqDB)
+	articleReactionStorage := pqstorage.NewArticleReactionStorage(pqDB)
+	articleReactionCounter := rdstorage.NewArticleReactionCounter(rdDB)
+	articleViewStorage := pqstorage.NewArticleViewStorage(pqDB)
+	articleViewCounter := rdstorage.NewArticleViewCounter(rdDB, logger)
+	followStorage := pqstorage.NewFollowStorage(pqDB)
+	readingListStorage := pqstorage.NewReadingListStorage(pqDB)
+	mediaStorage := pqstorage.NewMediaStorage(pqDB)
+	mediaBlobStorage := newMediaBlobStorage(config)
+	moderationStorage := pqstorage.NewModerationStorage(pqDB)
+	seriesStorage := pqstorage.NewSeriesStorage(pqDB)
+	articleTranslationStorage := pqstorage.NewArticleTranslationStorage(pqDB)
+	webhookStorage := pqstorage.NewWebhookStorage(pqDB)
+	articleRedisCache := rdstorage.NewArticleCache(rdDB, logger, rdstorage.ArticleCacheConfig{
+		Timeout:       config.articleCacheTimeout,
+		StaleTimeout:  config.articleCacheStaleTimeout,
+		EmptyTimeout:  config.articleCacheEmptyTimeout,
+		EnrichTimeout: config.articleEnrichCacheTimeout,
+		LockTimeout:   config.articleCacheLockTimeout,
+		Writer:        config.articleCacheWriter,
+	})
+	feedCache := rdstorage.NewFeedCache(rdDB, config.feedCacheTimeout)
+	sitemapCache := rdstorage.NewSitemapCache(rdDB, config.sitemapCacheTimeout)
+	timelineCache := rdstorage.NewTimelineCache(rdDB, config.timelineSize, config.timelineCacheTimeout)
+	relatedArticlesCache := rdstorage.NewRelatedArticlesCache(rdDB, config.relatedCacheTimeout)
+	articleCacheInvalidations := rdstorage.NewArticleCacheInvalidations(rdDB, logger)
+	articleCache := memstorage.NewArticleCache(articleRedisCache, articleCacheInvalidations, logger, config.articleLocalCacheSize, config.articleLocalCacheTimeout)
 
 	// Mailers
 	userActivationMailer := mail.NewUserActivationMailer(mailStorage)
 	passwordRecoveryMailer := mail.NewPasswordRecoveryMailer(mailStorage)
+	moderationMailer := mail.NewModerationMailer(mailStorage)
+	articleInvitationMailer := mail.NewArticleInvitationMailer(mailStorage)
+
+	// Webhook sender
+	webhookSender := webhookdriver.NewSender(newWebhookClient(config))
 
 	// App Layer
-	userActivationService := useractivation.NewService(config.userActivationURL, userActivationStorage, userStorage, userActivationMailer)
+	webhookService := webhook.NewService(webhook.Config{
+		DispatchInterval: config.webhookDispatchInterval,
+		Lease:            config.webhookTimeout + time.Minute,
+		MaxAttempts:      config.webhookMaxAttempts,
+		RetryDelay:       config.webhookRetryDelay,
+		MaxRetryDelay:    config.webhookMaxRetryDelay,
+		DisableAfter:     config.webhookDisableAfter,
+	}, config.siteURL, webhookStorage, userStorage, webhookSender, logger)
+	userActivationService := useractivation.NewService(config.userActivationURL, userActivationStorage, userStorage, userActivationMailer, webhookService, logger)
 	passwordRecoveryService := passwordrecovery.NewService(config.userPasswordRecoveryURL, userStorage, passwordRecoveryStorage, passwordRecoveryMailer, hashService)
 	authTokenService := authtoken.NewService(jwtService, authTokenBlackListStorage)
+	roleService := role.NewService(userStorage)
 	signupService := signup.NewService(hashService, userStorage, userActivationService)
 	loginService := login.NewService(userStorage, hashService, authTokenService)
 	logoutService := logout.NewService(authTokenService, logger)
-	articleService := article.NewService(articleStorage, articleCache, articleAuthorStorage, logger)
+	reactionService := reaction.NewService(config.reactionFlushInterval, articleStorage, articleReactionStorage, articleReactionCounter, logger)
+	viewService := view.NewService(config.viewFlushInterval, articleViewStorage, articleViewCounter, logger)
+	articleService := article.NewService(config.siteURL, articleStorage, articleCache, articleAuthorStorage, seriesStorage, articleTranslationStorage, userStorage, reactionService, viewService, logger)
+	sitemapService := sitemap.NewService(config.siteURL, config.sitemapFileSize, config.sitemapRefresher, articleStorage, sitemapRenderer, sitemapCache, logger)
+	timelineService := timeline.NewService(config.timelineSize, config.timelineFanOutThreshold, config.timelineFanOut, articleService, articleStorage, followStorage, timelineCache, logger)
+	mediaService := media.NewService(media.Config{
+		URL:             config.mediaURL,
+		MaxSize:         config.mediaMaxSize,
+		OrphanTTL:       config.mediaOrphanTTL,
+		CollectInterval: config.mediaCollectInterval,
+	}, mediaStorage, mediaBlobStorage, imageProcessor, logger)
+	relatedService := related.NewService(config.relatedSize, config.relatedRefresher, articleStorage, articleService, relatedArticlesCache, logger)
+	editorService := editor.NewService(config.articleRevisionRetention, config.articleExcerptLength, config.articleDefaultLocale, articleStorage, articleAuthorStorage, articleRevisionStorage, articleTranslationStorage, markdownRenderer, articleCache, feedCache, sitemapService, timelineService, relatedService, mediaService, webhookService, logger)
+	authorService := author.NewService(articleAuthorStorage)
+	followService := follow.NewService(articleAuthorStorage, followStorage, timelineService, logger)
+	feedService := feed.NewService(config.siteURL, config.feedSize, articleService, authorService, feedRenderer, feedCache, logger)
+	readingListService := readinglist.NewService(config.siteURL, readingListStorage, articleStorage, articleService)
+	commentService := comment.NewService(config.commentEditWindow, articleStorage, commentStorage, articleAuthorStorage, webhookService, logger)
+	transferService := transfer.NewService(articleStorage, userStorage, editorService)
+	coAuthorService := coauthor.NewService(config.siteURL, articleAuthorStorage, articleStorage, userStorage, articleCache, articleInvitationMailer, logger)
+	seriesService := series.NewService(seriesStorage, articleStorage, articleAuthorStorage, articleService, articleCache, logger)
+	moderationService := moderation.NewService(config.siteURL, moderationStorage, articleStorage, userStorage, articleCache, feedCache, sitemapService, moderationMailer, logger)
 
 	// Transport Layer
+	authorizedMiddleware := authorized.NewMiddleware(authTokenService, logger)
 	signupHandler := signuptp.NewHandler(signupService, logger, validator)
 	userActivateHandler := useractivatetp.NewHandler(userActivationService, logger, validator)
 	loginHandler := logintp.NewHandler(loginService, logger, validator)
@@ -74,8 +234,63 @@ func main() {
 	forgotPasswordHandler := forgotpasswordtp.NewHandler(passwordRecoveryService, logger, validator)
 	recoverPasswordHandler := recoverpasswordtp.NewHandler(passwordRecoveryService, logger, validator)
 	articlesGetHandler := articlesgettp.NewHandler(articleService, logger)
+	articleGetHandler := articlegettp.NewHandler(articleService, logger)
+	articleCreateHandler := articlecreatetp.NewHandler(editorService, logger, validator)
+	articleUpdateHandler := articleupdatetp.NewHandler(editorService, logger, validator)
+	articleTranslationPutHandler := articletranslationputtp.NewHandler(editorService, logger, validator)
+	articleTranslationDeleteHandler := articletranslationdeletetp.NewHandler(editorService, logger)
+	relatedArticlesGetHandler := relatedarticlesgettp.NewHandler(relatedService, logger)
+	revisionsGetHandler := revisionsgettp.NewHandler(editorService, logger)
+	revisionsDiffHandler := revisionsdifftp.NewHandler(editorService, logger)
+	revisionRestoreHandler := revisionrestoretp.NewHandler(editorService, logger)
+	authorGetHandler := authorgettp.NewHandler(authorService, logger)
+	feedGetHandler := feedgettp.NewHandler(feedService, logger)
+	sitemapGetHandler := sitemapgettp.NewHandler(sitemapService, logger)
+	followPutHandler := followputtp.NewHandler(followService, logger)
+	followDeleteHandler := followdeletetp.NewHandler(followService, logger)
+	followersGetHandler := followsgettp.NewHandler(dto.FollowListFollowers, followService, logger)
+	followingGetHandler := followsgettp.NewHandler(dto.FollowListFollowing, followService, logger)
+	timelineGetHandler := timelinegettp.NewHandler(timelineService, logger)
+	readingListsGetHandler := readinglistsgettp.NewHandler(readingListService, logger)
+	readingListCreateHandler := readinglistcreatetp.NewHandler(readingListService, logger, validator)
+	readingListGetHandler := readinglistgettp.NewHandler(readingListService, logger, validator)
+	readingListArticlePutHandler := readinglistarticleputtp.NewHandler(readingListService, logger, validator)
+	readingListArticleDeleteHandler := readinglistarticledeletetp.NewHandler(readingListService, logger, validator)
+	readingListOrderPutHandler := readinglistorderputtp.NewHandler(readingListService, logger, validator)
+	readingListSharingPutHandler := readinglistsharingputtp.NewHandler(readingListService, logger, validator)
+	mediaUploadHandler := mediauploadtp.NewHandler(config.mediaMaxSize, mediaService, logger)
+	mediaGetHandler := mediagettp.NewHandler(mediaService, logger, validator)
+	commentsGetHandler := commentsgettp.NewHandler(commentService, logger)
+	commentCreateHandler := commentcreatetp.NewHandler(commentService, logger, validator)
+	commentUpdateHandler := commentupdatetp.NewHandler(commentService, logger, validator)
+	commentDeleteHandler := commentdeletetp.NewHandler(commentService, logger, validator)
+	reactionPutHandler := reactionputtp.NewHandler(reactionService, logger, validator)
+	reactionDeleteHandler := reactiondeletetp.NewHandler(reactionService, logger, validator)
+	articlesImportHandler := articlesimporttp.NewHandler(config.articleImportMaxSize, transferService, logger)
+	articlesExportHandler := articlesexporttp.NewHandler(transferService, logger)
+	reportCreateHandler := reportcreatetp.NewHandler(moderationService, logger, validator)
+	moderationReportsGetHandler := moderationreportsgettp.NewHandler(moderationService, logger)
+	moderationReportPutHandler := moderationreportputtp.NewHandler(moderationService, logger, validator)
+	moderationActionCreateHandler := moderationactioncreatetp.NewHandler(moderationService, logger, validator)
+	moderationActionsGetHandler := moderationactionsgettp.NewHandler(moderationService, logger, validator)
+	webhooksGetHandler := webhooksgettp.NewHandler(webhookService, logger)
+	webhookCreateHandler := webhookcreatetp.NewHandler(webhookService, logger, validator)
+	webhookUpdateHandler := webhookupdatetp.NewHandler(webhookService, logger, validator)
+	webhookDeleteHandler := webhookdeletetp.NewHandler(webhookService, logger, validator)
+	webhookDeliveriesGetHandler := webhookdeliveriesgettp.NewHandler(webhookService, logger, validator)
+	webhookDeliveryRedeliverHandler := webhookdeliveryredelivertp.NewHandler(webhookService, logger, validator)
+	articleAuthorsGetHandler := articleauthorsgettp.NewHandler(coAuthorService, logger)
+	articleAuthorCreateHandler := articleauthorcreatetp.NewHandler(coAuthorService, logger, validator)
+	articleAuthorDeleteHandler := articleauthordeletetp.NewHandler(coAuthorService, logger)
+	articleInvitationAcceptHandler := articleinvitationaccepttp.NewHandler(coAuthorService, logger, validator)
+	seriesCreateHandler := seriescreatetp.NewHandler(seriesService, logger, validator)
+	seriesGetHandler := seriesgettp.NewHandler(seriesService, logger, validator)
+	seriesArticlePutHandler := seriesarticleputtp.NewHandler(seriesService, logger, validator)
+	seriesArticleDeleteHandler := seriesarticledeletetp.NewHandler(seriesService, logger, validator)
+	seriesOrderPutHandler := seriesorderputtp.NewHandler(seriesService, logger, validator)
+	debugVarsHandler := debugvarstp.NewHandler(roleService, logger)
 
-	router := gin.NewRouter()
+	router := gin.NewRouter(logger)
 	router.Register(http.MethodPost, "/auth/signup", signupHandler.Handle)
 	router.Register(http.MethodGet, "/auth/activate", userActivateHandler.Handle)
 	router.Register(http.MethodPost, "/auth/login", loginHandler.Handle)
@@ -83,9 +298,127 @@ func main() {
 	router.Register(http.MethodPost, "/auth/refresh", refreshHandler.Handle)
 	router.Register(http.MethodPost, "/auth/forgot-password", forgotPasswordHandler.Handle)
 	router.Register(http.MethodPost, "/auth/recover-password", recoverPasswordHandler.Handle)
-	router.Register(http.MethodGet, "/articles", articlesGetHandler.Handle)
+	router.Register(http.MethodGet, "/articles", authorizedMiddleware.WrapOptional(articlesGetHandler.Handle))
+	router.Register(http.MethodPost, "/articles", authorizedMiddleware.Wrap(articleCreateHandler.Handle))
+	router.Register(http.MethodGet, "/articles/:slug", authorizedMiddleware.WrapOptional(articleGetHandler.Handle))
+	router.Register(http.MethodPut, "/articles/:slug", authorizedMiddleware.Wrap(articleUpdateHandler.Handle))
+	router.Register(http.MethodGet, "/articles/:slug/related", authorizedMiddleware.WrapOptional(relatedArticlesGetHandler.Handle))
+	router.Register(http.MethodGet, "/articles/:slug/revisions", authorizedMiddleware.Wrap(revisionsGetHandler.Handle))
+	router.Register(http.MethodPut, "/articles/:slug/translations/:locale", authorizedMiddleware.Wrap(articleTranslationPutHandler.Handle))
+	router.Register(http.MethodDelete, "/articles/:slug/translations/:locale", authorizedMiddleware.Wrap(articleTranslationDeleteHandler.Handle))
+	router.Register(http.MethodGet, "/articles/:slug/revisions/diff", authorizedMiddleware.Wrap(revisionsDiffHandler.Handle))
+	router.Register(http.MethodPost, "/articles/:slug/revisions/:number/restore", authorizedMiddleware.Wrap(revisionRestoreHandler.Handle))
+	router.Register(http.MethodGet, "/authors/:nickname", authorGetHandler.Handle)
+	router.Register(http.MethodGet, "/authors/:nickname/articles", authorizedMiddleware.WrapOptional(articlesGetHandler.Handle))
+	router.Register(http.MethodPut, "/authors/:nickname/follow", authorizedMiddleware.Wrap(followPutHandler.Handle))
+	router.Register(http.MethodDelete, "/authors/:nickname/follow", authorizedMiddleware.Wrap(followDeleteHandler.Handle))
+	router.Register(http.MethodGet, "/authors/:nickname/followers", followersGetHandler.Handle)
+	router.Register(http.MethodGet, "/authors/:nickname/following", followingGetHandler.Handle)
+	router.Register(http.MethodGet, "/feed", authorizedMiddleware.Wrap(timelineGetHandler.Handle))
+	router.Register(http.MethodGet, "/me/reading-lists", authorizedMiddleware.Wrap(readingListsGetHandler.Handle))
+	router.Register(http.MethodPost, "/me/reading-lists", authorizedMiddleware.Wrap(readingListCreateHandler.Handle))
+	router.Register(http.MethodGet, "/me/reading-lists/:id", authorizedMiddleware.Wrap(readingListGetHandler.Handle))
+	router.Register(http.MethodPut, "/me/reading-lists/:id/articles/:slug", authorizedMiddleware.Wrap(readingListArticlePutHandler.Handle))
+	router.Register(http.MethodDelete, "/me/reading-lists/:id/articles/:slug", authorizedMiddleware.Wrap(readingListArticleDeleteHandler.Handle))
+	router.Register(http.MethodPut, "/me/reading-lists/:id/order", authorizedMiddleware.Wrap(readingListOrderPutHandler.Handle))
+	router.Register(http.MethodPut, "/me/reading-lists/:id/sharing", authorizedMiddleware.Wrap(readingListSharingPutHandler.Handle))
+	router.Register(http.MethodGet, "/reading-lists/shared/:token", authorizedMiddleware.WrapOptional(readingListGetHandler.Handle))
+	for _, format := range []string{dto.FeedFormatRSS, dto.FeedFormatAtom, dto.FeedFormatJSON} {
+		router.Register(http.MethodGet, "/feed."+format, feedGetHandler.Handle)
+		router.Register(http.MethodGet, "/authors/:nickname/feed."+format, feedGetHandler.Handle)
+		router.Register(http.MethodGet, "/tags/:tag/feed."+format, feedGetHandler.Handle)
+	}
+	router.Register(http.MethodGet, "/sitemap.xml", sitemapGetHandler.Handle)
+	router.Register(http.MethodGet, "/sitemaps/:file", sitemapGetHandler.Handle)
+	router.Register(http.MethodPost, "/media", authorizedMiddleware.Wrap(mediaUploadHandler.Handle))
+	router.Register(http.MethodGet, "/media/:id/:name", mediaGetHandler.Handle)
+	router.Register(http.MethodGet, "/articles/:slug/comments", commentsGetHandler.Handle)
+	router.Register(http.MethodPost, "/articles/:slug/comments", authorizedMiddleware.Wrap(commentCreateHandler.Handle))
+	router.Register(http.MethodPut, "/comments/:id", authorizedMiddleware.Wrap(commentUpdateHandler.Handle))
+	router.Register(http.MethodDelete, "/comments/:id", authorizedMiddleware.Wrap(commentDeleteHandler.Handle))
+	router.Register(http.MethodPut, "/articles/:slug/reactions/:kind", authorizedMiddleware.Wrap(reactionPutHandler.Handle))
+	router.Register(http.MethodDelete, "/articles/:slug/reactions/:kind", authorizedMiddleware.Wrap(reactionDeleteHandler.Handle))
+	router.Register(http.MethodPost, "/admin/articles/import", authorizedMiddleware.Wrap(articlesImportHandler.Handle))
+	router.Register(http.MethodGet, "/admin/articles/export", authorizedMiddleware.Wrap(articlesExportHandler.Handle))
+	router.Register(http.MethodGet, "/admin/webhooks", authorizedMiddleware.Wrap(webhooksGetHandler.Handle))
+	router.Register(http.MethodPost, "/admin/webhooks", authorizedMiddleware.Wrap(webhookCreateHandler.Handle))
+	router.Register(http.MethodPut, "/admin/webhooks/:id", authorizedMiddleware.Wrap(webhookUpdateHandler.Handle))
+	router.Register(http.MethodDelete, "/admin/webhooks/:id", authorizedMiddleware.Wrap(webhookDeleteHandler.Handle))
+	router.Register(http.MethodGet, "/admin/webhooks/:id/deliveries", authorizedMiddleware.Wrap(webhookDeliveriesGetHandler.Handle))
+	router.Register(http.MethodPost, "/admin/webhooks/:id/deliveries/:deliveryId/redeliver", authorizedMiddleware.Wrap(webhookDeliveryRedeliverHandler.Handle))
+	router.Register(http.MethodPost, "/reports", authorizedMiddleware.Wrap(reportCreateHandler.Handle))
+	router.Register(http.MethodGet, "/moderation/reports", authorizedMiddleware.Wrap(moderationReportsGetHandler.Handle))
+	router.Register(http.MethodPut, "/moderation/reports/:id/state", authorizedMiddleware.Wrap(moderationReportPutHandler.Handle))
+	router.Register(http.MethodGet, "/moderation/actions", authorizedMiddleware.Wrap(moderationActionsGetHandler.Handle))
+	router.Register(http.MethodPost, "/moderation/actions", authorizedMiddleware.Wrap(moderationActionCreateHandler.Handle))
+	router.Register(http.MethodGet, "/articles/:slug/authors", authorizedMiddleware.Wrap(articleAuthorsGetHandler.Handle))
+	router.Register(http.MethodPost, "/articles/:slug/authors", authorizedMiddleware.Wrap(articleAuthorCreateHandler.Handle))
+	router.Register(http.MethodDelete, "/articles/:slug/authors/:nickname", authorizedMiddleware.Wrap(articleAuthorDeleteHandler.Handle))
+	router.Register(http.MethodPost, "/article-invitations/accept", authorizedMiddleware.Wrap(articleInvitationAcceptHandler.Handle))
+	router.Register(http.MethodPost, "/series", authorizedMiddleware.Wrap(seriesCreateHandler.Handle))
+	router.Register(http.MethodGet, "/series/:id", authorizedMiddleware.WrapOptional(seriesGetHandler.Handle))
+	router.Register(http.MethodPut, "/series/:id/articles/:slug", authorizedMiddleware.Wrap(seriesArticlePutHandler.Handle))
+	router.Register(http.MethodDelete, "/series/:id/articles/:slug", authorizedMiddleware.Wrap(seriesArticleDeleteHandler.Handle))
+	router.Register(http.MethodPut, "/series/:id/order", authorizedMiddleware.Wrap(seriesOrderPutHandler.Handle))
+	router.Register(http.MethodGet, "/debug/vars", authorizedMiddleware.Wrap(debugVarsHandler.Handle))
+
+	// Metrics
+	expvar.Publish("article_cache", expvar.Func(func() any {
+		return map[string]any{
+			"local":  articleCache.Stats(),
+			"redis":  articleRedisCache.Stats(),
+			"writer": articleRedisCache.WriterStats(),
+		}
+	}))
+
+	// Lifecycle, components are stopped in reverse order
+	lifecycleManager := lifecycle.NewManager(logger)
+	lifecycleManager.Add("article cache writer", articleRedisCache)
+	lifecycleManager.Add("article refresher", articleService)
+	lifecycleManager.Add("article cache invalidator", lifecycle.NewRunner(articleCache.RunInvalidator))
+	lifecycleManager.Add("reaction flusher", lifecycle.NewRunner(reactionService.RunFlusher))
+	lifecycleManager.Add("view flusher", lifecycle.NewRunner(viewService.RunFlusher))
+	lifecycleManager.Add("sitemap refresher", sitemapService)
+	lifecycleManager.Add("timeline fan-out", timelineService)
+	lifecycleManager.Add("related refresher", relatedService)
+	lifecycleManager.Add("media collector", lifecycle.NewRunner(mediaService.RunCollector))
+	lifecycleManager.Add("webhook dispatcher", lifecycle.NewRunner(webhookService.RunDispatcher))
+	lifecycleManager.Add("router", router)
+
+	if err := lifecycleManager.Start(ctx); err != nil {
+		logger.Panic().Err(err).Msg("start service error")
+	}
+
+	<-ctx.Done()
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), config.shutdownTimeout)
+	defer cancel()
+
+	if err := lifecycleManager.Stop(stopCtx); err != nil {
+		logger.Error().Err(err).Msg("stop service error")
+	}
+}
+
+type mediaBlobStorage interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	Get(ctx context.Context, key string) (*dto.Blob, error)
+	Delete(ctx context.Context, key string) error
+}
+
+func newMediaBlobStorage(config *appConfig) mediaBlobStorage {
+	if config.mediaStorage == mediaStorageS3 {
+		return s3.NewBlobStorage(config.mediaS3, &http.Client{Timeout: time.Minute})
+	}
+
+	return filesystem.NewBlobStorage(config.mediaDir)
+}
 
-	if err := router.Run(); err != nil {
-		logger.Panic().Err(err).Msg("router run error")
+// newWebhookClient doesn't follow redirects, a receiver answering with one is treated as failed.
+func newWebhookClient(config *appConfig) *http.Client {
+	return &http.Client{
+		Timeout: config.webhookTimeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
 	}
 }
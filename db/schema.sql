@@ -1,10 +1,22 @@
 -- enables function gen_random_uuid()
 CREATE EXTENSION IF NOT EXISTS "pgcrypto";
+-- enables function similarity() and trigram indexes, used to find related articles
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
 
 CREATE TABLE users (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     name VARCHAR(255) NOT NULL,
+    -- public handle used in author URLs
+    nickname VARCHAR(32) UNIQUE NOT NULL,
     email VARCHAR(255) UNIQUE NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+    bio TEXT NOT NULL DEFAULT '',
+    avatar_url VARCHAR(2048),
+    -- kept in sync with follows, see FollowStorage
+    followers_count INT NOT NULL DEFAULT 0,
+    following_count INT NOT NULL DEFAULT 0,
+    -- e.g. moderator, granted by operators
+    roles TEXT[] NOT NULL DEFAULT '{}',
     activated_at TIMESTAMP WITH TIME ZONE,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE
@@ -28,5 +40,265 @@ CREATE TABLE mails (
     subject TEXT NOT NULL,
     content TEXT NOT NULL,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    mailed_at TIMESTAMP WITH TIME ZONE
+    mailed_at TIMESTAMP WITH TIME ZONE,
+    -- attempts counts claims, a claim leases the mail until locked_until so other workers skip it
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    locked_until TIMESTAMP WITH TIME ZONE
 );
+
+CREATE INDEX mails_pending_idx ON mails (created_at) WHERE mailed_at IS NULL;
+
+CREATE TABLE articles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slug VARCHAR(255) UNIQUE NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content TEXT NOT NULL,
+    -- sanitized HTML and table of contents rendered from the markdown content on save
+    content_html TEXT NOT NULL DEFAULT '',
+    toc JSONB NOT NULL DEFAULT '[]',
+    -- plain text summary and statistics of the rendered content, computed on save
+    excerpt TEXT NOT NULL DEFAULT '',
+    word_count INTEGER NOT NULL DEFAULT 0,
+    reading_time INTEGER NOT NULL DEFAULT 0,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    seo_meta_description VARCHAR(300) NOT NULL DEFAULT '',
+    seo_og_image_url VARCHAR(2048) NOT NULL DEFAULT '',
+    -- empty unless the article is canonical elsewhere
+    seo_canonical_url VARCHAR(2048) NOT NULL DEFAULT '',
+    -- public, users or members, content of gated articles is truncated for readers without access
+    visibility VARCHAR(16) NOT NULL DEFAULT 'public',
+    -- BCP 47 language tag of the content, translations are in article_translations
+    locale VARCHAR(35) NOT NULL DEFAULT 'en',
+    views_count BIGINT NOT NULL DEFAULT 0,
+    -- set by moderators, hidden articles are left out of public reads
+    hidden_at TIMESTAMP WITH TIME ZONE,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+
+-- keysets of the article list sort orders
+CREATE INDEX articles_created_at_id_idx ON articles (created_at, id);
+CREATE INDEX articles_title_id_idx ON articles (title, id);
+CREATE INDEX articles_author_id_created_at_id_idx ON articles (author_id, created_at, id);
+-- candidates of related articles
+CREATE INDEX articles_tags_idx ON articles USING GIN (tags);
+CREATE INDEX articles_title_trgm_idx ON articles USING GIN (title gin_trgm_ops);
+CREATE INDEX articles_excerpt_trgm_idx ON articles USING GIN (excerpt gin_trgm_ops);
+
+CREATE TABLE follows (
+    follower_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (follower_id, author_id)
+);
+
+-- keysets of the follower and following lists
+CREATE INDEX follows_author_id_created_at_idx ON follows (author_id, created_at, follower_id);
+CREATE INDEX follows_follower_id_created_at_idx ON follows (follower_id, created_at, author_id);
+
+CREATE TABLE comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES comments(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    -- set by moderators, hidden comments are shown like deleted ones
+    hidden_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX comments_article_id_parent_id_idx ON comments (article_id, parent_id, created_at);
+
+CREATE TABLE article_reactions (
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (article_id, user_id, kind)
+);
+
+-- counters are flushed in batches from redis, see ArticleReactionCounter
+CREATE TABLE article_reaction_counters (
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    count BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (article_id, kind)
+);
+
+CREATE TABLE reading_lists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    -- lists are private unless shared by the link with the token
+    share_token VARCHAR(64) UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX reading_lists_user_id_idx ON reading_lists (user_id, created_at);
+CREATE UNIQUE INDEX reading_lists_default_idx ON reading_lists (user_id) WHERE is_default;
+
+-- items go away together with their articles
+CREATE TABLE reading_list_items (
+    list_id UUID NOT NULL REFERENCES reading_lists(id) ON DELETE CASCADE,
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (list_id, article_id)
+);
+
+CREATE INDEX reading_list_items_article_id_idx ON reading_list_items (article_id);
+
+CREATE TABLE media (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    -- stored files, the blobs are at media/<id>/<name>
+    variants JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX media_created_at_idx ON media (created_at);
+
+-- media not referenced by any article are collected as orphans, see MediaStorage
+CREATE TABLE media_references (
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    -- locale of the translation referencing the media, empty for the article itself
+    locale VARCHAR(35) NOT NULL DEFAULT '',
+    media_id UUID NOT NULL REFERENCES media(id) ON DELETE CASCADE,
+    PRIMARY KEY (article_id, locale, media_id)
+);
+
+CREATE INDEX media_references_media_id_idx ON media_references (media_id);
+
+-- co-authors of articles besides the owner in articles.author_id
+CREATE TABLE article_authors (
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- owner or contributor
+    role VARCHAR(16) NOT NULL,
+    -- sent by mail, the invitation is pending until it's accepted with the token
+    invitation_token UUID UNIQUE DEFAULT gen_random_uuid(),
+    invited_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    accepted_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (article_id, user_id)
+);
+
+-- translations of articles, each one is published under its own slug
+CREATE TABLE article_translations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    locale VARCHAR(35) NOT NULL,
+    slug VARCHAR(255) UNIQUE NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content TEXT NOT NULL,
+    content_html TEXT NOT NULL DEFAULT '',
+    toc JSONB NOT NULL DEFAULT '[]',
+    excerpt TEXT NOT NULL DEFAULT '',
+    word_count INTEGER NOT NULL DEFAULT 0,
+    reading_time INTEGER NOT NULL DEFAULT 0,
+    -- draft or published, drafts are only seen by authors of the article
+    status VARCHAR(16) NOT NULL DEFAULT 'draft',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (article_id, locale)
+);
+
+-- ordered collections of articles, an article belongs to one series at most
+CREATE TABLE series (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    description VARCHAR(1000) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX series_owner_id_idx ON series (owner_id, created_at);
+
+CREATE TABLE series_articles (
+    article_id UUID PRIMARY KEY REFERENCES articles(id) ON DELETE CASCADE,
+    series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+    position INT NOT NULL
+);
+
+CREATE INDEX series_articles_series_id_idx ON series_articles (series_id, position);
+
+CREATE TABLE article_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    number INT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content TEXT NOT NULL,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (article_id, number)
+);
+
+-- reported articles and comments, target_id references articles or comments depending on target_type
+CREATE TABLE reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    target_type VARCHAR(16) NOT NULL,
+    target_id UUID NOT NULL,
+    reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(1000) NOT NULL,
+    state VARCHAR(16) NOT NULL DEFAULT 'open',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+-- a user has at most one open report of the same content
+CREATE UNIQUE INDEX reports_open_reporter_idx ON reports (reporter_id, target_type, target_id) WHERE state='open';
+CREATE INDEX reports_state_created_at_idx ON reports (state, created_at, id);
+CREATE INDEX reports_target_idx ON reports (target_type, target_id);
+
+-- audit trail of moderation, rows are never updated or deleted
+CREATE TABLE moderation_actions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    moderator_id UUID NOT NULL REFERENCES users(id),
+    action VARCHAR(16) NOT NULL,
+    target_type VARCHAR(16) NOT NULL,
+    target_id UUID NOT NULL,
+    report_id UUID REFERENCES reports(id),
+    note VARCHAR(1000) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX moderation_actions_created_at_idx ON moderation_actions (created_at, id);
+CREATE INDEX moderation_actions_target_idx ON moderation_actions (target_type, target_id, created_at, id);
+
+-- outbound webhooks, managed by admins
+CREATE TABLE webhooks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(64) NOT NULL,
+    events TEXT[] NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    -- consecutive failed delivery attempts, the webhook is deactivated once they reach the limit
+    failures INT NOT NULL DEFAULT 0,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+
+-- queue and log of webhook deliveries, claimed deliveries have next_attempt_at moved by the lease
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    webhook_id UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    response_status INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at) WHERE status='pending';
+CREATE INDEX webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id, created_at, id);
@@ -13,7 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
-	models "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
 	transaction "github.com/art-es/yet-another-service/internal/core/transaction"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -96,8 +96,23 @@ func (mr *MockuserRepositoryMockRecorder) Exists(ctx, email any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockuserRepository)(nil).Exists), ctx, email)
 }
 
+// NickNameExists mocks base method.
+func (m *MockuserRepository) NickNameExists(ctx context.Context, nickName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NickNameExists", ctx, nickName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NickNameExists indicates an expected call of NickNameExists.
+func (mr *MockuserRepositoryMockRecorder) NickNameExists(ctx, nickName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NickNameExists", reflect.TypeOf((*MockuserRepository)(nil).NickNameExists), ctx, nickName)
+}
+
 // Save mocks base method.
-func (m *MockuserRepository) Save(ctx context.Context, tx transaction.Transaction, user *models.User) error {
+func (m *MockuserRepository) Save(ctx context.Context, tx transaction.Transaction, user *dto.User) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Save", ctx, tx, user)
 	ret0, _ := ret[0].(error)
@@ -135,7 +150,7 @@ func (m *MockactivationService) EXPECT() *MockactivationServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockactivationService) Create(ctx context.Context, tx transaction.Transaction, user *models.User) error {
+func (m *MockactivationService) Create(ctx context.Context, tx transaction.Transaction, user *dto.User) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", ctx, tx, user)
 	ret0, _ := ret[0].(error)
@@ -16,6 +16,7 @@ type hashGenerator interface {
 
 type userRepository interface {
 	Exists(ctx context.Context, email string) (bool, error)
+	NickNameExists(ctx context.Context, nickName string) (bool, error)
 	Save(ctx context.Context, tx transaction.Transaction, user *dto.User) error
 }
 
@@ -51,6 +52,15 @@ func (s *Service) Signup(ctx context.Context, in *dto.SignupIn) error {
 		return errors.ErrEmailAlreadyTaken
 	}
 
+	nickNameExists, err := s.userRepository.NickNameExists(ctx, in.NickName)
+	if err != nil {
+		return fmt.Errorf("check nickname exists in repository: %w", err)
+	}
+
+	if nickNameExists {
+		return errors.ErrNickNameAlreadyTaken
+	}
+
 	tx := transaction.New(ctx)
 
 	if err = s.doTransaction(ctx, tx, in); err != nil {
@@ -17,6 +17,7 @@ import (
 const (
 	userID            = "dummy user id"
 	userName          = "Ivanov Ivan"
+	userNickName      = "iivan"
 	userEmail         = "iivan@example.com"
 	userPassword      = "secret123"
 	userPasswordHash  = "dummy user password hash"
@@ -57,6 +58,36 @@ func TestService(t *testing.T) {
 				assert.ErrorIs(t, err, apperrors.ErrEmailAlreadyTaken)
 			},
 		},
+		{
+			name: "check nickname exists in repository error",
+			setup: func(t *testing.T, m mocks) {
+				m.userRepository.EXPECT().
+					Exists(gomock.Any(), gomock.Eq(userEmail)).
+					Return(false, nil)
+
+				m.userRepository.EXPECT().
+					NickNameExists(gomock.Any(), gomock.Eq(userNickName)).
+					Return(false, errors.New(dummyErrorMessage))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "check nickname exists in repository: "+dummyErrorMessage)
+			},
+		},
+		{
+			name: "nickname is already taken",
+			setup: func(t *testing.T, m mocks) {
+				m.userRepository.EXPECT().
+					Exists(gomock.Any(), gomock.Eq(userEmail)).
+					Return(false, nil)
+
+				m.userRepository.EXPECT().
+					NickNameExists(gomock.Any(), gomock.Eq(userNickName)).
+					Return(true, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrNickNameAlreadyTaken)
+			},
+		},
 		{
 			name: "generate password hash error",
 			setup: func(t *testing.T, m mocks) {
@@ -64,6 +95,10 @@ func TestService(t *testing.T) {
 					Exists(gomock.Any(), gomock.Eq(userEmail)).
 					Return(false, nil)
 
+				m.userRepository.EXPECT().
+					NickNameExists(gomock.Any(), gomock.Eq(userNickName)).
+					Return(false, nil)
+
 				m.hashGenerator.EXPECT().
 					Generate(gomock.Eq(userPassword)).
 					Return("", errors.New(dummyErrorMessage))
@@ -79,12 +114,17 @@ func TestService(t *testing.T) {
 					Exists(gomock.Any(), gomock.Eq(userEmail)).
 					Return(false, nil)
 
+				m.userRepository.EXPECT().
+					NickNameExists(gomock.Any(), gomock.Eq(userNickName)).
+					Return(false, nil)
+
 				m.hashGenerator.EXPECT().
 					Generate(gomock.Eq(userPassword)).
 					Return(userPasswordHash, nil)
 
 				expectedUser := &dto.User{
 					DisplayName:  userName,
+					NickName:     userNickName,
 					Email:        userEmail,
 					PasswordHash: userPasswordHash,
 				}
@@ -104,12 +144,17 @@ func TestService(t *testing.T) {
 					Exists(gomock.Any(), gomock.Eq(userEmail)).
 					Return(false, nil)
 
+				m.userRepository.EXPECT().
+					NickNameExists(gomock.Any(), gomock.Eq(userNickName)).
+					Return(false, nil)
+
 				m.hashGenerator.EXPECT().
 					Generate(gomock.Eq(userPassword)).
 					Return(userPasswordHash, nil)
 
 				expectedUser := &dto.User{
 					DisplayName:  userName,
+					NickName:     userNickName,
 					Email:        userEmail,
 					PasswordHash: userPasswordHash,
 				}
@@ -124,6 +169,7 @@ func TestService(t *testing.T) {
 				expectedUser = &dto.User{
 					ID:           userID,
 					DisplayName:  userName,
+					NickName:     userNickName,
 					Email:        userEmail,
 					PasswordHash: userPasswordHash,
 				}
@@ -143,12 +189,17 @@ func TestService(t *testing.T) {
 					Exists(gomock.Any(), gomock.Eq(userEmail)).
 					Return(false, nil)
 
+				m.userRepository.EXPECT().
+					NickNameExists(gomock.Any(), gomock.Eq(userNickName)).
+					Return(false, nil)
+
 				m.hashGenerator.EXPECT().
 					Generate(gomock.Eq(userPassword)).
 					Return(userPasswordHash, nil)
 
 				expectedUser := &dto.User{
 					DisplayName:  userName,
+					NickName:     userNickName,
 					Email:        userEmail,
 					PasswordHash: userPasswordHash,
 				}
@@ -167,6 +218,7 @@ func TestService(t *testing.T) {
 				expectedUser = &dto.User{
 					ID:           userID,
 					DisplayName:  userName,
+					NickName:     userNickName,
 					Email:        userEmail,
 					PasswordHash: userPasswordHash,
 				}
@@ -186,12 +238,17 @@ func TestService(t *testing.T) {
 					Exists(gomock.Any(), gomock.Eq(userEmail)).
 					Return(false, nil)
 
+				m.userRepository.EXPECT().
+					NickNameExists(gomock.Any(), gomock.Eq(userNickName)).
+					Return(false, nil)
+
 				m.hashGenerator.EXPECT().
 					Generate(gomock.Eq(userPassword)).
 					Return(userPasswordHash, nil)
 
 				expectedUser := &dto.User{
 					DisplayName:  userName,
+					NickName:     userNickName,
 					Email:        userEmail,
 					PasswordHash: userPasswordHash,
 				}
@@ -206,6 +263,7 @@ func TestService(t *testing.T) {
 				expectedUser = &dto.User{
 					ID:           userID,
 					DisplayName:  userName,
+					NickName:     userNickName,
 					Email:        userEmail,
 					PasswordHash: userPasswordHash,
 				}
@@ -240,6 +298,7 @@ func TestService(t *testing.T) {
 			)
 			err := service.Signup(context.Background(), &dto.SignupIn{
 				DisplayName: userName,
+				NickName:    userNickName,
 				Email:       userEmail,
 				Password:    userPassword,
 			})
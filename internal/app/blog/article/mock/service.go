@@ -0,0 +1,433 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockarticleRepository is a mock of articleRepository interface.
+type MockarticleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleRepositoryMockRecorder is the mock recorder for MockarticleRepository.
+type MockarticleRepositoryMockRecorder struct {
+	mock *MockarticleRepository
+}
+
+// NewMockarticleRepository creates a new mock instance.
+func NewMockarticleRepository(ctrl *gomock.Controller) *MockarticleRepository {
+	mock := &MockarticleRepository{ctrl: ctrl}
+	mock.recorder = &MockarticleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleRepository) EXPECT() *MockarticleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockarticleRepository) Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, in)
+	ret0, _ := ret[0].(*dto.GetArticlesOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockarticleRepositoryMockRecorder) Get(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockarticleRepository)(nil).Get), ctx, in)
+}
+
+// GetByIDs mocks base method.
+func (m *MockarticleRepository) GetByIDs(ctx context.Context, ids []string) ([]dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockarticleRepositoryMockRecorder) GetByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockarticleRepository)(nil).GetByIDs), ctx, ids)
+}
+
+// MockauthorRepository is a mock of authorRepository interface.
+type MockauthorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorRepositoryMockRecorder is the mock recorder for MockauthorRepository.
+type MockauthorRepositoryMockRecorder struct {
+	mock *MockauthorRepository
+}
+
+// NewMockauthorRepository creates a new mock instance.
+func NewMockauthorRepository(ctrl *gomock.Controller) *MockauthorRepository {
+	mock := &MockauthorRepository{ctrl: ctrl}
+	mock.recorder = &MockauthorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthorRepository) EXPECT() *MockauthorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockauthorRepository) Find(ctx context.Context, nickName string) (*dto.AuthorProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, nickName)
+	ret0, _ := ret[0].(*dto.AuthorProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockauthorRepositoryMockRecorder) Find(ctx, nickName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockauthorRepository)(nil).Find), ctx, nickName)
+}
+
+// GetByArticles mocks base method.
+func (m *MockauthorRepository) GetByArticles(ctx context.Context, articleIDs []string) (map[string][]dto.ArticleAuthor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByArticles", ctx, articleIDs)
+	ret0, _ := ret[0].(map[string][]dto.ArticleAuthor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByArticles indicates an expected call of GetByArticles.
+func (mr *MockauthorRepositoryMockRecorder) GetByArticles(ctx, articleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByArticles", reflect.TypeOf((*MockauthorRepository)(nil).GetByArticles), ctx, articleIDs)
+}
+
+// MockseriesRepository is a mock of seriesRepository interface.
+type MockseriesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockseriesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockseriesRepositoryMockRecorder is the mock recorder for MockseriesRepository.
+type MockseriesRepositoryMockRecorder struct {
+	mock *MockseriesRepository
+}
+
+// NewMockseriesRepository creates a new mock instance.
+func NewMockseriesRepository(ctrl *gomock.Controller) *MockseriesRepository {
+	mock := &MockseriesRepository{ctrl: ctrl}
+	mock.recorder = &MockseriesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockseriesRepository) EXPECT() *MockseriesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByArticle mocks base method.
+func (m *MockseriesRepository) FindByArticle(ctx context.Context, articleID string) (*dto.ArticleSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByArticle", ctx, articleID)
+	ret0, _ := ret[0].(*dto.ArticleSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByArticle indicates an expected call of FindByArticle.
+func (mr *MockseriesRepositoryMockRecorder) FindByArticle(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByArticle", reflect.TypeOf((*MockseriesRepository)(nil).FindByArticle), ctx, articleID)
+}
+
+// MocktranslationRepository is a mock of translationRepository interface.
+type MocktranslationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MocktranslationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MocktranslationRepositoryMockRecorder is the mock recorder for MocktranslationRepository.
+type MocktranslationRepositoryMockRecorder struct {
+	mock *MocktranslationRepository
+}
+
+// NewMocktranslationRepository creates a new mock instance.
+func NewMocktranslationRepository(ctrl *gomock.Controller) *MocktranslationRepository {
+	mock := &MocktranslationRepository{ctrl: ctrl}
+	mock.recorder = &MocktranslationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktranslationRepository) EXPECT() *MocktranslationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBySlug mocks base method.
+func (m *MocktranslationRepository) FindBySlug(ctx context.Context, slug string) (*dto.ArticleTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlug", ctx, slug)
+	ret0, _ := ret[0].(*dto.ArticleTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MocktranslationRepositoryMockRecorder) FindBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MocktranslationRepository)(nil).FindBySlug), ctx, slug)
+}
+
+// GetByArticles mocks base method.
+func (m *MocktranslationRepository) GetByArticles(ctx context.Context, articleIDs []string) (map[string][]dto.ArticleTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByArticles", ctx, articleIDs)
+	ret0, _ := ret[0].(map[string][]dto.ArticleTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByArticles indicates an expected call of GetByArticles.
+func (mr *MocktranslationRepositoryMockRecorder) GetByArticles(ctx, articleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByArticles", reflect.TypeOf((*MocktranslationRepository)(nil).GetByArticles), ctx, articleIDs)
+}
+
+// MockuserRepository is a mock of userRepository interface.
+type MockuserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepositoryMockRecorder is the mock recorder for MockuserRepository.
+type MockuserRepositoryMockRecorder struct {
+	mock *MockuserRepository
+}
+
+// NewMockuserRepository creates a new mock instance.
+func NewMockuserRepository(ctrl *gomock.Controller) *MockuserRepository {
+	mock := &MockuserRepository{ctrl: ctrl}
+	mock.recorder = &MockuserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepository) EXPECT() *MockuserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockuserRepository) Find(ctx context.Context, id string) (*dto.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(*dto.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockuserRepositoryMockRecorder) Find(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockuserRepository)(nil).Find), ctx, id)
+}
+
+// MockarticleCache is a mock of articleCache interface.
+type MockarticleCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleCacheMockRecorder is the mock recorder for MockarticleCache.
+type MockarticleCacheMockRecorder struct {
+	mock *MockarticleCache
+}
+
+// NewMockarticleCache creates a new mock instance.
+func NewMockarticleCache(ctrl *gomock.Controller) *MockarticleCache {
+	mock := &MockarticleCache{ctrl: ctrl}
+	mock.recorder = &MockarticleCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleCache) EXPECT() *MockarticleCacheMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockarticleCache) Add(ctx context.Context, in *dto.GetArticlesIn, token *dto.ArticleCacheToken, out *dto.GetArticlesOut) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, in, token, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockarticleCacheMockRecorder) Add(ctx, in, token, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockarticleCache)(nil).Add), ctx, in, token, out)
+}
+
+// Await mocks base method.
+func (m *MockarticleCache) Await(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Await", ctx, in)
+	ret0, _ := ret[0].(*dto.GetArticlesOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Await indicates an expected call of Await.
+func (mr *MockarticleCacheMockRecorder) Await(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Await", reflect.TypeOf((*MockarticleCache)(nil).Await), ctx, in)
+}
+
+// Get mocks base method.
+func (m *MockarticleCache) Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, in)
+	ret0, _ := ret[0].(*dto.GetArticlesOut)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockarticleCacheMockRecorder) Get(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockarticleCache)(nil).Get), ctx, in)
+}
+
+// Lock mocks base method.
+func (m *MockarticleCache) Lock(ctx context.Context, in *dto.GetArticlesIn) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, in)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockarticleCacheMockRecorder) Lock(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockarticleCache)(nil).Lock), ctx, in)
+}
+
+// Token mocks base method.
+func (m *MockarticleCache) Token(ctx context.Context) (*dto.ArticleCacheToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token", ctx)
+	ret0, _ := ret[0].(*dto.ArticleCacheToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockarticleCacheMockRecorder) Token(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockarticleCache)(nil).Token), ctx)
+}
+
+// MockreactionEnricher is a mock of reactionEnricher interface.
+type MockreactionEnricher struct {
+	ctrl     *gomock.Controller
+	recorder *MockreactionEnricherMockRecorder
+	isgomock struct{}
+}
+
+// MockreactionEnricherMockRecorder is the mock recorder for MockreactionEnricher.
+type MockreactionEnricherMockRecorder struct {
+	mock *MockreactionEnricher
+}
+
+// NewMockreactionEnricher creates a new mock instance.
+func NewMockreactionEnricher(ctrl *gomock.Controller) *MockreactionEnricher {
+	mock := &MockreactionEnricher{ctrl: ctrl}
+	mock.recorder = &MockreactionEnricherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreactionEnricher) EXPECT() *MockreactionEnricherMockRecorder {
+	return m.recorder
+}
+
+// Enrich mocks base method.
+func (m *MockreactionEnricher) Enrich(ctx context.Context, articles []dto.Article, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enrich", ctx, articles, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enrich indicates an expected call of Enrich.
+func (mr *MockreactionEnricherMockRecorder) Enrich(ctx, articles, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enrich", reflect.TypeOf((*MockreactionEnricher)(nil).Enrich), ctx, articles, userID)
+}
+
+// MockviewCounter is a mock of viewCounter interface.
+type MockviewCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockviewCounterMockRecorder
+	isgomock struct{}
+}
+
+// MockviewCounterMockRecorder is the mock recorder for MockviewCounter.
+type MockviewCounterMockRecorder struct {
+	mock *MockviewCounter
+}
+
+// NewMockviewCounter creates a new mock instance.
+func NewMockviewCounter(ctrl *gomock.Controller) *MockviewCounter {
+	mock := &MockviewCounter{ctrl: ctrl}
+	mock.recorder = &MockviewCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockviewCounter) EXPECT() *MockviewCounterMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockviewCounter) Count(ctx context.Context, articleID string, visitor dto.Visitor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, articleID, visitor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockviewCounterMockRecorder) Count(ctx, articleID, visitor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockviewCounter)(nil).Count), ctx, articleID, visitor)
+}
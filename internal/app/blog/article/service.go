@@ -1,10 +1,18 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
 package article
 
 import (
 	"context"
 	"errors"
 	"fmt"
+	"html"
+	"net/url"
+	"slices"
+	"sync"
 
+	"golang.org/x/sync/singleflight"
+
+	"github.com/art-es/yet-another-service/internal/core/locale"
 	"github.com/art-es/yet-another-service/internal/core/log"
 
 	"github.com/art-es/yet-another-service/internal/app/shared/dto"
@@ -13,42 +21,348 @@ import (
 
 type articleRepository interface {
 	Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error)
+	GetByIDs(ctx context.Context, ids []string) ([]dto.Article, error)
 }
 
 type authorRepository interface {
-	Get(ctx context.Context, authorIDs []int64) (map[int64]*dto.ArticleAuthor, error)
+	// GetByArticles returns authors of each article, the owner first.
+	GetByArticles(ctx context.Context, articleIDs []string) (map[string][]dto.ArticleAuthor, error)
+	Find(ctx context.Context, nickName string) (*dto.AuthorProfile, error)
+}
+
+type seriesRepository interface {
+	// FindByArticle returns the series of the article with its neighbours, nil if the article is in no series.
+	FindByArticle(ctx context.Context, articleID string) (*dto.ArticleSeries, error)
+}
+
+// translationRepository loads published translations, which are cached along with the articles.
+type translationRepository interface {
+	GetByArticles(ctx context.Context, articleIDs []string) (map[string][]dto.ArticleTranslation, error)
+	// FindBySlug returns the translation with the slug whatever its status, nil if there is none.
+	FindBySlug(ctx context.Context, slug string) (*dto.ArticleTranslation, error)
+}
+
+// userRepository tells members apart from other readers of members-only articles.
+type userRepository interface {
+	Find(ctx context.Context, id string) (*dto.User, error)
 }
 
 type articleCache interface {
-	articleRepository
-	Add(ctx context.Context, in *dto.GetArticlesIn, out *dto.GetArticlesOut) error
+	// Get returns the cached page and whether it is stale and needs to be refreshed.
+	Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, bool, error)
+	// Token must be taken before the page is loaded, the page isn't cached if it gets purged meanwhile.
+	Token(ctx context.Context) (*dto.ArticleCacheToken, error)
+	Add(ctx context.Context, in *dto.GetArticlesIn, token *dto.ArticleCacheToken, out *dto.GetArticlesOut) error
+	// Lock takes the lock for loading the page across instances, it fails with ErrCacheLocked if the page is being loaded.
+	Lock(ctx context.Context, in *dto.GetArticlesIn) (func(), error)
+	// Await waits for the page loaded by the lock holder, it fails with ErrNoCache if the page doesn't show up.
+	Await(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error)
+}
+
+// reactionEnricher fills per-request reaction data, which must never be cached.
+type reactionEnricher interface {
+	Enrich(ctx context.Context, articles []dto.Article, userID string) error
+}
+
+// viewCounter counts views of the article, which must not write to the storage on reads.
+type viewCounter interface {
+	Count(ctx context.Context, articleID string, visitor dto.Visitor) error
 }
 
 type Service struct {
-	articleStorage articleRepository
-	articleCache   articleCache
-	authorStorage  authorRepository
-	logger         log.Logger
+	siteURL            url.URL
+	articleStorage     articleRepository
+	articleCache       articleCache
+	authorStorage      authorRepository
+	seriesStorage      seriesRepository
+	translationStorage translationRepository
+	userStorage        userRepository
+	reactionEnricher   reactionEnricher
+	viewCounter        viewCounter
+	logger             log.Logger
+
+	loads        singleflight.Group
+	refreshes    sync.Map
+	refreshMu    sync.Mutex
+	refreshGroup sync.WaitGroup
+	stopped      bool
 }
 
+// NewService creates the article service, siteURL is the public URL of the blog used in canonical URLs of articles.
 func NewService(
+	siteURL url.URL,
 	articleStorage articleRepository,
 	articleCache articleCache,
 	authorStorage authorRepository,
+	seriesStorage seriesRepository,
+	translationStorage translationRepository,
+	userStorage userRepository,
+	reactionEnricher reactionEnricher,
+	viewCounter viewCounter,
 	logger log.Logger,
 ) *Service {
 	return &Service{
-		articleStorage: articleStorage,
-		articleCache:   articleCache,
-		authorStorage:  authorStorage,
-		logger:         logger,
+		siteURL:            siteURL,
+		articleStorage:     articleStorage,
+		articleCache:       articleCache,
+		authorStorage:      authorStorage,
+		seriesStorage:      seriesStorage,
+		translationStorage: translationStorage,
+		userStorage:        userStorage,
+		reactionEnricher:   reactionEnricher,
+		viewCounter:        viewCounter,
+		logger:             logger,
 	}
 }
 
 func (s *Service) Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
-	out, err := s.articleCache.Get(ctx, in)
+	in, err := s.resolveAuthor(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.get(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	articles, err := s.decorate(ctx, out.Articles, in.UserID, in.Languages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GetArticlesOut{
+		Articles:   articles,
+		NextCursor: out.NextCursor,
+		PrevCursor: out.PrevCursor,
+	}, nil
+}
+
+// Find returns the article and counts its view by the visitor.
+// The article is loaded as a single-article page, so it shares the cache with listings.
+// A slug of a translation serves the article in the language of the translation, whatever the visitor prefers.
+func (s *Service) Find(ctx context.Context, in *dto.FindArticleIn) (*dto.Article, error) {
+	out, err := s.get(ctx, &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 1, Slug: in.Slug})
+	if err != nil {
+		return nil, err
+	}
+
+	languages := in.Languages
+	if len(out.Articles) == 0 {
+		translation, err := s.translationStorage.FindBySlug(ctx, in.Slug)
+		if err != nil {
+			return nil, fmt.Errorf("find translation in storage: %w", err)
+		}
+
+		if translation == nil || translation.Status != dto.TranslationStatusPublished {
+			return nil, apperrors.ErrArticleNotFound
+		}
+
+		out, err = s.get(ctx, &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 1, Slug: translation.ArticleSlug})
+		if err != nil {
+			return nil, err
+		}
+
+		languages = []string{translation.Locale}
+	}
+
+	if len(out.Articles) == 0 {
+		return nil, apperrors.ErrArticleNotFound
+	}
+
+	articles, err := s.decorate(ctx, out.Articles, in.Visitor.UserID, languages)
+	if err != nil {
+		return nil, err
+	}
+
+	article := &articles[0]
+	// a lost view isn't worth failing the request
+	if err = s.viewCounter.Count(ctx, article.ID, in.Visitor); err != nil {
+		s.logger.Error().Err(err).Msg("count article view error")
+	}
+
+	return article, nil
+}
+
+// GetByIDs returns the articles in the order of the IDs, unknown IDs are skipped.
+// The articles are loaded from the storage, since lists of arbitrary articles aren't worth caching.
+func (s *Service) GetByIDs(ctx context.Context, ids []string, userID string) ([]dto.Article, error) {
+	if len(ids) == 0 {
+		return []dto.Article{}, nil
+	}
+
+	loaded, err := s.articleStorage.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get articles from storage: %w", err)
+	}
+
+	if err = s.enrichAuthors(ctx, loaded); err != nil {
+		return nil, err
+	}
+
+	if err = s.enrichTranslations(ctx, loaded); err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string]int, len(ids))
+	for i, id := range ids {
+		positions[id] = i
+	}
+
+	slices.SortFunc(loaded, func(a, b dto.Article) int {
+		return positions[a.ID] - positions[b.ID]
+	})
+
+	return s.decorate(ctx, loaded, userID, nil)
+}
+
+// decorate returns a copy of the articles with per-request data and canonical URLs,
+// since cached articles may still be in use by the cache writer.
+// Gated articles are locked here as well, so cached pages always hold the full content
+// and are shared by readers of any access level. Translations are picked here for the same reason.
+func (s *Service) decorate(ctx context.Context, in []dto.Article, userID string, languages []string) ([]dto.Article, error) {
+	articles := slices.Clone(in)
+	translate(articles, languages)
+
+	if err := s.lock(ctx, articles, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.reactionEnricher.Enrich(ctx, articles, userID); err != nil {
+		return nil, fmt.Errorf("enrich articles with reactions: %w", err)
+	}
+
+	for i := range articles {
+		if articles[i].SEO.CanonicalURL == "" {
+			articles[i].SEO.CanonicalURL = s.siteURL.JoinPath("articles", articles[i].Slug).String()
+		}
+	}
+
+	return articles, nil
+}
+
+// translate serves articles with translations in the language matching the preferred ones best,
+// the original language is the fallback. All language versions of such articles are listed in alternates.
+func translate(articles []dto.Article, languages []string) {
+	for i := range articles {
+		article := &articles[i]
+		if len(article.Translations) == 0 {
+			continue
+		}
+
+		locales := make([]string, 0, len(article.Translations)+1)
+		article.Alternates = make([]dto.ArticleAlternate, 0, len(article.Translations)+1)
+
+		locales = append(locales, article.Locale)
+		article.Alternates = append(article.Alternates, dto.ArticleAlternate{
+			Locale: article.Locale,
+			Slug:   article.Slug,
+			Title:  article.Title,
+		})
+
+		for _, translation := range article.Translations {
+			locales = append(locales, translation.Locale)
+			article.Alternates = append(article.Alternates, dto.ArticleAlternate{
+				Locale: translation.Locale,
+				Slug:   translation.Slug,
+				Title:  translation.Title,
+			})
+		}
+
+		index := locale.Match(languages, locales)
+		if index == 0 {
+			continue
+		}
+
+		translation := article.Translations[index-1]
+		article.Locale = translation.Locale
+		article.Slug = translation.Slug
+		article.Title = translation.Title
+		article.Content = translation.Content
+		article.ContentHTML = translation.ContentHTML
+		article.TOC = translation.TOC
+		article.Excerpt = translation.Excerpt
+		article.WordCount = translation.WordCount
+		article.ReadingTime = translation.ReadingTime
+	}
+}
+
+// lock truncates the content of articles the user may not read to their excerpts.
+// Authors, co-authors included, read their articles. The user is only looked up when there is
+// a members-only article of someone else to check.
+func (s *Service) lock(ctx context.Context, articles []dto.Article, userID string) error {
+	var user *dto.User
+	for i := range articles {
+		article := &articles[i]
+
+		readable := true
+		switch article.Visibility {
+		case "", dto.ArticleVisibilityPublic:
+		case dto.ArticleVisibilityUsers:
+			readable = userID != ""
+		default:
+			readable = userID != "" && isAuthor(article, userID)
+			if !readable && userID != "" {
+				if user == nil {
+					var err error
+					if user, err = s.userStorage.Find(ctx, userID); err != nil {
+						return fmt.Errorf("find user in storage: %w", err)
+					}
+				}
+				readable = slices.Contains(user.Roles, dto.UserRoleMember)
+			}
+		}
+
+		if readable {
+			continue
+		}
+
+		article.Locked = true
+		article.Content = article.Excerpt
+		article.ContentHTML = "<p>" + html.EscapeString(article.Excerpt) + "</p>"
+		article.TOC = nil
+	}
+
+	return nil
+}
+
+// isAuthor tells whether the user is the owner or an accepted co-author of the article.
+func isAuthor(article *dto.Article, userID string) bool {
+	if article.AuthorID == userID {
+		return true
+	}
+
+	return slices.ContainsFunc(article.Authors, func(author dto.ArticleAuthor) bool {
+		return author.UserID == userID
+	})
+}
+
+func (s *Service) resolveAuthor(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesIn, error) {
+	if in.AuthorNickName == "" {
+		return in, nil
+	}
+
+	author, err := s.authorStorage.Find(ctx, in.AuthorNickName)
+	if err != nil {
+		return nil, fmt.Errorf("find author in storage: %w", err)
+	}
+
+	if author == nil {
+		return nil, apperrors.ErrAuthorNotFound
+	}
+
+	resolved := *in
+	resolved.AuthorID = author.ID
+	return &resolved, nil
+}
+
+func (s *Service) get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
+	out, stale, err := s.articleCache.Get(ctx, in)
 	switch {
 	case err == nil:
+		if stale {
+			s.refresh(ctx, in)
+		}
 		return out, nil
 	case errors.Is(err, apperrors.ErrNoCache):
 		// need to load from storage
@@ -56,40 +370,189 @@ func (s *Service) Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArtic
 		return nil, fmt.Errorf("get articles from cache: %w", err)
 	}
 
-	out, err = s.articleStorage.Get(ctx, in)
+	// concurrent misses of the page share a single load,
+	// which must not be canceled when the request that started it goes away
+	loaded, err, _ := s.loads.Do(in.CacheKey(), func() (any, error) {
+		return s.loadLocked(context.WithoutCancel(ctx), in)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("get articles from storage: %w", err)
+		return nil, err
 	}
 
-	if len(out.Articles) == 0 {
-		// should save empty response in cache?
-		return out, nil
+	return loaded.(*dto.GetArticlesOut), nil
+}
+
+// loadLocked loads the page unless another instance is loading it already, then its result is awaited.
+func (s *Service) loadLocked(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
+	unlock, err := s.articleCache.Lock(ctx, in)
+	switch {
+	case err == nil:
+		defer unlock()
+	case errors.Is(err, apperrors.ErrCacheLocked):
+		out, err := s.articleCache.Await(ctx, in)
+		if err == nil {
+			return out, nil
+		}
+
+		if !errors.Is(err, apperrors.ErrNoCache) {
+			s.logger.Error().Err(err).Msg("await articles in cache error")
+		}
+	default:
+		// the lock only protects the storage from duplicate loads, so the request goes on without it
+		s.logger.Error().Err(err).Msg("lock articles in cache error")
+	}
+
+	return s.load(ctx, in)
+}
+
+// Start does nothing, the service only runs background refreshes of stale pages on reads.
+func (s *Service) Start(_ context.Context) error {
+	return nil
+}
+
+// Stop waits for running background refreshes, stale pages are served without refreshing from now on.
+// It must be called before the article cache is stopped, refreshes write into it.
+func (s *Service) Stop(ctx context.Context) error {
+	s.refreshMu.Lock()
+	s.stopped = true
+	s.refreshMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.refreshGroup.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// refresh reloads the stale page in background, meanwhile the stale one is served.
+// A page is refreshed by one worker of the process, and by one process thanks to the lock.
+func (s *Service) refresh(ctx context.Context, in *dto.GetArticlesIn) {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	if s.stopped {
+		return
 	}
 
-	authorMap, err := s.authorStorage.Get(ctx, getAuthorIDs(out.Articles))
+	key := in.CacheKey()
+	if _, running := s.refreshes.LoadOrStore(key, struct{}{}); running {
+		return
+	}
+
+	s.refreshGroup.Add(1)
+	go func() {
+		defer s.refreshGroup.Done()
+		defer s.refreshes.Delete(key)
+
+		ctx := context.WithoutCancel(ctx)
+
+		unlock, err := s.articleCache.Lock(ctx, in)
+		switch {
+		case err == nil:
+			defer unlock()
+		case errors.Is(err, apperrors.ErrCacheLocked):
+			return
+		default:
+			s.logger.Error().Err(err).Msg("lock articles in cache error")
+			return
+		}
+
+		if _, err = s.load(ctx, in); err != nil {
+			s.logger.Error().Err(err).Msg("refresh articles error")
+		}
+	}()
+}
+
+func (s *Service) load(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
+	// an edit committed after the token is taken may be missing from the page, and its purge drops the page
+	token, err := s.articleCache.Token(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("get authors from storage: %w", err)
+		s.logger.Error().Err(err).Msg("get articles cache token error")
 	}
 
-	for _, article := range out.Articles {
-		article.Author = authorMap[article.AuthorID]
+	out, err := s.articleStorage.Get(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("get articles from storage: %w", err)
+	}
+
+	if err = s.enrichAuthors(ctx, out.Articles); err != nil {
+		return nil, err
+	}
+
+	if err = s.enrichTranslations(ctx, out.Articles); err != nil {
+		return nil, err
+	}
+
+	// series navigation is only shown on single articles, listings don't pay for it
+	if in.Slug != "" && len(out.Articles) == 1 {
+		if out.Articles[0].Series, err = s.seriesStorage.FindByArticle(ctx, out.Articles[0].ID); err != nil {
+			return nil, fmt.Errorf("find series in storage: %w", err)
+		}
+	}
+
+	if token == nil {
+		return out, nil
 	}
 
-	if err = s.articleCache.Add(ctx, in, out); err != nil {
+	// empty pages are cached too, the cache decides for how long
+	if err = s.articleCache.Add(ctx, in, token, out); err != nil {
 		s.logger.Error().Err(err).Msg("add articles to cache error")
 	}
 
 	return out, nil
 }
 
-func getAuthorIDs(articles []dto.Article) []int64 {
-	out := make([]int64, 0)
-	set := make(map[int64]struct{})
-	for _, article := range articles {
-		if _, exists := set[article.AuthorID]; !exists {
-			set[article.AuthorID] = struct{}{}
-			out = append(out, article.AuthorID)
+func (s *Service) enrichAuthors(ctx context.Context, articles []dto.Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	authorMap, err := s.authorStorage.GetByArticles(ctx, getArticleIDs(articles))
+	if err != nil {
+		return fmt.Errorf("get authors from storage: %w", err)
+	}
+
+	for i := range articles {
+		authors := authorMap[articles[i].ID]
+		if len(authors) == 0 {
+			continue
 		}
+
+		articles[i].Author = &authors[0]
+		articles[i].Authors = authors
+	}
+
+	return nil
+}
+
+func (s *Service) enrichTranslations(ctx context.Context, articles []dto.Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	translationMap, err := s.translationStorage.GetByArticles(ctx, getArticleIDs(articles))
+	if err != nil {
+		return fmt.Errorf("get translations from storage: %w", err)
+	}
+
+	for i := range articles {
+		articles[i].Translations = translationMap[articles[i].ID]
+	}
+
+	return nil
+}
+
+func getArticleIDs(articles []dto.Article) []string {
+	out := make([]string, 0, len(articles))
+	for _, article := range articles {
+		out = append(out, article.ID)
 	}
 	return out
 }
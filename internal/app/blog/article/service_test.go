@@ -0,0 +1,999 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/article/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+// withCanonicalURLs sets canonical URLs the service fills for articles without an override.
+func withCanonicalURLs(articles ...dto.Article) []dto.Article {
+	for i := range articles {
+		articles[i].SEO.CanonicalURL, _ = url.JoinPath("https://example.com/blog", "articles", articles[i].Slug)
+	}
+	return articles
+}
+
+var cacheToken = &dto.ArticleCacheToken{Version: 1, Sequence: 2}
+
+type serviceMocks struct {
+	articleStorage     *mock.MockarticleRepository
+	articleCache       *mock.MockarticleCache
+	authorStorage      *mock.MockauthorRepository
+	seriesStorage      *mock.MockseriesRepository
+	translationStorage *mock.MocktranslationRepository
+	userStorage        *mock.MockuserRepository
+	reactionEnricher   *mock.MockreactionEnricher
+	viewCounter        *mock.MockviewCounter
+}
+
+func TestGet(t *testing.T) {
+	in := &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20, UserID: "user id"}
+	nextCursor := &dto.ArticleCursor{ID: "1"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string)
+	}{
+		{
+			name: "get from cache error",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(nil, false, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get articles from cache: foo error")
+			},
+		},
+		{
+			name: "cached",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(in)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{
+						{ID: "1", Slug: "foo"},
+						{ID: "2", Slug: "bar", SEO: dto.ArticleSEO{CanonicalURL: "https://example.org/bar"}},
+					}, NextCursor: nextCursor}, false, nil)
+				m.reactionEnricher.EXPECT().
+					Enrich(gomock.Any(), gomock.Len(2), gomock.Eq("user id")).
+					Do(func(_ context.Context, articles []dto.Article, _ string) {
+						articles[0].OwnReactions = []string{"like"}
+					}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetArticlesOut{
+					Articles: []dto.Article{
+						{
+							ID:           "1",
+							Slug:         "foo",
+							SEO:          dto.ArticleSEO{CanonicalURL: "https://example.com/blog/articles/foo"},
+							OwnReactions: []string{"like"},
+						},
+						{ID: "2", Slug: "bar", SEO: dto.ArticleSEO{CanonicalURL: "https://example.org/bar"}},
+					},
+					NextCursor: nextCursor,
+				}, out)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "enrich reactions error",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(in)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{{ID: "1"}}}, false, nil)
+				m.reactionEnricher.EXPECT().
+					Enrich(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "enrich articles with reactions: foo error")
+			},
+		},
+		{
+			name: "stale",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(in)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{{ID: "1"}}}, true, nil)
+				m.reactionEnricher.EXPECT().
+					Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).
+					Return(nil)
+				m.articleCache.EXPECT().Lock(gomock.Any(), gomock.Eq(in)).Return(func() {}, nil)
+				m.articleCache.EXPECT().Token(gomock.Any()).Return(cacheToken, nil)
+				m.articleStorage.EXPECT().
+					Get(gomock.Any(), gomock.Eq(in)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{{ID: "2", AuthorID: "author 1"}}}, nil)
+				m.translationStorage.EXPECT().GetByArticles(gomock.Any(), gomock.Eq([]string{"2"})).Return(map[string][]dto.ArticleTranslation{}, nil)
+				m.authorStorage.EXPECT().
+					GetByArticles(gomock.Any(), gomock.Eq([]string{"2"})).
+					Return(map[string][]dto.ArticleAuthor{"2": {{NickName: "foo", Role: dto.ArticleAuthorRoleOwner}}}, nil)
+				m.articleCache.EXPECT().
+					Add(gomock.Any(), gomock.Eq(in), gomock.Eq(cacheToken), gomock.Eq(&dto.GetArticlesOut{Articles: []dto.Article{
+						{
+							ID:       "2",
+							AuthorID: "author 1",
+							Author:   &dto.ArticleAuthor{NickName: "foo", Role: dto.ArticleAuthorRoleOwner},
+							Authors:  []dto.ArticleAuthor{{NickName: "foo", Role: dto.ArticleAuthorRoleOwner}},
+						},
+					}})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetArticlesOut{Articles: withCanonicalURLs(dto.Article{ID: "1"})}, out)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "stale refreshed by another instance",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(in)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{{ID: "1"}}}, true, nil)
+				m.reactionEnricher.EXPECT().
+					Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).
+					Return(nil)
+				m.articleCache.EXPECT().Lock(gomock.Any(), gomock.Eq(in)).Return(nil, apperrors.ErrCacheLocked)
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetArticlesOut{Articles: withCanonicalURLs(dto.Article{ID: "1"})}, out)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "loaded by another instance",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(nil, false, apperrors.ErrNoCache)
+				m.articleCache.EXPECT().Lock(gomock.Any(), gomock.Eq(in)).Return(nil, apperrors.ErrCacheLocked)
+				m.articleCache.EXPECT().
+					Await(gomock.Any(), gomock.Eq(in)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{{ID: "1"}}}, nil)
+				m.reactionEnricher.EXPECT().
+					Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetArticlesOut{Articles: withCanonicalURLs(dto.Article{ID: "1"})}, out)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "not loaded by another instance",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(nil, false, apperrors.ErrNoCache)
+				m.articleCache.EXPECT().Lock(gomock.Any(), gomock.Eq(in)).Return(nil, apperrors.ErrCacheLocked)
+				m.articleCache.EXPECT().Await(gomock.Any(), gomock.Eq(in)).Return(nil, apperrors.ErrNoCache)
+				m.articleCache.EXPECT().Token(gomock.Any()).Return(cacheToken, nil)
+				m.articleStorage.EXPECT().
+					Get(gomock.Any(), gomock.Eq(in)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{}}, nil)
+				m.articleCache.EXPECT().
+					Add(gomock.Any(), gomock.Eq(in), gomock.Eq(cacheToken), gomock.Eq(&dto.GetArticlesOut{Articles: []dto.Article{}})).
+					Return(nil)
+				m.reactionEnricher.EXPECT().
+					Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetArticlesOut{Articles: []dto.Article{}}, out)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "lock error",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(nil, false, apperrors.ErrNoCache)
+				m.articleCache.EXPECT().Lock(gomock.Any(), gomock.Eq(in)).Return(nil, errors.New("foo error"))
+				m.articleCache.EXPECT().Token(gomock.Any()).Return(cacheToken, nil)
+				m.articleStorage.EXPECT().
+					Get(gomock.Any(), gomock.Eq(in)).
+					Return(nil, errors.New("bar error"))
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get articles from storage: bar error")
+				assert.Equal(t, []string{`{"level":"error","error":"foo error","message":"lock articles in cache error"}`}, logs)
+			},
+		},
+		{
+			name: "not cached",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(nil, false, apperrors.ErrNoCache)
+				m.articleCache.EXPECT().Lock(gomock.Any(), gomock.Eq(in)).Return(func() {}, nil)
+				m.articleCache.EXPECT().Token(gomock.Any()).Return(cacheToken, nil)
+				m.articleStorage.EXPECT().
+					Get(gomock.Any(), gomock.Eq(in)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{
+						{ID: "1", AuthorID: "author 1"},
+						{ID: "2", AuthorID: "author 1"},
+					}}, nil)
+				m.translationStorage.EXPECT().GetByArticles(gomock.Any(), gomock.Eq([]string{"1", "2"})).Return(map[string][]dto.ArticleTranslation{}, nil)
+				m.authorStorage.EXPECT().
+					GetByArticles(gomock.Any(), gomock.Eq([]string{"1", "2"})).
+					Return(map[string][]dto.ArticleAuthor{
+						"1": {{NickName: "foo", Role: dto.ArticleAuthorRoleOwner}},
+						"2": {
+							{NickName: "foo", Role: dto.ArticleAuthorRoleOwner},
+							{NickName: "bar", Role: dto.ArticleAuthorRoleContributor},
+						},
+					}, nil)
+				m.articleCache.EXPECT().
+					Add(gomock.Any(), gomock.Eq(in), gomock.Eq(cacheToken), gomock.Any()).
+					Return(errors.New("bar error"))
+				m.reactionEnricher.EXPECT().
+					Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetArticlesOut{Articles: withCanonicalURLs(
+					dto.Article{
+						ID:       "1",
+						AuthorID: "author 1",
+						Author:   &dto.ArticleAuthor{NickName: "foo", Role: dto.ArticleAuthorRoleOwner},
+						Authors:  []dto.ArticleAuthor{{NickName: "foo", Role: dto.ArticleAuthorRoleOwner}},
+					},
+					dto.Article{
+						ID:       "2",
+						AuthorID: "author 1",
+						Author:   &dto.ArticleAuthor{NickName: "foo", Role: dto.ArticleAuthorRoleOwner},
+						Authors: []dto.ArticleAuthor{
+							{NickName: "foo", Role: dto.ArticleAuthorRoleOwner},
+							{NickName: "bar", Role: dto.ArticleAuthorRoleContributor},
+						},
+					},
+				)}, out)
+				assert.Equal(t, []string{`{"level":"error","error":"bar error","message":"add articles to cache error"}`}, logs)
+			},
+		},
+		{
+			name: "purged while loading",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(nil, false, apperrors.ErrNoCache)
+				m.articleCache.EXPECT().Lock(gomock.Any(), gomock.Eq(in)).Return(func() {}, nil)
+				// a purge after the token is taken bumps the sequence, the page still carries the token taken before the read,
+				// so the cache drops it instead of serving the page loaded before the edit
+				gomock.InOrder(
+					m.articleCache.EXPECT().Token(gomock.Any()).Return(cacheToken, nil),
+					m.articleStorage.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(&dto.GetArticlesOut{Articles: []dto.Article{}}, nil),
+					m.articleCache.EXPECT().
+						Add(gomock.Any(), gomock.Eq(in), gomock.Eq(cacheToken), gomock.Eq(&dto.GetArticlesOut{Articles: []dto.Article{}})).
+						Return(nil),
+				)
+				m.reactionEnricher.EXPECT().Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetArticlesOut{Articles: []dto.Article{}}, out)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "cache token error",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(nil, false, apperrors.ErrNoCache)
+				m.articleCache.EXPECT().Lock(gomock.Any(), gomock.Eq(in)).Return(func() {}, nil)
+				m.articleCache.EXPECT().Token(gomock.Any()).Return(nil, errors.New("foo error"))
+				m.articleStorage.EXPECT().
+					Get(gomock.Any(), gomock.Eq(in)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{}}, nil)
+				m.reactionEnricher.EXPECT().Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetArticlesOut{Articles: []dto.Article{}}, out)
+				assert.Equal(t, []string{`{"level":"error","error":"foo error","message":"get articles cache token error"}`}, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := serviceMocks{
+				articleStorage:     mock.NewMockarticleRepository(ctrl),
+				articleCache:       mock.NewMockarticleCache(ctrl),
+				authorStorage:      mock.NewMockauthorRepository(ctrl),
+				seriesStorage:      mock.NewMockseriesRepository(ctrl),
+				translationStorage: mock.NewMocktranslationRepository(ctrl),
+				userStorage:        mock.NewMockuserRepository(ctrl),
+				reactionEnricher:   mock.NewMockreactionEnricher(ctrl),
+				viewCounter:        mock.NewMockviewCounter(ctrl),
+			}
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			siteURL, _ := url.Parse("https://example.com/blog")
+			service := NewService(*siteURL, m.articleStorage, m.articleCache, m.authorStorage, m.seriesStorage, m.translationStorage, m.userStorage, m.reactionEnricher, m.viewCounter, logger)
+			out, err := service.Get(context.Background(), in)
+			assert.NoError(t, service.Stop(context.Background()))
+
+			tt.assert(t, out, err, logger.Logs())
+		})
+	}
+}
+
+func TestStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	in := &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20}
+	articleCache := mock.NewMockarticleCache(ctrl)
+	reactionEnricher := mock.NewMockreactionEnricher(ctrl)
+	// the stale page is served, but not refreshed once the service is stopped
+	articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(&dto.GetArticlesOut{Articles: []dto.Article{}}, true, nil)
+	reactionEnricher.EXPECT().Enrich(gomock.Any(), gomock.Any(), gomock.Eq("")).Return(nil)
+
+	siteURL, _ := url.Parse("https://example.com/blog")
+	service := NewService(*siteURL, nil, articleCache, nil, nil, nil, nil, reactionEnricher, nil, testutil.NewLogger())
+	ctx := context.Background()
+
+	assert.NoError(t, service.Start(ctx))
+	assert.NoError(t, service.Stop(ctx))
+
+	out, err := service.Get(ctx, in)
+	assert.NoError(t, err)
+	assert.Equal(t, &dto.GetArticlesOut{Articles: []dto.Article{}}, out)
+}
+
+func TestGetByAuthor(t *testing.T) {
+	in := &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20, AuthorNickName: "bob"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.GetArticlesOut, err error)
+	}{
+		{
+			name: "find author error",
+			setup: func(m serviceMocks) {
+				m.authorStorage.EXPECT().Find(gomock.Any(), gomock.Eq("bob")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find author in storage: foo error")
+			},
+		},
+		{
+			name: "author not found",
+			setup: func(m serviceMocks) {
+				m.authorStorage.EXPECT().Find(gomock.Any(), gomock.Eq("bob")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrAuthorNotFound)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.authorStorage.EXPECT().
+					Find(gomock.Any(), gomock.Eq("bob")).
+					Return(&dto.AuthorProfile{ID: "author 1", NickName: "bob"}, nil)
+
+				resolved := &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20, AuthorNickName: "bob", AuthorID: "author 1"}
+				m.articleCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(resolved)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{{ID: "1", AuthorID: "author 1"}}}, false, nil)
+				m.reactionEnricher.EXPECT().
+					Enrich(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.GetArticlesOut, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetArticlesOut{Articles: withCanonicalURLs(dto.Article{ID: "1", AuthorID: "author 1"})}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := serviceMocks{
+				articleStorage:     mock.NewMockarticleRepository(ctrl),
+				articleCache:       mock.NewMockarticleCache(ctrl),
+				authorStorage:      mock.NewMockauthorRepository(ctrl),
+				seriesStorage:      mock.NewMockseriesRepository(ctrl),
+				translationStorage: mock.NewMocktranslationRepository(ctrl),
+				userStorage:        mock.NewMockuserRepository(ctrl),
+				reactionEnricher:   mock.NewMockreactionEnricher(ctrl),
+				viewCounter:        mock.NewMockviewCounter(ctrl),
+			}
+			tt.setup(m)
+
+			siteURL, _ := url.Parse("https://example.com/blog")
+			service := NewService(*siteURL, m.articleStorage, m.articleCache, m.authorStorage, m.seriesStorage, m.translationStorage, m.userStorage, m.reactionEnricher, m.viewCounter, testutil.NewLogger())
+			out, err := service.Get(context.Background(), in)
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	in := &dto.FindArticleIn{Slug: "foo", Visitor: dto.Visitor{UserID: "user id"}}
+	pageIn := &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 1, Slug: "foo"}
+	page := &dto.GetArticlesOut{Articles: []dto.Article{{ID: "1", Slug: "foo"}}}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Article, err error, logs []string)
+	}{
+		{
+			name: "get from cache error",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(pageIn)).Return(nil, false, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get articles from cache: foo error")
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(pageIn)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{}}, false, nil)
+				m.translationStorage.EXPECT().FindBySlug(gomock.Any(), gomock.Eq("foo")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "find translation error",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(pageIn)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{}}, false, nil)
+				m.translationStorage.EXPECT().FindBySlug(gomock.Any(), gomock.Eq("foo")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find translation in storage: foo error")
+			},
+		},
+		{
+			name: "draft translation",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(pageIn)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{}}, false, nil)
+				m.translationStorage.EXPECT().
+					FindBySlug(gomock.Any(), gomock.Eq("foo")).
+					Return(&dto.ArticleTranslation{ArticleSlug: "bar", Locale: "de", Status: dto.TranslationStatusDraft}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "translation",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(pageIn)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{}}, false, nil)
+				m.translationStorage.EXPECT().
+					FindBySlug(gomock.Any(), gomock.Eq("foo")).
+					Return(&dto.ArticleTranslation{ArticleSlug: "bar", Locale: "de", Status: dto.TranslationStatusPublished}, nil)
+				m.articleCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 1, Slug: "bar"})).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{{
+						ID:     "1",
+						Slug:   "bar",
+						Title:  "Bar",
+						Locale: "en",
+						Translations: []dto.ArticleTranslation{
+							{Locale: "de", Slug: "foo", Title: "Foo"},
+							{Locale: "fr", Slug: "baz", Title: "Baz"},
+						},
+					}}}, false, nil)
+				m.reactionEnricher.EXPECT().Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).Return(nil)
+				m.viewCounter.EXPECT().Count(gomock.Any(), gomock.Eq("1"), gomock.Eq(in.Visitor)).Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, "de", out.Locale)
+				assert.Equal(t, "foo", out.Slug)
+				assert.Equal(t, "Foo", out.Title)
+				assert.Equal(t, "https://example.com/blog/articles/foo", out.SEO.CanonicalURL)
+				assert.Equal(t, []dto.ArticleAlternate{
+					{Locale: "en", Slug: "bar", Title: "Bar"},
+					{Locale: "de", Slug: "foo", Title: "Foo"},
+					{Locale: "fr", Slug: "baz", Title: "Baz"},
+				}, out.Alternates)
+			},
+		},
+		{
+			name: "enrich reactions error",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(pageIn)).Return(page, false, nil)
+				m.reactionEnricher.EXPECT().
+					Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "enrich articles with reactions: foo error")
+			},
+		},
+		{
+			name: "count view error",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(pageIn)).Return(page, false, nil)
+				m.reactionEnricher.EXPECT().
+					Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).
+					Return(nil)
+				m.viewCounter.EXPECT().
+					Count(gomock.Any(), gomock.Eq("1"), gomock.Eq(in.Visitor)).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, "1", out.ID)
+				assert.Equal(t, []string{`{"level":"error","error":"foo error","message":"count article view error"}`}, logs)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(pageIn)).Return(page, false, nil)
+				m.reactionEnricher.EXPECT().
+					Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).
+					Do(func(_ context.Context, articles []dto.Article, _ string) {
+						articles[0].OwnReactions = []string{"like"}
+					}).
+					Return(nil)
+				m.viewCounter.EXPECT().
+					Count(gomock.Any(), gomock.Eq("1"), gomock.Eq(in.Visitor)).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.Article{
+					ID:           "1",
+					Slug:         "foo",
+					SEO:          dto.ArticleSEO{CanonicalURL: "https://example.com/blog/articles/foo"},
+					OwnReactions: []string{"like"},
+				}, out)
+				// the cached article is left intact
+				assert.Nil(t, page.Articles[0].OwnReactions)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "find series in storage error",
+			setup: func(m serviceMocks) {
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(pageIn)).Return(nil, false, apperrors.ErrNoCache)
+				m.articleCache.EXPECT().Lock(gomock.Any(), gomock.Eq(pageIn)).Return(func() {}, nil)
+				m.articleCache.EXPECT().Token(gomock.Any()).Return(cacheToken, nil)
+				m.articleStorage.EXPECT().
+					Get(gomock.Any(), gomock.Eq(pageIn)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{{ID: "1", Slug: "foo"}}}, nil)
+				m.translationStorage.EXPECT().GetByArticles(gomock.Any(), gomock.Eq([]string{"1"})).Return(map[string][]dto.ArticleTranslation{}, nil)
+				m.authorStorage.EXPECT().
+					GetByArticles(gomock.Any(), gomock.Eq([]string{"1"})).
+					Return(map[string][]dto.ArticleAuthor{}, nil)
+				m.seriesStorage.EXPECT().FindByArticle(gomock.Any(), gomock.Eq("1")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find series in storage: foo error")
+			},
+		},
+		{
+			name: "loaded with series",
+			setup: func(m serviceMocks) {
+				series := &dto.ArticleSeries{
+					ID:            "series id",
+					Title:         "Series",
+					Position:      2,
+					ArticlesCount: 2,
+					Prev:          &dto.SeriesNeighbour{Slug: "bar", Title: "Bar"},
+				}
+
+				m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(pageIn)).Return(nil, false, apperrors.ErrNoCache)
+				m.articleCache.EXPECT().Lock(gomock.Any(), gomock.Eq(pageIn)).Return(func() {}, nil)
+				m.articleCache.EXPECT().Token(gomock.Any()).Return(cacheToken, nil)
+				m.articleStorage.EXPECT().
+					Get(gomock.Any(), gomock.Eq(pageIn)).
+					Return(&dto.GetArticlesOut{Articles: []dto.Article{{ID: "1", Slug: "foo"}}}, nil)
+				m.translationStorage.EXPECT().GetByArticles(gomock.Any(), gomock.Eq([]string{"1"})).Return(map[string][]dto.ArticleTranslation{}, nil)
+				m.authorStorage.EXPECT().
+					GetByArticles(gomock.Any(), gomock.Eq([]string{"1"})).
+					Return(map[string][]dto.ArticleAuthor{}, nil)
+				m.seriesStorage.EXPECT().FindByArticle(gomock.Any(), gomock.Eq("1")).Return(series, nil)
+				m.articleCache.EXPECT().
+					Add(gomock.Any(), gomock.Eq(pageIn), gomock.Eq(cacheToken), gomock.Eq(&dto.GetArticlesOut{Articles: []dto.Article{
+						{ID: "1", Slug: "foo", Series: series},
+					}})).
+					Return(nil)
+				m.reactionEnricher.EXPECT().Enrich(gomock.Any(), gomock.Any(), gomock.Eq("user id")).Return(nil)
+				m.viewCounter.EXPECT().Count(gomock.Any(), gomock.Eq("1"), gomock.Eq(in.Visitor)).Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, "series id", out.Series.ID)
+				assert.Equal(t, "bar", out.Series.Prev.Slug)
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := serviceMocks{
+				articleStorage:     mock.NewMockarticleRepository(ctrl),
+				articleCache:       mock.NewMockarticleCache(ctrl),
+				authorStorage:      mock.NewMockauthorRepository(ctrl),
+				seriesStorage:      mock.NewMockseriesRepository(ctrl),
+				translationStorage: mock.NewMocktranslationRepository(ctrl),
+				userStorage:        mock.NewMockuserRepository(ctrl),
+				reactionEnricher:   mock.NewMockreactionEnricher(ctrl),
+				viewCounter:        mock.NewMockviewCounter(ctrl),
+			}
+			tt.setup(m)
+
+			siteURL, _ := url.Parse("https://example.com/blog")
+			logger := testutil.NewLogger()
+			service := NewService(*siteURL, m.articleStorage, m.articleCache, m.authorStorage, m.seriesStorage, m.translationStorage, m.userStorage, m.reactionEnricher, m.viewCounter, logger)
+			out, err := service.Find(context.Background(), in)
+
+			tt.assert(t, out, err, logger.Logs())
+		})
+	}
+}
+
+func TestGetByIDs(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		ids    []string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out []dto.Article, err error)
+	}{
+		{
+			name:  "no ids",
+			ids:   []string{},
+			setup: func(m serviceMocks) {},
+			assert: func(t *testing.T, out []dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, out)
+			},
+		},
+		{
+			name: "get from storage error",
+			ids:  []string{"1"},
+			setup: func(m serviceMocks) {
+				m.articleStorage.EXPECT().GetByIDs(gomock.Any(), gomock.Eq([]string{"1"})).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get articles from storage: foo error")
+			},
+		},
+		{
+			name: "get authors error",
+			ids:  []string{"1"},
+			setup: func(m serviceMocks) {
+				m.articleStorage.EXPECT().
+					GetByIDs(gomock.Any(), gomock.Any()).
+					Return([]dto.Article{{ID: "1", AuthorID: "author id"}}, nil)
+				m.authorStorage.EXPECT().GetByArticles(gomock.Any(), gomock.Eq([]string{"1"})).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get authors from storage: foo error")
+			},
+		},
+		{
+			name: "get translations error",
+			ids:  []string{"1"},
+			setup: func(m serviceMocks) {
+				m.articleStorage.EXPECT().
+					GetByIDs(gomock.Any(), gomock.Any()).
+					Return([]dto.Article{{ID: "1", AuthorID: "author id"}}, nil)
+				m.authorStorage.EXPECT().GetByArticles(gomock.Any(), gomock.Eq([]string{"1"})).Return(map[string][]dto.ArticleAuthor{}, nil)
+				m.translationStorage.EXPECT().GetByArticles(gomock.Any(), gomock.Eq([]string{"1"})).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get translations from storage: foo error")
+			},
+		},
+		{
+			name: "ok",
+			ids:  []string{"2", "unknown", "1"},
+			setup: func(m serviceMocks) {
+				m.articleStorage.EXPECT().
+					GetByIDs(gomock.Any(), gomock.Eq([]string{"2", "unknown", "1"})).
+					Return([]dto.Article{
+						{ID: "1", Slug: "foo", AuthorID: "author id"},
+						{ID: "2", Slug: "bar", AuthorID: "author id"},
+					}, nil)
+				m.translationStorage.EXPECT().GetByArticles(gomock.Any(), gomock.Eq([]string{"1", "2"})).Return(map[string][]dto.ArticleTranslation{}, nil)
+				m.authorStorage.EXPECT().
+					GetByArticles(gomock.Any(), gomock.Eq([]string{"1", "2"})).
+					Return(map[string][]dto.ArticleAuthor{
+						"1": {{NickName: "bob", Role: dto.ArticleAuthorRoleOwner}},
+						"2": {{NickName: "bob", Role: dto.ArticleAuthorRoleOwner}},
+					}, nil)
+				m.reactionEnricher.EXPECT().Enrich(gomock.Any(), gomock.Len(2), gomock.Eq("user id")).Return(nil)
+			},
+			assert: func(t *testing.T, out []dto.Article, err error) {
+				assert.NoError(t, err)
+				author := dto.ArticleAuthor{NickName: "bob", Role: dto.ArticleAuthorRoleOwner}
+				assert.Equal(t, withCanonicalURLs(
+					dto.Article{ID: "2", Slug: "bar", AuthorID: "author id", Author: &author, Authors: []dto.ArticleAuthor{author}},
+					dto.Article{ID: "1", Slug: "foo", AuthorID: "author id", Author: &author, Authors: []dto.ArticleAuthor{author}},
+				), out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := serviceMocks{
+				articleStorage:     mock.NewMockarticleRepository(ctrl),
+				articleCache:       mock.NewMockarticleCache(ctrl),
+				authorStorage:      mock.NewMockauthorRepository(ctrl),
+				seriesStorage:      mock.NewMockseriesRepository(ctrl),
+				translationStorage: mock.NewMocktranslationRepository(ctrl),
+				userStorage:        mock.NewMockuserRepository(ctrl),
+				reactionEnricher:   mock.NewMockreactionEnricher(ctrl),
+				viewCounter:        mock.NewMockviewCounter(ctrl),
+			}
+			tt.setup(m)
+
+			siteURL, _ := url.Parse("https://example.com/blog")
+			service := NewService(*siteURL, m.articleStorage, m.articleCache, m.authorStorage, m.seriesStorage, m.translationStorage, m.userStorage, m.reactionEnricher, m.viewCounter, testutil.NewLogger())
+			out, err := service.GetByIDs(context.Background(), tt.ids, "user id")
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestFindGated(t *testing.T) {
+	pageIn := &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 1, Slug: "foo"}
+	article := dto.Article{
+		ID:          "1",
+		Slug:        "foo",
+		Content:     "# Foo\n\nBar & baz.",
+		ContentHTML: "<h1 id=\"foo\">Foo</h1>\n<p>Bar &amp; baz.</p>\n",
+		TOC:         []dto.TOCEntry{{Level: 1, Title: "Foo", Anchor: "foo"}},
+		Excerpt:     "Bar & baz.",
+		AuthorID:    "author id",
+		Authors: []dto.ArticleAuthor{
+			{UserID: "author id", Role: dto.ArticleAuthorRoleOwner},
+			{UserID: "co-author id", Role: dto.ArticleAuthorRoleContributor},
+		},
+	}
+	locked := article
+	locked.Content = "Bar & baz."
+	locked.ContentHTML = "<p>Bar &amp; baz.</p>"
+	locked.TOC = nil
+	locked.Locked = true
+
+	for _, tt := range []struct {
+		name       string
+		visibility string
+		userID     string
+		setup      func(m serviceMocks)
+		assert     func(t *testing.T, out *dto.Article, err error)
+	}{
+		{
+			name:       "public for anonymous",
+			visibility: dto.ArticleVisibilityPublic,
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.False(t, out.Locked)
+				assert.Equal(t, article.Content, out.Content)
+			},
+		},
+		{
+			name:       "users for anonymous",
+			visibility: dto.ArticleVisibilityUsers,
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.True(t, out.Locked)
+				assert.Equal(t, locked.Content, out.Content)
+				assert.Equal(t, locked.ContentHTML, out.ContentHTML)
+				assert.Nil(t, out.TOC)
+			},
+		},
+		{
+			name:       "users for user",
+			visibility: dto.ArticleVisibilityUsers,
+			userID:     "user id",
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.False(t, out.Locked)
+				assert.Equal(t, article.Content, out.Content)
+			},
+		},
+		{
+			name:       "members for anonymous",
+			visibility: dto.ArticleVisibilityMembers,
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.True(t, out.Locked)
+				assert.Equal(t, locked.Content, out.Content)
+			},
+		},
+		{
+			name:       "members for author",
+			visibility: dto.ArticleVisibilityMembers,
+			userID:     "author id",
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.False(t, out.Locked)
+				assert.Equal(t, article.Content, out.Content)
+			},
+		},
+		{
+			name:       "members for co-author",
+			visibility: dto.ArticleVisibilityMembers,
+			userID:     "co-author id",
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.False(t, out.Locked)
+				assert.Equal(t, article.Content, out.Content)
+			},
+		},
+		{
+			name:       "find user error",
+			visibility: dto.ArticleVisibilityMembers,
+			userID:     "user id",
+			setup: func(m serviceMocks) {
+				m.userStorage.EXPECT().Find(gomock.Any(), gomock.Eq("user id")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find user in storage: foo error")
+			},
+		},
+		{
+			name:       "members for user",
+			visibility: dto.ArticleVisibilityMembers,
+			userID:     "user id",
+			setup: func(m serviceMocks) {
+				m.userStorage.EXPECT().Find(gomock.Any(), gomock.Eq("user id")).Return(&dto.User{ID: "user id"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.True(t, out.Locked)
+				assert.Equal(t, locked.Content, out.Content)
+			},
+		},
+		{
+			name:       "members for member",
+			visibility: dto.ArticleVisibilityMembers,
+			userID:     "user id",
+			setup: func(m serviceMocks) {
+				m.userStorage.EXPECT().
+					Find(gomock.Any(), gomock.Eq("user id")).
+					Return(&dto.User{ID: "user id", Roles: []string{dto.UserRoleMember}}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.False(t, out.Locked)
+				assert.Equal(t, article.Content, out.Content)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := serviceMocks{
+				articleStorage:     mock.NewMockarticleRepository(ctrl),
+				articleCache:       mock.NewMockarticleCache(ctrl),
+				authorStorage:      mock.NewMockauthorRepository(ctrl),
+				seriesStorage:      mock.NewMockseriesRepository(ctrl),
+				translationStorage: mock.NewMocktranslationRepository(ctrl),
+				userStorage:        mock.NewMockuserRepository(ctrl),
+				reactionEnricher:   mock.NewMockreactionEnricher(ctrl),
+				viewCounter:        mock.NewMockviewCounter(ctrl),
+			}
+
+			cached := article
+			cached.Visibility = tt.visibility
+			page := &dto.GetArticlesOut{Articles: []dto.Article{cached}}
+
+			m.articleCache.EXPECT().Get(gomock.Any(), gomock.Eq(pageIn)).Return(page, false, nil)
+			m.reactionEnricher.EXPECT().Enrich(gomock.Any(), gomock.Any(), gomock.Eq(tt.userID)).Return(nil).AnyTimes()
+			m.viewCounter.EXPECT().Count(gomock.Any(), gomock.Eq("1"), gomock.Any()).Return(nil).AnyTimes()
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			siteURL, _ := url.Parse("https://example.com/blog")
+			service := NewService(*siteURL, m.articleStorage, m.articleCache, m.authorStorage, m.seriesStorage, m.translationStorage, m.userStorage, m.reactionEnricher, m.viewCounter, testutil.NewLogger())
+			out, err := service.Find(context.Background(), &dto.FindArticleIn{Slug: "foo", Visitor: dto.Visitor{UserID: tt.userID}})
+
+			tt.assert(t, out, err)
+			// the cached article is never locked, so readers with access still get the full content
+			assert.Equal(t, article.Content, page.Articles[0].Content)
+			assert.False(t, page.Articles[0].Locked)
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	newArticles := func() []dto.Article {
+		return []dto.Article{
+			{
+				Slug:    "foo",
+				Title:   "Foo",
+				Content: "foo content",
+				Locale:  "en",
+				Translations: []dto.ArticleTranslation{{
+					Locale:      "de",
+					Slug:        "foo-de",
+					Title:       "Foo DE",
+					Content:     "foo content de",
+					ContentHTML: "<p>foo content de</p>",
+					Excerpt:     "foo content de",
+					WordCount:   3,
+					ReadingTime: 1,
+				}},
+			},
+			{Slug: "bar", Title: "Bar", Content: "bar content", Locale: "en"},
+		}
+	}
+
+	t.Run("preferred translation", func(t *testing.T) {
+		articles := newArticles()
+		translate(articles, []string{"de-AT", "en"})
+
+		assert.Equal(t, dto.Article{
+			Slug:         "foo-de",
+			Title:        "Foo DE",
+			Content:      "foo content de",
+			ContentHTML:  "<p>foo content de</p>",
+			Excerpt:      "foo content de",
+			WordCount:    3,
+			ReadingTime:  1,
+			Locale:       "de",
+			Translations: newArticles()[0].Translations,
+			Alternates: []dto.ArticleAlternate{
+				{Locale: "en", Slug: "foo", Title: "Foo"},
+				{Locale: "de", Slug: "foo-de", Title: "Foo DE"},
+			},
+		}, articles[0])
+		// articles without translations are served as they are
+		assert.Equal(t, newArticles()[1], articles[1])
+	})
+
+	t.Run("original as fallback", func(t *testing.T) {
+		articles := newArticles()
+		translate(articles, []string{"fr"})
+
+		assert.Equal(t, "foo", articles[0].Slug)
+		assert.Equal(t, "en", articles[0].Locale)
+		assert.Len(t, articles[0].Alternates, 2)
+	})
+}
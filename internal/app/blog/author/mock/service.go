@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockauthorRepository is a mock of authorRepository interface.
+type MockauthorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorRepositoryMockRecorder is the mock recorder for MockauthorRepository.
+type MockauthorRepositoryMockRecorder struct {
+	mock *MockauthorRepository
+}
+
+// NewMockauthorRepository creates a new mock instance.
+func NewMockauthorRepository(ctrl *gomock.Controller) *MockauthorRepository {
+	mock := &MockauthorRepository{ctrl: ctrl}
+	mock.recorder = &MockauthorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthorRepository) EXPECT() *MockauthorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockauthorRepository) Find(ctx context.Context, nickName string) (*dto.AuthorProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, nickName)
+	ret0, _ := ret[0].(*dto.AuthorProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockauthorRepositoryMockRecorder) Find(ctx, nickName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockauthorRepository)(nil).Find), ctx, nickName)
+}
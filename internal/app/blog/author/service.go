@@ -0,0 +1,37 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package author
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+type authorRepository interface {
+	Find(ctx context.Context, nickName string) (*dto.AuthorProfile, error)
+}
+
+type Service struct {
+	authorRepository authorRepository
+}
+
+func NewService(authorRepository authorRepository) *Service {
+	return &Service{
+		authorRepository: authorRepository,
+	}
+}
+
+func (s *Service) Get(ctx context.Context, nickName string) (*dto.AuthorProfile, error) {
+	profile, err := s.authorRepository.Find(ctx, nickName)
+	if err != nil {
+		return nil, fmt.Errorf("find author in repository: %w", err)
+	}
+
+	if profile == nil {
+		return nil, errors.ErrAuthorNotFound
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,67 @@
+package author
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/author/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+func TestGet(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(authorRepository *mock.MockauthorRepository)
+		assert func(t *testing.T, out *dto.AuthorProfile, err error)
+	}{
+		{
+			name: "find author error",
+			setup: func(authorRepository *mock.MockauthorRepository) {
+				authorRepository.EXPECT().Find(gomock.Any(), gomock.Eq("bob")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.AuthorProfile, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find author in repository: foo error")
+			},
+		},
+		{
+			name: "author not found",
+			setup: func(authorRepository *mock.MockauthorRepository) {
+				authorRepository.EXPECT().Find(gomock.Any(), gomock.Eq("bob")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.AuthorProfile, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrAuthorNotFound)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(authorRepository *mock.MockauthorRepository) {
+				authorRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("bob")).
+					Return(&dto.AuthorProfile{ID: "author id", NickName: "bob", ArticlesCount: 2}, nil)
+			},
+			assert: func(t *testing.T, out *dto.AuthorProfile, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.AuthorProfile{ID: "author id", NickName: "bob", ArticlesCount: 2}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorRepository := mock.NewMockauthorRepository(ctrl)
+			tt.setup(authorRepository)
+
+			out, err := NewService(authorRepository).Get(context.Background(), "bob")
+
+			tt.assert(t, out, err)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package coauthor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// Get returns co-authors of the article including pending invitations, only to authors of the article.
+func (s *Service) Get(ctx context.Context, articleSlug, userID string) ([]*dto.ArticleCoAuthor, error) {
+	article, err := s.findArticle(ctx, articleSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkRole(ctx, article, userID, ""); err != nil {
+		return nil, err
+	}
+
+	coAuthors, err := s.authorRepository.GetCoAuthors(ctx, article.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get co-authors from repository: %w", err)
+	}
+
+	return coAuthors, nil
+}
+
+// Remove removes the co-author or revokes the invitation. Owners remove anyone but the owner of the article,
+// other co-authors only leave the article themselves.
+func (s *Service) Remove(ctx context.Context, in *dto.ArticleAuthorIn) error {
+	article, err := s.findArticle(ctx, in.ArticleSlug)
+	if err != nil {
+		return err
+	}
+
+	author, err := s.authorRepository.Find(ctx, in.NickName)
+	if err != nil {
+		return fmt.Errorf("find author in repository: %w", err)
+	}
+
+	if author == nil {
+		return errors.ErrArticleAuthorNotFound
+	}
+
+	if author.ID == article.AuthorID {
+		return errors.ErrForbidden
+	}
+
+	if author.ID != in.UserID {
+		if err = s.checkRole(ctx, article, in.UserID, dto.ArticleAuthorRoleOwner); err != nil {
+			return err
+		}
+	}
+
+	coAuthor, err := s.authorRepository.FindCoAuthor(ctx, article.ID, author.ID)
+	if err != nil {
+		return fmt.Errorf("find co-author in repository: %w", err)
+	}
+
+	if coAuthor == nil {
+		return errors.ErrArticleAuthorNotFound
+	}
+
+	if err = s.authorRepository.DeleteCoAuthor(ctx, article.ID, author.ID); err != nil {
+		return fmt.Errorf("delete co-author in repository: %w", err)
+	}
+
+	// pending invitations are not shown with articles
+	if coAuthor.Accepted() {
+		s.purgeCache(ctx, article.Slug)
+	}
+
+	return nil
+}
+
+func (s *Service) findArticle(ctx context.Context, slug string) (*dto.Article, error) {
+	article, err := s.articleRepository.Find(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("find article in repository: %w", err)
+	}
+
+	if article == nil {
+		return nil, errors.ErrArticleNotFound
+	}
+
+	return article, nil
+}
+
+// checkRole fails with ErrForbidden unless the user is an author of the article with the role, any role if it's empty.
+func (s *Service) checkRole(ctx context.Context, article *dto.Article, userID, role string) error {
+	if article.AuthorID == userID {
+		return nil
+	}
+
+	actual, err := s.authorRepository.FindRole(ctx, article.ID, userID)
+	if err != nil {
+		return fmt.Errorf("find author role in repository: %w", err)
+	}
+
+	if actual == "" || role != "" && actual != role {
+		return errors.ErrForbidden
+	}
+
+	return nil
+}
+
+// purgeCache drops cached pages of the article, failures are only logged since cached pages expire anyway.
+func (s *Service) purgeCache(ctx context.Context, slug string) {
+	if err := s.articleCache.PurgeArticles(ctx, slug); err != nil {
+		s.logger.Error().Err(err).Msg("purge articles in cache error")
+	}
+}
@@ -0,0 +1,206 @@
+package coauthor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestGet(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		userID string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out []*dto.ArticleCoAuthor, err error)
+	}{
+		{
+			name:   "not an author",
+			userID: "user id",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindRole("user id", "", nil)
+			},
+			assert: func(t *testing.T, out []*dto.ArticleCoAuthor, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name:   "find role error",
+			userID: "user id",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindRole("user id", "", errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []*dto.ArticleCoAuthor, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find author role in repository: foo error")
+			},
+		},
+		{
+			name:   "ok by contributor",
+			userID: "user id",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindRole("user id", dto.ArticleAuthorRoleContributor, nil)
+				m.authorRepository.EXPECT().
+					GetCoAuthors(gomock.Any(), gomock.Eq("article id")).
+					Return([]*dto.ArticleCoAuthor{{UserID: "user id"}}, nil)
+			},
+			assert: func(t *testing.T, out []*dto.ArticleCoAuthor, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []*dto.ArticleCoAuthor{{UserID: "user id"}}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Get(context.Background(), "foo-article", tt.userID)
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	acceptedAt := time.Now()
+	bob := &dto.AuthorProfile{ID: "bob id", NickName: "bob"}
+
+	for _, tt := range []struct {
+		name   string
+		userID string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error, logs []string)
+	}{
+		{
+			name:   "author not found",
+			userID: "owner id",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(nil, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.ErrorIs(t, err, apperrors.ErrArticleAuthorNotFound)
+			},
+		},
+		{
+			name:   "owner of the article",
+			userID: "owner id",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(&dto.AuthorProfile{ID: "owner id", NickName: "bob"}, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name:   "removed by contributor",
+			userID: "user id",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(bob, nil)
+				m.expectFindRole("user id", dto.ArticleAuthorRoleContributor, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name:   "not a co-author",
+			userID: "owner id",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(bob, nil)
+				m.authorRepository.EXPECT().FindCoAuthor(gomock.Any(), gomock.Eq("article id"), gomock.Eq("bob id")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.ErrorIs(t, err, apperrors.ErrArticleAuthorNotFound)
+			},
+		},
+		{
+			name:   "delete error",
+			userID: "owner id",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(bob, nil)
+				m.authorRepository.EXPECT().
+					FindCoAuthor(gomock.Any(), gomock.Eq("article id"), gomock.Eq("bob id")).
+					Return(&dto.ArticleCoAuthor{UserID: "bob id"}, nil)
+				m.authorRepository.EXPECT().
+					DeleteCoAuthor(gomock.Any(), gomock.Eq("article id"), gomock.Eq("bob id")).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "delete co-author in repository: foo error")
+			},
+		},
+		{
+			name:   "invitation revoked",
+			userID: "owner id",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(bob, nil)
+				m.authorRepository.EXPECT().
+					FindCoAuthor(gomock.Any(), gomock.Eq("article id"), gomock.Eq("bob id")).
+					Return(&dto.ArticleCoAuthor{UserID: "bob id"}, nil)
+				m.authorRepository.EXPECT().
+					DeleteCoAuthor(gomock.Any(), gomock.Eq("article id"), gomock.Eq("bob id")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:   "co-author left",
+			userID: "bob id",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(bob, nil)
+				m.authorRepository.EXPECT().
+					FindCoAuthor(gomock.Any(), gomock.Eq("article id"), gomock.Eq("bob id")).
+					Return(&dto.ArticleCoAuthor{UserID: "bob id", AcceptedAt: &acceptedAt}, nil)
+				m.authorRepository.EXPECT().
+					DeleteCoAuthor(gomock.Any(), gomock.Eq("article id"), gomock.Eq("bob id")).
+					Return(nil)
+				m.articleCache.EXPECT().PurgeArticles(gomock.Any(), gomock.Eq("foo-article")).Return(nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			err := m.newService(logger).Remove(context.Background(), &dto.ArticleAuthorIn{
+				UserID:      tt.userID,
+				ArticleSlug: "foo-article",
+				NickName:    "bob",
+			})
+
+			tt.assert(t, err, logger.Logs())
+		})
+	}
+}
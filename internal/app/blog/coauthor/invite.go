@@ -0,0 +1,107 @@
+package coauthor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/mail"
+)
+
+// Invite mails the user an invitation to co-author the article, only owners of the article invite.
+// Inviting a user with a pending invitation again sends a new link, the old one stops working.
+func (s *Service) Invite(ctx context.Context, in *dto.InviteArticleAuthorIn) (*dto.ArticleCoAuthor, error) {
+	article, err := s.findArticle(ctx, in.ArticleSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkRole(ctx, article, in.UserID, dto.ArticleAuthorRoleOwner); err != nil {
+		return nil, err
+	}
+
+	invitee, err := s.authorRepository.Find(ctx, in.NickName)
+	if err != nil {
+		return nil, fmt.Errorf("find author in repository: %w", err)
+	}
+
+	if invitee == nil {
+		return nil, errors.ErrAuthorNotFound
+	}
+
+	if invitee.ID == article.AuthorID {
+		return nil, errors.ErrArticleAuthorExists
+	}
+
+	existing, err := s.authorRepository.FindCoAuthor(ctx, article.ID, invitee.ID)
+	if err != nil {
+		return nil, fmt.Errorf("find co-author in repository: %w", err)
+	}
+
+	if existing != nil && existing.Accepted() {
+		return nil, errors.ErrArticleAuthorExists
+	}
+
+	user, err := s.userRepository.Find(ctx, invitee.ID)
+	if err != nil {
+		return nil, fmt.Errorf("find user in repository: %w", err)
+	}
+
+	if user == nil {
+		return nil, errors.ErrAuthorNotFound
+	}
+
+	coAuthor := &dto.ArticleCoAuthor{
+		ArticleID:   article.ID,
+		ArticleSlug: article.Slug,
+		UserID:      invitee.ID,
+		NickName:    invitee.NickName,
+		Role:        in.Role,
+	}
+
+	if err = s.authorRepository.SaveInvitation(ctx, coAuthor); err != nil {
+		return nil, fmt.Errorf("save invitation in repository: %w", err)
+	}
+
+	mailData := mail.ArticleInvitationData{
+		ArticleTitle: article.Title,
+		Role:         coAuthor.Role,
+		AcceptURL:    s.newAcceptURL(coAuthor.InvitationToken),
+	}
+
+	if err = s.invitationMailer.MailTo(ctx, user.Email, mailData); err != nil {
+		return nil, fmt.Errorf("mail invitation to user: %w", err)
+	}
+
+	return coAuthor, nil
+}
+
+// Accept makes the user a co-author by the invitation token mailed to the user.
+// Tokens of other users are not found, so forwarded links don't work.
+func (s *Service) Accept(ctx context.Context, in *dto.AcceptArticleAuthorIn) (*dto.ArticleCoAuthor, error) {
+	invitation, err := s.authorRepository.FindInvitation(ctx, in.Token)
+	if err != nil {
+		return nil, fmt.Errorf("find invitation in repository: %w", err)
+	}
+
+	if invitation == nil || invitation.UserID != in.UserID {
+		return nil, errors.ErrInvitationNotFound
+	}
+
+	if err = s.authorRepository.Accept(ctx, invitation.ArticleID, invitation.UserID); err != nil {
+		return nil, fmt.Errorf("accept invitation in repository: %w", err)
+	}
+
+	s.purgeCache(ctx, invitation.ArticleSlug)
+
+	return invitation, nil
+}
+
+func (s *Service) newAcceptURL(token string) string {
+	u := s.siteURL.JoinPath("article-invitations")
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -0,0 +1,296 @@
+package coauthor
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/coauthor/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/mail"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	authorRepository  *mock.MockauthorRepository
+	articleRepository *mock.MockarticleRepository
+	userRepository    *mock.MockuserRepository
+	articleCache      *mock.MockarticleCache
+	invitationMailer  *mock.MockinvitationMailer
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		authorRepository:  mock.NewMockauthorRepository(ctrl),
+		articleRepository: mock.NewMockarticleRepository(ctrl),
+		userRepository:    mock.NewMockuserRepository(ctrl),
+		articleCache:      mock.NewMockarticleCache(ctrl),
+		invitationMailer:  mock.NewMockinvitationMailer(ctrl),
+	}
+}
+
+func (m serviceMocks) newService(logger log.Logger) *Service {
+	siteURL, _ := url.Parse("https://example.com/blog")
+	return NewService(*siteURL, m.authorRepository, m.articleRepository, m.userRepository, m.articleCache, m.invitationMailer, logger)
+}
+
+func (m serviceMocks) expectFindArticle(article *dto.Article, err error) {
+	m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo-article")).Return(article, err)
+}
+
+func (m serviceMocks) expectFindRole(userID, role string, err error) {
+	m.authorRepository.EXPECT().
+		FindRole(gomock.Any(), gomock.Eq("article id"), gomock.Eq(userID)).
+		Return(role, err)
+}
+
+func (m serviceMocks) expectFindAuthor(profile *dto.AuthorProfile, err error) {
+	m.authorRepository.EXPECT().Find(gomock.Any(), gomock.Eq("bob")).Return(profile, err)
+}
+
+func ownedArticle() *dto.Article {
+	return &dto.Article{ID: "article id", Slug: "foo-article", Title: "Foo", AuthorID: "owner id"}
+}
+
+func TestInvite(t *testing.T) {
+	in := &dto.InviteArticleAuthorIn{
+		UserID:      "owner id",
+		ArticleSlug: "foo-article",
+		NickName:    "bob",
+		Role:        dto.ArticleAuthorRoleContributor,
+	}
+	bob := &dto.AuthorProfile{ID: "bob id", NickName: "bob"}
+
+	for _, tt := range []struct {
+		name   string
+		in     *dto.InviteArticleAuthorIn
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.ArticleCoAuthor, err error)
+	}{
+		{
+			name: "article not found",
+			in:   in,
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "invited by contributor",
+			in:   &dto.InviteArticleAuthorIn{UserID: "user id", ArticleSlug: "foo-article", NickName: "bob", Role: dto.ArticleAuthorRoleContributor},
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindRole("user id", dto.ArticleAuthorRoleContributor, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "find author error",
+			in:   in,
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find author in repository: foo error")
+			},
+		},
+		{
+			name: "author not found",
+			in:   in,
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrAuthorNotFound)
+			},
+		},
+		{
+			name: "owner invited",
+			in:   in,
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(&dto.AuthorProfile{ID: "owner id", NickName: "bob"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleAuthorExists)
+			},
+		},
+		{
+			name: "co-author invited",
+			in:   in,
+			setup: func(m serviceMocks) {
+				acceptedAt := time.Now()
+
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(bob, nil)
+				m.authorRepository.EXPECT().
+					FindCoAuthor(gomock.Any(), gomock.Eq("article id"), gomock.Eq("bob id")).
+					Return(&dto.ArticleCoAuthor{UserID: "bob id", AcceptedAt: &acceptedAt}, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleAuthorExists)
+			},
+		},
+		{
+			name: "mail error",
+			in:   in,
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindAuthor(bob, nil)
+				m.authorRepository.EXPECT().FindCoAuthor(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq("bob id")).Return(&dto.User{Email: "bob@example.com"}, nil)
+				m.authorRepository.EXPECT().SaveInvitation(gomock.Any(), gomock.Any()).Return(nil)
+				m.invitationMailer.EXPECT().MailTo(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "mail invitation to user: foo error")
+			},
+		},
+		{
+			name: "ok by co-owner, pending invitation renewed",
+			in:   &dto.InviteArticleAuthorIn{UserID: "user id", ArticleSlug: "foo-article", NickName: "bob", Role: dto.ArticleAuthorRoleOwner},
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownedArticle(), nil)
+				m.expectFindRole("user id", dto.ArticleAuthorRoleOwner, nil)
+				m.expectFindAuthor(bob, nil)
+				m.authorRepository.EXPECT().
+					FindCoAuthor(gomock.Any(), gomock.Eq("article id"), gomock.Eq("bob id")).
+					Return(&dto.ArticleCoAuthor{UserID: "bob id", InvitationToken: "old token"}, nil)
+				m.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq("bob id")).Return(&dto.User{Email: "bob@example.com"}, nil)
+				m.authorRepository.EXPECT().
+					SaveInvitation(gomock.Any(), gomock.Eq(&dto.ArticleCoAuthor{
+						ArticleID:   "article id",
+						ArticleSlug: "foo-article",
+						UserID:      "bob id",
+						NickName:    "bob",
+						Role:        dto.ArticleAuthorRoleOwner,
+					})).
+					Do(func(_ context.Context, coAuthor *dto.ArticleCoAuthor) {
+						coAuthor.InvitationToken = "new token"
+					}).
+					Return(nil)
+				m.invitationMailer.EXPECT().
+					MailTo(gomock.Any(), gomock.Eq("bob@example.com"), gomock.Eq(mail.ArticleInvitationData{
+						ArticleTitle: "Foo",
+						Role:         dto.ArticleAuthorRoleOwner,
+						AcceptURL:    "https://example.com/blog/article-invitations?token=new+token",
+					})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "new token", out.InvitationToken)
+				assert.Equal(t, dto.ArticleAuthorRoleOwner, out.Role)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Invite(context.Background(), tt.in)
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestAccept(t *testing.T) {
+	in := &dto.AcceptArticleAuthorIn{UserID: "bob id", Token: "token"}
+	invitation := func() *dto.ArticleCoAuthor {
+		return &dto.ArticleCoAuthor{ArticleID: "article id", ArticleSlug: "foo-article", UserID: "bob id", InvitationToken: "token"}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.ArticleCoAuthor, err error, logs []string)
+	}{
+		{
+			name: "find invitation error",
+			setup: func(m serviceMocks) {
+				m.authorRepository.EXPECT().FindInvitation(gomock.Any(), gomock.Eq("token")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find invitation in repository: foo error")
+			},
+		},
+		{
+			name: "invitation of another user",
+			setup: func(m serviceMocks) {
+				other := invitation()
+				other.UserID = "alice id"
+				m.authorRepository.EXPECT().FindInvitation(gomock.Any(), gomock.Eq("token")).Return(other, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrInvitationNotFound)
+			},
+		},
+		{
+			name: "accept error",
+			setup: func(m serviceMocks) {
+				m.authorRepository.EXPECT().FindInvitation(gomock.Any(), gomock.Eq("token")).Return(invitation(), nil)
+				m.authorRepository.EXPECT().
+					Accept(gomock.Any(), gomock.Eq("article id"), gomock.Eq("bob id")).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "accept invitation in repository: foo error")
+			},
+		},
+		{
+			name: "ok, purge cache error",
+			setup: func(m serviceMocks) {
+				m.authorRepository.EXPECT().FindInvitation(gomock.Any(), gomock.Eq("token")).Return(invitation(), nil)
+				m.authorRepository.EXPECT().
+					Accept(gomock.Any(), gomock.Eq("article id"), gomock.Eq("bob id")).
+					Return(nil)
+				m.articleCache.EXPECT().PurgeArticles(gomock.Any(), gomock.Eq("foo-article")).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ArticleCoAuthor, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, "foo-article", out.ArticleSlug)
+				assert.Equal(t, []string{`{"level":"error","error":"foo error","message":"purge articles in cache error"}`}, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			out, err := m.newService(logger).Accept(context.Background(), in)
+
+			tt.assert(t, out, err, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,319 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	mail "github.com/art-es/yet-another-service/internal/core/mail"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockauthorRepository is a mock of authorRepository interface.
+type MockauthorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorRepositoryMockRecorder is the mock recorder for MockauthorRepository.
+type MockauthorRepositoryMockRecorder struct {
+	mock *MockauthorRepository
+}
+
+// NewMockauthorRepository creates a new mock instance.
+func NewMockauthorRepository(ctrl *gomock.Controller) *MockauthorRepository {
+	mock := &MockauthorRepository{ctrl: ctrl}
+	mock.recorder = &MockauthorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthorRepository) EXPECT() *MockauthorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockauthorRepository) Accept(ctx context.Context, articleID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, articleID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockauthorRepositoryMockRecorder) Accept(ctx, articleID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockauthorRepository)(nil).Accept), ctx, articleID, userID)
+}
+
+// DeleteCoAuthor mocks base method.
+func (m *MockauthorRepository) DeleteCoAuthor(ctx context.Context, articleID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCoAuthor", ctx, articleID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCoAuthor indicates an expected call of DeleteCoAuthor.
+func (mr *MockauthorRepositoryMockRecorder) DeleteCoAuthor(ctx, articleID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCoAuthor", reflect.TypeOf((*MockauthorRepository)(nil).DeleteCoAuthor), ctx, articleID, userID)
+}
+
+// Find mocks base method.
+func (m *MockauthorRepository) Find(ctx context.Context, nickName string) (*dto.AuthorProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, nickName)
+	ret0, _ := ret[0].(*dto.AuthorProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockauthorRepositoryMockRecorder) Find(ctx, nickName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockauthorRepository)(nil).Find), ctx, nickName)
+}
+
+// FindCoAuthor mocks base method.
+func (m *MockauthorRepository) FindCoAuthor(ctx context.Context, articleID, userID string) (*dto.ArticleCoAuthor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCoAuthor", ctx, articleID, userID)
+	ret0, _ := ret[0].(*dto.ArticleCoAuthor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCoAuthor indicates an expected call of FindCoAuthor.
+func (mr *MockauthorRepositoryMockRecorder) FindCoAuthor(ctx, articleID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCoAuthor", reflect.TypeOf((*MockauthorRepository)(nil).FindCoAuthor), ctx, articleID, userID)
+}
+
+// FindInvitation mocks base method.
+func (m *MockauthorRepository) FindInvitation(ctx context.Context, token string) (*dto.ArticleCoAuthor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInvitation", ctx, token)
+	ret0, _ := ret[0].(*dto.ArticleCoAuthor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInvitation indicates an expected call of FindInvitation.
+func (mr *MockauthorRepositoryMockRecorder) FindInvitation(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInvitation", reflect.TypeOf((*MockauthorRepository)(nil).FindInvitation), ctx, token)
+}
+
+// FindRole mocks base method.
+func (m *MockauthorRepository) FindRole(ctx context.Context, articleID, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRole", ctx, articleID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRole indicates an expected call of FindRole.
+func (mr *MockauthorRepositoryMockRecorder) FindRole(ctx, articleID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRole", reflect.TypeOf((*MockauthorRepository)(nil).FindRole), ctx, articleID, userID)
+}
+
+// GetCoAuthors mocks base method.
+func (m *MockauthorRepository) GetCoAuthors(ctx context.Context, articleID string) ([]*dto.ArticleCoAuthor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoAuthors", ctx, articleID)
+	ret0, _ := ret[0].([]*dto.ArticleCoAuthor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoAuthors indicates an expected call of GetCoAuthors.
+func (mr *MockauthorRepositoryMockRecorder) GetCoAuthors(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoAuthors", reflect.TypeOf((*MockauthorRepository)(nil).GetCoAuthors), ctx, articleID)
+}
+
+// SaveInvitation mocks base method.
+func (m *MockauthorRepository) SaveInvitation(ctx context.Context, coAuthor *dto.ArticleCoAuthor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveInvitation", ctx, coAuthor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveInvitation indicates an expected call of SaveInvitation.
+func (mr *MockauthorRepositoryMockRecorder) SaveInvitation(ctx, coAuthor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveInvitation", reflect.TypeOf((*MockauthorRepository)(nil).SaveInvitation), ctx, coAuthor)
+}
+
+// MockarticleRepository is a mock of articleRepository interface.
+type MockarticleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleRepositoryMockRecorder is the mock recorder for MockarticleRepository.
+type MockarticleRepositoryMockRecorder struct {
+	mock *MockarticleRepository
+}
+
+// NewMockarticleRepository creates a new mock instance.
+func NewMockarticleRepository(ctrl *gomock.Controller) *MockarticleRepository {
+	mock := &MockarticleRepository{ctrl: ctrl}
+	mock.recorder = &MockarticleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleRepository) EXPECT() *MockarticleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockarticleRepository) Find(ctx context.Context, slug string) (*dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, slug)
+	ret0, _ := ret[0].(*dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockarticleRepositoryMockRecorder) Find(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockarticleRepository)(nil).Find), ctx, slug)
+}
+
+// MockuserRepository is a mock of userRepository interface.
+type MockuserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepositoryMockRecorder is the mock recorder for MockuserRepository.
+type MockuserRepositoryMockRecorder struct {
+	mock *MockuserRepository
+}
+
+// NewMockuserRepository creates a new mock instance.
+func NewMockuserRepository(ctrl *gomock.Controller) *MockuserRepository {
+	mock := &MockuserRepository{ctrl: ctrl}
+	mock.recorder = &MockuserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepository) EXPECT() *MockuserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockuserRepository) Find(ctx context.Context, id string) (*dto.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(*dto.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockuserRepositoryMockRecorder) Find(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockuserRepository)(nil).Find), ctx, id)
+}
+
+// MockarticleCache is a mock of articleCache interface.
+type MockarticleCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleCacheMockRecorder is the mock recorder for MockarticleCache.
+type MockarticleCacheMockRecorder struct {
+	mock *MockarticleCache
+}
+
+// NewMockarticleCache creates a new mock instance.
+func NewMockarticleCache(ctrl *gomock.Controller) *MockarticleCache {
+	mock := &MockarticleCache{ctrl: ctrl}
+	mock.recorder = &MockarticleCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleCache) EXPECT() *MockarticleCacheMockRecorder {
+	return m.recorder
+}
+
+// PurgeArticles mocks base method.
+func (m *MockarticleCache) PurgeArticles(ctx context.Context, slugs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range slugs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeArticles", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeArticles indicates an expected call of PurgeArticles.
+func (mr *MockarticleCacheMockRecorder) PurgeArticles(ctx any, slugs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, slugs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeArticles", reflect.TypeOf((*MockarticleCache)(nil).PurgeArticles), varargs...)
+}
+
+// MockinvitationMailer is a mock of invitationMailer interface.
+type MockinvitationMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockinvitationMailerMockRecorder
+	isgomock struct{}
+}
+
+// MockinvitationMailerMockRecorder is the mock recorder for MockinvitationMailer.
+type MockinvitationMailerMockRecorder struct {
+	mock *MockinvitationMailer
+}
+
+// NewMockinvitationMailer creates a new mock instance.
+func NewMockinvitationMailer(ctrl *gomock.Controller) *MockinvitationMailer {
+	mock := &MockinvitationMailer{ctrl: ctrl}
+	mock.recorder = &MockinvitationMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinvitationMailer) EXPECT() *MockinvitationMailerMockRecorder {
+	return m.recorder
+}
+
+// MailTo mocks base method.
+func (m *MockinvitationMailer) MailTo(ctx context.Context, address string, data mail.ArticleInvitationData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MailTo", ctx, address, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MailTo indicates an expected call of MailTo.
+func (mr *MockinvitationMailerMockRecorder) MailTo(ctx, address, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MailTo", reflect.TypeOf((*MockinvitationMailer)(nil).MailTo), ctx, address, data)
+}
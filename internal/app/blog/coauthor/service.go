@@ -0,0 +1,73 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package coauthor
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/mail"
+)
+
+type authorRepository interface {
+	Find(ctx context.Context, nickName string) (*dto.AuthorProfile, error)
+	// FindRole returns the role of the user among authors of the article, empty if the user is not one of them.
+	FindRole(ctx context.Context, articleID, userID string) (string, error)
+	GetCoAuthors(ctx context.Context, articleID string) ([]*dto.ArticleCoAuthor, error)
+	FindCoAuthor(ctx context.Context, articleID, userID string) (*dto.ArticleCoAuthor, error)
+	FindInvitation(ctx context.Context, token string) (*dto.ArticleCoAuthor, error)
+	// SaveInvitation invites the user with a new token, a pending invitation of the user is replaced.
+	SaveInvitation(ctx context.Context, coAuthor *dto.ArticleCoAuthor) error
+	Accept(ctx context.Context, articleID, userID string) error
+	DeleteCoAuthor(ctx context.Context, articleID, userID string) error
+}
+
+type articleRepository interface {
+	Find(ctx context.Context, slug string) (*dto.Article, error)
+}
+
+type userRepository interface {
+	Find(ctx context.Context, id string) (*dto.User, error)
+}
+
+// articleCache is purged after authors of an article change, since articles are cached with their authors.
+type articleCache interface {
+	PurgeArticles(ctx context.Context, slugs ...string) error
+}
+
+// invitationMailer mails invitations to co-author articles.
+type invitationMailer interface {
+	MailTo(ctx context.Context, address string, data mail.ArticleInvitationData) error
+}
+
+type Service struct {
+	siteURL           url.URL
+	authorRepository  authorRepository
+	articleRepository articleRepository
+	userRepository    userRepository
+	articleCache      articleCache
+	invitationMailer  invitationMailer
+	logger            log.Logger
+}
+
+// NewService creates the co-author service, siteURL is the public URL of the blog used in invitation links.
+func NewService(
+	siteURL url.URL,
+	authorRepository authorRepository,
+	articleRepository articleRepository,
+	userRepository userRepository,
+	articleCache articleCache,
+	invitationMailer invitationMailer,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		siteURL:           siteURL,
+		authorRepository:  authorRepository,
+		articleRepository: articleRepository,
+		userRepository:    userRepository,
+		articleCache:      articleCache,
+		invitationMailer:  invitationMailer,
+		logger:            logger,
+	}
+}
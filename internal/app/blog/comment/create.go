@@ -0,0 +1,69 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+func (s *Service) Create(ctx context.Context, in *dto.CreateCommentIn) (*dto.Comment, error) {
+	article, err := s.articleRepository.Find(ctx, in.ArticleSlug)
+	if err != nil {
+		return nil, fmt.Errorf("find article in repository: %w", err)
+	}
+
+	if article == nil || article.Hidden {
+		return nil, errors.ErrArticleNotFound
+	}
+
+	if in.ParentID != nil {
+		parent, err := s.commentRepository.Find(ctx, *in.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("find parent comment in repository: %w", err)
+		}
+
+		if parent == nil || parent.Deleted || parent.ArticleID != article.ID {
+			return nil, errors.ErrCommentNotFound
+		}
+	}
+
+	comment := &dto.Comment{
+		ArticleID: article.ID,
+		ParentID:  in.ParentID,
+		AuthorID:  in.UserID,
+		Content:   in.Content,
+	}
+
+	tx := transaction.New(ctx)
+
+	if err = s.doCreateTransaction(ctx, tx, comment, article); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return comment, nil
+}
+
+func (s *Service) doCreateTransaction(
+	ctx context.Context,
+	tx transaction.Transaction,
+	comment *dto.Comment,
+	article *dto.Article,
+) error {
+	if err := s.commentRepository.Save(ctx, tx, comment); err != nil {
+		return fmt.Errorf("save comment in repository: %w", err)
+	}
+
+	if err := s.webhookPublisher.PublishComment(ctx, tx, comment, article); err != nil {
+		return fmt.Errorf("publish webhook event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+func TestCreate(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Comment, err error)
+	}{
+		{
+			name: "article not found",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "article hidden",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id", Hidden: true}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "find parent comment error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("parent id")).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find parent comment in repository: foo error")
+			},
+		},
+		{
+			name: "parent comment from another article",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("parent id")).
+					Return(&dto.Comment{ID: "parent id", ArticleID: "another article id"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrCommentNotFound)
+			},
+		},
+		{
+			name: "save comment error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("parent id")).
+					Return(&dto.Comment{ID: "parent id", ArticleID: "article id"}, nil)
+				m.commentRepository.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "save comment in repository: foo error")
+			},
+		},
+		{
+			name: "publish webhook event error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("parent id")).
+					Return(&dto.Comment{ID: "parent id", ArticleID: "article id"}, nil)
+				m.commentRepository.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.webhookPublisher.EXPECT().
+					PublishComment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "publish webhook event: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				article := &dto.Article{ID: "article id", Slug: "foo-article"}
+				m.expectFindArticle(article, nil)
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("parent id")).
+					Return(&dto.Comment{ID: "parent id", ArticleID: "article id"}, nil)
+
+				expectedComment := &dto.Comment{
+					ArticleID: "article id",
+					ParentID:  pointer.To("parent id"),
+					AuthorID:  "user id",
+					Content:   "lorem ipsum",
+				}
+				m.commentRepository.EXPECT().
+					Save(gomock.Any(), gomock.Not(nil), gomock.Eq(expectedComment)).
+					Do(func(_ context.Context, _ transaction.Transaction, comment *dto.Comment) {
+						comment.ID = "comment id"
+					}).
+					Return(nil)
+				m.webhookPublisher.EXPECT().
+					PublishComment(gomock.Any(), gomock.Not(nil), gomock.Cond(func(comment *dto.Comment) bool { return comment.ID == "comment id" }), gomock.Eq(article)).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.Comment{
+					ID:        "comment id",
+					ArticleID: "article id",
+					ParentID:  pointer.To("parent id"),
+					AuthorID:  "user id",
+					Content:   "lorem ipsum",
+				}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService().Create(context.Background(), &dto.CreateCommentIn{
+				ArticleSlug: "foo-article",
+				ParentID:    pointer.To("parent id"),
+				UserID:      "user id",
+				Content:     "lorem ipsum",
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
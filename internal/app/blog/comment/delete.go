@@ -0,0 +1,20 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+func (s *Service) Delete(ctx context.Context, in *dto.DeleteCommentIn) error {
+	if _, err := s.findOwnComment(ctx, in.ID, in.UserID); err != nil {
+		return err
+	}
+
+	if err := s.commentRepository.Delete(ctx, in.ID); err != nil {
+		return fmt.Errorf("delete comment in repository: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+func TestDelete(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "comment not found",
+			setup: func(m serviceMocks) {
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("comment id")).
+					Return(nil, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrCommentNotFound)
+			},
+		},
+		{
+			name: "delete comment error",
+			setup: func(m serviceMocks) {
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("comment id")).
+					Return(&dto.Comment{ID: "comment id", AuthorID: "user id"}, nil)
+				m.commentRepository.EXPECT().
+					Delete(gomock.Any(), gomock.Eq("comment id")).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "delete comment in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("comment id")).
+					Return(&dto.Comment{ID: "comment id", AuthorID: "user id"}, nil)
+				m.commentRepository.EXPECT().
+					Delete(gomock.Any(), gomock.Eq("comment id")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService().Delete(context.Background(), &dto.DeleteCommentIn{
+				ID:     "comment id",
+				UserID: "user id",
+			})
+
+			tt.assert(t, err)
+		})
+	}
+}
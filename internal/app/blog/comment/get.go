@@ -0,0 +1,85 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+func (s *Service) Get(ctx context.Context, in *dto.GetCommentsIn) (*dto.GetCommentsOut, error) {
+	article, err := s.articleRepository.Find(ctx, in.ArticleSlug)
+	if err != nil {
+		return nil, fmt.Errorf("find article in repository: %w", err)
+	}
+
+	if article == nil || article.Hidden {
+		return nil, errors.ErrArticleNotFound
+	}
+
+	out, err := s.commentRepository.Get(ctx, article.ID, in.FromID)
+	if err != nil {
+		return nil, fmt.Errorf("get comments from repository: %w", err)
+	}
+
+	if len(out.Comments) == 0 {
+		return out, nil
+	}
+
+	authorMap, err := s.authorRepository.Get(ctx, getAuthorIDs(out.Comments))
+	if err != nil {
+		return nil, fmt.Errorf("get authors from repository: %w", err)
+	}
+
+	for _, comment := range out.Comments {
+		if comment.Deleted {
+			comment.Content = ""
+			continue
+		}
+
+		comment.Author = authorMap[comment.AuthorID]
+	}
+
+	out.Comments = buildTree(out.Comments)
+	return out, nil
+}
+
+// buildTree links replies to their parents and returns root comments.
+// The input order is kept for both roots and replies.
+func buildTree(comments []*dto.Comment) []*dto.Comment {
+	byID := make(map[string]*dto.Comment, len(comments))
+	for _, comment := range comments {
+		byID[comment.ID] = comment
+	}
+
+	roots := make([]*dto.Comment, 0)
+	for _, comment := range comments {
+		if comment.ParentID == nil {
+			roots = append(roots, comment)
+			continue
+		}
+
+		if parent, ok := byID[*comment.ParentID]; ok {
+			parent.Replies = append(parent.Replies, comment)
+		}
+	}
+
+	return roots
+}
+
+func getAuthorIDs(comments []*dto.Comment) []string {
+	out := make([]string, 0)
+	set := make(map[string]struct{})
+	for _, comment := range comments {
+		if comment.Deleted {
+			continue
+		}
+
+		if _, exists := set[comment.AuthorID]; !exists {
+			set[comment.AuthorID] = struct{}{}
+			out = append(out, comment.AuthorID)
+		}
+	}
+	return out
+}
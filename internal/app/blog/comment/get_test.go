@@ -0,0 +1,184 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/comment/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	articleRepository *mock.MockarticleRepository
+	commentRepository *mock.MockcommentRepository
+	authorRepository  *mock.MockauthorRepository
+	webhookPublisher  *mock.MockwebhookPublisher
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		articleRepository: mock.NewMockarticleRepository(ctrl),
+		commentRepository: mock.NewMockcommentRepository(ctrl),
+		authorRepository:  mock.NewMockauthorRepository(ctrl),
+		webhookPublisher:  mock.NewMockwebhookPublisher(ctrl),
+	}
+}
+
+func (m serviceMocks) newService() *Service {
+	return NewService(time.Minute*15, m.articleRepository, m.commentRepository, m.authorRepository, m.webhookPublisher, testutil.NewLogger())
+}
+
+func (m serviceMocks) expectFindArticle(article *dto.Article, err error) {
+	m.articleRepository.EXPECT().
+		Find(gomock.Any(), gomock.Eq("foo-article")).
+		Return(article, err)
+}
+
+func TestGet(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.GetCommentsOut, err error)
+	}{
+		{
+			name: "find article error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetCommentsOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find article in repository: foo error")
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetCommentsOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "article hidden",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id", Hidden: true}, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetCommentsOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "get comments error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.commentRepository.EXPECT().
+					Get(gomock.Any(), gomock.Eq("article id"), gomock.Eq(pointer.To("from id"))).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetCommentsOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get comments from repository: foo error")
+			},
+		},
+		{
+			name: "get authors error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.commentRepository.EXPECT().
+					Get(gomock.Any(), gomock.Eq("article id"), gomock.Eq(pointer.To("from id"))).
+					Return(&dto.GetCommentsOut{Comments: []*dto.Comment{{ID: "1", AuthorID: "author 1"}}}, nil)
+				m.authorRepository.EXPECT().
+					Get(gomock.Any(), gomock.Eq([]string{"author 1"})).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetCommentsOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get authors from repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.commentRepository.EXPECT().
+					Get(gomock.Any(), gomock.Eq("article id"), gomock.Eq(pointer.To("from id"))).
+					Return(&dto.GetCommentsOut{
+						Comments: []*dto.Comment{
+							{ID: "1", AuthorID: "author 1", Content: "root 1", CreatedAt: createdAt},
+							{ID: "2", AuthorID: "author 2", Content: "root 2", CreatedAt: createdAt, Deleted: true},
+							{ID: "3", ParentID: pointer.To("2"), AuthorID: "author 1", Content: "reply 3", CreatedAt: createdAt},
+							{ID: "4", ParentID: pointer.To("3"), AuthorID: "author 3", Content: "reply 4", CreatedAt: createdAt},
+						},
+						HasMore: true,
+					}, nil)
+				m.authorRepository.EXPECT().
+					Get(gomock.Any(), gomock.Eq([]string{"author 1", "author 3"})).
+					Return(map[string]*dto.ArticleAuthor{
+						"author 1": {DisplayName: "Foo", NickName: "foo"},
+					}, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetCommentsOut, err error) {
+				assert.NoError(t, err)
+
+				reply4 := &dto.Comment{ID: "4", ParentID: pointer.To("3"), AuthorID: "author 3", Content: "reply 4", CreatedAt: createdAt}
+				reply3 := &dto.Comment{
+					ID:        "3",
+					ParentID:  pointer.To("2"),
+					AuthorID:  "author 1",
+					Content:   "reply 3",
+					CreatedAt: createdAt,
+					Author:    &dto.ArticleAuthor{DisplayName: "Foo", NickName: "foo"},
+					Replies:   []*dto.Comment{reply4},
+				}
+				expected := &dto.GetCommentsOut{
+					Comments: []*dto.Comment{
+						{
+							ID:        "1",
+							AuthorID:  "author 1",
+							Content:   "root 1",
+							CreatedAt: createdAt,
+							Author:    &dto.ArticleAuthor{DisplayName: "Foo", NickName: "foo"},
+						},
+						{
+							ID:        "2",
+							AuthorID:  "author 2",
+							CreatedAt: createdAt,
+							Deleted:   true,
+							Replies:   []*dto.Comment{reply3},
+						},
+					},
+					HasMore: true,
+				}
+				assert.Equal(t, expected, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService().Get(context.Background(), &dto.GetCommentsIn{
+				ArticleSlug: "foo-article",
+				FromID:      pointer.To("from id"),
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	transaction "github.com/art-es/yet-another-service/internal/core/transaction"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockarticleRepository is a mock of articleRepository interface.
+type MockarticleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleRepositoryMockRecorder is the mock recorder for MockarticleRepository.
+type MockarticleRepositoryMockRecorder struct {
+	mock *MockarticleRepository
+}
+
+// NewMockarticleRepository creates a new mock instance.
+func NewMockarticleRepository(ctrl *gomock.Controller) *MockarticleRepository {
+	mock := &MockarticleRepository{ctrl: ctrl}
+	mock.recorder = &MockarticleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleRepository) EXPECT() *MockarticleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockarticleRepository) Find(ctx context.Context, slug string) (*dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, slug)
+	ret0, _ := ret[0].(*dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockarticleRepositoryMockRecorder) Find(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockarticleRepository)(nil).Find), ctx, slug)
+}
+
+// MockcommentRepository is a mock of commentRepository interface.
+type MockcommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockcommentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockcommentRepositoryMockRecorder is the mock recorder for MockcommentRepository.
+type MockcommentRepositoryMockRecorder struct {
+	mock *MockcommentRepository
+}
+
+// NewMockcommentRepository creates a new mock instance.
+func NewMockcommentRepository(ctrl *gomock.Controller) *MockcommentRepository {
+	mock := &MockcommentRepository{ctrl: ctrl}
+	mock.recorder = &MockcommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcommentRepository) EXPECT() *MockcommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockcommentRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockcommentRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockcommentRepository)(nil).Delete), ctx, id)
+}
+
+// Find mocks base method.
+func (m *MockcommentRepository) Find(ctx context.Context, id string) (*dto.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(*dto.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockcommentRepositoryMockRecorder) Find(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockcommentRepository)(nil).Find), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockcommentRepository) Get(ctx context.Context, articleID string, fromID *string) (*dto.GetCommentsOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, articleID, fromID)
+	ret0, _ := ret[0].(*dto.GetCommentsOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockcommentRepositoryMockRecorder) Get(ctx, articleID, fromID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockcommentRepository)(nil).Get), ctx, articleID, fromID)
+}
+
+// Save mocks base method.
+func (m *MockcommentRepository) Save(ctx context.Context, tx transaction.Transaction, comment *dto.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, tx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockcommentRepositoryMockRecorder) Save(ctx, tx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockcommentRepository)(nil).Save), ctx, tx, comment)
+}
+
+// MockauthorRepository is a mock of authorRepository interface.
+type MockauthorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorRepositoryMockRecorder is the mock recorder for MockauthorRepository.
+type MockauthorRepositoryMockRecorder struct {
+	mock *MockauthorRepository
+}
+
+// NewMockauthorRepository creates a new mock instance.
+func NewMockauthorRepository(ctrl *gomock.Controller) *MockauthorRepository {
+	mock := &MockauthorRepository{ctrl: ctrl}
+	mock.recorder = &MockauthorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthorRepository) EXPECT() *MockauthorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockauthorRepository) Get(ctx context.Context, authorIDs []string) (map[string]*dto.ArticleAuthor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, authorIDs)
+	ret0, _ := ret[0].(map[string]*dto.ArticleAuthor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockauthorRepositoryMockRecorder) Get(ctx, authorIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockauthorRepository)(nil).Get), ctx, authorIDs)
+}
+
+// MockwebhookPublisher is a mock of webhookPublisher interface.
+type MockwebhookPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookPublisherMockRecorder is the mock recorder for MockwebhookPublisher.
+type MockwebhookPublisherMockRecorder struct {
+	mock *MockwebhookPublisher
+}
+
+// NewMockwebhookPublisher creates a new mock instance.
+func NewMockwebhookPublisher(ctrl *gomock.Controller) *MockwebhookPublisher {
+	mock := &MockwebhookPublisher{ctrl: ctrl}
+	mock.recorder = &MockwebhookPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookPublisher) EXPECT() *MockwebhookPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishComment mocks base method.
+func (m *MockwebhookPublisher) PublishComment(ctx context.Context, tx transaction.Transaction, comment *dto.Comment, article *dto.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishComment", ctx, tx, comment, article)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishComment indicates an expected call of PublishComment.
+func (mr *MockwebhookPublisherMockRecorder) PublishComment(ctx, tx, comment, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishComment", reflect.TypeOf((*MockwebhookPublisher)(nil).PublishComment), ctx, tx, comment, article)
+}
@@ -0,0 +1,60 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package comment
+
+import (
+	"context"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+var getCurrentTime = time.Now
+
+type articleRepository interface {
+	Find(ctx context.Context, slug string) (*dto.Article, error)
+}
+
+type commentRepository interface {
+	Get(ctx context.Context, articleID string, fromID *string) (*dto.GetCommentsOut, error)
+	Find(ctx context.Context, id string) (*dto.Comment, error)
+	Save(ctx context.Context, tx transaction.Transaction, comment *dto.Comment) error
+	Delete(ctx context.Context, id string) error
+}
+
+type authorRepository interface {
+	Get(ctx context.Context, authorIDs []string) (map[string]*dto.ArticleAuthor, error)
+}
+
+// webhookPublisher queues comment.created for webhooks subscribed to it, in the transaction saving the comment.
+type webhookPublisher interface {
+	PublishComment(ctx context.Context, tx transaction.Transaction, comment *dto.Comment, article *dto.Article) error
+}
+
+type Service struct {
+	editWindow        time.Duration
+	articleRepository articleRepository
+	commentRepository commentRepository
+	authorRepository  authorRepository
+	webhookPublisher  webhookPublisher
+	logger            log.Logger
+}
+
+func NewService(
+	editWindow time.Duration,
+	articleRepository articleRepository,
+	commentRepository commentRepository,
+	authorRepository authorRepository,
+	webhookPublisher webhookPublisher,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		editWindow:        editWindow,
+		articleRepository: articleRepository,
+		commentRepository: commentRepository,
+		authorRepository:  authorRepository,
+		webhookPublisher:  webhookPublisher,
+		logger:            logger,
+	}
+}
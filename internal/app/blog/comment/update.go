@@ -0,0 +1,53 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+func (s *Service) Update(ctx context.Context, in *dto.UpdateCommentIn) (*dto.Comment, error) {
+	comment, err := s.findOwnComment(ctx, in.ID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if getCurrentTime().After(comment.CreatedAt.Add(s.editWindow)) {
+		return nil, errors.ErrCommentEditWindowExpired
+	}
+
+	comment.Content = in.Content
+
+	tx := transaction.New(ctx)
+
+	if err = s.commentRepository.Save(ctx, tx, comment); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("save comment in repository: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return comment, nil
+}
+
+func (s *Service) findOwnComment(ctx context.Context, id, userID string) (*dto.Comment, error) {
+	comment, err := s.commentRepository.Find(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find comment in repository: %w", err)
+	}
+
+	if comment == nil || comment.Deleted {
+		return nil, errors.ErrCommentNotFound
+	}
+
+	if comment.AuthorID != userID {
+		return nil, errors.ErrForbidden
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,108 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+func TestUpdate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	getCurrentTime = func() time.Time { return now }
+	defer func() { getCurrentTime = time.Now }()
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Comment, err error)
+	}{
+		{
+			name: "find comment error",
+			setup: func(m serviceMocks) {
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("comment id")).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find comment in repository: foo error")
+			},
+		},
+		{
+			name: "comment deleted",
+			setup: func(m serviceMocks) {
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("comment id")).
+					Return(&dto.Comment{ID: "comment id", AuthorID: "user id", Deleted: true}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrCommentNotFound)
+			},
+		},
+		{
+			name: "comment of another user",
+			setup: func(m serviceMocks) {
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("comment id")).
+					Return(&dto.Comment{ID: "comment id", AuthorID: "another user id"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "edit window expired",
+			setup: func(m serviceMocks) {
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("comment id")).
+					Return(&dto.Comment{ID: "comment id", AuthorID: "user id", CreatedAt: now.Add(-time.Hour)}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrCommentEditWindowExpired)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.commentRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("comment id")).
+					Return(&dto.Comment{ID: "comment id", AuthorID: "user id", Content: "old", CreatedAt: now.Add(-time.Minute)}, nil)
+
+				expectedComment := &dto.Comment{ID: "comment id", AuthorID: "user id", Content: "new", CreatedAt: now.Add(-time.Minute)}
+				m.commentRepository.EXPECT().
+					Save(gomock.Any(), gomock.Not(nil), gomock.Eq(expectedComment)).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.Comment, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "new", out.Content)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService().Update(context.Background(), &dto.UpdateCommentIn{
+				ID:      "comment id",
+				UserID:  "user id",
+				Content: "new",
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
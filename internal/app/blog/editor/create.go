@@ -0,0 +1,58 @@
+package editor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/locale"
+)
+
+func (s *Service) Create(ctx context.Context, in *dto.CreateArticleIn) (*dto.Article, error) {
+	existing, err := s.articleRepository.Find(ctx, in.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("find article in repository: %w", err)
+	}
+
+	if existing != nil {
+		return nil, errors.ErrArticleSlugTaken
+	}
+
+	if err = s.checkSlugOfTranslations(ctx, in.Slug, ""); err != nil {
+		return nil, err
+	}
+
+	article := &dto.Article{
+		Slug:       in.Slug,
+		Title:      in.Title,
+		Content:    in.Content,
+		Tags:       in.Tags,
+		SEO:        in.SEO,
+		Visibility: in.Visibility,
+		Locale:     s.defaultLocale,
+		AuthorID:   in.UserID,
+	}
+	if article.Visibility == "" {
+		article.Visibility = dto.ArticleVisibilityPublic
+	}
+	if in.Locale != "" {
+		if article.Locale, err = locale.Canonical(in.Locale); err != nil {
+			return nil, fmt.Errorf("canonicalize locale: %w", err)
+		}
+	}
+
+	if err = s.save(ctx, article, in.UserID, dto.WebhookEventArticlePublished); err != nil {
+		return nil, err
+	}
+
+	s.purgeCache(ctx, article, true, true)
+
+	if err = s.timelinePublisher.Publish(ctx, article); err != nil {
+		s.logger.Error().Err(err).Msg("publish article to timelines error")
+	}
+
+	s.refreshRelated(ctx, article)
+
+	return article, nil
+}
@@ -0,0 +1,351 @@
+package editor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/editor/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	articleRepository     *mock.MockarticleRepository
+	authorRepository      *mock.MockauthorRepository
+	revisionRepository    *mock.MockrevisionRepository
+	translationRepository *mock.MocktranslationRepository
+	contentRenderer       *mock.MockcontentRenderer
+	articleCache          *mock.MockarticleCache
+	feedCache             *mock.MockfeedCache
+	sitemapRefresher      *mock.MocksitemapRefresher
+	timelinePublisher     *mock.MocktimelinePublisher
+	relatedRefresher      *mock.MockrelatedRefresher
+	mediaReferrer         *mock.MockmediaReferrer
+	webhookPublisher      *mock.MockwebhookPublisher
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		articleRepository:     mock.NewMockarticleRepository(ctrl),
+		authorRepository:      mock.NewMockauthorRepository(ctrl),
+		revisionRepository:    mock.NewMockrevisionRepository(ctrl),
+		translationRepository: mock.NewMocktranslationRepository(ctrl),
+		contentRenderer:       mock.NewMockcontentRenderer(ctrl),
+		articleCache:          mock.NewMockarticleCache(ctrl),
+		feedCache:             mock.NewMockfeedCache(ctrl),
+		sitemapRefresher:      mock.NewMocksitemapRefresher(ctrl),
+		timelinePublisher:     mock.NewMocktimelinePublisher(ctrl),
+		relatedRefresher:      mock.NewMockrelatedRefresher(ctrl),
+		mediaReferrer:         mock.NewMockmediaReferrer(ctrl),
+		webhookPublisher:      mock.NewMockwebhookPublisher(ctrl),
+	}
+}
+
+func (m serviceMocks) newService(logger log.Logger) *Service {
+	return NewService(10, 8, "en", m.articleRepository, m.authorRepository, m.revisionRepository, m.translationRepository, m.contentRenderer, m.articleCache, m.feedCache, m.sitemapRefresher, m.timelinePublisher, m.relatedRefresher, m.mediaReferrer, m.webhookPublisher, logger)
+}
+
+func (m serviceMocks) expectRender(content string, err error) {
+	if err != nil {
+		m.contentRenderer.EXPECT().
+			Render(gomock.Eq(content)).
+			Return(nil, err)
+		return
+	}
+
+	m.contentRenderer.EXPECT().
+		Render(gomock.Eq(content)).
+		Return(&dto.RenderedContent{HTML: "<p>" + content + "</p>", TOC: []dto.TOCEntry{}}, nil)
+}
+
+func (m serviceMocks) expectFindArticle(article *dto.Article, err error) {
+	m.articleRepository.EXPECT().
+		Find(gomock.Any(), gomock.Eq("foo-article")).
+		Return(article, err)
+}
+
+func (m serviceMocks) expectFindTranslationBySlug(translation *dto.ArticleTranslation, err error) {
+	m.translationRepository.EXPECT().
+		FindBySlug(gomock.Any(), gomock.Eq("foo-article")).
+		Return(translation, err)
+}
+
+func (m serviceMocks) expectFindRole(role string, err error) {
+	m.authorRepository.EXPECT().
+		FindRole(gomock.Any(), gomock.Eq("article id"), gomock.Eq("user id")).
+		Return(role, err)
+}
+
+func (m serviceMocks) expectSaveArticle(expected *dto.Article, err error) {
+	m.articleRepository.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Eq(expected)).
+		Do(func(_ context.Context, _ transaction.Transaction, article *dto.Article) {
+			article.ID = "article id"
+		}).
+		Return(err)
+}
+
+func (m serviceMocks) expectReference(content string, err error) {
+	m.mediaReferrer.EXPECT().
+		Reference(gomock.Any(), gomock.Any(), gomock.Eq("article id"), gomock.Eq(content)).
+		Return(err)
+}
+
+func (m serviceMocks) expectSaveRevision(title, content string, err error) {
+	expected := &dto.ArticleRevision{
+		ArticleID: "article id",
+		Title:     title,
+		Content:   content,
+		AuthorID:  "user id",
+	}
+
+	m.revisionRepository.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Eq(expected)).
+		Return(err)
+}
+
+func (m serviceMocks) expectPruneRevisions(err error) {
+	m.revisionRepository.EXPECT().
+		Prune(gomock.Any(), gomock.Any(), gomock.Eq("article id"), gomock.Eq(10)).
+		Return(err)
+}
+
+func (m serviceMocks) expectPurgeCache(reordered, shifted bool, err error) {
+	m.expectPurgeTaggedCache(nil, reordered, shifted, err)
+}
+
+func (m serviceMocks) expectPurgeTaggedCache(tags []string, reordered, shifted bool, err error) {
+	m.sitemapRefresher.EXPECT().Refresh(gomock.Any(), gomock.Eq("article id"), gomock.Eq(shifted)).Return(err)
+	m.feedCache.EXPECT().Purge(gomock.Any(), gomock.Eq([]string{"user id"}), gomock.Eq(tags)).Return(err)
+	m.articleCache.EXPECT().PurgeArticles(gomock.Any(), gomock.Eq("foo-article")).Return(err)
+
+	if !reordered {
+		return
+	}
+
+	m.articleCache.EXPECT().PurgeAuthors(gomock.Any(), gomock.Eq("user id")).Return(err)
+	m.articleCache.EXPECT().PurgeListings(gomock.Any()).Return(err)
+}
+
+func (m serviceMocks) expectPublish(err error) {
+	m.timelinePublisher.EXPECT().
+		Publish(gomock.Any(), gomock.Cond(func(article *dto.Article) bool { return article.ID == "article id" })).
+		Return(err)
+}
+
+func (m serviceMocks) expectPublishWebhook(event string, err error) {
+	m.webhookPublisher.EXPECT().
+		PublishArticle(gomock.Any(), gomock.Any(), gomock.Eq(event), gomock.Cond(func(article *dto.Article) bool { return article.ID == "article id" })).
+		Return(err)
+}
+
+func (m serviceMocks) expectRefreshRelated(err error) {
+	m.relatedRefresher.EXPECT().Refresh(gomock.Any(), gomock.Eq("article id")).Return(err)
+}
+
+func TestCreate(t *testing.T) {
+	newArticle := func() *dto.Article {
+		return &dto.Article{
+			Slug:        "foo-article",
+			Title:       "Foo",
+			Content:     "foo content",
+			ContentHTML: "<p>foo content</p>",
+			TOC:         []dto.TOCEntry{},
+			Excerpt:     "foo…",
+			WordCount:   2,
+			ReadingTime: 1,
+			Tags:        []string{"go"},
+			SEO:         dto.ArticleSEO{MetaDescription: "Foo description"},
+			Visibility:  dto.ArticleVisibilityPublic,
+			Locale:      "en",
+			AuthorID:    "user id",
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Article, err error)
+	}{
+		{
+			name: "find article error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find article in repository: foo error")
+			},
+		},
+		{
+			name: "slug taken",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "another article id"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleSlugTaken)
+			},
+		},
+		{
+			name: "find translation error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find translation in repository: foo error")
+			},
+		},
+		{
+			name: "slug taken by translation",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(&dto.ArticleTranslation{ID: "translation id"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleSlugTaken)
+			},
+		},
+		{
+			name: "render content error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(nil, nil)
+				m.expectRender("foo content", errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "render article content: foo error")
+			},
+		},
+		{
+			name: "save article error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(nil, nil)
+				m.expectRender("foo content", nil)
+				m.expectSaveArticle(newArticle(), errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "save article in repository: foo error")
+			},
+		},
+		{
+			name: "reference media error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(nil, nil)
+				m.expectRender("foo content", nil)
+				m.expectSaveArticle(newArticle(), nil)
+				m.expectReference("foo content", errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "reference media: foo error")
+			},
+		},
+		{
+			name: "save revision error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(nil, nil)
+				m.expectRender("foo content", nil)
+				m.expectSaveArticle(newArticle(), nil)
+				m.expectReference("foo content", nil)
+				m.expectSaveRevision("Foo", "foo content", errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "save revision in repository: foo error")
+			},
+		},
+		{
+			name: "publish webhook error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(nil, nil)
+				m.expectRender("foo content", nil)
+				m.expectSaveArticle(newArticle(), nil)
+				m.expectReference("foo content", nil)
+				m.expectSaveRevision("Foo", "foo content", nil)
+				m.expectPublishWebhook(dto.WebhookEventArticlePublished, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "publish webhook event: foo error")
+			},
+		},
+		{
+			name: "purge cache error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(nil, nil)
+				m.expectRender("foo content", nil)
+				m.expectSaveArticle(newArticle(), nil)
+				m.expectReference("foo content", nil)
+				m.expectSaveRevision("Foo", "foo content", nil)
+				m.expectPruneRevisions(nil)
+				m.expectPublishWebhook(dto.WebhookEventArticlePublished, nil)
+				m.expectPurgeTaggedCache([]string{"go"}, true, true, errors.New("foo error"))
+				m.expectPublish(errors.New("foo error"))
+				m.expectRefreshRelated(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "article id", out.ID)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(nil, nil)
+				m.expectRender("foo content", nil)
+				m.expectSaveArticle(newArticle(), nil)
+				m.expectReference("foo content", nil)
+				m.expectSaveRevision("Foo", "foo content", nil)
+				m.expectPruneRevisions(nil)
+				m.expectPurgeTaggedCache([]string{"go"}, true, true, nil)
+				m.expectPublishWebhook(dto.WebhookEventArticlePublished, nil)
+				m.expectPublish(nil)
+				m.expectRefreshRelated(nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "article id", out.ID)
+				assert.Equal(t, "foo-article", out.Slug)
+				assert.Equal(t, "<p>foo content</p>", out.ContentHTML)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Create(context.Background(), &dto.CreateArticleIn{
+				UserID:  "user id",
+				Slug:    "foo-article",
+				Title:   "Foo",
+				Content: "foo content",
+				Tags:    []string{"go"},
+				SEO:     dto.ArticleSEO{MetaDescription: "Foo description"},
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
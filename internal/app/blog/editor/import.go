@@ -0,0 +1,84 @@
+package editor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// Import creates the article or updates the article with the same slug, so importing an archive again
+// changes nothing. Imported articles are not published to timelines: they are usually old and would flood
+// timelines of followers, they show up there once the timelines are rebuilt. Webhooks aren't notified either,
+// integrations would be flooded the same way.
+func (s *Service) Import(ctx context.Context, in *dto.ImportArticleIn) (string, error) {
+	article, err := s.articleRepository.Find(ctx, in.Slug)
+	if err != nil {
+		return "", fmt.Errorf("find article in repository: %w", err)
+	}
+
+	status := dto.ImportStatusUpdated
+	if article == nil {
+		if err = s.checkSlugOfTranslations(ctx, in.Slug, ""); err != nil {
+			return "", err
+		}
+
+		status = dto.ImportStatusCreated
+		article = &dto.Article{
+			Slug:       in.Slug,
+			AuthorID:   in.AuthorID,
+			Visibility: dto.ArticleVisibilityPublic,
+			Locale:     s.defaultLocale,
+		}
+	}
+
+	if article.AuthorID != in.AuthorID {
+		return "", errors.ErrArticleSlugTaken
+	}
+
+	if article.Stored() && !importChanges(article, in) {
+		return dto.ImportStatusUnchanged, nil
+	}
+
+	if in.DryRun {
+		return status, nil
+	}
+
+	retagged := !article.Stored() || !slices.Equal(article.Tags, in.Tags)
+	previousTags := article.Tags
+	moved := !in.CreatedAt.IsZero() && !in.CreatedAt.Equal(article.CreatedAt)
+	reordered := article.Title != in.Title || moved
+	// sitemaps list articles in the order they were created
+	shifted := !article.Stored() || moved || sitemapShifted(article.SEO, in.SEO)
+	article.Title = in.Title
+	article.Content = in.Content
+	article.Tags = in.Tags
+	article.SEO = in.SEO
+	if !in.CreatedAt.IsZero() {
+		article.CreatedAt = in.CreatedAt
+	}
+
+	if err = s.save(ctx, article, in.AuthorID, ""); err != nil {
+		return "", err
+	}
+
+	// a retagged article moves between tag listings, so they are purged like on reordering
+	s.purgeCache(ctx, article, reordered || retagged, shifted)
+
+	if retagged {
+		s.purgeTagFeeds(ctx, previousTags)
+		s.refreshRelated(ctx, article)
+	}
+
+	return status, nil
+}
+
+func importChanges(article *dto.Article, in *dto.ImportArticleIn) bool {
+	return article.Title != in.Title ||
+		article.Content != in.Content ||
+		!slices.Equal(article.Tags, in.Tags) ||
+		article.SEO != in.SEO ||
+		!in.CreatedAt.IsZero() && !in.CreatedAt.Equal(article.CreatedAt)
+}
@@ -0,0 +1,220 @@
+package editor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestImport(t *testing.T) {
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	newIn := func() *dto.ImportArticleIn {
+		return &dto.ImportArticleIn{
+			Slug:      "foo-article",
+			Title:     "Foo",
+			Content:   "foo content",
+			Tags:      []string{"bar"},
+			SEO:       dto.ArticleSEO{MetaDescription: "Foo description"},
+			AuthorID:  "user id",
+			CreatedAt: createdAt,
+		}
+	}
+	storedArticle := func() *dto.Article {
+		return &dto.Article{
+			ID:        "article id",
+			Slug:      "foo-article",
+			Title:     "Foo",
+			Content:   "foo content",
+			Tags:      []string{"bar"},
+			SEO:       dto.ArticleSEO{MetaDescription: "Foo description"},
+			AuthorID:  "user id",
+			CreatedAt: createdAt,
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		in     func() *dto.ImportArticleIn
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, status string, err error)
+	}{
+		{
+			name: "find article error",
+			in:   newIn,
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, status string, err error) {
+				assert.EqualError(t, err, "find article in repository: foo error")
+			},
+		},
+		{
+			name: "slug of another author",
+			in:   newIn,
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "another article id", AuthorID: "another user id"}, nil)
+			},
+			assert: func(t *testing.T, status string, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrArticleSlugTaken)
+			},
+		},
+		{
+			name: "slug of translation",
+			in:   newIn,
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(&dto.ArticleTranslation{ID: "translation id"}, nil)
+			},
+			assert: func(t *testing.T, status string, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrArticleSlugTaken)
+			},
+		},
+		{
+			name: "unchanged",
+			in: func() *dto.ImportArticleIn {
+				in := newIn()
+				in.CreatedAt = time.Time{}
+				return in
+			},
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+			},
+			assert: func(t *testing.T, status string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.ImportStatusUnchanged, status)
+			},
+		},
+		{
+			name: "dry run",
+			in: func() *dto.ImportArticleIn {
+				in := newIn()
+				in.DryRun = true
+				return in
+			},
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(nil, nil)
+			},
+			assert: func(t *testing.T, status string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.ImportStatusCreated, status)
+			},
+		},
+		{
+			name: "save article error",
+			in:   newIn,
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(nil, nil)
+				m.expectRender("foo content", nil)
+				m.articleRepository.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, status string, err error) {
+				assert.EqualError(t, err, "save article in repository: foo error")
+			},
+		},
+		{
+			name: "ok created",
+			in:   newIn,
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+				m.expectFindTranslationBySlug(nil, nil)
+				m.expectRender("foo content", nil)
+				m.expectSaveArticle(&dto.Article{
+					Slug:        "foo-article",
+					Title:       "Foo",
+					Content:     "foo content",
+					ContentHTML: "<p>foo content</p>",
+					TOC:         []dto.TOCEntry{},
+					Excerpt:     "foo…",
+					WordCount:   2,
+					ReadingTime: 1,
+					Tags:        []string{"bar"},
+					SEO:         dto.ArticleSEO{MetaDescription: "Foo description"},
+					Visibility:  dto.ArticleVisibilityPublic,
+					Locale:      "en",
+					AuthorID:    "user id",
+					CreatedAt:   createdAt,
+				}, nil)
+				m.expectReference("foo content", nil)
+				m.expectSaveRevision("Foo", "foo content", nil)
+				m.expectPruneRevisions(nil)
+				m.expectPurgeTaggedCache([]string{"bar"}, true, true, nil)
+				m.expectRefreshRelated(nil)
+			},
+			assert: func(t *testing.T, status string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.ImportStatusCreated, status)
+			},
+		},
+		{
+			name: "ok updated",
+			in: func() *dto.ImportArticleIn {
+				in := newIn()
+				in.Content = "bar content"
+				in.Tags = nil
+				return in
+			},
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectRender("bar content", nil)
+				m.articleRepository.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Cond(func(article *dto.Article) bool {
+						return article.ID == "article id" && article.Content == "bar content" && article.Tags == nil &&
+							article.CreatedAt.Equal(createdAt)
+					})).
+					Return(nil)
+				m.expectReference("bar content", nil)
+				m.expectSaveRevision("Foo", "bar content", nil)
+				m.expectPruneRevisions(nil)
+				m.expectPurgeCache(true, false, nil)
+				m.feedCache.EXPECT().Purge(gomock.Any(), gomock.Nil(), gomock.Eq([]string{"bar"})).Return(nil)
+				m.expectRefreshRelated(nil)
+			},
+			assert: func(t *testing.T, status string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.ImportStatusUpdated, status)
+			},
+		},
+		{
+			name: "ok updated with same tags",
+			in: func() *dto.ImportArticleIn {
+				in := newIn()
+				in.Content = "bar content"
+				return in
+			},
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectRender("bar content", nil)
+				m.articleRepository.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.expectReference("bar content", nil)
+				m.expectSaveRevision("Foo", "bar content", nil)
+				m.expectPruneRevisions(nil)
+				m.expectPurgeTaggedCache([]string{"bar"}, false, false, nil)
+			},
+			assert: func(t *testing.T, status string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.ImportStatusUpdated, status)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			status, err := m.newService(testutil.NewLogger()).Import(context.Background(), tt.in())
+			tt.assert(t, status, err)
+		})
+	}
+}
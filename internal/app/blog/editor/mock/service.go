@@ -0,0 +1,632 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	transaction "github.com/art-es/yet-another-service/internal/core/transaction"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockarticleRepository is a mock of articleRepository interface.
+type MockarticleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleRepositoryMockRecorder is the mock recorder for MockarticleRepository.
+type MockarticleRepositoryMockRecorder struct {
+	mock *MockarticleRepository
+}
+
+// NewMockarticleRepository creates a new mock instance.
+func NewMockarticleRepository(ctrl *gomock.Controller) *MockarticleRepository {
+	mock := &MockarticleRepository{ctrl: ctrl}
+	mock.recorder = &MockarticleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleRepository) EXPECT() *MockarticleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockarticleRepository) Find(ctx context.Context, slug string) (*dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, slug)
+	ret0, _ := ret[0].(*dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockarticleRepositoryMockRecorder) Find(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockarticleRepository)(nil).Find), ctx, slug)
+}
+
+// Save mocks base method.
+func (m *MockarticleRepository) Save(ctx context.Context, tx transaction.Transaction, article *dto.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, tx, article)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockarticleRepositoryMockRecorder) Save(ctx, tx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockarticleRepository)(nil).Save), ctx, tx, article)
+}
+
+// MockauthorRepository is a mock of authorRepository interface.
+type MockauthorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorRepositoryMockRecorder is the mock recorder for MockauthorRepository.
+type MockauthorRepositoryMockRecorder struct {
+	mock *MockauthorRepository
+}
+
+// NewMockauthorRepository creates a new mock instance.
+func NewMockauthorRepository(ctrl *gomock.Controller) *MockauthorRepository {
+	mock := &MockauthorRepository{ctrl: ctrl}
+	mock.recorder = &MockauthorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthorRepository) EXPECT() *MockauthorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindRole mocks base method.
+func (m *MockauthorRepository) FindRole(ctx context.Context, articleID, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRole", ctx, articleID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRole indicates an expected call of FindRole.
+func (mr *MockauthorRepositoryMockRecorder) FindRole(ctx, articleID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRole", reflect.TypeOf((*MockauthorRepository)(nil).FindRole), ctx, articleID, userID)
+}
+
+// MockrevisionRepository is a mock of revisionRepository interface.
+type MockrevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockrevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockrevisionRepositoryMockRecorder is the mock recorder for MockrevisionRepository.
+type MockrevisionRepositoryMockRecorder struct {
+	mock *MockrevisionRepository
+}
+
+// NewMockrevisionRepository creates a new mock instance.
+func NewMockrevisionRepository(ctrl *gomock.Controller) *MockrevisionRepository {
+	mock := &MockrevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockrevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrevisionRepository) EXPECT() *MockrevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockrevisionRepository) Find(ctx context.Context, articleID string, number int) (*dto.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, articleID, number)
+	ret0, _ := ret[0].(*dto.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockrevisionRepositoryMockRecorder) Find(ctx, articleID, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockrevisionRepository)(nil).Find), ctx, articleID, number)
+}
+
+// Get mocks base method.
+func (m *MockrevisionRepository) Get(ctx context.Context, articleID string) ([]*dto.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, articleID)
+	ret0, _ := ret[0].([]*dto.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockrevisionRepositoryMockRecorder) Get(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockrevisionRepository)(nil).Get), ctx, articleID)
+}
+
+// Prune mocks base method.
+func (m *MockrevisionRepository) Prune(ctx context.Context, tx transaction.Transaction, articleID string, keep int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prune", ctx, tx, articleID, keep)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Prune indicates an expected call of Prune.
+func (mr *MockrevisionRepositoryMockRecorder) Prune(ctx, tx, articleID, keep any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockrevisionRepository)(nil).Prune), ctx, tx, articleID, keep)
+}
+
+// Save mocks base method.
+func (m *MockrevisionRepository) Save(ctx context.Context, tx transaction.Transaction, revision *dto.ArticleRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, tx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockrevisionRepositoryMockRecorder) Save(ctx, tx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockrevisionRepository)(nil).Save), ctx, tx, revision)
+}
+
+// MocktranslationRepository is a mock of translationRepository interface.
+type MocktranslationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MocktranslationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MocktranslationRepositoryMockRecorder is the mock recorder for MocktranslationRepository.
+type MocktranslationRepositoryMockRecorder struct {
+	mock *MocktranslationRepository
+}
+
+// NewMocktranslationRepository creates a new mock instance.
+func NewMocktranslationRepository(ctrl *gomock.Controller) *MocktranslationRepository {
+	mock := &MocktranslationRepository{ctrl: ctrl}
+	mock.recorder = &MocktranslationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktranslationRepository) EXPECT() *MocktranslationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MocktranslationRepository) Delete(ctx context.Context, tx transaction.Transaction, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MocktranslationRepositoryMockRecorder) Delete(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocktranslationRepository)(nil).Delete), ctx, tx, id)
+}
+
+// Find mocks base method.
+func (m *MocktranslationRepository) Find(ctx context.Context, articleID, locale string) (*dto.ArticleTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, articleID, locale)
+	ret0, _ := ret[0].(*dto.ArticleTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MocktranslationRepositoryMockRecorder) Find(ctx, articleID, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MocktranslationRepository)(nil).Find), ctx, articleID, locale)
+}
+
+// FindBySlug mocks base method.
+func (m *MocktranslationRepository) FindBySlug(ctx context.Context, slug string) (*dto.ArticleTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlug", ctx, slug)
+	ret0, _ := ret[0].(*dto.ArticleTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MocktranslationRepositoryMockRecorder) FindBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MocktranslationRepository)(nil).FindBySlug), ctx, slug)
+}
+
+// Save mocks base method.
+func (m *MocktranslationRepository) Save(ctx context.Context, tx transaction.Transaction, translation *dto.ArticleTranslation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, tx, translation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MocktranslationRepositoryMockRecorder) Save(ctx, tx, translation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MocktranslationRepository)(nil).Save), ctx, tx, translation)
+}
+
+// MockcontentRenderer is a mock of contentRenderer interface.
+type MockcontentRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentRendererMockRecorder
+	isgomock struct{}
+}
+
+// MockcontentRendererMockRecorder is the mock recorder for MockcontentRenderer.
+type MockcontentRendererMockRecorder struct {
+	mock *MockcontentRenderer
+}
+
+// NewMockcontentRenderer creates a new mock instance.
+func NewMockcontentRenderer(ctrl *gomock.Controller) *MockcontentRenderer {
+	mock := &MockcontentRenderer{ctrl: ctrl}
+	mock.recorder = &MockcontentRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentRenderer) EXPECT() *MockcontentRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockcontentRenderer) Render(content string) (*dto.RenderedContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", content)
+	ret0, _ := ret[0].(*dto.RenderedContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockcontentRendererMockRecorder) Render(content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockcontentRenderer)(nil).Render), content)
+}
+
+// MockarticleCache is a mock of articleCache interface.
+type MockarticleCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleCacheMockRecorder is the mock recorder for MockarticleCache.
+type MockarticleCacheMockRecorder struct {
+	mock *MockarticleCache
+}
+
+// NewMockarticleCache creates a new mock instance.
+func NewMockarticleCache(ctrl *gomock.Controller) *MockarticleCache {
+	mock := &MockarticleCache{ctrl: ctrl}
+	mock.recorder = &MockarticleCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleCache) EXPECT() *MockarticleCacheMockRecorder {
+	return m.recorder
+}
+
+// PurgeArticles mocks base method.
+func (m *MockarticleCache) PurgeArticles(ctx context.Context, slugs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range slugs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeArticles", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeArticles indicates an expected call of PurgeArticles.
+func (mr *MockarticleCacheMockRecorder) PurgeArticles(ctx any, slugs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, slugs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeArticles", reflect.TypeOf((*MockarticleCache)(nil).PurgeArticles), varargs...)
+}
+
+// PurgeAuthors mocks base method.
+func (m *MockarticleCache) PurgeAuthors(ctx context.Context, authorIDs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range authorIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeAuthors", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeAuthors indicates an expected call of PurgeAuthors.
+func (mr *MockarticleCacheMockRecorder) PurgeAuthors(ctx any, authorIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, authorIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAuthors", reflect.TypeOf((*MockarticleCache)(nil).PurgeAuthors), varargs...)
+}
+
+// PurgeListings mocks base method.
+func (m *MockarticleCache) PurgeListings(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeListings", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeListings indicates an expected call of PurgeListings.
+func (mr *MockarticleCacheMockRecorder) PurgeListings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeListings", reflect.TypeOf((*MockarticleCache)(nil).PurgeListings), ctx)
+}
+
+// MockfeedCache is a mock of feedCache interface.
+type MockfeedCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockfeedCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockfeedCacheMockRecorder is the mock recorder for MockfeedCache.
+type MockfeedCacheMockRecorder struct {
+	mock *MockfeedCache
+}
+
+// NewMockfeedCache creates a new mock instance.
+func NewMockfeedCache(ctrl *gomock.Controller) *MockfeedCache {
+	mock := &MockfeedCache{ctrl: ctrl}
+	mock.recorder = &MockfeedCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfeedCache) EXPECT() *MockfeedCacheMockRecorder {
+	return m.recorder
+}
+
+// Purge mocks base method.
+func (m *MockfeedCache) Purge(ctx context.Context, authorIDs, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, authorIDs, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockfeedCacheMockRecorder) Purge(ctx, authorIDs, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockfeedCache)(nil).Purge), ctx, authorIDs, tags)
+}
+
+// MocksitemapRefresher is a mock of sitemapRefresher interface.
+type MocksitemapRefresher struct {
+	ctrl     *gomock.Controller
+	recorder *MocksitemapRefresherMockRecorder
+	isgomock struct{}
+}
+
+// MocksitemapRefresherMockRecorder is the mock recorder for MocksitemapRefresher.
+type MocksitemapRefresherMockRecorder struct {
+	mock *MocksitemapRefresher
+}
+
+// NewMocksitemapRefresher creates a new mock instance.
+func NewMocksitemapRefresher(ctrl *gomock.Controller) *MocksitemapRefresher {
+	mock := &MocksitemapRefresher{ctrl: ctrl}
+	mock.recorder = &MocksitemapRefresherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksitemapRefresher) EXPECT() *MocksitemapRefresherMockRecorder {
+	return m.recorder
+}
+
+// Refresh mocks base method.
+func (m *MocksitemapRefresher) Refresh(ctx context.Context, articleID string, shifted bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, articleID, shifted)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MocksitemapRefresherMockRecorder) Refresh(ctx, articleID, shifted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MocksitemapRefresher)(nil).Refresh), ctx, articleID, shifted)
+}
+
+// MocktimelinePublisher is a mock of timelinePublisher interface.
+type MocktimelinePublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MocktimelinePublisherMockRecorder
+	isgomock struct{}
+}
+
+// MocktimelinePublisherMockRecorder is the mock recorder for MocktimelinePublisher.
+type MocktimelinePublisherMockRecorder struct {
+	mock *MocktimelinePublisher
+}
+
+// NewMocktimelinePublisher creates a new mock instance.
+func NewMocktimelinePublisher(ctrl *gomock.Controller) *MocktimelinePublisher {
+	mock := &MocktimelinePublisher{ctrl: ctrl}
+	mock.recorder = &MocktimelinePublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktimelinePublisher) EXPECT() *MocktimelinePublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MocktimelinePublisher) Publish(ctx context.Context, article *dto.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, article)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MocktimelinePublisherMockRecorder) Publish(ctx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MocktimelinePublisher)(nil).Publish), ctx, article)
+}
+
+// MockrelatedRefresher is a mock of relatedRefresher interface.
+type MockrelatedRefresher struct {
+	ctrl     *gomock.Controller
+	recorder *MockrelatedRefresherMockRecorder
+	isgomock struct{}
+}
+
+// MockrelatedRefresherMockRecorder is the mock recorder for MockrelatedRefresher.
+type MockrelatedRefresherMockRecorder struct {
+	mock *MockrelatedRefresher
+}
+
+// NewMockrelatedRefresher creates a new mock instance.
+func NewMockrelatedRefresher(ctrl *gomock.Controller) *MockrelatedRefresher {
+	mock := &MockrelatedRefresher{ctrl: ctrl}
+	mock.recorder = &MockrelatedRefresherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrelatedRefresher) EXPECT() *MockrelatedRefresherMockRecorder {
+	return m.recorder
+}
+
+// Refresh mocks base method.
+func (m *MockrelatedRefresher) Refresh(ctx context.Context, articleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, articleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockrelatedRefresherMockRecorder) Refresh(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockrelatedRefresher)(nil).Refresh), ctx, articleID)
+}
+
+// MockmediaReferrer is a mock of mediaReferrer interface.
+type MockmediaReferrer struct {
+	ctrl     *gomock.Controller
+	recorder *MockmediaReferrerMockRecorder
+	isgomock struct{}
+}
+
+// MockmediaReferrerMockRecorder is the mock recorder for MockmediaReferrer.
+type MockmediaReferrerMockRecorder struct {
+	mock *MockmediaReferrer
+}
+
+// NewMockmediaReferrer creates a new mock instance.
+func NewMockmediaReferrer(ctrl *gomock.Controller) *MockmediaReferrer {
+	mock := &MockmediaReferrer{ctrl: ctrl}
+	mock.recorder = &MockmediaReferrerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmediaReferrer) EXPECT() *MockmediaReferrerMockRecorder {
+	return m.recorder
+}
+
+// Reference mocks base method.
+func (m *MockmediaReferrer) Reference(ctx context.Context, tx transaction.Transaction, articleID, content string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reference", ctx, tx, articleID, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reference indicates an expected call of Reference.
+func (mr *MockmediaReferrerMockRecorder) Reference(ctx, tx, articleID, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reference", reflect.TypeOf((*MockmediaReferrer)(nil).Reference), ctx, tx, articleID, content)
+}
+
+// ReferenceTranslation mocks base method.
+func (m *MockmediaReferrer) ReferenceTranslation(ctx context.Context, tx transaction.Transaction, articleID, locale, content string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReferenceTranslation", ctx, tx, articleID, locale, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReferenceTranslation indicates an expected call of ReferenceTranslation.
+func (mr *MockmediaReferrerMockRecorder) ReferenceTranslation(ctx, tx, articleID, locale, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReferenceTranslation", reflect.TypeOf((*MockmediaReferrer)(nil).ReferenceTranslation), ctx, tx, articleID, locale, content)
+}
+
+// MockwebhookPublisher is a mock of webhookPublisher interface.
+type MockwebhookPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookPublisherMockRecorder is the mock recorder for MockwebhookPublisher.
+type MockwebhookPublisherMockRecorder struct {
+	mock *MockwebhookPublisher
+}
+
+// NewMockwebhookPublisher creates a new mock instance.
+func NewMockwebhookPublisher(ctrl *gomock.Controller) *MockwebhookPublisher {
+	mock := &MockwebhookPublisher{ctrl: ctrl}
+	mock.recorder = &MockwebhookPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookPublisher) EXPECT() *MockwebhookPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishArticle mocks base method.
+func (m *MockwebhookPublisher) PublishArticle(ctx context.Context, tx transaction.Transaction, event string, article *dto.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishArticle", ctx, tx, event, article)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishArticle indicates an expected call of PublishArticle.
+func (mr *MockwebhookPublisherMockRecorder) PublishArticle(ctx, tx, event, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishArticle", reflect.TypeOf((*MockwebhookPublisher)(nil).PublishArticle), ctx, tx, event, article)
+}
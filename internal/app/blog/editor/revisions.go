@@ -0,0 +1,93 @@
+package editor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/diff"
+)
+
+func (s *Service) GetRevisions(ctx context.Context, in *dto.GetRevisionsIn) ([]*dto.ArticleRevision, error) {
+	article, err := s.findOwnArticle(ctx, in.ArticleSlug, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.revisionRepository.Get(ctx, article.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get revisions from repository: %w", err)
+	}
+
+	return revisions, nil
+}
+
+func (s *Service) DiffRevisions(ctx context.Context, in *dto.DiffRevisionsIn) (*dto.DiffRevisionsOut, error) {
+	article, err := s.findOwnArticle(ctx, in.ArticleSlug, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := s.findRevision(ctx, article.ID, in.From)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.findRevision(ctx, article.ID, in.To)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := diff.Lines(from.Content, to.Content)
+	out := &dto.DiffRevisionsOut{
+		From:  from,
+		To:    to,
+		Lines: make([]dto.DiffLine, 0, len(lines)),
+	}
+
+	for _, line := range lines {
+		out.Lines = append(out.Lines, dto.DiffLine{Op: string(line.Op), Text: line.Text})
+	}
+
+	return out, nil
+}
+
+// RestoreRevision brings the article back to the given revision.
+// The history is never rewritten, so the restored content is saved as a new revision.
+func (s *Service) RestoreRevision(ctx context.Context, in *dto.RestoreRevisionIn) (*dto.Article, error) {
+	article, err := s.findOwnedArticle(ctx, in.ArticleSlug, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := s.findRevision(ctx, article.ID, in.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	reordered := article.Title != revision.Title
+	article.Title = revision.Title
+	article.Content = revision.Content
+
+	if err = s.save(ctx, article, in.UserID, dto.WebhookEventArticleUpdated); err != nil {
+		return nil, err
+	}
+
+	s.purgeCache(ctx, article, reordered, false)
+
+	return article, nil
+}
+
+func (s *Service) findRevision(ctx context.Context, articleID string, number int) (*dto.ArticleRevision, error) {
+	revision, err := s.revisionRepository.Find(ctx, articleID, number)
+	if err != nil {
+		return nil, fmt.Errorf("find revision in repository: %w", err)
+	}
+
+	if revision == nil {
+		return nil, errors.ErrRevisionNotFound
+	}
+
+	return revision, nil
+}
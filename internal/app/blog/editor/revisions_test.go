@@ -0,0 +1,242 @@
+package editor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func ownArticle() *dto.Article {
+	return &dto.Article{ID: "article id", Slug: "foo-article", Title: "Bar", Content: "new content", AuthorID: "user id"}
+}
+
+func (m serviceMocks) expectFindRevision(number int, revision *dto.ArticleRevision, err error) {
+	m.revisionRepository.EXPECT().
+		Find(gomock.Any(), gomock.Eq("article id"), gomock.Eq(number)).
+		Return(revision, err)
+}
+
+func TestGetRevisions(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out []*dto.ArticleRevision, err error)
+	}{
+		{
+			name: "article of another user",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id", AuthorID: "another user id"}, nil)
+				m.expectFindRole("", nil)
+			},
+			assert: func(t *testing.T, out []*dto.ArticleRevision, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "get revisions error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownArticle(), nil)
+				m.revisionRepository.EXPECT().
+					Get(gomock.Any(), gomock.Eq("article id")).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []*dto.ArticleRevision, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get revisions from repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownArticle(), nil)
+				m.revisionRepository.EXPECT().
+					Get(gomock.Any(), gomock.Eq("article id")).
+					Return([]*dto.ArticleRevision{{Number: 2}, {Number: 1}}, nil)
+			},
+			assert: func(t *testing.T, out []*dto.ArticleRevision, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []*dto.ArticleRevision{{Number: 2}, {Number: 1}}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).GetRevisions(context.Background(), &dto.GetRevisionsIn{
+				ArticleSlug: "foo-article",
+				UserID:      "user id",
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestDiffRevisions(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.DiffRevisionsOut, err error)
+	}{
+		{
+			name: "find revision error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownArticle(), nil)
+				m.expectFindRevision(1, nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.DiffRevisionsOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find revision in repository: foo error")
+			},
+		},
+		{
+			name: "revision not found",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownArticle(), nil)
+				m.expectFindRevision(1, &dto.ArticleRevision{Number: 1}, nil)
+				m.expectFindRevision(2, nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.DiffRevisionsOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrRevisionNotFound)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownArticle(), nil)
+				m.expectFindRevision(1, &dto.ArticleRevision{Number: 1, Content: "a\nb\nc"}, nil)
+				m.expectFindRevision(2, &dto.ArticleRevision{Number: 2, Content: "a\nc\nd"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.DiffRevisionsOut, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, out.From.Number)
+				assert.Equal(t, 2, out.To.Number)
+				assert.Equal(t, []dto.DiffLine{
+					{Op: "equal", Text: "a"},
+					{Op: "delete", Text: "b"},
+					{Op: "equal", Text: "c"},
+					{Op: "insert", Text: "d"},
+				}, out.Lines)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).DiffRevisions(context.Background(), &dto.DiffRevisionsIn{
+				ArticleSlug: "foo-article",
+				UserID:      "user id",
+				From:        1,
+				To:          2,
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestRestoreRevision(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Article, err error)
+	}{
+		{
+			name: "article not found",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "restored by contributor",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id", Slug: "foo-article", AuthorID: "owner id"}, nil)
+				m.expectFindRole(dto.ArticleAuthorRoleContributor, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "revision not found",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownArticle(), nil)
+				m.expectFindRevision(1, nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrRevisionNotFound)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(ownArticle(), nil)
+				m.expectFindRevision(1, &dto.ArticleRevision{Number: 1, Title: "Foo", Content: "old content"}, nil)
+
+				m.expectRender("old content", nil)
+				restored := &dto.Article{
+					ID:          "article id",
+					Slug:        "foo-article",
+					Title:       "Foo",
+					Content:     "old content",
+					ContentHTML: "<p>old content</p>",
+					TOC:         []dto.TOCEntry{},
+					Excerpt:     "old…",
+					WordCount:   2,
+					ReadingTime: 1,
+					AuthorID:    "user id",
+				}
+				m.expectSaveArticle(restored, nil)
+				m.expectReference("old content", nil)
+				m.expectSaveRevision("Foo", "old content", nil)
+				m.expectPruneRevisions(nil)
+				m.expectPurgeCache(true, false, nil)
+				m.expectPublishWebhook(dto.WebhookEventArticleUpdated, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "Foo", out.Title)
+				assert.Equal(t, "old content", out.Content)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).RestoreRevision(context.Background(), &dto.RestoreRevisionIn{
+				ArticleSlug: "foo-article",
+				UserID:      "user id",
+				Number:      1,
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
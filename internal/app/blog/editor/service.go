@@ -0,0 +1,142 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package editor
+
+import (
+	"context"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+type articleRepository interface {
+	Find(ctx context.Context, slug string) (*dto.Article, error)
+	Save(ctx context.Context, tx transaction.Transaction, article *dto.Article) error
+}
+
+// authorRepository resolves co-authors, who edit articles of their owners.
+type authorRepository interface {
+	// FindRole returns the role of the user among authors of the article, empty if the user is not one of them.
+	FindRole(ctx context.Context, articleID, userID string) (string, error)
+}
+
+type revisionRepository interface {
+	Get(ctx context.Context, articleID string) ([]*dto.ArticleRevision, error)
+	Find(ctx context.Context, articleID string, number int) (*dto.ArticleRevision, error)
+	Save(ctx context.Context, tx transaction.Transaction, revision *dto.ArticleRevision) error
+	Prune(ctx context.Context, tx transaction.Transaction, articleID string, keep int) error
+}
+
+// translationRepository stores translations of articles, see dto.ArticleTranslation.
+type translationRepository interface {
+	// Find returns the translation of the article to the locale, nil if there is none.
+	Find(ctx context.Context, articleID, locale string) (*dto.ArticleTranslation, error)
+	// FindBySlug returns the translation with the slug, nil if there is none.
+	FindBySlug(ctx context.Context, slug string) (*dto.ArticleTranslation, error)
+	Save(ctx context.Context, tx transaction.Transaction, translation *dto.ArticleTranslation) error
+	Delete(ctx context.Context, tx transaction.Transaction, id string) error
+}
+
+type contentRenderer interface {
+	Render(content string) (*dto.RenderedContent, error)
+}
+
+// articleCache is purged after articles change, so readers don't see stale pages until they expire.
+type articleCache interface {
+	PurgeArticles(ctx context.Context, slugs ...string) error
+	PurgeAuthors(ctx context.Context, authorIDs ...string) error
+	PurgeListings(ctx context.Context) error
+}
+
+// feedCache is purged after articles change, the feed of all articles and feeds of the authors and tags are dropped.
+type feedCache interface {
+	Purge(ctx context.Context, authorIDs, tags []string) error
+}
+
+// sitemapRefresher regenerates sitemap files listing the article after it changes,
+// shifted regenerates the files after the article too.
+type sitemapRefresher interface {
+	Refresh(ctx context.Context, articleID string, shifted bool) error
+}
+
+// timelinePublisher pushes new articles to home timelines of the author's followers.
+type timelinePublisher interface {
+	Publish(ctx context.Context, article *dto.Article) error
+}
+
+// relatedRefresher ranks related articles of an article again after it's published or its tags change.
+type relatedRefresher interface {
+	Refresh(ctx context.Context, articleID string) error
+}
+
+// mediaReferrer records media linked from articles, so media no article links to can be collected.
+type mediaReferrer interface {
+	Reference(ctx context.Context, tx transaction.Transaction, articleID, content string) error
+	ReferenceTranslation(ctx context.Context, tx transaction.Transaction, articleID, locale, content string) error
+}
+
+// webhookPublisher queues article events for webhooks subscribed to them.
+type webhookPublisher interface {
+	PublishArticle(ctx context.Context, tx transaction.Transaction, event string, article *dto.Article) error
+}
+
+type Service struct {
+	revisionRetention     int
+	excerptLength         int
+	defaultLocale         string
+	articleRepository     articleRepository
+	authorRepository      authorRepository
+	revisionRepository    revisionRepository
+	translationRepository translationRepository
+	contentRenderer       contentRenderer
+	articleCache          articleCache
+	feedCache             feedCache
+	sitemapRefresher      sitemapRefresher
+	timelinePublisher     timelinePublisher
+	relatedRefresher      relatedRefresher
+	mediaReferrer         mediaReferrer
+	webhookPublisher      webhookPublisher
+	logger                log.Logger
+}
+
+// NewService creates the article editor service.
+// revisionRetention is the number of latest revisions kept per article, zero means unlimited.
+// excerptLength is the maximum number of characters in article excerpts.
+// defaultLocale is the language of articles created without one.
+func NewService(
+	revisionRetention int,
+	excerptLength int,
+	defaultLocale string,
+	articleRepository articleRepository,
+	authorRepository authorRepository,
+	revisionRepository revisionRepository,
+	translationRepository translationRepository,
+	contentRenderer contentRenderer,
+	articleCache articleCache,
+	feedCache feedCache,
+	sitemapRefresher sitemapRefresher,
+	timelinePublisher timelinePublisher,
+	relatedRefresher relatedRefresher,
+	mediaReferrer mediaReferrer,
+	webhookPublisher webhookPublisher,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		revisionRetention:     revisionRetention,
+		excerptLength:         excerptLength,
+		defaultLocale:         defaultLocale,
+		articleRepository:     articleRepository,
+		authorRepository:      authorRepository,
+		revisionRepository:    revisionRepository,
+		translationRepository: translationRepository,
+		contentRenderer:       contentRenderer,
+		articleCache:          articleCache,
+		feedCache:             feedCache,
+		sitemapRefresher:      sitemapRefresher,
+		timelinePublisher:     timelinePublisher,
+		relatedRefresher:      relatedRefresher,
+		mediaReferrer:         mediaReferrer,
+		webhookPublisher:      webhookPublisher,
+		logger:                logger,
+	}
+}
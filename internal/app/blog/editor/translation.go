@@ -0,0 +1,174 @@
+package editor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/locale"
+	"github.com/art-es/yet-another-service/internal/core/plaintext"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+// SaveTranslation creates or updates the translation of the article to the locale.
+// Translations are served at their own slugs next to articles, so a slug is either an article's or a translation's.
+func (s *Service) SaveTranslation(ctx context.Context, in *dto.SaveTranslationIn) (*dto.ArticleTranslation, error) {
+	tag, err := locale.Canonical(in.Locale)
+	if err != nil {
+		return nil, fmt.Errorf("canonicalize locale: %w", err)
+	}
+
+	article, err := s.findOwnedArticle(ctx, in.ArticleSlug, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if article.Locale == tag {
+		return nil, errors.ErrTranslationLocale
+	}
+
+	translation, err := s.translationRepository.Find(ctx, article.ID, tag)
+	if err != nil {
+		return nil, fmt.Errorf("find translation in repository: %w", err)
+	}
+
+	if translation == nil {
+		translation = &dto.ArticleTranslation{ArticleID: article.ID, ArticleSlug: article.Slug, Locale: tag}
+	}
+
+	if err = s.checkTranslationSlug(ctx, translation, in.Slug); err != nil {
+		return nil, err
+	}
+
+	rendered, err := s.contentRenderer.Render(in.Content)
+	if err != nil {
+		return nil, fmt.Errorf("render translation content: %w", err)
+	}
+
+	text := plaintext.FromHTML(rendered.HTML)
+	translation.Slug = in.Slug
+	translation.Title = in.Title
+	translation.Content = in.Content
+	translation.ContentHTML = rendered.HTML
+	translation.TOC = rendered.TOC
+	translation.Excerpt = plaintext.Excerpt(text, s.excerptLength)
+	translation.WordCount = plaintext.WordCount(text)
+	translation.ReadingTime = readingTime(translation.WordCount)
+	translation.Status = in.Status
+
+	tx := transaction.New(ctx)
+
+	if err = s.doSaveTranslationTransaction(ctx, tx, translation); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	// listings carry translations of their articles, so they are purged as well,
+	// and sitemap entries of the article take more or fewer elements
+	s.purgeCache(ctx, article, true, true)
+
+	return translation, nil
+}
+
+// DeleteTranslation deletes the translation of the article to the locale.
+func (s *Service) DeleteTranslation(ctx context.Context, in *dto.DeleteTranslationIn) error {
+	tag, err := locale.Canonical(in.Locale)
+	if err != nil {
+		return fmt.Errorf("canonicalize locale: %w", err)
+	}
+
+	article, err := s.findOwnedArticle(ctx, in.ArticleSlug, in.UserID)
+	if err != nil {
+		return err
+	}
+
+	translation, err := s.translationRepository.Find(ctx, article.ID, tag)
+	if err != nil {
+		return fmt.Errorf("find translation in repository: %w", err)
+	}
+
+	if translation == nil {
+		return errors.ErrTranslationNotFound
+	}
+
+	tx := transaction.New(ctx)
+
+	if err = s.doDeleteTranslationTransaction(ctx, tx, translation); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	s.purgeCache(ctx, article, true, true)
+
+	return nil
+}
+
+func (s *Service) checkTranslationSlug(ctx context.Context, translation *dto.ArticleTranslation, slug string) error {
+	article, err := s.articleRepository.Find(ctx, slug)
+	if err != nil {
+		return fmt.Errorf("find article in repository: %w", err)
+	}
+
+	if article != nil {
+		return errors.ErrArticleSlugTaken
+	}
+
+	return s.checkSlugOfTranslations(ctx, slug, translation.ID)
+}
+
+// checkSlugOfTranslations returns ErrArticleSlugTaken when a translation other than translationID has the slug,
+// articles and translations share URLs so neither may shadow the other.
+func (s *Service) checkSlugOfTranslations(ctx context.Context, slug, translationID string) error {
+	other, err := s.translationRepository.FindBySlug(ctx, slug)
+	if err != nil {
+		return fmt.Errorf("find translation in repository: %w", err)
+	}
+
+	if other != nil && other.ID != translationID {
+		return errors.ErrArticleSlugTaken
+	}
+
+	return nil
+}
+
+func (s *Service) doSaveTranslationTransaction(
+	ctx context.Context,
+	tx transaction.Transaction,
+	translation *dto.ArticleTranslation,
+) error {
+	if err := s.translationRepository.Save(ctx, tx, translation); err != nil {
+		return fmt.Errorf("save translation in repository: %w", err)
+	}
+
+	err := s.mediaReferrer.ReferenceTranslation(ctx, tx, translation.ArticleID, translation.Locale, translation.Content)
+	if err != nil {
+		return fmt.Errorf("reference media: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) doDeleteTranslationTransaction(
+	ctx context.Context,
+	tx transaction.Transaction,
+	translation *dto.ArticleTranslation,
+) error {
+	if err := s.translationRepository.Delete(ctx, tx, translation.ID); err != nil {
+		return fmt.Errorf("delete translation in repository: %w", err)
+	}
+
+	if err := s.mediaReferrer.ReferenceTranslation(ctx, tx, translation.ArticleID, translation.Locale, ""); err != nil {
+		return fmt.Errorf("reference media: %w", err)
+	}
+
+	return nil
+}
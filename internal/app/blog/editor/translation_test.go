@@ -0,0 +1,335 @@
+package editor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func (m serviceMocks) expectFindTranslation(translation *dto.ArticleTranslation, err error) {
+	m.translationRepository.EXPECT().
+		Find(gomock.Any(), gomock.Eq("article id"), gomock.Eq("pt-BR")).
+		Return(translation, err)
+}
+
+func TestSaveTranslation(t *testing.T) {
+	storedArticle := func() *dto.Article {
+		return &dto.Article{ID: "article id", Slug: "foo-article", Locale: "en", AuthorID: "user id"}
+	}
+	storedTranslation := func() *dto.ArticleTranslation {
+		return &dto.ArticleTranslation{
+			ID:          "translation id",
+			ArticleID:   "article id",
+			ArticleSlug: "foo-article",
+			Locale:      "pt-BR",
+			Slug:        "foo-artigo",
+			Title:       "Foo",
+			Content:     "old content",
+			Status:      dto.TranslationStatusDraft,
+		}
+	}
+	savedTranslation := func(id string) *dto.ArticleTranslation {
+		return &dto.ArticleTranslation{
+			ID:          id,
+			ArticleID:   "article id",
+			ArticleSlug: "foo-article",
+			Locale:      "pt-BR",
+			Slug:        "foo-artigo",
+			Title:       "Foo artigo",
+			Content:     "novo conteudo",
+			ContentHTML: "<p>novo conteudo</p>",
+			TOC:         []dto.TOCEntry{},
+			Excerpt:     "novo…",
+			WordCount:   2,
+			ReadingTime: 1,
+			Status:      dto.TranslationStatusPublished,
+		}
+	}
+	expectSlugFree := func(m serviceMocks) {
+		m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo-artigo")).Return(nil, nil)
+		m.translationRepository.EXPECT().FindBySlug(gomock.Any(), gomock.Eq("foo-artigo")).Return(nil, nil)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.ArticleTranslation, err error)
+	}{
+		{
+			name: "article not found",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleTranslation, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "article of another user",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id", AuthorID: "another user id"}, nil)
+				m.expectFindRole("", nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleTranslation, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "saved by contributor",
+			setup: func(m serviceMocks) {
+				article := storedArticle()
+				article.AuthorID = "owner id"
+
+				m.expectFindArticle(article, nil)
+				m.expectFindRole(dto.ArticleAuthorRoleContributor, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleTranslation, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "locale of the article",
+			setup: func(m serviceMocks) {
+				article := storedArticle()
+				article.Locale = "pt-BR"
+				m.expectFindArticle(article, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleTranslation, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrTranslationLocale)
+			},
+		},
+		{
+			name: "find translation error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectFindTranslation(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ArticleTranslation, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find translation in repository: foo error")
+			},
+		},
+		{
+			name: "slug of an article",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectFindTranslation(nil, nil)
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo-artigo")).Return(&dto.Article{ID: "another id"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleTranslation, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleSlugTaken)
+			},
+		},
+		{
+			name: "slug of another translation",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectFindTranslation(nil, nil)
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo-artigo")).Return(nil, nil)
+				m.translationRepository.EXPECT().
+					FindBySlug(gomock.Any(), gomock.Eq("foo-artigo")).
+					Return(&dto.ArticleTranslation{ID: "another translation id"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleTranslation, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleSlugTaken)
+			},
+		},
+		{
+			name: "render error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectFindTranslation(nil, nil)
+				expectSlugFree(m)
+				m.expectRender("novo conteudo", errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ArticleTranslation, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "render translation content: foo error")
+			},
+		},
+		{
+			name: "reference media error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectFindTranslation(nil, nil)
+				expectSlugFree(m)
+				m.expectRender("novo conteudo", nil)
+				m.translationRepository.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Eq(savedTranslation(""))).Return(nil)
+				m.mediaReferrer.EXPECT().
+					ReferenceTranslation(gomock.Any(), gomock.Any(), gomock.Eq("article id"), gomock.Eq("pt-BR"), gomock.Eq("novo conteudo")).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ArticleTranslation, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "reference media: foo error")
+			},
+		},
+		{
+			name: "ok created",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectFindTranslation(nil, nil)
+				expectSlugFree(m)
+				m.expectRender("novo conteudo", nil)
+				m.translationRepository.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Eq(savedTranslation(""))).
+					Do(func(_ context.Context, _ transaction.Transaction, translation *dto.ArticleTranslation) {
+						translation.ID = "translation id"
+					}).
+					Return(nil)
+				m.mediaReferrer.EXPECT().
+					ReferenceTranslation(gomock.Any(), gomock.Any(), gomock.Eq("article id"), gomock.Eq("pt-BR"), gomock.Eq("novo conteudo")).
+					Return(nil)
+				m.expectPurgeCache(true, true, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleTranslation, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, savedTranslation("translation id"), out)
+			},
+		},
+		{
+			name: "ok updated",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectFindTranslation(storedTranslation(), nil)
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo-artigo")).Return(nil, nil)
+				m.translationRepository.EXPECT().FindBySlug(gomock.Any(), gomock.Eq("foo-artigo")).Return(storedTranslation(), nil)
+				m.expectRender("novo conteudo", nil)
+				m.translationRepository.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Eq(savedTranslation("translation id"))).Return(nil)
+				m.mediaReferrer.EXPECT().
+					ReferenceTranslation(gomock.Any(), gomock.Any(), gomock.Eq("article id"), gomock.Eq("pt-BR"), gomock.Eq("novo conteudo")).
+					Return(nil)
+				m.expectPurgeCache(true, true, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleTranslation, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, savedTranslation("translation id"), out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).SaveTranslation(context.Background(), &dto.SaveTranslationIn{
+				UserID:      "user id",
+				ArticleSlug: "foo-article",
+				Locale:      "pt-br",
+				Slug:        "foo-artigo",
+				Title:       "Foo artigo",
+				Content:     "novo conteudo",
+				Status:      dto.TranslationStatusPublished,
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestDeleteTranslation(t *testing.T) {
+	storedArticle := func() *dto.Article {
+		return &dto.Article{ID: "article id", Slug: "foo-article", Locale: "en", AuthorID: "user id"}
+	}
+	storedTranslation := &dto.ArticleTranslation{ID: "translation id", ArticleID: "article id", Locale: "pt-BR"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "deleted by contributor",
+			setup: func(m serviceMocks) {
+				article := storedArticle()
+				article.AuthorID = "owner id"
+
+				m.expectFindArticle(article, nil)
+				m.expectFindRole(dto.ArticleAuthorRoleContributor, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "find translation error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectFindTranslation(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "find translation in repository: foo error")
+			},
+		},
+		{
+			name: "translation not found",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectFindTranslation(nil, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrTranslationNotFound)
+			},
+		},
+		{
+			name: "delete error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectFindTranslation(storedTranslation, nil)
+				m.translationRepository.EXPECT().
+					Delete(gomock.Any(), gomock.Any(), gomock.Eq("translation id")).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "delete translation in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectFindTranslation(storedTranslation, nil)
+				m.translationRepository.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Eq("translation id")).Return(nil)
+				m.mediaReferrer.EXPECT().
+					ReferenceTranslation(gomock.Any(), gomock.Any(), gomock.Eq("article id"), gomock.Eq("pt-BR"), gomock.Eq("")).
+					Return(nil)
+				m.expectPurgeCache(true, true, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService(testutil.NewLogger()).DeleteTranslation(context.Background(), &dto.DeleteTranslationIn{
+				UserID:      "user id",
+				ArticleSlug: "foo-article",
+				Locale:      "pt-BR",
+			})
+
+			tt.assert(t, err)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package editor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/plaintext"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+// wordsPerMinute is the average silent reading speed used for reading time estimates.
+const wordsPerMinute = 200
+
+func (s *Service) Update(ctx context.Context, in *dto.UpdateArticleIn) (*dto.Article, error) {
+	article, role, err := s.findAuthoredArticle(ctx, in.Slug, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Visibility != "" && in.Visibility != article.Visibility && role != dto.ArticleAuthorRoleOwner {
+		return nil, errors.ErrForbidden
+	}
+
+	reordered := article.Title != in.Title
+	retagged := in.Tags != nil && !slices.Equal(article.Tags, in.Tags)
+	shifted := sitemapShifted(article.SEO, in.SEO)
+	previousTags := article.Tags
+	article.Title = in.Title
+	article.Content = in.Content
+	article.SEO = in.SEO
+	if in.Visibility != "" {
+		article.Visibility = in.Visibility
+	}
+	if in.Tags != nil {
+		article.Tags = in.Tags
+	}
+
+	if err = s.save(ctx, article, in.UserID, dto.WebhookEventArticleUpdated); err != nil {
+		return nil, err
+	}
+
+	s.purgeCache(ctx, article, reordered || retagged, shifted)
+
+	if retagged {
+		s.purgeTagFeeds(ctx, previousTags)
+		s.refreshRelated(ctx, article)
+	}
+
+	return article, nil
+}
+
+// sitemapShifted tells whether the article enters or leaves sitemaps, which only list articles
+// without a canonical URL elsewhere.
+func sitemapShifted(previous, current dto.ArticleSEO) bool {
+	return (previous.CanonicalURL == "") != (current.CanonicalURL == "")
+}
+
+// findOwnArticle finds the article the user is an author of, co-authors of any role edit the article too.
+func (s *Service) findOwnArticle(ctx context.Context, slug, userID string) (*dto.Article, error) {
+	article, _, err := s.findAuthoredArticle(ctx, slug, userID)
+	return article, err
+}
+
+// findOwnedArticle finds the article the user is an owner of, contributors can't change what readers see
+// besides the content: visibility, restored revisions and translations.
+func (s *Service) findOwnedArticle(ctx context.Context, slug, userID string) (*dto.Article, error) {
+	article, role, err := s.findAuthoredArticle(ctx, slug, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != dto.ArticleAuthorRoleOwner {
+		return nil, errors.ErrForbidden
+	}
+
+	return article, nil
+}
+
+// findAuthoredArticle finds the article with the role of the user in it, the author of the article is its owner.
+func (s *Service) findAuthoredArticle(ctx context.Context, slug, userID string) (*dto.Article, string, error) {
+	article, err := s.articleRepository.Find(ctx, slug)
+	if err != nil {
+		return nil, "", fmt.Errorf("find article in repository: %w", err)
+	}
+
+	if article == nil {
+		return nil, "", errors.ErrArticleNotFound
+	}
+
+	if article.AuthorID == userID {
+		return article, dto.ArticleAuthorRoleOwner, nil
+	}
+
+	role, err := s.authorRepository.FindRole(ctx, article.ID, userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("find author role in repository: %w", err)
+	}
+
+	if role == "" {
+		return nil, "", errors.ErrForbidden
+	}
+
+	return article, role, nil
+}
+
+// save renders the article content and stores the article together with a new immutable revision of its content.
+// The webhook event, if any, is queued in the same transaction, so it's delivered exactly when the article is saved.
+func (s *Service) save(ctx context.Context, article *dto.Article, userID, event string) error {
+	rendered, err := s.contentRenderer.Render(article.Content)
+	if err != nil {
+		return fmt.Errorf("render article content: %w", err)
+	}
+
+	text := plaintext.FromHTML(rendered.HTML)
+	article.ContentHTML = rendered.HTML
+	article.TOC = rendered.TOC
+	article.Excerpt = plaintext.Excerpt(text, s.excerptLength)
+	article.WordCount = plaintext.WordCount(text)
+	article.ReadingTime = readingTime(article.WordCount)
+
+	tx := transaction.New(ctx)
+
+	if err = s.doSaveTransaction(ctx, tx, article, userID, event); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) doSaveTransaction(
+	ctx context.Context,
+	tx transaction.Transaction,
+	article *dto.Article,
+	userID string,
+	event string,
+) error {
+	if err := s.articleRepository.Save(ctx, tx, article); err != nil {
+		return fmt.Errorf("save article in repository: %w", err)
+	}
+
+	if err := s.mediaReferrer.Reference(ctx, tx, article.ID, article.Content); err != nil {
+		return fmt.Errorf("reference media: %w", err)
+	}
+
+	revision := &dto.ArticleRevision{
+		ArticleID: article.ID,
+		Title:     article.Title,
+		Content:   article.Content,
+		AuthorID:  userID,
+	}
+
+	if err := s.revisionRepository.Save(ctx, tx, revision); err != nil {
+		return fmt.Errorf("save revision in repository: %w", err)
+	}
+
+	if event != "" {
+		if err := s.webhookPublisher.PublishArticle(ctx, tx, event, article); err != nil {
+			return fmt.Errorf("publish webhook event: %w", err)
+		}
+	}
+
+	if s.revisionRetention <= 0 {
+		return nil
+	}
+
+	if err := s.revisionRepository.Prune(ctx, tx, article.ID, s.revisionRetention); err != nil {
+		return fmt.Errorf("prune revisions in repository: %w", err)
+	}
+
+	return nil
+}
+
+// purgeCache drops cached pages affected by the saved article and queues regeneration of its sitemap.
+// A reordered article may move to pages it wasn't on, so every listing it can appear in is purged too.
+// A shifted article moves sitemap entries of the articles after it, see sitemapShifted.
+// Failures are only logged: the article is already saved and cached pages expire anyway.
+func (s *Service) purgeCache(ctx context.Context, article *dto.Article, reordered, shifted bool) {
+	if err := s.sitemapRefresher.Refresh(ctx, article.ID, shifted); err != nil {
+		s.logger.Error().Err(err).Msg("refresh sitemap error")
+	}
+
+	if err := s.feedCache.Purge(ctx, []string{article.AuthorID}, article.Tags); err != nil {
+		s.logger.Error().Err(err).Msg("purge feeds in cache error")
+	}
+
+	if err := s.articleCache.PurgeArticles(ctx, article.Slug); err != nil {
+		s.logger.Error().Err(err).Msg("purge articles in cache error")
+	}
+
+	if !reordered {
+		return
+	}
+
+	if err := s.articleCache.PurgeAuthors(ctx, article.AuthorID); err != nil {
+		s.logger.Error().Err(err).Msg("purge authors in cache error")
+	}
+
+	if err := s.articleCache.PurgeListings(ctx); err != nil {
+		s.logger.Error().Err(err).Msg("purge listings in cache error")
+	}
+}
+
+// purgeTagFeeds drops feeds of the tags the article had before it was retagged,
+// purgeCache only knows the tags the article has now.
+func (s *Service) purgeTagFeeds(ctx context.Context, tags []string) {
+	if len(tags) == 0 {
+		return
+	}
+
+	if err := s.feedCache.Purge(ctx, nil, tags); err != nil {
+		s.logger.Error().Err(err).Msg("purge feeds in cache error")
+	}
+}
+
+// refreshRelated queues ranking of related articles of the article, failures are only logged
+// since the ranking is computed again on reads anyway.
+func (s *Service) refreshRelated(ctx context.Context, article *dto.Article) {
+	if err := s.relatedRefresher.Refresh(ctx, article.ID); err != nil {
+		s.logger.Error().Err(err).Msg("refresh related articles error")
+	}
+}
+
+// readingTime estimates minutes to read the words, rounded up.
+func readingTime(words int) int {
+	return (words + wordsPerMinute - 1) / wordsPerMinute
+}
@@ -0,0 +1,270 @@
+package editor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestUpdate(t *testing.T) {
+	storedArticle := func() *dto.Article {
+		return &dto.Article{
+			ID:         "article id",
+			Slug:       "foo-article",
+			Title:      "Foo",
+			Content:    "old content",
+			SEO:        dto.ArticleSEO{MetaDescription: "Foo description"},
+			Visibility: dto.ArticleVisibilityPublic,
+			AuthorID:   "user id",
+		}
+	}
+	updatedArticle := func() *dto.Article {
+		return &dto.Article{
+			ID:          "article id",
+			Slug:        "foo-article",
+			Title:       "Bar",
+			Content:     "new content",
+			ContentHTML: "<p>new content</p>",
+			TOC:         []dto.TOCEntry{},
+			Excerpt:     "new…",
+			WordCount:   2,
+			ReadingTime: 1,
+			SEO:         dto.ArticleSEO{MetaDescription: "Bar description", CanonicalURL: "https://example.org/bar"},
+			Visibility:  dto.ArticleVisibilityMembers,
+			AuthorID:    "user id",
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		tags   []string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Article, err error)
+	}{
+		{
+			name: "find article error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find article in repository: foo error")
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "find author role error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id", AuthorID: "another user id"}, nil)
+				m.expectFindRole("", errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find author role in repository: foo error")
+			},
+		},
+		{
+			name: "article of another user",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id", AuthorID: "another user id"}, nil)
+				m.expectFindRole("", nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "prune revisions error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectRender("new content", nil)
+				m.expectSaveArticle(updatedArticle(), nil)
+				m.expectReference("new content", nil)
+				m.expectSaveRevision("Bar", "new content", nil)
+				m.expectPublishWebhook(dto.WebhookEventArticleUpdated, nil)
+				m.expectPruneRevisions(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "prune revisions in repository: foo error")
+			},
+		},
+		{
+			name: "ok without title change",
+			setup: func(m serviceMocks) {
+				stored := storedArticle()
+				stored.Title = "Bar"
+				stored.SEO.CanonicalURL = "https://example.org/foo"
+				m.expectFindArticle(stored, nil)
+				m.expectRender("new content", nil)
+				m.expectSaveArticle(updatedArticle(), nil)
+				m.expectReference("new content", nil)
+				m.expectSaveRevision("Bar", "new content", nil)
+				m.expectPruneRevisions(nil)
+				m.expectPurgeCache(false, false, nil)
+				m.expectPublishWebhook(dto.WebhookEventArticleUpdated, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, updatedArticle(), out)
+			},
+		},
+		{
+			name: "visibility changed by contributor",
+			setup: func(m serviceMocks) {
+				stored := storedArticle()
+				stored.AuthorID = "owner id"
+
+				m.expectFindArticle(stored, nil)
+				m.expectFindRole(dto.ArticleAuthorRoleContributor, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "ok by co-author",
+			setup: func(m serviceMocks) {
+				stored := storedArticle()
+				stored.AuthorID = "owner id"
+				stored.Visibility = dto.ArticleVisibilityMembers
+				updated := updatedArticle()
+				updated.AuthorID = "owner id"
+
+				m.expectFindArticle(stored, nil)
+				m.expectFindRole(dto.ArticleAuthorRoleContributor, nil)
+				m.expectRender("new content", nil)
+				m.expectSaveArticle(updated, nil)
+				m.expectReference("new content", nil)
+				// the revision is authored by the co-author, caches of the owner are purged
+				m.expectSaveRevision("Bar", "new content", nil)
+				m.expectPruneRevisions(nil)
+				m.sitemapRefresher.EXPECT().Refresh(gomock.Any(), gomock.Eq("article id"), gomock.Eq(true)).Return(nil)
+				m.feedCache.EXPECT().Purge(gomock.Any(), gomock.Eq([]string{"owner id"}), gomock.Nil()).Return(nil)
+				m.articleCache.EXPECT().PurgeArticles(gomock.Any(), gomock.Eq("foo-article")).Return(nil)
+				m.articleCache.EXPECT().PurgeAuthors(gomock.Any(), gomock.Eq("owner id")).Return(nil)
+				m.articleCache.EXPECT().PurgeListings(gomock.Any()).Return(nil)
+				m.expectPublishWebhook(dto.WebhookEventArticleUpdated, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "owner id", out.AuthorID)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(storedArticle(), nil)
+				m.expectRender("new content", nil)
+				m.expectSaveArticle(updatedArticle(), nil)
+				m.expectReference("new content", nil)
+				m.expectSaveRevision("Bar", "new content", nil)
+				m.expectPruneRevisions(nil)
+				// the canonical URL takes the article out of sitemaps
+				m.expectPurgeCache(true, true, nil)
+				m.expectPublishWebhook(dto.WebhookEventArticleUpdated, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, updatedArticle(), out)
+			},
+		},
+		{
+			name: "ok without tags change",
+			tags: []string{"go"},
+			setup: func(m serviceMocks) {
+				stored := storedArticle()
+				stored.Title = "Bar"
+				stored.Tags = []string{"go"}
+				stored.SEO.CanonicalURL = "https://example.org/foo"
+				updated := updatedArticle()
+				updated.Tags = []string{"go"}
+
+				m.expectFindArticle(stored, nil)
+				m.expectRender("new content", nil)
+				m.expectSaveArticle(updated, nil)
+				m.expectReference("new content", nil)
+				m.expectSaveRevision("Bar", "new content", nil)
+				m.expectPruneRevisions(nil)
+				m.expectPurgeTaggedCache([]string{"go"}, false, false, nil)
+				m.expectPublishWebhook(dto.WebhookEventArticleUpdated, nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"go"}, out.Tags)
+			},
+		},
+		{
+			name: "ok retagged",
+			tags: []string{"go", "sql"},
+			setup: func(m serviceMocks) {
+				stored := storedArticle()
+				stored.Title = "Bar"
+				stored.Tags = []string{"go"}
+				updated := updatedArticle()
+				updated.Tags = []string{"go", "sql"}
+
+				m.expectFindArticle(stored, nil)
+				m.expectRender("new content", nil)
+				m.expectSaveArticle(updated, nil)
+				m.expectReference("new content", nil)
+				m.expectSaveRevision("Bar", "new content", nil)
+				m.expectPruneRevisions(nil)
+				m.expectPublishWebhook(dto.WebhookEventArticleUpdated, nil)
+				// listings are filtered by tag, so they are purged along with feeds of the previous tags
+				m.expectPurgeTaggedCache([]string{"go", "sql"}, true, true, nil)
+				m.feedCache.EXPECT().Purge(gomock.Any(), gomock.Nil(), gomock.Eq([]string{"go"})).Return(nil)
+				m.expectRefreshRelated(nil)
+			},
+			assert: func(t *testing.T, out *dto.Article, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"go", "sql"}, out.Tags)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Update(context.Background(), &dto.UpdateArticleIn{
+				Slug:       "foo-article",
+				UserID:     "user id",
+				Title:      "Bar",
+				Content:    "new content",
+				Tags:       tt.tags,
+				SEO:        dto.ArticleSEO{MetaDescription: "Bar description", CanonicalURL: "https://example.org/bar"},
+				Visibility: dto.ArticleVisibilityMembers,
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestReadingTime(t *testing.T) {
+	assert.Equal(t, 0, readingTime(0))
+	assert.Equal(t, 1, readingTime(1))
+	assert.Equal(t, 1, readingTime(200))
+	assert.Equal(t, 2, readingTime(201))
+}
@@ -0,0 +1,188 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockarticleService is a mock of articleService interface.
+type MockarticleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleServiceMockRecorder is the mock recorder for MockarticleService.
+type MockarticleServiceMockRecorder struct {
+	mock *MockarticleService
+}
+
+// NewMockarticleService creates a new mock instance.
+func NewMockarticleService(ctrl *gomock.Controller) *MockarticleService {
+	mock := &MockarticleService{ctrl: ctrl}
+	mock.recorder = &MockarticleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleService) EXPECT() *MockarticleServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockarticleService) Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, in)
+	ret0, _ := ret[0].(*dto.GetArticlesOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockarticleServiceMockRecorder) Get(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockarticleService)(nil).Get), ctx, in)
+}
+
+// MockauthorService is a mock of authorService interface.
+type MockauthorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorServiceMockRecorder is the mock recorder for MockauthorService.
+type MockauthorServiceMockRecorder struct {
+	mock *MockauthorService
+}
+
+// NewMockauthorService creates a new mock instance.
+func NewMockauthorService(ctrl *gomock.Controller) *MockauthorService {
+	mock := &MockauthorService{ctrl: ctrl}
+	mock.recorder = &MockauthorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthorService) EXPECT() *MockauthorServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockauthorService) Get(ctx context.Context, nickName string) (*dto.AuthorProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, nickName)
+	ret0, _ := ret[0].(*dto.AuthorProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockauthorServiceMockRecorder) Get(ctx, nickName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockauthorService)(nil).Get), ctx, nickName)
+}
+
+// MockfeedRenderer is a mock of feedRenderer interface.
+type MockfeedRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockfeedRendererMockRecorder
+	isgomock struct{}
+}
+
+// MockfeedRendererMockRecorder is the mock recorder for MockfeedRenderer.
+type MockfeedRendererMockRecorder struct {
+	mock *MockfeedRenderer
+}
+
+// NewMockfeedRenderer creates a new mock instance.
+func NewMockfeedRenderer(ctrl *gomock.Controller) *MockfeedRenderer {
+	mock := &MockfeedRenderer{ctrl: ctrl}
+	mock.recorder = &MockfeedRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfeedRenderer) EXPECT() *MockfeedRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockfeedRenderer) Render(feed *dto.Feed, format string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", feed, format)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockfeedRendererMockRecorder) Render(feed, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockfeedRenderer)(nil).Render), feed, format)
+}
+
+// MockfeedCache is a mock of feedCache interface.
+type MockfeedCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockfeedCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockfeedCacheMockRecorder is the mock recorder for MockfeedCache.
+type MockfeedCacheMockRecorder struct {
+	mock *MockfeedCache
+}
+
+// NewMockfeedCache creates a new mock instance.
+func NewMockfeedCache(ctrl *gomock.Controller) *MockfeedCache {
+	mock := &MockfeedCache{ctrl: ctrl}
+	mock.recorder = &MockfeedCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfeedCache) EXPECT() *MockfeedCacheMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockfeedCache) Add(ctx context.Context, format, authorID, tag string, feed *dto.RenderedFeed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, format, authorID, tag, feed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockfeedCacheMockRecorder) Add(ctx, format, authorID, tag, feed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockfeedCache)(nil).Add), ctx, format, authorID, tag, feed)
+}
+
+// Get mocks base method.
+func (m *MockfeedCache) Get(ctx context.Context, format, authorID, tag string) (*dto.RenderedFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, format, authorID, tag)
+	ret0, _ := ret[0].(*dto.RenderedFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockfeedCacheMockRecorder) Get(ctx, format, authorID, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockfeedCache)(nil).Get), ctx, format, authorID, tag)
+}
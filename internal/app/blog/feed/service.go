@@ -0,0 +1,189 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package feed
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type articleService interface {
+	Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error)
+}
+
+type authorService interface {
+	Get(ctx context.Context, nickName string) (*dto.AuthorProfile, error)
+}
+
+type feedRenderer interface {
+	Render(feed *dto.Feed, format string) ([]byte, error)
+}
+
+// feedCache keeps rendered feeds by format, author and tag, both are empty for the feed of all articles.
+type feedCache interface {
+	Get(ctx context.Context, format, authorID, tag string) (*dto.RenderedFeed, error)
+	Add(ctx context.Context, format, authorID, tag string, feed *dto.RenderedFeed) error
+}
+
+type Service struct {
+	siteURL        url.URL
+	size           int
+	articleService articleService
+	authorService  authorService
+	feedRenderer   feedRenderer
+	feedCache      feedCache
+	logger         log.Logger
+}
+
+// NewService creates the feed service.
+// siteURL is the public URL of the blog used in feed links, size is the number of latest articles in a feed.
+func NewService(
+	siteURL url.URL,
+	size int,
+	articleService articleService,
+	authorService authorService,
+	feedRenderer feedRenderer,
+	feedCache feedCache,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		siteURL:        siteURL,
+		size:           size,
+		articleService: articleService,
+		authorService:  authorService,
+		feedRenderer:   feedRenderer,
+		feedCache:      feedCache,
+		logger:         logger,
+	}
+}
+
+func (s *Service) Get(ctx context.Context, in *dto.GetFeedIn) (*dto.RenderedFeed, error) {
+	var author *dto.AuthorProfile
+	if in.AuthorNickName != "" {
+		var err error
+		if author, err = s.authorService.Get(ctx, in.AuthorNickName); err != nil {
+			return nil, fmt.Errorf("get author: %w", err)
+		}
+	}
+
+	var authorID string
+	if author != nil {
+		authorID = author.ID
+	}
+
+	cached, err := s.feedCache.Get(ctx, in.Format, authorID, in.Tag)
+	switch {
+	case err == nil:
+		return cached, nil
+	case errors.Is(err, apperrors.ErrNoCache):
+		// need to render
+	default:
+		return nil, fmt.Errorf("get feed from cache: %w", err)
+	}
+
+	articles, err := s.articleService.Get(ctx, &dto.GetArticlesIn{
+		Sort:     dto.ArticleSortNewest,
+		Limit:    s.size,
+		AuthorID: authorID,
+		Tag:      in.Tag,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get articles: %w", err)
+	}
+
+	feed := s.build(in.Format, author, in.Tag, articles.Articles)
+
+	body, err := s.feedRenderer.Render(feed, in.Format)
+	if err != nil {
+		return nil, fmt.Errorf("render feed: %w", err)
+	}
+
+	hash := sha256.Sum256(body)
+	rendered := &dto.RenderedFeed{
+		Body:         body,
+		ContentType:  dto.FeedContentTypes[in.Format],
+		ETag:         `"` + hex.EncodeToString(hash[:16]) + `"`,
+		LastModified: feed.Updated,
+	}
+
+	if err = s.feedCache.Add(ctx, in.Format, authorID, in.Tag, rendered); err != nil {
+		s.logger.Error().Err(err).Msg("add feed to cache error")
+	}
+
+	return rendered, nil
+}
+
+func (s *Service) build(format string, author *dto.AuthorProfile, tag string, articles []dto.Article) *dto.Feed {
+	feed := &dto.Feed{
+		Title:       "Blog",
+		Description: "Latest articles",
+		Link:        s.link(),
+		FeedLink:    s.link("feed." + format),
+		Items:       make([]dto.FeedItem, 0, len(articles)),
+	}
+
+	if author != nil {
+		feed.Title = author.DisplayName
+		feed.Description = author.Bio
+		feed.Link = s.link("authors", author.NickName)
+		feed.FeedLink = s.link("authors", author.NickName, "feed."+format)
+	}
+
+	if tag != "" {
+		feed.Title = "#" + tag
+		feed.Description = "Latest articles tagged " + tag
+		feed.Link = s.link("tags", tag)
+		feed.FeedLink = s.link("tags", tag, "feed."+format)
+	}
+
+	for _, article := range articles {
+		item := dto.FeedItem{
+			ID:          s.link("articles", article.Slug),
+			Title:       article.Title,
+			Link:        s.link("articles", article.Slug),
+			Excerpt:     article.Excerpt,
+			ContentHTML: article.ContentHTML,
+			Published:   article.CreatedAt,
+			Updated:     article.CreatedAt,
+		}
+
+		if article.UpdatedAt != nil {
+			item.Updated = *article.UpdatedAt
+		}
+
+		if article.Author != nil {
+			item.AuthorName = article.Author.DisplayName
+			item.AuthorLink = s.link("authors", article.Author.NickName)
+		}
+
+		if len(article.Alternates) > 0 {
+			item.Language = article.Locale
+			item.Alternates = make([]dto.FeedAlternate, 0, len(article.Alternates))
+			for _, alternate := range article.Alternates {
+				item.Alternates = append(item.Alternates, dto.FeedAlternate{
+					Language: alternate.Locale,
+					Link:     s.link("articles", alternate.Slug),
+				})
+			}
+		}
+
+		if item.Updated.After(feed.Updated) {
+			feed.Updated = item.Updated
+		}
+
+		feed.Items = append(feed.Items, item)
+	}
+
+	return feed
+}
+
+func (s *Service) link(elem ...string) string {
+	return s.siteURL.JoinPath(elem...).String()
+}
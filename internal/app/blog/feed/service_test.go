@@ -0,0 +1,254 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/feed/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	articleService *mock.MockarticleService
+	authorService  *mock.MockauthorService
+	feedRenderer   *mock.MockfeedRenderer
+	feedCache      *mock.MockfeedCache
+}
+
+func TestGet(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	articles := &dto.GetArticlesOut{Articles: []dto.Article{
+		{
+			Slug:        "foo",
+			Title:       "Foo",
+			ContentHTML: "<p>Foo content</p>",
+			Excerpt:     "Foo content",
+			CreatedAt:   created,
+			UpdatedAt:   &updated,
+			Author:      &dto.ArticleAuthor{DisplayName: "Bob", NickName: "bob"},
+		},
+		{
+			Slug:        "bar",
+			Title:       "Bar",
+			ContentHTML: "<p>Bar content</p>",
+			Excerpt:     "Bar content",
+			CreatedAt:   created,
+			Locale:      "en",
+			Alternates: []dto.ArticleAlternate{
+				{Locale: "en", Slug: "bar", Title: "Bar"},
+				{Locale: "de", Slug: "bar-de", Title: "Bar (de)"},
+			},
+		},
+	}}
+	feedItems := []dto.FeedItem{
+		{
+			ID:          "https://example.com/blog/articles/foo",
+			Title:       "Foo",
+			Link:        "https://example.com/blog/articles/foo",
+			Excerpt:     "Foo content",
+			ContentHTML: "<p>Foo content</p>",
+			AuthorName:  "Bob",
+			AuthorLink:  "https://example.com/blog/authors/bob",
+			Published:   created,
+			Updated:     updated,
+		},
+		{
+			ID:          "https://example.com/blog/articles/bar",
+			Title:       "Bar",
+			Link:        "https://example.com/blog/articles/bar",
+			Excerpt:     "Bar content",
+			ContentHTML: "<p>Bar content</p>",
+			Published:   created,
+			Updated:     created,
+			Language:    "en",
+			Alternates: []dto.FeedAlternate{
+				{Language: "en", Link: "https://example.com/blog/articles/bar"},
+				{Language: "de", Link: "https://example.com/blog/articles/bar-de"},
+			},
+		},
+	}
+	rendered := &dto.RenderedFeed{
+		Body:         []byte("feed"),
+		ContentType:  "application/rss+xml; charset=utf-8",
+		ETag:         `"c8bc2586cdd87cd6f970fc4262c4bbc4"`,
+		LastModified: updated,
+	}
+
+	for _, tt := range []struct {
+		name   string
+		in     *dto.GetFeedIn
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.RenderedFeed, err error, logs []string)
+	}{
+		{
+			name: "author not found",
+			in:   &dto.GetFeedIn{Format: dto.FeedFormatRSS, AuthorNickName: "bob"},
+			setup: func(m serviceMocks) {
+				m.authorService.EXPECT().Get(gomock.Any(), gomock.Eq("bob")).Return(nil, apperrors.ErrAuthorNotFound)
+			},
+			assert: func(t *testing.T, out *dto.RenderedFeed, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrAuthorNotFound)
+			},
+		},
+		{
+			name: "get from cache error",
+			in:   &dto.GetFeedIn{Format: dto.FeedFormatRSS},
+			setup: func(m serviceMocks) {
+				m.feedCache.EXPECT().Get(gomock.Any(), gomock.Eq("rss"), gomock.Eq(""), gomock.Eq("")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.RenderedFeed, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get feed from cache: foo error")
+			},
+		},
+		{
+			name: "cached",
+			in:   &dto.GetFeedIn{Format: dto.FeedFormatRSS},
+			setup: func(m serviceMocks) {
+				m.feedCache.EXPECT().Get(gomock.Any(), gomock.Eq("rss"), gomock.Eq(""), gomock.Eq("")).Return(rendered, nil)
+			},
+			assert: func(t *testing.T, out *dto.RenderedFeed, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, rendered, out)
+			},
+		},
+		{
+			name: "get articles error",
+			in:   &dto.GetFeedIn{Format: dto.FeedFormatRSS},
+			setup: func(m serviceMocks) {
+				m.feedCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, apperrors.ErrNoCache)
+				m.articleService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.RenderedFeed, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get articles: foo error")
+			},
+		},
+		{
+			name: "render error",
+			in:   &dto.GetFeedIn{Format: dto.FeedFormatRSS},
+			setup: func(m serviceMocks) {
+				m.feedCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, apperrors.ErrNoCache)
+				m.articleService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(articles, nil)
+				m.feedRenderer.EXPECT().Render(gomock.Any(), gomock.Eq("rss")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.RenderedFeed, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "render feed: foo error")
+			},
+		},
+		{
+			name: "all articles",
+			in:   &dto.GetFeedIn{Format: dto.FeedFormatRSS},
+			setup: func(m serviceMocks) {
+				m.feedCache.EXPECT().Get(gomock.Any(), gomock.Eq("rss"), gomock.Eq(""), gomock.Eq("")).Return(nil, apperrors.ErrNoCache)
+				m.articleService.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20})).
+					Return(articles, nil)
+				m.feedRenderer.EXPECT().
+					Render(gomock.Eq(&dto.Feed{
+						Title:       "Blog",
+						Description: "Latest articles",
+						Link:        "https://example.com/blog",
+						FeedLink:    "https://example.com/blog/feed.rss",
+						Updated:     updated,
+						Items:       feedItems,
+					}), gomock.Eq("rss")).
+					Return([]byte("feed"), nil)
+				m.feedCache.EXPECT().
+					Add(gomock.Any(), gomock.Eq("rss"), gomock.Eq(""), gomock.Eq(""), gomock.Eq(rendered)).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.RenderedFeed, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, rendered, out)
+				assert.Equal(t, []string{`{"level":"error","error":"foo error","message":"add feed to cache error"}`}, logs)
+			},
+		},
+		{
+			name: "author articles",
+			in:   &dto.GetFeedIn{Format: dto.FeedFormatAtom, AuthorNickName: "bob"},
+			setup: func(m serviceMocks) {
+				m.authorService.EXPECT().
+					Get(gomock.Any(), gomock.Eq("bob")).
+					Return(&dto.AuthorProfile{ID: "author id", NickName: "bob", DisplayName: "Bob", Bio: "Bob's bio"}, nil)
+				m.feedCache.EXPECT().Get(gomock.Any(), gomock.Eq("atom"), gomock.Eq("author id"), gomock.Eq("")).Return(nil, apperrors.ErrNoCache)
+				m.articleService.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20, AuthorID: "author id"})).
+					Return(&dto.GetArticlesOut{Articles: articles.Articles[:1]}, nil)
+				m.feedRenderer.EXPECT().
+					Render(gomock.Eq(&dto.Feed{
+						Title:       "Bob",
+						Description: "Bob's bio",
+						Link:        "https://example.com/blog/authors/bob",
+						FeedLink:    "https://example.com/blog/authors/bob/feed.atom",
+						Updated:     updated,
+						Items:       feedItems[:1],
+					}), gomock.Eq("atom")).
+					Return([]byte("feed"), nil)
+				m.feedCache.EXPECT().Add(gomock.Any(), gomock.Eq("atom"), gomock.Eq("author id"), gomock.Eq(""), gomock.Any()).Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.RenderedFeed, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, "application/atom+xml; charset=utf-8", out.ContentType)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "tag articles",
+			in:   &dto.GetFeedIn{Format: dto.FeedFormatAtom, Tag: "go"},
+			setup: func(m serviceMocks) {
+				m.feedCache.EXPECT().Get(gomock.Any(), gomock.Eq("atom"), gomock.Eq(""), gomock.Eq("go")).Return(nil, apperrors.ErrNoCache)
+				m.articleService.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20, Tag: "go"})).
+					Return(&dto.GetArticlesOut{Articles: articles.Articles[:1]}, nil)
+				m.feedRenderer.EXPECT().
+					Render(gomock.Eq(&dto.Feed{
+						Title:       "#go",
+						Description: "Latest articles tagged go",
+						Link:        "https://example.com/blog/tags/go",
+						FeedLink:    "https://example.com/blog/tags/go/feed.atom",
+						Updated:     updated,
+						Items:       feedItems[:1],
+					}), gomock.Eq("atom")).
+					Return([]byte("feed"), nil)
+				m.feedCache.EXPECT().Add(gomock.Any(), gomock.Eq("atom"), gomock.Eq(""), gomock.Eq("go"), gomock.Any()).Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.RenderedFeed, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, "application/atom+xml; charset=utf-8", out.ContentType)
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := serviceMocks{
+				articleService: mock.NewMockarticleService(ctrl),
+				authorService:  mock.NewMockauthorService(ctrl),
+				feedRenderer:   mock.NewMockfeedRenderer(ctrl),
+				feedCache:      mock.NewMockfeedCache(ctrl),
+			}
+			tt.setup(m)
+
+			siteURL, _ := url.Parse("https://example.com/blog")
+			logger := testutil.NewLogger()
+			service := NewService(*siteURL, 20, m.articleService, m.authorService, m.feedRenderer, m.feedCache, logger)
+			out, err := service.Get(context.Background(), tt.in)
+
+			tt.assert(t, out, err, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package follow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+func (s *Service) Follow(ctx context.Context, in *dto.FollowIn) error {
+	author, err := s.findAuthor(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	added, err := s.save(ctx, in.UserID, author.ID, s.followRepository.Add, 1)
+	if err != nil {
+		return err
+	}
+
+	// the follow is stored already, the timeline catches up with the author's next articles anyway
+	if added {
+		if err = s.timelineUpdater.AddAuthor(ctx, in.UserID, author.ID); err != nil {
+			s.logger.Error().Err(err).Msg("add author to timeline error")
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) Unfollow(ctx context.Context, in *dto.FollowIn) error {
+	author, err := s.findAuthor(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.save(ctx, in.UserID, author.ID, s.followRepository.Delete, -1)
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		if err = s.timelineUpdater.RemoveAuthor(ctx, in.UserID, author.ID); err != nil {
+			s.logger.Error().Err(err).Msg("remove author from timeline error")
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) findAuthor(ctx context.Context, in *dto.FollowIn) (*dto.AuthorProfile, error) {
+	author, err := s.authorRepository.Find(ctx, in.AuthorNickName)
+	if err != nil {
+		return nil, fmt.Errorf("find author in repository: %w", err)
+	}
+
+	if author == nil {
+		return nil, errors.ErrAuthorNotFound
+	}
+
+	if author.ID == in.UserID {
+		return nil, errors.ErrFollowSelf
+	}
+
+	return author, nil
+}
+
+// save adds or deletes the follow and updates follow counts of both users by the delta if the follow changed.
+func (s *Service) save(
+	ctx context.Context,
+	followerID, authorID string,
+	change func(ctx context.Context, tx transaction.Transaction, followerID, authorID string) (bool, error),
+	delta int,
+) (bool, error) {
+	tx := transaction.New(ctx)
+
+	changed, err := change(ctx, tx, followerID, authorID)
+	if err != nil {
+		tx.Rollback()
+		return false, fmt.Errorf("save follow in repository: %w", err)
+	}
+
+	if changed {
+		if err = s.followRepository.UpdateCounts(ctx, tx, followerID, authorID, delta); err != nil {
+			tx.Rollback()
+			return false, fmt.Errorf("update follow counts in repository: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,225 @@
+package follow
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/follow/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	authorRepository *mock.MockauthorRepository
+	followRepository *mock.MockfollowRepository
+	timelineUpdater  *mock.MocktimelineUpdater
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		authorRepository: mock.NewMockauthorRepository(ctrl),
+		followRepository: mock.NewMockfollowRepository(ctrl),
+		timelineUpdater:  mock.NewMocktimelineUpdater(ctrl),
+	}
+}
+
+func (m serviceMocks) expectFindAuthor(author *dto.AuthorProfile, err error) {
+	m.authorRepository.EXPECT().Find(gomock.Any(), gomock.Eq("bob")).Return(author, err)
+}
+
+func TestFollow(t *testing.T) {
+	author := &dto.AuthorProfile{ID: "author id", NickName: "bob"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error, logs []string)
+	}{
+		{
+			name: "find author error",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "find author in repository: foo error")
+			},
+		},
+		{
+			name: "author not found",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(nil, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.ErrorIs(t, err, apperrors.ErrAuthorNotFound)
+			},
+		},
+		{
+			name: "follow self",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(&dto.AuthorProfile{ID: "user id"}, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.ErrorIs(t, err, apperrors.ErrFollowSelf)
+			},
+		},
+		{
+			name: "add follow error",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().
+					Add(gomock.Any(), gomock.Any(), gomock.Eq("user id"), gomock.Eq("author id")).
+					Return(false, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "save follow in repository: foo error")
+			},
+		},
+		{
+			name: "update counts error",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().Add(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.followRepository.EXPECT().
+					UpdateCounts(gomock.Any(), gomock.Any(), gomock.Eq("user id"), gomock.Eq("author id"), gomock.Eq(1)).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "update follow counts in repository: foo error")
+			},
+		},
+		{
+			name: "already following",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().Add(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "add author to timeline error",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().Add(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.followRepository.EXPECT().UpdateCounts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.timelineUpdater.EXPECT().
+					AddAuthor(gomock.Any(), gomock.Eq("user id"), gomock.Eq("author id")).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{`{"level":"error","error":"foo error","message":"add author to timeline error"}`}, logs)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().Add(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.followRepository.EXPECT().UpdateCounts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(1)).Return(nil)
+				m.timelineUpdater.EXPECT().AddAuthor(gomock.Any(), gomock.Eq("user id"), gomock.Eq("author id")).Return(nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			service := NewService(m.authorRepository, m.followRepository, m.timelineUpdater, logger)
+			err := service.Follow(context.Background(), &dto.FollowIn{UserID: "user id", AuthorNickName: "bob"})
+
+			tt.assert(t, err, logger.Logs())
+		})
+	}
+}
+
+func TestUnfollow(t *testing.T) {
+	author := &dto.AuthorProfile{ID: "author id", NickName: "bob"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error, logs []string)
+	}{
+		{
+			name: "delete follow error",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().
+					Delete(gomock.Any(), gomock.Any(), gomock.Eq("user id"), gomock.Eq("author id")).
+					Return(false, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "save follow in repository: foo error")
+			},
+		},
+		{
+			name: "not following",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "remove author from timeline error",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.followRepository.EXPECT().UpdateCounts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(-1)).Return(nil)
+				m.timelineUpdater.EXPECT().
+					RemoveAuthor(gomock.Any(), gomock.Eq("user id"), gomock.Eq("author id")).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{`{"level":"error","error":"foo error","message":"remove author from timeline error"}`}, logs)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.followRepository.EXPECT().
+					UpdateCounts(gomock.Any(), gomock.Any(), gomock.Eq("user id"), gomock.Eq("author id"), gomock.Eq(-1)).
+					Return(nil)
+				m.timelineUpdater.EXPECT().RemoveAuthor(gomock.Any(), gomock.Eq("user id"), gomock.Eq("author id")).Return(nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			service := NewService(m.authorRepository, m.followRepository, m.timelineUpdater, logger)
+			err := service.Unfollow(context.Background(), &dto.FollowIn{UserID: "user id", AuthorNickName: "bob"})
+
+			tt.assert(t, err, logger.Logs())
+		})
+	}
+}
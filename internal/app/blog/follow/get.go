@@ -0,0 +1,39 @@
+package follow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// Get returns a page of the followers or following list of the author, latest follows first.
+func (s *Service) Get(ctx context.Context, in *dto.GetFollowsIn) (*dto.GetFollowsOut, error) {
+	author, err := s.authorRepository.Find(ctx, in.AuthorNickName)
+	if err != nil {
+		return nil, fmt.Errorf("find author in repository: %w", err)
+	}
+
+	if author == nil {
+		return nil, errors.ErrAuthorNotFound
+	}
+
+	follows, err := s.followRepository.Get(ctx, in.List, author.ID, in.Cursor, in.Limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("get follows from repository: %w", err)
+	}
+
+	out := &dto.GetFollowsOut{Follows: follows, Count: author.FollowersCount}
+	if in.List == dto.FollowListFollowing {
+		out.Count = author.FollowingCount
+	}
+
+	if len(follows) > in.Limit {
+		out.Follows = follows[:in.Limit]
+		last := out.Follows[len(out.Follows)-1]
+		out.NextCursor = &dto.FollowCursor{FollowedAt: last.FollowedAt, UserID: last.UserID}
+	}
+
+	return out, nil
+}
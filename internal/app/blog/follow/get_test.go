@@ -0,0 +1,109 @@
+package follow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestGet(t *testing.T) {
+	author := &dto.AuthorProfile{ID: "author id", NickName: "bob", FollowersCount: 3, FollowingCount: 1}
+	followedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	follows := []dto.Follow{
+		{UserID: "1", User: dto.ArticleAuthor{NickName: "alice"}, FollowedAt: followedAt},
+		{UserID: "2", User: dto.ArticleAuthor{NickName: "carol"}, FollowedAt: followedAt},
+		{UserID: "3", User: dto.ArticleAuthor{NickName: "dave"}, FollowedAt: followedAt},
+	}
+
+	for _, tt := range []struct {
+		name   string
+		in     *dto.GetFollowsIn
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.GetFollowsOut, err error)
+	}{
+		{
+			name: "author not found",
+			in:   &dto.GetFollowsIn{List: dto.FollowListFollowers, AuthorNickName: "bob", Limit: 2},
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetFollowsOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrAuthorNotFound)
+			},
+		},
+		{
+			name: "get follows error",
+			in:   &dto.GetFollowsIn{List: dto.FollowListFollowers, AuthorNickName: "bob", Limit: 2},
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetFollowsOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get follows from repository: foo error")
+			},
+		},
+		{
+			name: "followers with more",
+			in:   &dto.GetFollowsIn{List: dto.FollowListFollowers, AuthorNickName: "bob", Limit: 2},
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().
+					Get(gomock.Any(), gomock.Eq(dto.FollowListFollowers), gomock.Eq("author id"), gomock.Nil(), gomock.Eq(3)).
+					Return(follows, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetFollowsOut, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetFollowsOut{
+					Follows:    follows[:2],
+					Count:      3,
+					NextCursor: &dto.FollowCursor{FollowedAt: followedAt, UserID: "2"},
+				}, out)
+			},
+		},
+		{
+			name: "following",
+			in: &dto.GetFollowsIn{
+				List:           dto.FollowListFollowing,
+				AuthorNickName: "bob",
+				Cursor:         &dto.FollowCursor{FollowedAt: followedAt, UserID: "2"},
+				Limit:          2,
+			},
+			setup: func(m serviceMocks) {
+				m.expectFindAuthor(author, nil)
+				m.followRepository.EXPECT().
+					Get(gomock.Any(), gomock.Eq(dto.FollowListFollowing), gomock.Eq("author id"),
+						gomock.Eq(&dto.FollowCursor{FollowedAt: followedAt, UserID: "2"}), gomock.Eq(3)).
+					Return(follows[2:], nil)
+			},
+			assert: func(t *testing.T, out *dto.GetFollowsOut, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetFollowsOut{Follows: follows[2:], Count: 1}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			service := NewService(m.authorRepository, m.followRepository, m.timelineUpdater, testutil.NewLogger())
+			out, err := service.Get(context.Background(), tt.in)
+
+			tt.assert(t, out, err)
+		})
+	}
+}
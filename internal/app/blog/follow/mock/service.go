@@ -0,0 +1,193 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	transaction "github.com/art-es/yet-another-service/internal/core/transaction"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockauthorRepository is a mock of authorRepository interface.
+type MockauthorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorRepositoryMockRecorder is the mock recorder for MockauthorRepository.
+type MockauthorRepositoryMockRecorder struct {
+	mock *MockauthorRepository
+}
+
+// NewMockauthorRepository creates a new mock instance.
+func NewMockauthorRepository(ctrl *gomock.Controller) *MockauthorRepository {
+	mock := &MockauthorRepository{ctrl: ctrl}
+	mock.recorder = &MockauthorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthorRepository) EXPECT() *MockauthorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockauthorRepository) Find(ctx context.Context, nickName string) (*dto.AuthorProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, nickName)
+	ret0, _ := ret[0].(*dto.AuthorProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockauthorRepositoryMockRecorder) Find(ctx, nickName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockauthorRepository)(nil).Find), ctx, nickName)
+}
+
+// MockfollowRepository is a mock of followRepository interface.
+type MockfollowRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockfollowRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockfollowRepositoryMockRecorder is the mock recorder for MockfollowRepository.
+type MockfollowRepositoryMockRecorder struct {
+	mock *MockfollowRepository
+}
+
+// NewMockfollowRepository creates a new mock instance.
+func NewMockfollowRepository(ctrl *gomock.Controller) *MockfollowRepository {
+	mock := &MockfollowRepository{ctrl: ctrl}
+	mock.recorder = &MockfollowRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfollowRepository) EXPECT() *MockfollowRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockfollowRepository) Add(ctx context.Context, tx transaction.Transaction, followerID, authorID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, tx, followerID, authorID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockfollowRepositoryMockRecorder) Add(ctx, tx, followerID, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockfollowRepository)(nil).Add), ctx, tx, followerID, authorID)
+}
+
+// Delete mocks base method.
+func (m *MockfollowRepository) Delete(ctx context.Context, tx transaction.Transaction, followerID, authorID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tx, followerID, authorID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockfollowRepositoryMockRecorder) Delete(ctx, tx, followerID, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockfollowRepository)(nil).Delete), ctx, tx, followerID, authorID)
+}
+
+// Get mocks base method.
+func (m *MockfollowRepository) Get(ctx context.Context, list, userID string, cursor *dto.FollowCursor, limit int) ([]dto.Follow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, list, userID, cursor, limit)
+	ret0, _ := ret[0].([]dto.Follow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockfollowRepositoryMockRecorder) Get(ctx, list, userID, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockfollowRepository)(nil).Get), ctx, list, userID, cursor, limit)
+}
+
+// UpdateCounts mocks base method.
+func (m *MockfollowRepository) UpdateCounts(ctx context.Context, tx transaction.Transaction, followerID, authorID string, delta int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCounts", ctx, tx, followerID, authorID, delta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCounts indicates an expected call of UpdateCounts.
+func (mr *MockfollowRepositoryMockRecorder) UpdateCounts(ctx, tx, followerID, authorID, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCounts", reflect.TypeOf((*MockfollowRepository)(nil).UpdateCounts), ctx, tx, followerID, authorID, delta)
+}
+
+// MocktimelineUpdater is a mock of timelineUpdater interface.
+type MocktimelineUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MocktimelineUpdaterMockRecorder
+	isgomock struct{}
+}
+
+// MocktimelineUpdaterMockRecorder is the mock recorder for MocktimelineUpdater.
+type MocktimelineUpdaterMockRecorder struct {
+	mock *MocktimelineUpdater
+}
+
+// NewMocktimelineUpdater creates a new mock instance.
+func NewMocktimelineUpdater(ctrl *gomock.Controller) *MocktimelineUpdater {
+	mock := &MocktimelineUpdater{ctrl: ctrl}
+	mock.recorder = &MocktimelineUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktimelineUpdater) EXPECT() *MocktimelineUpdaterMockRecorder {
+	return m.recorder
+}
+
+// AddAuthor mocks base method.
+func (m *MocktimelineUpdater) AddAuthor(ctx context.Context, userID, authorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAuthor", ctx, userID, authorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAuthor indicates an expected call of AddAuthor.
+func (mr *MocktimelineUpdaterMockRecorder) AddAuthor(ctx, userID, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAuthor", reflect.TypeOf((*MocktimelineUpdater)(nil).AddAuthor), ctx, userID, authorID)
+}
+
+// RemoveAuthor mocks base method.
+func (m *MocktimelineUpdater) RemoveAuthor(ctx context.Context, userID, authorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAuthor", ctx, userID, authorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAuthor indicates an expected call of RemoveAuthor.
+func (mr *MocktimelineUpdaterMockRecorder) RemoveAuthor(ctx, userID, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAuthor", reflect.TypeOf((*MocktimelineUpdater)(nil).RemoveAuthor), ctx, userID, authorID)
+}
@@ -0,0 +1,49 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package follow
+
+import (
+	"context"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+type authorRepository interface {
+	Find(ctx context.Context, nickName string) (*dto.AuthorProfile, error)
+}
+
+type followRepository interface {
+	Add(ctx context.Context, tx transaction.Transaction, followerID, authorID string) (bool, error)
+	Delete(ctx context.Context, tx transaction.Transaction, followerID, authorID string) (bool, error)
+	// UpdateCounts adds the delta to the following count of the follower and to the followers count of the author.
+	UpdateCounts(ctx context.Context, tx transaction.Transaction, followerID, authorID string, delta int) error
+	Get(ctx context.Context, list, userID string, cursor *dto.FollowCursor, limit int) ([]dto.Follow, error)
+}
+
+// timelineUpdater brings timelines of followers in line with the authors they follow.
+type timelineUpdater interface {
+	AddAuthor(ctx context.Context, userID, authorID string) error
+	RemoveAuthor(ctx context.Context, userID, authorID string) error
+}
+
+type Service struct {
+	authorRepository authorRepository
+	followRepository followRepository
+	timelineUpdater  timelineUpdater
+	logger           log.Logger
+}
+
+func NewService(
+	authorRepository authorRepository,
+	followRepository followRepository,
+	timelineUpdater timelineUpdater,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		authorRepository: authorRepository,
+		followRepository: followRepository,
+		timelineUpdater:  timelineUpdater,
+		logger:           logger,
+	}
+}
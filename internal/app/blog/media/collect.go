@@ -0,0 +1,90 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+const collectBatchSize = 100
+
+// Reference records media linked from the article content, replacing the ones recorded before.
+func (s *Service) Reference(ctx context.Context, tx transaction.Transaction, articleID, content string) error {
+	return s.reference(ctx, tx, articleID, "", content)
+}
+
+// ReferenceTranslation records media linked from the translation content, apart from media of the article itself.
+// Empty content drops references of a deleted translation.
+func (s *Service) ReferenceTranslation(ctx context.Context, tx transaction.Transaction, articleID, locale, content string) error {
+	return s.reference(ctx, tx, articleID, locale, content)
+}
+
+func (s *Service) reference(ctx context.Context, tx transaction.Transaction, articleID, locale, content string) error {
+	ids := make([]string, 0)
+	for _, match := range s.referenceRegexp.FindAllStringSubmatch(content, -1) {
+		if !slices.Contains(ids, match[1]) {
+			ids = append(ids, match[1])
+		}
+	}
+
+	if err := s.mediaRepository.SetReferences(ctx, tx, articleID, locale, ids); err != nil {
+		return fmt.Errorf("set references in repository: %w", err)
+	}
+
+	return nil
+}
+
+// RunCollector periodically deletes orphaned media until ctx is done.
+func (s *Service) RunCollector(ctx context.Context) {
+	ticker := time.NewTicker(s.config.CollectInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Collect(ctx); err != nil {
+				s.logger.Error().Err(err).Msg("collect orphaned media error")
+			}
+		}
+	}
+}
+
+// Collect deletes a batch of media no article has referenced for the orphan TTL.
+// Media which fail to be deleted are left for the next run.
+func (s *Service) Collect(ctx context.Context) error {
+	orphans, err := s.mediaRepository.GetOrphans(ctx, time.Now().Add(-s.config.OrphanTTL), collectBatchSize)
+	if err != nil {
+		return fmt.Errorf("get orphaned media from repository: %w", err)
+	}
+
+	for _, media := range orphans {
+		if err = s.delete(ctx, media); err != nil {
+			s.logger.Error().Err(err).
+				Str("media_id", media.ID).
+				Msg("delete orphaned media error")
+		}
+	}
+
+	return nil
+}
+
+// delete removes the files before the media, so files are never left without the media pointing to them.
+func (s *Service) delete(ctx context.Context, media *dto.Media) error {
+	for _, v := range media.Variants {
+		if err := s.blobStorage.Delete(ctx, blobKey(media.ID, v.Name)); err != nil {
+			return fmt.Errorf("delete blob in storage: %w", err)
+		}
+	}
+
+	if err := s.mediaRepository.Delete(ctx, media.ID); err != nil {
+		return fmt.Errorf("delete media in repository: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestReference(t *testing.T) {
+	const (
+		fooID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+		barID = "6f1c3c59-1a0f-4a43-9d7e-2f3f0f3f3b8e"
+	)
+
+	for _, tt := range []struct {
+		name    string
+		content string
+		setup   func(m serviceMocks)
+		assert  func(t *testing.T, err error)
+	}{
+		{
+			name:    "set references error",
+			content: "no images",
+			setup: func(m serviceMocks) {
+				m.mediaRepository.EXPECT().
+					SetReferences(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "set references in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			content: "![foo](https://example.com/media/" + fooID + "/original.jpg)\n" +
+				"![bar](/media/" + barID + "/w320.webp)\n" +
+				"![foo again](https://example.com/media/" + fooID + "/w320.jpg)\n" +
+				"[not media](https://example.com/articles/" + fooID + "/)",
+			setup: func(m serviceMocks) {
+				m.mediaRepository.EXPECT().
+					SetReferences(gomock.Any(), gomock.Any(), gomock.Eq("article id"), gomock.Eq(""), gomock.Eq([]string{fooID, barID})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService(testutil.NewLogger()).Reference(context.Background(), nil, "article id", tt.content)
+
+			tt.assert(t, err)
+		})
+	}
+}
+
+func TestReferenceTranslation(t *testing.T) {
+	const fooID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newServiceMocks(ctrl)
+	m.mediaRepository.EXPECT().
+		SetReferences(gomock.Any(), gomock.Any(), gomock.Eq("article id"), gomock.Eq("de"), gomock.Eq([]string{fooID})).
+		Return(nil)
+
+	err := m.newService(testutil.NewLogger()).
+		ReferenceTranslation(context.Background(), nil, "article id", "de", "![foo](/media/"+fooID+"/original.jpg)")
+
+	assert.NoError(t, err)
+}
+
+func TestCollect(t *testing.T) {
+	orphans := []*dto.Media{
+		{ID: "foo id", Variants: []dto.MediaVariant{{Name: "original.png"}, {Name: "original.webp"}}},
+		{ID: "bar id", Variants: []dto.MediaVariant{{Name: "original.jpg"}}},
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error, logs []string)
+	}{
+		{
+			name: "get orphans error",
+			setup: func(m serviceMocks) {
+				m.mediaRepository.EXPECT().GetOrphans(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "get orphaned media from repository: foo error")
+			},
+		},
+		{
+			name: "delete errors",
+			setup: func(m serviceMocks) {
+				m.mediaRepository.EXPECT().GetOrphans(gomock.Any(), gomock.Any(), gomock.Eq(100)).Return(orphans, nil)
+				m.blobStorage.EXPECT().Delete(gomock.Any(), gomock.Eq("media/foo id/original.png")).Return(errors.New("foo error"))
+				m.blobStorage.EXPECT().Delete(gomock.Any(), gomock.Eq("media/bar id/original.jpg")).Return(nil)
+				m.mediaRepository.EXPECT().Delete(gomock.Any(), gomock.Eq("bar id")).Return(errors.New("bar error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{
+					`{"level":"error","error":"delete blob in storage: foo error","media_id":"foo id","message":"delete orphaned media error"}`,
+					`{"level":"error","error":"delete media in repository: bar error","media_id":"bar id","message":"delete orphaned media error"}`,
+				}, logs)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.mediaRepository.EXPECT().GetOrphans(gomock.Any(), gomock.Any(), gomock.Eq(100)).Return(orphans, nil)
+				m.blobStorage.EXPECT().Delete(gomock.Any(), gomock.Eq("media/foo id/original.png")).Return(nil)
+				m.blobStorage.EXPECT().Delete(gomock.Any(), gomock.Eq("media/foo id/original.webp")).Return(nil)
+				m.mediaRepository.EXPECT().Delete(gomock.Any(), gomock.Eq("foo id")).Return(nil)
+				m.blobStorage.EXPECT().Delete(gomock.Any(), gomock.Eq("media/bar id/original.jpg")).Return(nil)
+				m.mediaRepository.EXPECT().Delete(gomock.Any(), gomock.Eq("bar id")).Return(nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			err := m.newService(logger).Collect(context.Background())
+
+			tt.assert(t, err, logger.Logs())
+		})
+	}
+}
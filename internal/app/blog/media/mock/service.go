@@ -0,0 +1,207 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	transaction "github.com/art-es/yet-another-service/internal/core/transaction"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockmediaRepository is a mock of mediaRepository interface.
+type MockmediaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmediaRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockmediaRepositoryMockRecorder is the mock recorder for MockmediaRepository.
+type MockmediaRepositoryMockRecorder struct {
+	mock *MockmediaRepository
+}
+
+// NewMockmediaRepository creates a new mock instance.
+func NewMockmediaRepository(ctrl *gomock.Controller) *MockmediaRepository {
+	mock := &MockmediaRepository{ctrl: ctrl}
+	mock.recorder = &MockmediaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmediaRepository) EXPECT() *MockmediaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockmediaRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockmediaRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockmediaRepository)(nil).Delete), ctx, id)
+}
+
+// GetOrphans mocks base method.
+func (m *MockmediaRepository) GetOrphans(ctx context.Context, createdBefore time.Time, limit int) ([]*dto.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrphans", ctx, createdBefore, limit)
+	ret0, _ := ret[0].([]*dto.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrphans indicates an expected call of GetOrphans.
+func (mr *MockmediaRepositoryMockRecorder) GetOrphans(ctx, createdBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrphans", reflect.TypeOf((*MockmediaRepository)(nil).GetOrphans), ctx, createdBefore, limit)
+}
+
+// Save mocks base method.
+func (m *MockmediaRepository) Save(ctx context.Context, media *dto.Media) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, media)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockmediaRepositoryMockRecorder) Save(ctx, media any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockmediaRepository)(nil).Save), ctx, media)
+}
+
+// SetReferences mocks base method.
+func (m *MockmediaRepository) SetReferences(ctx context.Context, tx transaction.Transaction, articleID, locale string, mediaIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReferences", ctx, tx, articleID, locale, mediaIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReferences indicates an expected call of SetReferences.
+func (mr *MockmediaRepositoryMockRecorder) SetReferences(ctx, tx, articleID, locale, mediaIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReferences", reflect.TypeOf((*MockmediaRepository)(nil).SetReferences), ctx, tx, articleID, locale, mediaIDs)
+}
+
+// MockblobStorage is a mock of blobStorage interface.
+type MockblobStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockblobStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockblobStorageMockRecorder is the mock recorder for MockblobStorage.
+type MockblobStorageMockRecorder struct {
+	mock *MockblobStorage
+}
+
+// NewMockblobStorage creates a new mock instance.
+func NewMockblobStorage(ctrl *gomock.Controller) *MockblobStorage {
+	mock := &MockblobStorage{ctrl: ctrl}
+	mock.recorder = &MockblobStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockblobStorage) EXPECT() *MockblobStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockblobStorage) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockblobStorageMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockblobStorage)(nil).Delete), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockblobStorage) Get(ctx context.Context, key string) (*dto.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(*dto.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockblobStorageMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockblobStorage)(nil).Get), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockblobStorage) Put(ctx context.Context, key, contentType string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, contentType, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockblobStorageMockRecorder) Put(ctx, key, contentType, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockblobStorage)(nil).Put), ctx, key, contentType, data)
+}
+
+// MockimageProcessor is a mock of imageProcessor interface.
+type MockimageProcessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageProcessorMockRecorder
+	isgomock struct{}
+}
+
+// MockimageProcessorMockRecorder is the mock recorder for MockimageProcessor.
+type MockimageProcessorMockRecorder struct {
+	mock *MockimageProcessor
+}
+
+// NewMockimageProcessor creates a new mock instance.
+func NewMockimageProcessor(ctrl *gomock.Controller) *MockimageProcessor {
+	mock := &MockimageProcessor{ctrl: ctrl}
+	mock.recorder = &MockimageProcessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageProcessor) EXPECT() *MockimageProcessorMockRecorder {
+	return m.recorder
+}
+
+// Process mocks base method.
+func (m *MockimageProcessor) Process(data []byte) (*dto.ProcessedImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Process", data)
+	ret0, _ := ret[0].(*dto.ProcessedImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Process indicates an expected call of Process.
+func (mr *MockimageProcessorMockRecorder) Process(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Process", reflect.TypeOf((*MockimageProcessor)(nil).Process), data)
+}
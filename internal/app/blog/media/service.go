@@ -0,0 +1,78 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package media
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+type mediaRepository interface {
+	Save(ctx context.Context, media *dto.Media) error
+	// SetReferences replaces media referenced by the article, or by its translation when the locale isn't empty.
+	SetReferences(ctx context.Context, tx transaction.Transaction, articleID, locale string, mediaIDs []string) error
+	GetOrphans(ctx context.Context, createdBefore time.Time, limit int) ([]*dto.Media, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// blobStorage keeps files of media, Get returns nil for missing blobs.
+type blobStorage interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	Get(ctx context.Context, key string) (*dto.Blob, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type imageProcessor interface {
+	Process(data []byte) (*dto.ProcessedImage, error)
+}
+
+type Config struct {
+	// URL is the public URL media files are served at, as URL/<media id>/<variant name>.
+	URL     url.URL
+	MaxSize int64
+	// OrphanTTL is how long unreferenced media are kept, so authors can upload images before saving articles.
+	OrphanTTL       time.Duration
+	CollectInterval time.Duration
+}
+
+// Service stores uploaded images together with their thumbnails and collects media no article references.
+type Service struct {
+	config          Config
+	mediaRepository mediaRepository
+	blobStorage     blobStorage
+	imageProcessor  imageProcessor
+	logger          log.Logger
+	referenceRegexp *regexp.Regexp
+}
+
+func NewService(
+	config Config,
+	mediaRepository mediaRepository,
+	blobStorage blobStorage,
+	imageProcessor imageProcessor,
+	logger log.Logger,
+) *Service {
+	// the path matches both absolute and relative media URLs
+	prefix := strings.TrimSuffix(config.URL.Path, "/") + "/"
+	referenceRegexp := regexp.MustCompile(regexp.QuoteMeta(prefix) +
+		`([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})/`)
+
+	return &Service{
+		config:          config,
+		mediaRepository: mediaRepository,
+		blobStorage:     blobStorage,
+		imageProcessor:  imageProcessor,
+		logger:          logger,
+		referenceRegexp: referenceRegexp,
+	}
+}
+
+func blobKey(mediaID, name string) string {
+	return "media/" + mediaID + "/" + name
+}
@@ -0,0 +1,69 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// Upload stores the image and its variants. The content type is sniffed, the one claimed by the client isn't trusted.
+func (s *Service) Upload(ctx context.Context, in *dto.UploadMediaIn) (*dto.Media, error) {
+	if int64(len(in.Data)) > s.config.MaxSize {
+		return nil, errors.ErrMediaTooLarge
+	}
+
+	if !slices.Contains(dto.MediaContentTypes, http.DetectContentType(in.Data)) {
+		return nil, errors.ErrMediaUnsupportedType
+	}
+
+	processed, err := s.imageProcessor.Process(in.Data)
+	if err != nil {
+		return nil, fmt.Errorf("process image: %w", err)
+	}
+
+	media := &dto.Media{
+		OwnerID:  in.UserID,
+		Width:    processed.Width,
+		Height:   processed.Height,
+		Variants: make([]dto.MediaVariant, 0, len(processed.Variants)),
+	}
+
+	for _, v := range processed.Variants {
+		media.Variants = append(media.Variants, v.MediaVariant)
+	}
+
+	if err = s.mediaRepository.Save(ctx, media); err != nil {
+		return nil, fmt.Errorf("save media in repository: %w", err)
+	}
+
+	// media are saved first, so blobs of a failed upload are collected with the unreferenced media
+	for _, v := range processed.Variants {
+		if err = s.blobStorage.Put(ctx, blobKey(media.ID, v.Name), v.ContentType, v.Data); err != nil {
+			return nil, fmt.Errorf("put blob in storage: %w", err)
+		}
+	}
+
+	for i := range media.Variants {
+		media.Variants[i].URL = s.config.URL.JoinPath(media.ID, media.Variants[i].Name).String()
+	}
+
+	return media, nil
+}
+
+// Get opens the media file. Files never change, so they are found by the key without checking the media.
+func (s *Service) Get(ctx context.Context, mediaID, name string) (*dto.Blob, error) {
+	blob, err := s.blobStorage.Get(ctx, blobKey(mediaID, name))
+	if err != nil {
+		return nil, fmt.Errorf("get blob from storage: %w", err)
+	}
+
+	if blob == nil {
+		return nil, errors.ErrMediaNotFound
+	}
+
+	return blob, nil
+}
@@ -0,0 +1,224 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/media/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+// pngData is enough of a PNG file for the content type to be sniffed.
+var pngData = []byte("\x89PNG\x0D\x0A\x1A\x0Arest of the image")
+
+type serviceMocks struct {
+	mediaRepository *mock.MockmediaRepository
+	blobStorage     *mock.MockblobStorage
+	imageProcessor  *mock.MockimageProcessor
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		mediaRepository: mock.NewMockmediaRepository(ctrl),
+		blobStorage:     mock.NewMockblobStorage(ctrl),
+		imageProcessor:  mock.NewMockimageProcessor(ctrl),
+	}
+}
+
+func (m serviceMocks) newService(logger *testutil.Logger) *Service {
+	mediaURL, _ := url.Parse("https://example.com/media")
+	return NewService(Config{
+		URL:             *mediaURL,
+		MaxSize:         64,
+		OrphanTTL:       time.Hour,
+		CollectInterval: time.Minute,
+	}, m.mediaRepository, m.blobStorage, m.imageProcessor, logger)
+}
+
+func TestUpload(t *testing.T) {
+	processed := &dto.ProcessedImage{
+		Width:  640,
+		Height: 480,
+		Variants: []dto.ProcessedImageVariant{
+			{
+				MediaVariant: dto.MediaVariant{Name: "original.png", ContentType: "image/png", Width: 640, Height: 480, Size: 3},
+				Data:         []byte("png"),
+			},
+			{
+				MediaVariant: dto.MediaVariant{Name: "original.webp", ContentType: "image/webp", Width: 640, Height: 480, Size: 4},
+				Data:         []byte("webp"),
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name   string
+		data   []byte
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Media, err error)
+	}{
+		{
+			name:  "too large",
+			data:  []byte(strings.Repeat("a", 65)),
+			setup: func(m serviceMocks) {},
+			assert: func(t *testing.T, out *dto.Media, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrMediaTooLarge)
+			},
+		},
+		{
+			name:  "unsupported type",
+			data:  []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"),
+			setup: func(m serviceMocks) {},
+			assert: func(t *testing.T, out *dto.Media, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrMediaUnsupportedType)
+			},
+		},
+		{
+			name: "process error",
+			data: pngData,
+			setup: func(m serviceMocks) {
+				m.imageProcessor.EXPECT().Process(gomock.Eq(pngData)).Return(nil, apperrors.ErrMediaInvalid)
+			},
+			assert: func(t *testing.T, out *dto.Media, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrMediaInvalid)
+			},
+		},
+		{
+			name: "save media error",
+			data: pngData,
+			setup: func(m serviceMocks) {
+				m.imageProcessor.EXPECT().Process(gomock.Any()).Return(processed, nil)
+				m.mediaRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Media, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "save media in repository: foo error")
+			},
+		},
+		{
+			name: "put blob error",
+			data: pngData,
+			setup: func(m serviceMocks) {
+				m.imageProcessor.EXPECT().Process(gomock.Any()).Return(processed, nil)
+				m.mediaRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+				m.blobStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Media, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "put blob in storage: foo error")
+			},
+		},
+		{
+			name: "ok",
+			data: pngData,
+			setup: func(m serviceMocks) {
+				m.imageProcessor.EXPECT().Process(gomock.Any()).Return(processed, nil)
+				m.mediaRepository.EXPECT().
+					Save(gomock.Any(), gomock.Eq(&dto.Media{
+						OwnerID: "user id",
+						Width:   640,
+						Height:  480,
+						Variants: []dto.MediaVariant{
+							processed.Variants[0].MediaVariant,
+							processed.Variants[1].MediaVariant,
+						},
+					})).
+					Do(func(_ context.Context, media *dto.Media) { media.ID = "media id" }).
+					Return(nil)
+				m.blobStorage.EXPECT().
+					Put(gomock.Any(), gomock.Eq("media/media id/original.png"), gomock.Eq("image/png"), gomock.Eq([]byte("png"))).
+					Return(nil)
+				m.blobStorage.EXPECT().
+					Put(gomock.Any(), gomock.Eq("media/media id/original.webp"), gomock.Eq("image/webp"), gomock.Eq([]byte("webp"))).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.Media, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "media id", out.ID)
+				assert.Equal(t, "https://example.com/media/media%20id/original.png", out.Variants[0].URL)
+				assert.Equal(t, "https://example.com/media/media%20id/original.webp", out.Variants[1].URL)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Upload(context.Background(), &dto.UploadMediaIn{
+				UserID: "user id",
+				Data:   tt.data,
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Blob, err error)
+	}{
+		{
+			name: "get blob error",
+			setup: func(m serviceMocks) {
+				m.blobStorage.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Blob, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get blob from storage: foo error")
+			},
+		},
+		{
+			name: "not found",
+			setup: func(m serviceMocks) {
+				m.blobStorage.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.Blob, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrMediaNotFound)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.blobStorage.EXPECT().
+					Get(gomock.Any(), gomock.Eq("media/media id/w320.webp")).
+					Return(&dto.Blob{Body: io.NopCloser(strings.NewReader("webp")), ContentType: "image/webp", Size: 4}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Blob, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "image/webp", out.ContentType)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Get(context.Background(), "media id", "w320.webp")
+
+			tt.assert(t, out, err)
+		})
+	}
+}
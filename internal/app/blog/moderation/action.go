@@ -0,0 +1,148 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/mail"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+// Moderate hides or restores the content. Hiding resolves all open reports of the content.
+// The author is notified by mail.
+func (s *Service) Moderate(ctx context.Context, in *dto.ModerateIn) (*dto.ModerationAction, error) {
+	if err := s.checkModerator(ctx, in.UserID); err != nil {
+		return nil, err
+	}
+
+	target, err := s.moderationRepository.FindTarget(ctx, in.TargetType, in.TargetID)
+	if err != nil {
+		return nil, fmt.Errorf("find target in repository: %w", err)
+	}
+
+	if target == nil {
+		return nil, errors.ErrModerationTargetNotFound
+	}
+
+	if in.ReportID != nil {
+		report, err := s.moderationRepository.FindReport(ctx, *in.ReportID)
+		if err != nil {
+			return nil, fmt.Errorf("find report in repository: %w", err)
+		}
+
+		if report == nil || report.TargetType != target.Type || report.TargetID != target.ID {
+			return nil, errors.ErrReportNotFound
+		}
+	}
+
+	target.Hidden = in.Action == dto.ModerationActionHide
+	action := &dto.ModerationAction{
+		ModeratorID: in.UserID,
+		Action:      in.Action,
+		TargetType:  target.Type,
+		TargetID:    target.ID,
+		ReportID:    in.ReportID,
+		Note:        in.Note,
+	}
+
+	tx := transaction.New(ctx)
+
+	if err = s.doModerateTransaction(ctx, tx, target, action); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	s.purgeCache(ctx, target)
+	s.notifyAuthor(ctx, target, in.Note)
+	return action, nil
+}
+
+func (s *Service) doModerateTransaction(
+	ctx context.Context,
+	tx transaction.Transaction,
+	target *dto.ModerationTarget,
+	action *dto.ModerationAction,
+) error {
+	if err := s.moderationRepository.SetHidden(ctx, tx, target); err != nil {
+		return fmt.Errorf("set hidden in repository: %w", err)
+	}
+
+	if target.Hidden {
+		if err := s.moderationRepository.ResolveReports(ctx, tx, target.Type, target.ID); err != nil {
+			return fmt.Errorf("resolve reports in repository: %w", err)
+		}
+	}
+
+	if err := s.moderationRepository.SaveAction(ctx, tx, action); err != nil {
+		return fmt.Errorf("save action in repository: %w", err)
+	}
+
+	return nil
+}
+
+// purgeCache drops cached pages listing the article, or the article of the comment for its comment count.
+func (s *Service) purgeCache(ctx context.Context, target *dto.ModerationTarget) {
+	if err := s.articleCache.PurgeArticles(ctx, target.ArticleSlug); err != nil {
+		s.logger.Error().Err(err).Msg("purge articles in cache error")
+	}
+
+	if target.Type != dto.ModerationTargetArticle {
+		return
+	}
+
+	// hiding or restoring the article takes it out of sitemaps or brings it back
+	if err := s.sitemapRefresher.Refresh(ctx, target.ID, true); err != nil {
+		s.logger.Error().Err(err).Msg("refresh sitemap error")
+	}
+
+	if err := s.feedCache.Purge(ctx, []string{target.AuthorID}, target.ArticleTags); err != nil {
+		s.logger.Error().Err(err).Msg("purge feeds in cache error")
+	}
+
+	if err := s.articleCache.PurgeAuthors(ctx, target.AuthorID); err != nil {
+		s.logger.Error().Err(err).Msg("purge authors in cache error")
+	}
+
+	if err := s.articleCache.PurgeListings(ctx); err != nil {
+		s.logger.Error().Err(err).Msg("purge listings in cache error")
+	}
+}
+
+// notifyAuthor puts a notice to the mail outbox. The action is taken already, so failures are only logged.
+func (s *Service) notifyAuthor(ctx context.Context, target *dto.ModerationTarget, note string) {
+	author, err := s.userRepository.Find(ctx, target.AuthorID)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("find author of moderated content error")
+		return
+	}
+
+	data := mail.ModerationData{
+		TargetType: target.Type,
+		ContentURL: s.siteURL.JoinPath("articles", target.ArticleSlug).String(),
+		Hidden:     target.Hidden,
+		Note:       note,
+	}
+
+	if err = s.moderationMailer.MailTo(ctx, author.Email, data); err != nil {
+		s.logger.Error().Err(err).Msg("mail moderation notice error")
+	}
+}
+
+func (s *Service) GetActions(ctx context.Context, in *dto.GetModerationActionsIn) (*dto.GetModerationActionsOut, error) {
+	if err := s.checkModerator(ctx, in.UserID); err != nil {
+		return nil, err
+	}
+
+	out, err := s.moderationRepository.GetActions(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("get actions from repository: %w", err)
+	}
+
+	return out, nil
+}
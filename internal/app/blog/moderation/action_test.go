@@ -0,0 +1,242 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/mail"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestModerate(t *testing.T) {
+	reportID := "report id"
+	articleTarget := func() *dto.ModerationTarget {
+		return &dto.ModerationTarget{
+			Type:        dto.ModerationTargetArticle,
+			ID:          "article id",
+			AuthorID:    "author id",
+			ArticleSlug: "foo-article",
+			ArticleTags: []string{"foo"},
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		in     *dto.ModerateIn
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.ModerationAction, err error, logs []string)
+	}{
+		{
+			name: "not a moderator",
+			in:   &dto.ModerateIn{UserID: "moderator id", Action: dto.ModerationActionHide, TargetType: dto.ModerationTargetArticle, TargetID: "article id"},
+			setup: func(m serviceMocks) {
+				m.expectModerator(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.ModerationAction, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "target not found",
+			in:   &dto.ModerateIn{UserID: "moderator id", Action: dto.ModerationActionHide, TargetType: dto.ModerationTargetArticle, TargetID: "article id"},
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().FindTarget(gomock.Any(), gomock.Eq(dto.ModerationTargetArticle), gomock.Eq("article id")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.ModerationAction, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrModerationTargetNotFound)
+			},
+		},
+		{
+			name: "report of other content",
+			in:   &dto.ModerateIn{UserID: "moderator id", Action: dto.ModerationActionHide, TargetType: dto.ModerationTargetArticle, TargetID: "article id", ReportID: &reportID},
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().FindTarget(gomock.Any(), gomock.Any(), gomock.Any()).Return(articleTarget(), nil)
+				m.moderationRepository.EXPECT().
+					FindReport(gomock.Any(), gomock.Eq("report id")).
+					Return(&dto.Report{ID: "report id", TargetType: dto.ModerationTargetComment, TargetID: "article id"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.ModerationAction, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrReportNotFound)
+			},
+		},
+		{
+			name: "resolve reports error",
+			in:   &dto.ModerateIn{UserID: "moderator id", Action: dto.ModerationActionHide, TargetType: dto.ModerationTargetArticle, TargetID: "article id"},
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().FindTarget(gomock.Any(), gomock.Any(), gomock.Any()).Return(articleTarget(), nil)
+				m.moderationRepository.EXPECT().SetHidden(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.moderationRepository.EXPECT().ResolveReports(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ModerationAction, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "resolve reports in repository: foo error")
+			},
+		},
+		{
+			name: "ok hide article",
+			in: &dto.ModerateIn{
+				UserID:     "moderator id",
+				Action:     dto.ModerationActionHide,
+				TargetType: dto.ModerationTargetArticle,
+				TargetID:   "article id",
+				ReportID:   &reportID,
+				Note:       "spam",
+			},
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().FindTarget(gomock.Any(), gomock.Any(), gomock.Any()).Return(articleTarget(), nil)
+				m.moderationRepository.EXPECT().
+					FindReport(gomock.Any(), gomock.Eq("report id")).
+					Return(&dto.Report{ID: "report id", TargetType: dto.ModerationTargetArticle, TargetID: "article id"}, nil)
+
+				hidden := articleTarget()
+				hidden.Hidden = true
+				m.moderationRepository.EXPECT().SetHidden(gomock.Any(), gomock.Any(), gomock.Eq(hidden)).Return(nil)
+				m.moderationRepository.EXPECT().
+					ResolveReports(gomock.Any(), gomock.Any(), gomock.Eq(dto.ModerationTargetArticle), gomock.Eq("article id")).
+					Return(nil)
+				m.moderationRepository.EXPECT().
+					SaveAction(gomock.Any(), gomock.Any(), gomock.Eq(&dto.ModerationAction{
+						ModeratorID: "moderator id",
+						Action:      dto.ModerationActionHide,
+						TargetType:  dto.ModerationTargetArticle,
+						TargetID:    "article id",
+						ReportID:    &reportID,
+						Note:        "spam",
+					})).
+					Return(nil)
+
+				m.articleCache.EXPECT().PurgeArticles(gomock.Any(), gomock.Eq("foo-article")).Return(nil)
+				m.sitemapRefresher.EXPECT().Refresh(gomock.Any(), gomock.Eq("article id"), gomock.Eq(true)).Return(nil)
+				m.feedCache.EXPECT().Purge(gomock.Any(), gomock.Eq([]string{"author id"}), gomock.Eq([]string{"foo"})).Return(nil)
+				m.articleCache.EXPECT().PurgeAuthors(gomock.Any(), gomock.Eq("author id")).Return(nil)
+				m.articleCache.EXPECT().PurgeListings(gomock.Any()).Return(errors.New("foo error"))
+
+				m.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq("author id")).Return(&dto.User{Email: "bob@example.com"}, nil)
+				m.moderationMailer.EXPECT().
+					MailTo(gomock.Any(), gomock.Eq("bob@example.com"), gomock.Eq(mail.ModerationData{
+						TargetType: dto.ModerationTargetArticle,
+						ContentURL: "https://example.com/blog/articles/foo-article",
+						Hidden:     true,
+						Note:       "spam",
+					})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.ModerationAction, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.ModerationActionHide, out.Action)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error","error":"foo error","message":"purge listings in cache error"}`, logs[0])
+			},
+		},
+		{
+			name: "ok restore comment",
+			in:   &dto.ModerateIn{UserID: "moderator id", Action: dto.ModerationActionRestore, TargetType: dto.ModerationTargetComment, TargetID: "comment id"},
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().
+					FindTarget(gomock.Any(), gomock.Eq(dto.ModerationTargetComment), gomock.Eq("comment id")).
+					Return(&dto.ModerationTarget{
+						Type:        dto.ModerationTargetComment,
+						ID:          "comment id",
+						AuthorID:    "author id",
+						ArticleSlug: "foo-article",
+						Hidden:      true,
+					}, nil)
+				m.moderationRepository.EXPECT().
+					SetHidden(gomock.Any(), gomock.Any(), gomock.Cond(func(target *dto.ModerationTarget) bool { return !target.Hidden })).
+					Return(nil)
+				m.moderationRepository.EXPECT().SaveAction(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.articleCache.EXPECT().PurgeArticles(gomock.Any(), gomock.Eq("foo-article")).Return(nil)
+				m.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq("author id")).Return(&dto.User{Email: "bob@example.com"}, nil)
+				m.moderationMailer.EXPECT().MailTo(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ModerationAction, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.ModerationActionRestore, out.Action)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error","error":"foo error","message":"mail moderation notice error"}`, logs[0])
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			out, err := m.newService(logger).Moderate(context.Background(), tt.in)
+			tt.assert(t, out, err, logger.Logs())
+		})
+	}
+}
+
+func TestGetActions(t *testing.T) {
+	in := &dto.GetModerationActionsIn{UserID: "moderator id", TargetType: dto.ModerationTargetArticle, TargetID: "article id"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.GetModerationActionsOut, err error)
+	}{
+		{
+			name: "not a moderator",
+			setup: func(m serviceMocks) {
+				m.expectModerator(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetModerationActionsOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "get actions error",
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().GetActions(gomock.Any(), gomock.Eq(in)).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetModerationActionsOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get actions from repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().
+					GetActions(gomock.Any(), gomock.Eq(in)).
+					Return(&dto.GetModerationActionsOut{Actions: []*dto.ModerationAction{{ID: "action id"}}}, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetModerationActionsOut, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetModerationActionsOut{Actions: []*dto.ModerationAction{{ID: "action id"}}}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).GetActions(context.Background(), in)
+			tt.assert(t, out, err)
+		})
+	}
+}
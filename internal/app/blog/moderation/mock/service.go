@@ -0,0 +1,443 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	mail "github.com/art-es/yet-another-service/internal/core/mail"
+	transaction "github.com/art-es/yet-another-service/internal/core/transaction"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockmoderationRepository is a mock of moderationRepository interface.
+type MockmoderationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmoderationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockmoderationRepositoryMockRecorder is the mock recorder for MockmoderationRepository.
+type MockmoderationRepositoryMockRecorder struct {
+	mock *MockmoderationRepository
+}
+
+// NewMockmoderationRepository creates a new mock instance.
+func NewMockmoderationRepository(ctrl *gomock.Controller) *MockmoderationRepository {
+	mock := &MockmoderationRepository{ctrl: ctrl}
+	mock.recorder = &MockmoderationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmoderationRepository) EXPECT() *MockmoderationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindReport mocks base method.
+func (m *MockmoderationRepository) FindReport(ctx context.Context, id string) (*dto.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReport", ctx, id)
+	ret0, _ := ret[0].(*dto.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReport indicates an expected call of FindReport.
+func (mr *MockmoderationRepositoryMockRecorder) FindReport(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReport", reflect.TypeOf((*MockmoderationRepository)(nil).FindReport), ctx, id)
+}
+
+// FindTarget mocks base method.
+func (m *MockmoderationRepository) FindTarget(ctx context.Context, targetType, id string) (*dto.ModerationTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTarget", ctx, targetType, id)
+	ret0, _ := ret[0].(*dto.ModerationTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTarget indicates an expected call of FindTarget.
+func (mr *MockmoderationRepositoryMockRecorder) FindTarget(ctx, targetType, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTarget", reflect.TypeOf((*MockmoderationRepository)(nil).FindTarget), ctx, targetType, id)
+}
+
+// GetActions mocks base method.
+func (m *MockmoderationRepository) GetActions(ctx context.Context, in *dto.GetModerationActionsIn) (*dto.GetModerationActionsOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActions", ctx, in)
+	ret0, _ := ret[0].(*dto.GetModerationActionsOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActions indicates an expected call of GetActions.
+func (mr *MockmoderationRepositoryMockRecorder) GetActions(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActions", reflect.TypeOf((*MockmoderationRepository)(nil).GetActions), ctx, in)
+}
+
+// GetReports mocks base method.
+func (m *MockmoderationRepository) GetReports(ctx context.Context, in *dto.GetReportsIn) (*dto.GetReportsOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReports", ctx, in)
+	ret0, _ := ret[0].(*dto.GetReportsOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockmoderationRepositoryMockRecorder) GetReports(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockmoderationRepository)(nil).GetReports), ctx, in)
+}
+
+// ResolveReports mocks base method.
+func (m *MockmoderationRepository) ResolveReports(ctx context.Context, tx transaction.Transaction, targetType, targetID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReports", ctx, tx, targetType, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveReports indicates an expected call of ResolveReports.
+func (mr *MockmoderationRepositoryMockRecorder) ResolveReports(ctx, tx, targetType, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReports", reflect.TypeOf((*MockmoderationRepository)(nil).ResolveReports), ctx, tx, targetType, targetID)
+}
+
+// SaveAction mocks base method.
+func (m *MockmoderationRepository) SaveAction(ctx context.Context, tx transaction.Transaction, action *dto.ModerationAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAction", ctx, tx, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAction indicates an expected call of SaveAction.
+func (mr *MockmoderationRepositoryMockRecorder) SaveAction(ctx, tx, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAction", reflect.TypeOf((*MockmoderationRepository)(nil).SaveAction), ctx, tx, action)
+}
+
+// SaveReport mocks base method.
+func (m *MockmoderationRepository) SaveReport(ctx context.Context, report *dto.Report) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReport", ctx, report)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveReport indicates an expected call of SaveReport.
+func (mr *MockmoderationRepositoryMockRecorder) SaveReport(ctx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReport", reflect.TypeOf((*MockmoderationRepository)(nil).SaveReport), ctx, report)
+}
+
+// SetHidden mocks base method.
+func (m *MockmoderationRepository) SetHidden(ctx context.Context, tx transaction.Transaction, target *dto.ModerationTarget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHidden", ctx, tx, target)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHidden indicates an expected call of SetHidden.
+func (mr *MockmoderationRepositoryMockRecorder) SetHidden(ctx, tx, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHidden", reflect.TypeOf((*MockmoderationRepository)(nil).SetHidden), ctx, tx, target)
+}
+
+// UpdateReportState mocks base method.
+func (m *MockmoderationRepository) UpdateReportState(ctx context.Context, tx transaction.Transaction, report *dto.Report) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReportState", ctx, tx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReportState indicates an expected call of UpdateReportState.
+func (mr *MockmoderationRepositoryMockRecorder) UpdateReportState(ctx, tx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReportState", reflect.TypeOf((*MockmoderationRepository)(nil).UpdateReportState), ctx, tx, report)
+}
+
+// MockarticleRepository is a mock of articleRepository interface.
+type MockarticleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleRepositoryMockRecorder is the mock recorder for MockarticleRepository.
+type MockarticleRepositoryMockRecorder struct {
+	mock *MockarticleRepository
+}
+
+// NewMockarticleRepository creates a new mock instance.
+func NewMockarticleRepository(ctrl *gomock.Controller) *MockarticleRepository {
+	mock := &MockarticleRepository{ctrl: ctrl}
+	mock.recorder = &MockarticleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleRepository) EXPECT() *MockarticleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockarticleRepository) Find(ctx context.Context, slug string) (*dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, slug)
+	ret0, _ := ret[0].(*dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockarticleRepositoryMockRecorder) Find(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockarticleRepository)(nil).Find), ctx, slug)
+}
+
+// MockuserRepository is a mock of userRepository interface.
+type MockuserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepositoryMockRecorder is the mock recorder for MockuserRepository.
+type MockuserRepositoryMockRecorder struct {
+	mock *MockuserRepository
+}
+
+// NewMockuserRepository creates a new mock instance.
+func NewMockuserRepository(ctrl *gomock.Controller) *MockuserRepository {
+	mock := &MockuserRepository{ctrl: ctrl}
+	mock.recorder = &MockuserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepository) EXPECT() *MockuserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockuserRepository) Find(ctx context.Context, id string) (*dto.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(*dto.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockuserRepositoryMockRecorder) Find(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockuserRepository)(nil).Find), ctx, id)
+}
+
+// MockarticleCache is a mock of articleCache interface.
+type MockarticleCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleCacheMockRecorder is the mock recorder for MockarticleCache.
+type MockarticleCacheMockRecorder struct {
+	mock *MockarticleCache
+}
+
+// NewMockarticleCache creates a new mock instance.
+func NewMockarticleCache(ctrl *gomock.Controller) *MockarticleCache {
+	mock := &MockarticleCache{ctrl: ctrl}
+	mock.recorder = &MockarticleCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleCache) EXPECT() *MockarticleCacheMockRecorder {
+	return m.recorder
+}
+
+// PurgeArticles mocks base method.
+func (m *MockarticleCache) PurgeArticles(ctx context.Context, slugs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range slugs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeArticles", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeArticles indicates an expected call of PurgeArticles.
+func (mr *MockarticleCacheMockRecorder) PurgeArticles(ctx any, slugs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, slugs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeArticles", reflect.TypeOf((*MockarticleCache)(nil).PurgeArticles), varargs...)
+}
+
+// PurgeAuthors mocks base method.
+func (m *MockarticleCache) PurgeAuthors(ctx context.Context, authorIDs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range authorIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeAuthors", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeAuthors indicates an expected call of PurgeAuthors.
+func (mr *MockarticleCacheMockRecorder) PurgeAuthors(ctx any, authorIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, authorIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAuthors", reflect.TypeOf((*MockarticleCache)(nil).PurgeAuthors), varargs...)
+}
+
+// PurgeListings mocks base method.
+func (m *MockarticleCache) PurgeListings(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeListings", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeListings indicates an expected call of PurgeListings.
+func (mr *MockarticleCacheMockRecorder) PurgeListings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeListings", reflect.TypeOf((*MockarticleCache)(nil).PurgeListings), ctx)
+}
+
+// MockfeedCache is a mock of feedCache interface.
+type MockfeedCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockfeedCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockfeedCacheMockRecorder is the mock recorder for MockfeedCache.
+type MockfeedCacheMockRecorder struct {
+	mock *MockfeedCache
+}
+
+// NewMockfeedCache creates a new mock instance.
+func NewMockfeedCache(ctrl *gomock.Controller) *MockfeedCache {
+	mock := &MockfeedCache{ctrl: ctrl}
+	mock.recorder = &MockfeedCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfeedCache) EXPECT() *MockfeedCacheMockRecorder {
+	return m.recorder
+}
+
+// Purge mocks base method.
+func (m *MockfeedCache) Purge(ctx context.Context, authorIDs, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, authorIDs, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockfeedCacheMockRecorder) Purge(ctx, authorIDs, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockfeedCache)(nil).Purge), ctx, authorIDs, tags)
+}
+
+// MocksitemapRefresher is a mock of sitemapRefresher interface.
+type MocksitemapRefresher struct {
+	ctrl     *gomock.Controller
+	recorder *MocksitemapRefresherMockRecorder
+	isgomock struct{}
+}
+
+// MocksitemapRefresherMockRecorder is the mock recorder for MocksitemapRefresher.
+type MocksitemapRefresherMockRecorder struct {
+	mock *MocksitemapRefresher
+}
+
+// NewMocksitemapRefresher creates a new mock instance.
+func NewMocksitemapRefresher(ctrl *gomock.Controller) *MocksitemapRefresher {
+	mock := &MocksitemapRefresher{ctrl: ctrl}
+	mock.recorder = &MocksitemapRefresherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksitemapRefresher) EXPECT() *MocksitemapRefresherMockRecorder {
+	return m.recorder
+}
+
+// Refresh mocks base method.
+func (m *MocksitemapRefresher) Refresh(ctx context.Context, articleID string, shifted bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, articleID, shifted)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MocksitemapRefresherMockRecorder) Refresh(ctx, articleID, shifted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MocksitemapRefresher)(nil).Refresh), ctx, articleID, shifted)
+}
+
+// MockmoderationMailer is a mock of moderationMailer interface.
+type MockmoderationMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockmoderationMailerMockRecorder
+	isgomock struct{}
+}
+
+// MockmoderationMailerMockRecorder is the mock recorder for MockmoderationMailer.
+type MockmoderationMailerMockRecorder struct {
+	mock *MockmoderationMailer
+}
+
+// NewMockmoderationMailer creates a new mock instance.
+func NewMockmoderationMailer(ctrl *gomock.Controller) *MockmoderationMailer {
+	mock := &MockmoderationMailer{ctrl: ctrl}
+	mock.recorder = &MockmoderationMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmoderationMailer) EXPECT() *MockmoderationMailerMockRecorder {
+	return m.recorder
+}
+
+// MailTo mocks base method.
+func (m *MockmoderationMailer) MailTo(ctx context.Context, address string, data mail.ModerationData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MailTo", ctx, address, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MailTo indicates an expected call of MailTo.
+func (mr *MockmoderationMailerMockRecorder) MailTo(ctx, address, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MailTo", reflect.TypeOf((*MockmoderationMailer)(nil).MailTo), ctx, address, data)
+}
@@ -0,0 +1,150 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+// reportTransitions lists states a report can be moved to from its current state.
+// Resolved reports can only be reopened.
+var reportTransitions = map[string][]string{
+	dto.ReportStateOpen:      {dto.ReportStateActioned, dto.ReportStateDismissed},
+	dto.ReportStateActioned:  {dto.ReportStateOpen},
+	dto.ReportStateDismissed: {dto.ReportStateOpen},
+}
+
+// reportStateActions are recorded in the audit trail when a report is moved to the state.
+var reportStateActions = map[string]string{
+	dto.ReportStateOpen:      dto.ModerationActionReopen,
+	dto.ReportStateActioned:  dto.ModerationActionResolve,
+	dto.ReportStateDismissed: dto.ModerationActionDismiss,
+}
+
+func (s *Service) Report(ctx context.Context, in *dto.CreateReportIn) (*dto.Report, error) {
+	targetID, err := s.findReportTarget(ctx, in.TargetType, in.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dto.Report{
+		TargetType: in.TargetType,
+		TargetID:   targetID,
+		ReporterID: in.UserID,
+		Reason:     in.Reason,
+	}
+
+	created, err := s.moderationRepository.SaveReport(ctx, report)
+	if err != nil {
+		return nil, fmt.Errorf("save report in repository: %w", err)
+	}
+
+	if !created {
+		return nil, errors.ErrReportExists
+	}
+
+	return report, nil
+}
+
+// findReportTarget returns the ID of reported content. Articles are reported by slug, comments by ID.
+func (s *Service) findReportTarget(ctx context.Context, targetType, target string) (string, error) {
+	if targetType == dto.ModerationTargetArticle {
+		article, err := s.articleRepository.Find(ctx, target)
+		if err != nil {
+			return "", fmt.Errorf("find article in repository: %w", err)
+		}
+
+		if article == nil {
+			return "", errors.ErrModerationTargetNotFound
+		}
+
+		return article.ID, nil
+	}
+
+	comment, err := s.moderationRepository.FindTarget(ctx, targetType, target)
+	if err != nil {
+		return "", fmt.Errorf("find target in repository: %w", err)
+	}
+
+	if comment == nil {
+		return "", errors.ErrModerationTargetNotFound
+	}
+
+	return comment.ID, nil
+}
+
+func (s *Service) GetReports(ctx context.Context, in *dto.GetReportsIn) (*dto.GetReportsOut, error) {
+	if err := s.checkModerator(ctx, in.UserID); err != nil {
+		return nil, err
+	}
+
+	out, err := s.moderationRepository.GetReports(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("get reports from repository: %w", err)
+	}
+
+	return out, nil
+}
+
+func (s *Service) SetReportState(ctx context.Context, in *dto.SetReportStateIn) (*dto.Report, error) {
+	if err := s.checkModerator(ctx, in.UserID); err != nil {
+		return nil, err
+	}
+
+	report, err := s.moderationRepository.FindReport(ctx, in.ReportID)
+	if err != nil {
+		return nil, fmt.Errorf("find report in repository: %w", err)
+	}
+
+	if report == nil {
+		return nil, errors.ErrReportNotFound
+	}
+
+	if !slices.Contains(reportTransitions[report.State], in.State) {
+		return nil, errors.ErrReportStateTransition
+	}
+
+	report.State = in.State
+	action := &dto.ModerationAction{
+		ModeratorID: in.UserID,
+		Action:      reportStateActions[in.State],
+		TargetType:  report.TargetType,
+		TargetID:    report.TargetID,
+		ReportID:    &report.ID,
+		Note:        in.Note,
+	}
+
+	tx := transaction.New(ctx)
+
+	if err = s.doSetReportStateTransaction(ctx, tx, report, action); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return report, nil
+}
+
+func (s *Service) doSetReportStateTransaction(
+	ctx context.Context,
+	tx transaction.Transaction,
+	report *dto.Report,
+	action *dto.ModerationAction,
+) error {
+	if err := s.moderationRepository.UpdateReportState(ctx, tx, report); err != nil {
+		return fmt.Errorf("update report state in repository: %w", err)
+	}
+
+	if err := s.moderationRepository.SaveAction(ctx, tx, action); err != nil {
+		return fmt.Errorf("save action in repository: %w", err)
+	}
+
+	return nil
+}
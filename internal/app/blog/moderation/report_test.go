@@ -0,0 +1,346 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/moderation/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	moderationRepository *mock.MockmoderationRepository
+	articleRepository    *mock.MockarticleRepository
+	userRepository       *mock.MockuserRepository
+	articleCache         *mock.MockarticleCache
+	feedCache            *mock.MockfeedCache
+	sitemapRefresher     *mock.MocksitemapRefresher
+	moderationMailer     *mock.MockmoderationMailer
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		moderationRepository: mock.NewMockmoderationRepository(ctrl),
+		articleRepository:    mock.NewMockarticleRepository(ctrl),
+		userRepository:       mock.NewMockuserRepository(ctrl),
+		articleCache:         mock.NewMockarticleCache(ctrl),
+		feedCache:            mock.NewMockfeedCache(ctrl),
+		sitemapRefresher:     mock.NewMocksitemapRefresher(ctrl),
+		moderationMailer:     mock.NewMockmoderationMailer(ctrl),
+	}
+}
+
+func (m serviceMocks) newService(logger log.Logger) *Service {
+	siteURL, _ := url.Parse("https://example.com/blog")
+	return NewService(*siteURL, m.moderationRepository, m.articleRepository, m.userRepository, m.articleCache, m.feedCache, m.sitemapRefresher, m.moderationMailer, logger)
+}
+
+func (m serviceMocks) expectModerator(roles []string, err error) {
+	if err != nil {
+		m.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq("moderator id")).Return(nil, err)
+		return
+	}
+
+	m.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq("moderator id")).Return(&dto.User{ID: "moderator id", Roles: roles}, nil)
+}
+
+func TestReport(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		in     *dto.CreateReportIn
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Report, err error)
+	}{
+		{
+			name: "find article error",
+			in:   &dto.CreateReportIn{UserID: "user id", TargetType: dto.ModerationTargetArticle, Target: "foo-article", Reason: "spam"},
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo-article")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Report, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find article in repository: foo error")
+			},
+		},
+		{
+			name: "article not found",
+			in:   &dto.CreateReportIn{UserID: "user id", TargetType: dto.ModerationTargetArticle, Target: "foo-article", Reason: "spam"},
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo-article")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.Report, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrModerationTargetNotFound)
+			},
+		},
+		{
+			name: "comment not found",
+			in:   &dto.CreateReportIn{UserID: "user id", TargetType: dto.ModerationTargetComment, Target: "comment id", Reason: "spam"},
+			setup: func(m serviceMocks) {
+				m.moderationRepository.EXPECT().FindTarget(gomock.Any(), gomock.Eq(dto.ModerationTargetComment), gomock.Eq("comment id")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.Report, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrModerationTargetNotFound)
+			},
+		},
+		{
+			name: "save report error",
+			in:   &dto.CreateReportIn{UserID: "user id", TargetType: dto.ModerationTargetArticle, Target: "foo-article", Reason: "spam"},
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo-article")).Return(&dto.Article{ID: "article id"}, nil)
+				m.moderationRepository.EXPECT().SaveReport(gomock.Any(), gomock.Any()).Return(false, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Report, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "save report in repository: foo error")
+			},
+		},
+		{
+			name: "already reported",
+			in:   &dto.CreateReportIn{UserID: "user id", TargetType: dto.ModerationTargetArticle, Target: "foo-article", Reason: "spam"},
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo-article")).Return(&dto.Article{ID: "article id"}, nil)
+				m.moderationRepository.EXPECT().SaveReport(gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			assert: func(t *testing.T, out *dto.Report, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrReportExists)
+			},
+		},
+		{
+			name: "ok comment",
+			in:   &dto.CreateReportIn{UserID: "user id", TargetType: dto.ModerationTargetComment, Target: "comment id", Reason: "spam"},
+			setup: func(m serviceMocks) {
+				m.moderationRepository.EXPECT().
+					FindTarget(gomock.Any(), gomock.Eq(dto.ModerationTargetComment), gomock.Eq("comment id")).
+					Return(&dto.ModerationTarget{Type: dto.ModerationTargetComment, ID: "comment id"}, nil)
+				m.moderationRepository.EXPECT().
+					SaveReport(gomock.Any(), gomock.Eq(&dto.Report{
+						TargetType: dto.ModerationTargetComment,
+						TargetID:   "comment id",
+						ReporterID: "user id",
+						Reason:     "spam",
+					})).
+					DoAndReturn(func(_ context.Context, report *dto.Report) (bool, error) {
+						report.ID = "report id"
+						report.State = dto.ReportStateOpen
+						return true, nil
+					})
+			},
+			assert: func(t *testing.T, out *dto.Report, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.Report{
+					ID:         "report id",
+					TargetType: dto.ModerationTargetComment,
+					TargetID:   "comment id",
+					ReporterID: "user id",
+					Reason:     "spam",
+					State:      dto.ReportStateOpen,
+				}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Report(context.Background(), tt.in)
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestGetReports(t *testing.T) {
+	in := &dto.GetReportsIn{UserID: "moderator id", State: dto.ReportStateOpen}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.GetReportsOut, err error)
+	}{
+		{
+			name: "find user error",
+			setup: func(m serviceMocks) {
+				m.expectModerator(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetReportsOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find user in repository: foo error")
+			},
+		},
+		{
+			name: "not a moderator",
+			setup: func(m serviceMocks) {
+				m.expectModerator(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetReportsOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "get reports error",
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().GetReports(gomock.Any(), gomock.Eq(in)).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetReportsOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get reports from repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().
+					GetReports(gomock.Any(), gomock.Eq(in)).
+					Return(&dto.GetReportsOut{Reports: []*dto.Report{{ID: "report id"}}, HasMore: true}, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetReportsOut, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetReportsOut{Reports: []*dto.Report{{ID: "report id"}}, HasMore: true}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).GetReports(context.Background(), in)
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestSetReportState(t *testing.T) {
+	openReport := func() *dto.Report {
+		return &dto.Report{
+			ID:         "report id",
+			TargetType: dto.ModerationTargetArticle,
+			TargetID:   "article id",
+			State:      dto.ReportStateOpen,
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		state  string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Report, err error)
+	}{
+		{
+			name:  "not a moderator",
+			state: dto.ReportStateDismissed,
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{"author"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.Report, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name:  "report not found",
+			state: dto.ReportStateDismissed,
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().FindReport(gomock.Any(), gomock.Eq("report id")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.Report, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrReportNotFound)
+			},
+		},
+		{
+			name:  "invalid transition",
+			state: dto.ReportStateOpen,
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().FindReport(gomock.Any(), gomock.Eq("report id")).Return(openReport(), nil)
+			},
+			assert: func(t *testing.T, out *dto.Report, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrReportStateTransition)
+			},
+		},
+		{
+			name:  "save action error",
+			state: dto.ReportStateDismissed,
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().FindReport(gomock.Any(), gomock.Eq("report id")).Return(openReport(), nil)
+				m.moderationRepository.EXPECT().UpdateReportState(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.moderationRepository.EXPECT().SaveAction(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Report, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "save action in repository: foo error")
+			},
+		},
+		{
+			name:  "ok",
+			state: dto.ReportStateDismissed,
+			setup: func(m serviceMocks) {
+				m.expectModerator([]string{dto.UserRoleModerator}, nil)
+				m.moderationRepository.EXPECT().FindReport(gomock.Any(), gomock.Eq("report id")).Return(openReport(), nil)
+
+				dismissed := openReport()
+				dismissed.State = dto.ReportStateDismissed
+				m.moderationRepository.EXPECT().UpdateReportState(gomock.Any(), gomock.Any(), gomock.Eq(dismissed)).Return(nil)
+
+				reportID := "report id"
+				m.moderationRepository.EXPECT().
+					SaveAction(gomock.Any(), gomock.Any(), gomock.Eq(&dto.ModerationAction{
+						ModeratorID: "moderator id",
+						Action:      dto.ModerationActionDismiss,
+						TargetType:  dto.ModerationTargetArticle,
+						TargetID:    "article id",
+						ReportID:    &reportID,
+						Note:        "not spam",
+					})).
+					Do(func(_ context.Context, _ transaction.Transaction, action *dto.ModerationAction) {
+						action.ID = "action id"
+					}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.Report, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "report id", out.ID)
+				assert.Equal(t, dto.ReportStateDismissed, out.State)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).SetReportState(context.Background(), &dto.SetReportStateIn{
+				UserID:   "moderator id",
+				ReportID: "report id",
+				State:    tt.state,
+				Note:     "not spam",
+			})
+			tt.assert(t, out, err)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/mail"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+type moderationRepository interface {
+	SaveReport(ctx context.Context, report *dto.Report) (bool, error)
+	FindReport(ctx context.Context, id string) (*dto.Report, error)
+	GetReports(ctx context.Context, in *dto.GetReportsIn) (*dto.GetReportsOut, error)
+	UpdateReportState(ctx context.Context, tx transaction.Transaction, report *dto.Report) error
+	ResolveReports(ctx context.Context, tx transaction.Transaction, targetType, targetID string) error
+	FindTarget(ctx context.Context, targetType, id string) (*dto.ModerationTarget, error)
+	SetHidden(ctx context.Context, tx transaction.Transaction, target *dto.ModerationTarget) error
+	SaveAction(ctx context.Context, tx transaction.Transaction, action *dto.ModerationAction) error
+	GetActions(ctx context.Context, in *dto.GetModerationActionsIn) (*dto.GetModerationActionsOut, error)
+}
+
+type articleRepository interface {
+	Find(ctx context.Context, slug string) (*dto.Article, error)
+}
+
+type userRepository interface {
+	Find(ctx context.Context, id string) (*dto.User, error)
+}
+
+// articleCache is purged after articles are hidden or restored, so readers don't see stale pages.
+type articleCache interface {
+	PurgeArticles(ctx context.Context, slugs ...string) error
+	PurgeAuthors(ctx context.Context, authorIDs ...string) error
+	PurgeListings(ctx context.Context) error
+}
+
+type feedCache interface {
+	Purge(ctx context.Context, authorIDs, tags []string) error
+}
+
+type sitemapRefresher interface {
+	Refresh(ctx context.Context, articleID string, shifted bool) error
+}
+
+// moderationMailer notifies authors about moderation of their content.
+type moderationMailer interface {
+	MailTo(ctx context.Context, address string, data mail.ModerationData) error
+}
+
+type Service struct {
+	siteURL              url.URL
+	moderationRepository moderationRepository
+	articleRepository    articleRepository
+	userRepository       userRepository
+	articleCache         articleCache
+	feedCache            feedCache
+	sitemapRefresher     sitemapRefresher
+	moderationMailer     moderationMailer
+	logger               log.Logger
+}
+
+func NewService(
+	siteURL url.URL,
+	moderationRepository moderationRepository,
+	articleRepository articleRepository,
+	userRepository userRepository,
+	articleCache articleCache,
+	feedCache feedCache,
+	sitemapRefresher sitemapRefresher,
+	moderationMailer moderationMailer,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		siteURL:              siteURL,
+		moderationRepository: moderationRepository,
+		articleRepository:    articleRepository,
+		userRepository:       userRepository,
+		articleCache:         articleCache,
+		feedCache:            feedCache,
+		sitemapRefresher:     sitemapRefresher,
+		moderationMailer:     moderationMailer,
+		logger:               logger,
+	}
+}
+
+func (s *Service) checkModerator(ctx context.Context, userID string) error {
+	user, err := s.userRepository.Find(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("find user in repository: %w", err)
+	}
+
+	if !slices.Contains(user.Roles, dto.UserRoleModerator) {
+		return errors.ErrForbidden
+	}
+
+	return nil
+}
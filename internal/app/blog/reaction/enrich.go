@@ -0,0 +1,62 @@
+package reaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+// Enrich fills reaction counts of the articles and, for a non-empty userID, the user's own reactions.
+// Counts are the sum of flushed counters and pending deltas.
+func (s *Service) Enrich(ctx context.Context, articles []dto.Article, userID string) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	articleIDs := make([]string, 0, len(articles))
+	for _, article := range articles {
+		articleIDs = append(articleIDs, article.ID)
+	}
+
+	counts, err := s.reactionRepository.GetCounts(ctx, articleIDs)
+	if err != nil {
+		return fmt.Errorf("get counts from repository: %w", err)
+	}
+
+	deltas, err := s.counterRepository.Get(ctx, articleIDs)
+	if err != nil {
+		return fmt.Errorf("get count deltas from repository: %w", err)
+	}
+
+	var own map[string][]string
+	if userID != "" {
+		own, err = s.reactionRepository.GetUserReactions(ctx, articleIDs, userID)
+		if err != nil {
+			return fmt.Errorf("get user reactions from repository: %w", err)
+		}
+	}
+
+	for i := range articles {
+		articles[i].ReactionCounts = mergeCounts(counts[articles[i].ID], deltas[articles[i].ID])
+		articles[i].OwnReactions = own[articles[i].ID]
+	}
+
+	return nil
+}
+
+func mergeCounts(counts, deltas map[string]int64) map[string]int64 {
+	out := make(map[string]int64, len(counts))
+	for kind, count := range counts {
+		out[kind] += count
+	}
+	for kind, delta := range deltas {
+		out[kind] += delta
+	}
+	for kind, count := range out {
+		if count <= 0 {
+			delete(out, kind)
+		}
+	}
+	return out
+}
@@ -0,0 +1,34 @@
+package reaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestEnrichAnonymous(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newServiceMocks(ctrl)
+	m.reactionRepository.EXPECT().
+		GetCounts(gomock.Any(), gomock.Eq([]string{"1", "2"})).
+		Return(dto.ReactionCounts{"1": {"like": 1, "love": 1}}, nil)
+	m.counterRepository.EXPECT().
+		Get(gomock.Any(), gomock.Eq([]string{"1", "2"})).
+		Return(dto.ReactionCounts{"1": {"love": -1}, "2": {"curious": 2}}, nil)
+
+	articles := []dto.Article{{ID: "1"}, {ID: "2"}}
+	err := m.newService(testutil.NewLogger()).Enrich(context.Background(), articles, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.Article{
+		{ID: "1", ReactionCounts: map[string]int64{"like": 1}},
+		{ID: "2", ReactionCounts: map[string]int64{"curious": 2}},
+	}, articles)
+}
@@ -0,0 +1,66 @@
+package reaction
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+// RunFlusher periodically moves pending count deltas to the reaction repository until ctx is done.
+func (s *Service) RunFlusher(ctx context.Context) {
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Flush(ctx); err != nil {
+				s.logger.Error().Err(err).Msg("flush reaction counters error")
+			}
+		}
+	}
+}
+
+// Flush recounts counters of articles with pending deltas from their stored reactions.
+// Counters aren't moved by the deltas, so a delta which was lost or popped twice is corrected
+// the next time the article is flushed.
+func (s *Service) Flush(ctx context.Context) error {
+	deltas, popErr := s.counterRepository.Pop(ctx)
+	if len(deltas) > 0 {
+		articleIDs := make([]string, 0, len(deltas))
+		for articleID := range deltas {
+			articleIDs = append(articleIDs, articleID)
+		}
+		slices.Sort(articleIDs)
+
+		if err := s.reactionRepository.Recount(ctx, articleIDs); err != nil {
+			s.restore(ctx, deltas)
+			return fmt.Errorf("recount counters in repository: %w", err)
+		}
+	}
+
+	if popErr != nil {
+		return fmt.Errorf("pop count deltas from repository: %w", popErr)
+	}
+
+	return nil
+}
+
+// restore puts deltas back so they are flushed next time.
+func (s *Service) restore(ctx context.Context, deltas dto.ReactionCounts) {
+	for articleID, kinds := range deltas {
+		for kind, delta := range kinds {
+			if err := s.counterRepository.Incr(ctx, articleID, kind, delta); err != nil {
+				s.logger.Error().Err(err).
+					Str("article_id", articleID).
+					Str("kind", kind).
+					Msg("restore reaction count delta error")
+			}
+		}
+	}
+}
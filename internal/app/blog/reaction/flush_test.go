@@ -0,0 +1,91 @@
+package reaction
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestFlush(t *testing.T) {
+	deltas := dto.ReactionCounts{"article id": {"like": 2}}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error, logs []string)
+	}{
+		{
+			name: "nothing to flush",
+			setup: func(m serviceMocks) {
+				m.counterRepository.EXPECT().Pop(gomock.Any()).Return(dto.ReactionCounts{}, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "recount error",
+			setup: func(m serviceMocks) {
+				m.counterRepository.EXPECT().Pop(gomock.Any()).Return(deltas, nil)
+				m.reactionRepository.EXPECT().
+					Recount(gomock.Any(), gomock.Eq([]string{"article id"})).
+					Return(errors.New("foo error"))
+				m.counterRepository.EXPECT().
+					Incr(gomock.Any(), gomock.Eq("article id"), gomock.Eq("like"), gomock.Eq(int64(2))).
+					Return(errors.New("bar error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "recount counters in repository: foo error")
+				assert.Equal(t, []string{
+					`{"level":"error","error":"bar error","article_id":"article id","kind":"like","message":"restore reaction count delta error"}`,
+				}, logs)
+			},
+		},
+		{
+			name: "partial pop error",
+			setup: func(m serviceMocks) {
+				m.counterRepository.EXPECT().Pop(gomock.Any()).Return(deltas, errors.New("foo error"))
+				m.reactionRepository.EXPECT().
+					Recount(gomock.Any(), gomock.Eq([]string{"article id"})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "pop count deltas from repository: foo error")
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.counterRepository.EXPECT().Pop(gomock.Any()).Return(deltas, nil)
+				m.reactionRepository.EXPECT().
+					Recount(gomock.Any(), gomock.Eq([]string{"article id"})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			err := m.newService(logger).Flush(context.Background())
+
+			tt.assert(t, err, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,223 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockarticleRepository is a mock of articleRepository interface.
+type MockarticleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleRepositoryMockRecorder is the mock recorder for MockarticleRepository.
+type MockarticleRepositoryMockRecorder struct {
+	mock *MockarticleRepository
+}
+
+// NewMockarticleRepository creates a new mock instance.
+func NewMockarticleRepository(ctrl *gomock.Controller) *MockarticleRepository {
+	mock := &MockarticleRepository{ctrl: ctrl}
+	mock.recorder = &MockarticleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleRepository) EXPECT() *MockarticleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockarticleRepository) Find(ctx context.Context, slug string) (*dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, slug)
+	ret0, _ := ret[0].(*dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockarticleRepositoryMockRecorder) Find(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockarticleRepository)(nil).Find), ctx, slug)
+}
+
+// MockreactionRepository is a mock of reactionRepository interface.
+type MockreactionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockreactionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockreactionRepositoryMockRecorder is the mock recorder for MockreactionRepository.
+type MockreactionRepositoryMockRecorder struct {
+	mock *MockreactionRepository
+}
+
+// NewMockreactionRepository creates a new mock instance.
+func NewMockreactionRepository(ctrl *gomock.Controller) *MockreactionRepository {
+	mock := &MockreactionRepository{ctrl: ctrl}
+	mock.recorder = &MockreactionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreactionRepository) EXPECT() *MockreactionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockreactionRepository) Add(ctx context.Context, articleID, userID, kind string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, articleID, userID, kind)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockreactionRepositoryMockRecorder) Add(ctx, articleID, userID, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockreactionRepository)(nil).Add), ctx, articleID, userID, kind)
+}
+
+// Delete mocks base method.
+func (m *MockreactionRepository) Delete(ctx context.Context, articleID, userID, kind string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, articleID, userID, kind)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockreactionRepositoryMockRecorder) Delete(ctx, articleID, userID, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockreactionRepository)(nil).Delete), ctx, articleID, userID, kind)
+}
+
+// GetCounts mocks base method.
+func (m *MockreactionRepository) GetCounts(ctx context.Context, articleIDs []string) (dto.ReactionCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCounts", ctx, articleIDs)
+	ret0, _ := ret[0].(dto.ReactionCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCounts indicates an expected call of GetCounts.
+func (mr *MockreactionRepositoryMockRecorder) GetCounts(ctx, articleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounts", reflect.TypeOf((*MockreactionRepository)(nil).GetCounts), ctx, articleIDs)
+}
+
+// GetUserReactions mocks base method.
+func (m *MockreactionRepository) GetUserReactions(ctx context.Context, articleIDs []string, userID string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserReactions", ctx, articleIDs, userID)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserReactions indicates an expected call of GetUserReactions.
+func (mr *MockreactionRepositoryMockRecorder) GetUserReactions(ctx, articleIDs, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReactions", reflect.TypeOf((*MockreactionRepository)(nil).GetUserReactions), ctx, articleIDs, userID)
+}
+
+// Recount mocks base method.
+func (m *MockreactionRepository) Recount(ctx context.Context, articleIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recount", ctx, articleIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Recount indicates an expected call of Recount.
+func (mr *MockreactionRepositoryMockRecorder) Recount(ctx, articleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recount", reflect.TypeOf((*MockreactionRepository)(nil).Recount), ctx, articleIDs)
+}
+
+// MockcounterRepository is a mock of counterRepository interface.
+type MockcounterRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockcounterRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockcounterRepositoryMockRecorder is the mock recorder for MockcounterRepository.
+type MockcounterRepositoryMockRecorder struct {
+	mock *MockcounterRepository
+}
+
+// NewMockcounterRepository creates a new mock instance.
+func NewMockcounterRepository(ctrl *gomock.Controller) *MockcounterRepository {
+	mock := &MockcounterRepository{ctrl: ctrl}
+	mock.recorder = &MockcounterRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcounterRepository) EXPECT() *MockcounterRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockcounterRepository) Get(ctx context.Context, articleIDs []string) (dto.ReactionCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, articleIDs)
+	ret0, _ := ret[0].(dto.ReactionCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockcounterRepositoryMockRecorder) Get(ctx, articleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockcounterRepository)(nil).Get), ctx, articleIDs)
+}
+
+// Incr mocks base method.
+func (m *MockcounterRepository) Incr(ctx context.Context, articleID, kind string, delta int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", ctx, articleID, kind, delta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Incr indicates an expected call of Incr.
+func (mr *MockcounterRepositoryMockRecorder) Incr(ctx, articleID, kind, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockcounterRepository)(nil).Incr), ctx, articleID, kind, delta)
+}
+
+// Pop mocks base method.
+func (m *MockcounterRepository) Pop(ctx context.Context) (dto.ReactionCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pop", ctx)
+	ret0, _ := ret[0].(dto.ReactionCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pop indicates an expected call of Pop.
+func (mr *MockcounterRepositoryMockRecorder) Pop(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pop", reflect.TypeOf((*MockcounterRepository)(nil).Pop), ctx)
+}
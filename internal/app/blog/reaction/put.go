@@ -0,0 +1,91 @@
+package reaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+func (s *Service) Put(ctx context.Context, in *dto.ReactionIn) (*dto.ArticleReactions, error) {
+	article, err := s.findArticle(ctx, in.ArticleSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := s.reactionRepository.Add(ctx, article.ID, in.UserID, in.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("add reaction in repository: %w", err)
+	}
+
+	if added {
+		if err = s.count(ctx, article.ID, in.Kind, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.get(ctx, article.ID, in.UserID)
+}
+
+func (s *Service) Delete(ctx context.Context, in *dto.ReactionIn) (*dto.ArticleReactions, error) {
+	article, err := s.findArticle(ctx, in.ArticleSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.reactionRepository.Delete(ctx, article.ID, in.UserID, in.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("delete reaction in repository: %w", err)
+	}
+
+	if deleted {
+		if err = s.count(ctx, article.ID, in.Kind, -1); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.get(ctx, article.ID, in.UserID)
+}
+
+// count adds the delta to the pending deltas of the article. The reaction is stored already,
+// so if that fails the delta would be lost and the counters of the article are recounted right away instead.
+func (s *Service) count(ctx context.Context, articleID, kind string, delta int64) error {
+	err := s.counterRepository.Incr(ctx, articleID, kind, delta)
+	if err == nil {
+		return nil
+	}
+
+	s.logger.Error().Err(err).Str("article_id", articleID).Msg("increment counter in repository error")
+
+	if err = s.reactionRepository.Recount(ctx, []string{articleID}); err != nil {
+		return fmt.Errorf("recount counters in repository: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) findArticle(ctx context.Context, slug string) (*dto.Article, error) {
+	article, err := s.articleRepository.Find(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("find article in repository: %w", err)
+	}
+
+	if article == nil || article.Hidden {
+		return nil, errors.ErrArticleNotFound
+	}
+
+	return article, nil
+}
+
+func (s *Service) get(ctx context.Context, articleID, userID string) (*dto.ArticleReactions, error) {
+	articles := []dto.Article{{ID: articleID}}
+	if err := s.Enrich(ctx, articles, userID); err != nil {
+		return nil, err
+	}
+
+	return &dto.ArticleReactions{
+		Counts: articles[0].ReactionCounts,
+		Own:    articles[0].OwnReactions,
+	}, nil
+}
@@ -0,0 +1,235 @@
+package reaction
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/reaction/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	articleRepository  *mock.MockarticleRepository
+	reactionRepository *mock.MockreactionRepository
+	counterRepository  *mock.MockcounterRepository
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		articleRepository:  mock.NewMockarticleRepository(ctrl),
+		reactionRepository: mock.NewMockreactionRepository(ctrl),
+		counterRepository:  mock.NewMockcounterRepository(ctrl),
+	}
+}
+
+func (m serviceMocks) newService(logger *testutil.Logger) *Service {
+	return NewService(time.Second, m.articleRepository, m.reactionRepository, m.counterRepository, logger)
+}
+
+func (m serviceMocks) expectFindArticle(article *dto.Article, err error) {
+	m.articleRepository.EXPECT().
+		Find(gomock.Any(), gomock.Eq("foo-article")).
+		Return(article, err)
+}
+
+func (m serviceMocks) expectGet() {
+	m.reactionRepository.EXPECT().
+		GetCounts(gomock.Any(), gomock.Eq([]string{"article id"})).
+		Return(dto.ReactionCounts{"article id": {"like": 5}}, nil)
+	m.counterRepository.EXPECT().
+		Get(gomock.Any(), gomock.Eq([]string{"article id"})).
+		Return(dto.ReactionCounts{"article id": {"like": 1}}, nil)
+	m.reactionRepository.EXPECT().
+		GetUserReactions(gomock.Any(), gomock.Eq([]string{"article id"}), gomock.Eq("user id")).
+		Return(map[string][]string{"article id": {"like"}}, nil)
+}
+
+func TestPut(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.ArticleReactions, err error)
+	}{
+		{
+			name: "article not found",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleReactions, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "article hidden",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id", Hidden: true}, nil)
+			},
+			assert: func(t *testing.T, out *dto.ArticleReactions, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "add reaction error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.reactionRepository.EXPECT().
+					Add(gomock.Any(), gomock.Eq("article id"), gomock.Eq("user id"), gomock.Eq("like")).
+					Return(false, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ArticleReactions, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "add reaction in repository: foo error")
+			},
+		},
+		{
+			name: "increment counter and recount error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.reactionRepository.EXPECT().
+					Add(gomock.Any(), gomock.Eq("article id"), gomock.Eq("user id"), gomock.Eq("like")).
+					Return(true, nil)
+				m.counterRepository.EXPECT().
+					Incr(gomock.Any(), gomock.Eq("article id"), gomock.Eq("like"), gomock.Eq(int64(1))).
+					Return(errors.New("foo error"))
+				m.reactionRepository.EXPECT().
+					Recount(gomock.Any(), gomock.Eq([]string{"article id"})).
+					Return(errors.New("bar error"))
+			},
+			assert: func(t *testing.T, out *dto.ArticleReactions, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "recount counters in repository: bar error")
+			},
+		},
+		{
+			name: "increment counter error recounted",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.reactionRepository.EXPECT().
+					Add(gomock.Any(), gomock.Eq("article id"), gomock.Eq("user id"), gomock.Eq("like")).
+					Return(true, nil)
+				m.counterRepository.EXPECT().
+					Incr(gomock.Any(), gomock.Eq("article id"), gomock.Eq("like"), gomock.Eq(int64(1))).
+					Return(errors.New("foo error"))
+				m.reactionRepository.EXPECT().
+					Recount(gomock.Any(), gomock.Eq([]string{"article id"})).
+					Return(nil)
+				m.expectGet()
+			},
+			assert: func(t *testing.T, out *dto.ArticleReactions, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.ArticleReactions{Counts: map[string]int64{"like": 6}, Own: []string{"like"}}, out)
+			},
+		},
+		{
+			name: "already added",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.reactionRepository.EXPECT().
+					Add(gomock.Any(), gomock.Eq("article id"), gomock.Eq("user id"), gomock.Eq("like")).
+					Return(false, nil)
+				m.expectGet()
+			},
+			assert: func(t *testing.T, out *dto.ArticleReactions, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.ArticleReactions{Counts: map[string]int64{"like": 6}, Own: []string{"like"}}, out)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.reactionRepository.EXPECT().
+					Add(gomock.Any(), gomock.Eq("article id"), gomock.Eq("user id"), gomock.Eq("like")).
+					Return(true, nil)
+				m.counterRepository.EXPECT().
+					Incr(gomock.Any(), gomock.Eq("article id"), gomock.Eq("like"), gomock.Eq(int64(1))).
+					Return(nil)
+				m.expectGet()
+			},
+			assert: func(t *testing.T, out *dto.ArticleReactions, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.ArticleReactions{Counts: map[string]int64{"like": 6}, Own: []string{"like"}}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Put(context.Background(), &dto.ReactionIn{
+				ArticleSlug: "foo-article",
+				UserID:      "user id",
+				Kind:        "like",
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.ArticleReactions, err error)
+	}{
+		{
+			name: "delete reaction error",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.reactionRepository.EXPECT().
+					Delete(gomock.Any(), gomock.Eq("article id"), gomock.Eq("user id"), gomock.Eq("like")).
+					Return(false, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ArticleReactions, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "delete reaction in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindArticle(&dto.Article{ID: "article id"}, nil)
+				m.reactionRepository.EXPECT().
+					Delete(gomock.Any(), gomock.Eq("article id"), gomock.Eq("user id"), gomock.Eq("like")).
+					Return(true, nil)
+				m.counterRepository.EXPECT().
+					Incr(gomock.Any(), gomock.Eq("article id"), gomock.Eq("like"), gomock.Eq(int64(-1))).
+					Return(nil)
+				m.expectGet()
+			},
+			assert: func(t *testing.T, out *dto.ArticleReactions, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.ArticleReactions{Counts: map[string]int64{"like": 6}, Own: []string{"like"}}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Delete(context.Background(), &dto.ReactionIn{
+				ArticleSlug: "foo-article",
+				UserID:      "user id",
+				Kind:        "like",
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package reaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type articleRepository interface {
+	Find(ctx context.Context, slug string) (*dto.Article, error)
+}
+
+type reactionRepository interface {
+	Add(ctx context.Context, articleID, userID, kind string) (bool, error)
+	Delete(ctx context.Context, articleID, userID, kind string) (bool, error)
+	GetCounts(ctx context.Context, articleIDs []string) (dto.ReactionCounts, error)
+	GetUserReactions(ctx context.Context, articleIDs []string, userID string) (map[string][]string, error)
+	Recount(ctx context.Context, articleIDs []string) error
+}
+
+// counterRepository keeps count deltas which are not flushed to reactionRepository yet.
+type counterRepository interface {
+	Incr(ctx context.Context, articleID, kind string, delta int64) error
+	Get(ctx context.Context, articleIDs []string) (dto.ReactionCounts, error)
+	Pop(ctx context.Context) (dto.ReactionCounts, error)
+}
+
+type Service struct {
+	flushInterval      time.Duration
+	articleRepository  articleRepository
+	reactionRepository reactionRepository
+	counterRepository  counterRepository
+	logger             log.Logger
+}
+
+func NewService(
+	flushInterval time.Duration,
+	articleRepository articleRepository,
+	reactionRepository reactionRepository,
+	counterRepository counterRepository,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		flushInterval:      flushInterval,
+		articleRepository:  articleRepository,
+		reactionRepository: reactionRepository,
+		counterRepository:  counterRepository,
+		logger:             logger,
+	}
+}
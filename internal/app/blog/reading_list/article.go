@@ -0,0 +1,99 @@
+package reading_list
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// AddArticle appends the article to the reading list of the user.
+func (s *Service) AddArticle(ctx context.Context, in *dto.ReadingListArticleIn) error {
+	list, article, err := s.findListArticle(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	if list.ArticlesCount >= maxArticles {
+		return errors.ErrReadingListFull
+	}
+
+	if err = s.readingListRepository.AddArticle(ctx, list.ID, article.ID); err != nil {
+		return fmt.Errorf("add article in repository: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteArticle removes the article from the reading list of the user.
+func (s *Service) DeleteArticle(ctx context.Context, in *dto.ReadingListArticleIn) error {
+	list, article, err := s.findListArticle(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	if err = s.readingListRepository.DeleteArticle(ctx, list.ID, article.ID); err != nil {
+		return fmt.Errorf("delete article in repository: %w", err)
+	}
+
+	return nil
+}
+
+// Reorder puts articles of the reading list of the user in the given order, which must list all of them.
+func (s *Service) Reorder(ctx context.Context, in *dto.ReorderReadingListIn) error {
+	list, err := s.findOwnList(ctx, in.UserID, in.ListID)
+	if err != nil {
+		return err
+	}
+
+	items, err := s.readingListRepository.GetItems(ctx, list.ID)
+	if err != nil {
+		return fmt.Errorf("get reading list items from repository: %w", err)
+	}
+
+	if len(items) != len(in.ArticleSlugs) {
+		return errors.ErrReadingListOrderMismatch
+	}
+
+	idsBySlug := make(map[string]string, len(items))
+	for _, item := range items {
+		idsBySlug[item.ArticleSlug] = item.ArticleID
+	}
+
+	ids := make([]string, 0, len(in.ArticleSlugs))
+	for _, slug := range in.ArticleSlugs {
+		id, ok := idsBySlug[slug]
+		if !ok {
+			return errors.ErrReadingListOrderMismatch
+		}
+
+		// a repeated slug would leave another article out
+		delete(idsBySlug, slug)
+		ids = append(ids, id)
+	}
+
+	if err = s.readingListRepository.Reorder(ctx, list.ID, ids); err != nil {
+		return fmt.Errorf("reorder reading list in repository: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) findListArticle(ctx context.Context, in *dto.ReadingListArticleIn) (*dto.ReadingList, *dto.Article, error) {
+	list, err := s.findOwnList(ctx, in.UserID, in.ListID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	article, err := s.articleRepository.Find(ctx, in.ArticleSlug)
+	if err != nil {
+		return nil, nil, fmt.Errorf("find article in repository: %w", err)
+	}
+
+	if article == nil || article.Hidden {
+		return nil, nil, errors.ErrArticleNotFound
+	}
+
+	return list, article, nil
+}
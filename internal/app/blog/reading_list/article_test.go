@@ -0,0 +1,297 @@
+package reading_list
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+func (m serviceMocks) expectFindArticle(article *dto.Article, err error) {
+	m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo")).Return(article, err)
+}
+
+func TestAddArticle(t *testing.T) {
+	list := &dto.ReadingList{ID: "list id", UserID: "user id", ArticlesCount: 1}
+	article := &dto.Article{ID: "foo id", Slug: "foo"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "list not found",
+			setup: func(m serviceMocks) {
+				m.expectFindList(&dto.ReadingList{ID: "list id", UserID: "another user id"}, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrReadingListNotFound)
+			},
+		},
+		{
+			name: "find article error",
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.expectFindArticle(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "find article in repository: foo error")
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.expectFindArticle(nil, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "article hidden",
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.expectFindArticle(&dto.Article{ID: "article id", Hidden: true}, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "list full",
+			setup: func(m serviceMocks) {
+				m.expectFindList(&dto.ReadingList{ID: "list id", UserID: "user id", ArticlesCount: maxArticles}, nil)
+				m.expectFindArticle(article, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrReadingListFull)
+			},
+		},
+		{
+			name: "add article error",
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.expectFindArticle(article, nil)
+				m.readingListRepository.EXPECT().AddArticle(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "add article in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.expectFindArticle(article, nil)
+				m.readingListRepository.EXPECT().AddArticle(gomock.Any(), gomock.Eq("list id"), gomock.Eq("foo id")).Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService().AddArticle(context.Background(), &dto.ReadingListArticleIn{
+				UserID:      "user id",
+				ListID:      "list id",
+				ArticleSlug: "foo",
+			})
+
+			tt.assert(t, err)
+		})
+	}
+}
+
+func TestDeleteArticle(t *testing.T) {
+	list := &dto.ReadingList{ID: "list id", UserID: "user id"}
+	article := &dto.Article{ID: "foo id", Slug: "foo"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "find list error",
+			setup: func(m serviceMocks) {
+				m.expectFindList(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "find reading list in repository: foo error")
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.expectFindArticle(nil, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "delete article error",
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.expectFindArticle(article, nil)
+				m.readingListRepository.EXPECT().DeleteArticle(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "delete article in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.expectFindArticle(article, nil)
+				m.readingListRepository.EXPECT().DeleteArticle(gomock.Any(), gomock.Eq("list id"), gomock.Eq("foo id")).Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService().DeleteArticle(context.Background(), &dto.ReadingListArticleIn{
+				UserID:      "user id",
+				ListID:      "list id",
+				ArticleSlug: "foo",
+			})
+
+			tt.assert(t, err)
+		})
+	}
+}
+
+func TestReorder(t *testing.T) {
+	list := &dto.ReadingList{ID: "list id", UserID: "user id"}
+	items := []dto.ReadingListItem{
+		{ArticleID: "foo id", ArticleSlug: "foo"},
+		{ArticleID: "bar id", ArticleSlug: "bar"},
+	}
+
+	for _, tt := range []struct {
+		name   string
+		slugs  []string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name:  "list not found",
+			slugs: []string{"bar", "foo"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(nil, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrReadingListNotFound)
+			},
+		},
+		{
+			name:  "get items error",
+			slugs: []string{"bar", "foo"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.readingListRepository.EXPECT().GetItems(gomock.Any(), gomock.Eq("list id")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "get reading list items from repository: foo error")
+			},
+		},
+		{
+			name:  "missing article",
+			slugs: []string{"bar"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.readingListRepository.EXPECT().GetItems(gomock.Any(), gomock.Eq("list id")).Return(items, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrReadingListOrderMismatch)
+			},
+		},
+		{
+			name:  "unknown article",
+			slugs: []string{"bar", "baz"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.readingListRepository.EXPECT().GetItems(gomock.Any(), gomock.Eq("list id")).Return(items, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrReadingListOrderMismatch)
+			},
+		},
+		{
+			name:  "repeated article",
+			slugs: []string{"bar", "bar"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.readingListRepository.EXPECT().GetItems(gomock.Any(), gomock.Eq("list id")).Return(items, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrReadingListOrderMismatch)
+			},
+		},
+		{
+			name:  "reorder error",
+			slugs: []string{"bar", "foo"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.readingListRepository.EXPECT().GetItems(gomock.Any(), gomock.Eq("list id")).Return(items, nil)
+				m.readingListRepository.EXPECT().Reorder(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "reorder reading list in repository: foo error")
+			},
+		},
+		{
+			name:  "ok",
+			slugs: []string{"bar", "foo"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(list, nil)
+				m.readingListRepository.EXPECT().GetItems(gomock.Any(), gomock.Eq("list id")).Return(items, nil)
+				m.readingListRepository.EXPECT().
+					Reorder(gomock.Any(), gomock.Eq("list id"), gomock.Eq([]string{"bar id", "foo id"})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService().Reorder(context.Background(), &dto.ReorderReadingListIn{
+				UserID:       "user id",
+				ListID:       "list id",
+				ArticleSlugs: tt.slugs,
+			})
+
+			tt.assert(t, err)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package reading_list
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// GetLists returns reading lists of the user. The default list is created on the first call.
+func (s *Service) GetLists(ctx context.Context, userID string) ([]*dto.ReadingList, error) {
+	if err := s.readingListRepository.AddDefault(ctx, userID); err != nil {
+		return nil, fmt.Errorf("add default reading list in repository: %w", err)
+	}
+
+	lists, err := s.readingListRepository.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get reading lists from repository: %w", err)
+	}
+
+	for _, list := range lists {
+		s.fillShareURL(list)
+	}
+
+	return lists, nil
+}
+
+// Create creates a private reading list.
+func (s *Service) Create(ctx context.Context, in *dto.CreateReadingListIn) (*dto.ReadingList, error) {
+	list := &dto.ReadingList{
+		UserID: in.UserID,
+		Name:   in.Name,
+	}
+
+	if err := s.readingListRepository.Save(ctx, list); err != nil {
+		return nil, fmt.Errorf("save reading list in repository: %w", err)
+	}
+
+	return list, nil
+}
+
+// Get returns the reading list with its articles, either the user's own one or a shared one.
+func (s *Service) Get(ctx context.Context, in *dto.GetReadingListIn) (*dto.GetReadingListOut, error) {
+	list, err := s.findList(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.readingListRepository.GetItems(ctx, list.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get reading list items from repository: %w", err)
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ArticleID)
+	}
+
+	articles, err := s.articleService.GetByIDs(ctx, ids, in.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get articles: %w", err)
+	}
+
+	s.fillShareURL(list)
+
+	return &dto.GetReadingListOut{List: list, Articles: articles}, nil
+}
+
+// Share opens the reading list to anyone with its share link or makes it private again.
+// A list shared again gets a new link, so old links stop working once the list is made private.
+func (s *Service) Share(ctx context.Context, in *dto.ShareReadingListIn) (*dto.ReadingList, error) {
+	list, err := s.findOwnList(ctx, in.UserID, in.ListID)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Shared == (list.ShareToken != "") {
+		s.fillShareURL(list)
+		return list, nil
+	}
+
+	list.ShareToken = ""
+	if in.Shared {
+		if list.ShareToken, err = generateShareToken(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.readingListRepository.Save(ctx, list); err != nil {
+		return nil, fmt.Errorf("save reading list in repository: %w", err)
+	}
+
+	s.fillShareURL(list)
+
+	return list, nil
+}
+
+func (s *Service) findList(ctx context.Context, in *dto.GetReadingListIn) (*dto.ReadingList, error) {
+	if in.ShareToken == "" {
+		return s.findOwnList(ctx, in.UserID, in.ID)
+	}
+
+	list, err := s.readingListRepository.FindShared(ctx, in.ShareToken)
+	if err != nil {
+		return nil, fmt.Errorf("find shared reading list in repository: %w", err)
+	}
+
+	if list == nil {
+		return nil, errors.ErrReadingListNotFound
+	}
+
+	return list, nil
+}
+
+// findOwnList finds the list of the user. Lists of other users are not found, so private lists don't leak.
+func (s *Service) findOwnList(ctx context.Context, userID, id string) (*dto.ReadingList, error) {
+	list, err := s.readingListRepository.Find(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find reading list in repository: %w", err)
+	}
+
+	if list == nil || list.UserID != userID {
+		return nil, errors.ErrReadingListNotFound
+	}
+
+	return list, nil
+}
+
+func (s *Service) fillShareURL(list *dto.ReadingList) {
+	if list.ShareToken != "" {
+		list.ShareURL = s.siteURL.JoinPath("reading-lists", "shared", list.ShareToken).String()
+	}
+}
+
+func generateShareToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate share token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,374 @@
+package reading_list
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/reading_list/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+type serviceMocks struct {
+	readingListRepository *mock.MockreadingListRepository
+	articleRepository     *mock.MockarticleRepository
+	articleService        *mock.MockarticleService
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		readingListRepository: mock.NewMockreadingListRepository(ctrl),
+		articleRepository:     mock.NewMockarticleRepository(ctrl),
+		articleService:        mock.NewMockarticleService(ctrl),
+	}
+}
+
+func (m serviceMocks) newService() *Service {
+	siteURL, _ := url.Parse("https://example.com/blog")
+	return NewService(*siteURL, m.readingListRepository, m.articleRepository, m.articleService)
+}
+
+func (m serviceMocks) expectFindList(list *dto.ReadingList, err error) {
+	m.readingListRepository.EXPECT().Find(gomock.Any(), gomock.Eq("list id")).Return(list, err)
+}
+
+func TestGetLists(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out []*dto.ReadingList, err error)
+	}{
+		{
+			name: "add default list error",
+			setup: func(m serviceMocks) {
+				m.readingListRepository.EXPECT().AddDefault(gomock.Any(), gomock.Eq("user id")).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []*dto.ReadingList, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "add default reading list in repository: foo error")
+			},
+		},
+		{
+			name: "get lists error",
+			setup: func(m serviceMocks) {
+				m.readingListRepository.EXPECT().AddDefault(gomock.Any(), gomock.Eq("user id")).Return(nil)
+				m.readingListRepository.EXPECT().GetByUser(gomock.Any(), gomock.Eq("user id")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []*dto.ReadingList, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get reading lists from repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.readingListRepository.EXPECT().AddDefault(gomock.Any(), gomock.Eq("user id")).Return(nil)
+				m.readingListRepository.EXPECT().GetByUser(gomock.Any(), gomock.Eq("user id")).Return([]*dto.ReadingList{
+					{ID: "default list id", Name: dto.DefaultReadingListName, Default: true},
+					{ID: "list id", Name: "Go", ShareToken: "token"},
+				}, nil)
+			},
+			assert: func(t *testing.T, out []*dto.ReadingList, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []*dto.ReadingList{
+					{ID: "default list id", Name: dto.DefaultReadingListName, Default: true},
+					{ID: "list id", Name: "Go", ShareToken: "token", ShareURL: "https://example.com/blog/reading-lists/shared/token"},
+				}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService().GetLists(context.Background(), "user id")
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.ReadingList, err error)
+	}{
+		{
+			name: "save list error",
+			setup: func(m serviceMocks) {
+				m.readingListRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ReadingList, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "save reading list in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.readingListRepository.EXPECT().
+					Save(gomock.Any(), gomock.Eq(&dto.ReadingList{UserID: "user id", Name: "Go"})).
+					Do(func(_ context.Context, list *dto.ReadingList) { list.ID = "list id" }).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.ReadingList, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.ReadingList{ID: "list id", UserID: "user id", Name: "Go"}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService().Create(context.Background(), &dto.CreateReadingListIn{UserID: "user id", Name: "Go"})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	items := []dto.ReadingListItem{
+		{ArticleID: "foo id", ArticleSlug: "foo"},
+		{ArticleID: "bar id", ArticleSlug: "bar"},
+	}
+	articles := []dto.Article{{ID: "foo id"}, {ID: "bar id"}}
+
+	for _, tt := range []struct {
+		name   string
+		in     *dto.GetReadingListIn
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.GetReadingListOut, err error)
+	}{
+		{
+			name: "find list error",
+			in:   &dto.GetReadingListIn{UserID: "user id", ID: "list id"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetReadingListOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find reading list in repository: foo error")
+			},
+		},
+		{
+			name: "list not found",
+			in:   &dto.GetReadingListIn{UserID: "user id", ID: "list id"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetReadingListOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrReadingListNotFound)
+			},
+		},
+		{
+			name: "list of another user",
+			in:   &dto.GetReadingListIn{UserID: "user id", ID: "list id"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(&dto.ReadingList{ID: "list id", UserID: "another user id", ShareToken: "token"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetReadingListOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrReadingListNotFound)
+			},
+		},
+		{
+			name: "find shared list error",
+			in:   &dto.GetReadingListIn{ShareToken: "token"},
+			setup: func(m serviceMocks) {
+				m.readingListRepository.EXPECT().FindShared(gomock.Any(), gomock.Eq("token")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetReadingListOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find shared reading list in repository: foo error")
+			},
+		},
+		{
+			name: "shared list not found",
+			in:   &dto.GetReadingListIn{ShareToken: "token"},
+			setup: func(m serviceMocks) {
+				m.readingListRepository.EXPECT().FindShared(gomock.Any(), gomock.Eq("token")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetReadingListOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrReadingListNotFound)
+			},
+		},
+		{
+			name: "get items error",
+			in:   &dto.GetReadingListIn{UserID: "user id", ID: "list id"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(&dto.ReadingList{ID: "list id", UserID: "user id"}, nil)
+				m.readingListRepository.EXPECT().GetItems(gomock.Any(), gomock.Eq("list id")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetReadingListOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get reading list items from repository: foo error")
+			},
+		},
+		{
+			name: "get articles error",
+			in:   &dto.GetReadingListIn{UserID: "user id", ID: "list id"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(&dto.ReadingList{ID: "list id", UserID: "user id"}, nil)
+				m.readingListRepository.EXPECT().GetItems(gomock.Any(), gomock.Eq("list id")).Return(items, nil)
+				m.articleService.EXPECT().GetByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetReadingListOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get articles: foo error")
+			},
+		},
+		{
+			name: "own list",
+			in:   &dto.GetReadingListIn{UserID: "user id", ID: "list id"},
+			setup: func(m serviceMocks) {
+				m.expectFindList(&dto.ReadingList{ID: "list id", UserID: "user id"}, nil)
+				m.readingListRepository.EXPECT().GetItems(gomock.Any(), gomock.Eq("list id")).Return(items, nil)
+				m.articleService.EXPECT().
+					GetByIDs(gomock.Any(), gomock.Eq([]string{"foo id", "bar id"}), gomock.Eq("user id")).
+					Return(articles, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetReadingListOut, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetReadingListOut{
+					List:     &dto.ReadingList{ID: "list id", UserID: "user id"},
+					Articles: articles,
+				}, out)
+			},
+		},
+		{
+			name: "shared list",
+			in:   &dto.GetReadingListIn{UserID: "user id", ShareToken: "token"},
+			setup: func(m serviceMocks) {
+				m.readingListRepository.EXPECT().
+					FindShared(gomock.Any(), gomock.Eq("token")).
+					Return(&dto.ReadingList{ID: "list id", UserID: "another user id", ShareToken: "token"}, nil)
+				m.readingListRepository.EXPECT().GetItems(gomock.Any(), gomock.Eq("list id")).Return(items, nil)
+				m.articleService.EXPECT().
+					GetByIDs(gomock.Any(), gomock.Eq([]string{"foo id", "bar id"}), gomock.Eq("user id")).
+					Return(articles, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetReadingListOut, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://example.com/blog/reading-lists/shared/token", out.List.ShareURL)
+				assert.Equal(t, articles, out.Articles)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService().Get(context.Background(), tt.in)
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestShare(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		shared bool
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.ReadingList, err error)
+	}{
+		{
+			name:   "list not found",
+			shared: true,
+			setup: func(m serviceMocks) {
+				m.expectFindList(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.ReadingList, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrReadingListNotFound)
+			},
+		},
+		{
+			name:   "already shared",
+			shared: true,
+			setup: func(m serviceMocks) {
+				m.expectFindList(&dto.ReadingList{ID: "list id", UserID: "user id", ShareToken: "token"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.ReadingList, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://example.com/blog/reading-lists/shared/token", out.ShareURL)
+			},
+		},
+		{
+			name:   "save list error",
+			shared: true,
+			setup: func(m serviceMocks) {
+				m.expectFindList(&dto.ReadingList{ID: "list id", UserID: "user id"}, nil)
+				m.readingListRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ReadingList, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "save reading list in repository: foo error")
+			},
+		},
+		{
+			name:   "share",
+			shared: true,
+			setup: func(m serviceMocks) {
+				m.expectFindList(&dto.ReadingList{ID: "list id", UserID: "user id"}, nil)
+				m.readingListRepository.EXPECT().
+					Save(gomock.Any(), gomock.Cond(func(list *dto.ReadingList) bool { return len(list.ShareToken) == 22 })).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.ReadingList, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://example.com/blog/reading-lists/shared/"+out.ShareToken, out.ShareURL)
+			},
+		},
+		{
+			name:   "unshare",
+			shared: false,
+			setup: func(m serviceMocks) {
+				m.expectFindList(&dto.ReadingList{ID: "list id", UserID: "user id", ShareToken: "token"}, nil)
+				m.readingListRepository.EXPECT().
+					Save(gomock.Any(), gomock.Eq(&dto.ReadingList{ID: "list id", UserID: "user id"})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.ReadingList, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, out.ShareToken)
+				assert.Empty(t, out.ShareURL)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService().Share(context.Background(), &dto.ShareReadingListIn{
+				UserID: "user id",
+				ListID: "list id",
+				Shared: tt.shared,
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
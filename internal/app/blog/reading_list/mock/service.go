@@ -0,0 +1,250 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockreadingListRepository is a mock of readingListRepository interface.
+type MockreadingListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockreadingListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockreadingListRepositoryMockRecorder is the mock recorder for MockreadingListRepository.
+type MockreadingListRepositoryMockRecorder struct {
+	mock *MockreadingListRepository
+}
+
+// NewMockreadingListRepository creates a new mock instance.
+func NewMockreadingListRepository(ctrl *gomock.Controller) *MockreadingListRepository {
+	mock := &MockreadingListRepository{ctrl: ctrl}
+	mock.recorder = &MockreadingListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreadingListRepository) EXPECT() *MockreadingListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddArticle mocks base method.
+func (m *MockreadingListRepository) AddArticle(ctx context.Context, listID, articleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddArticle", ctx, listID, articleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddArticle indicates an expected call of AddArticle.
+func (mr *MockreadingListRepositoryMockRecorder) AddArticle(ctx, listID, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddArticle", reflect.TypeOf((*MockreadingListRepository)(nil).AddArticle), ctx, listID, articleID)
+}
+
+// AddDefault mocks base method.
+func (m *MockreadingListRepository) AddDefault(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDefault", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDefault indicates an expected call of AddDefault.
+func (mr *MockreadingListRepositoryMockRecorder) AddDefault(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDefault", reflect.TypeOf((*MockreadingListRepository)(nil).AddDefault), ctx, userID)
+}
+
+// DeleteArticle mocks base method.
+func (m *MockreadingListRepository) DeleteArticle(ctx context.Context, listID, articleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticle", ctx, listID, articleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticle indicates an expected call of DeleteArticle.
+func (mr *MockreadingListRepositoryMockRecorder) DeleteArticle(ctx, listID, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticle", reflect.TypeOf((*MockreadingListRepository)(nil).DeleteArticle), ctx, listID, articleID)
+}
+
+// Find mocks base method.
+func (m *MockreadingListRepository) Find(ctx context.Context, id string) (*dto.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(*dto.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockreadingListRepositoryMockRecorder) Find(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockreadingListRepository)(nil).Find), ctx, id)
+}
+
+// FindShared mocks base method.
+func (m *MockreadingListRepository) FindShared(ctx context.Context, shareToken string) (*dto.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindShared", ctx, shareToken)
+	ret0, _ := ret[0].(*dto.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindShared indicates an expected call of FindShared.
+func (mr *MockreadingListRepositoryMockRecorder) FindShared(ctx, shareToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindShared", reflect.TypeOf((*MockreadingListRepository)(nil).FindShared), ctx, shareToken)
+}
+
+// GetByUser mocks base method.
+func (m *MockreadingListRepository) GetByUser(ctx context.Context, userID string) ([]*dto.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUser", ctx, userID)
+	ret0, _ := ret[0].([]*dto.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUser indicates an expected call of GetByUser.
+func (mr *MockreadingListRepositoryMockRecorder) GetByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUser", reflect.TypeOf((*MockreadingListRepository)(nil).GetByUser), ctx, userID)
+}
+
+// GetItems mocks base method.
+func (m *MockreadingListRepository) GetItems(ctx context.Context, listID string) ([]dto.ReadingListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItems", ctx, listID)
+	ret0, _ := ret[0].([]dto.ReadingListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItems indicates an expected call of GetItems.
+func (mr *MockreadingListRepositoryMockRecorder) GetItems(ctx, listID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItems", reflect.TypeOf((*MockreadingListRepository)(nil).GetItems), ctx, listID)
+}
+
+// Reorder mocks base method.
+func (m *MockreadingListRepository) Reorder(ctx context.Context, listID string, articleIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, listID, articleIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockreadingListRepositoryMockRecorder) Reorder(ctx, listID, articleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockreadingListRepository)(nil).Reorder), ctx, listID, articleIDs)
+}
+
+// Save mocks base method.
+func (m *MockreadingListRepository) Save(ctx context.Context, list *dto.ReadingList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, list)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockreadingListRepositoryMockRecorder) Save(ctx, list any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockreadingListRepository)(nil).Save), ctx, list)
+}
+
+// MockarticleRepository is a mock of articleRepository interface.
+type MockarticleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleRepositoryMockRecorder is the mock recorder for MockarticleRepository.
+type MockarticleRepositoryMockRecorder struct {
+	mock *MockarticleRepository
+}
+
+// NewMockarticleRepository creates a new mock instance.
+func NewMockarticleRepository(ctrl *gomock.Controller) *MockarticleRepository {
+	mock := &MockarticleRepository{ctrl: ctrl}
+	mock.recorder = &MockarticleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleRepository) EXPECT() *MockarticleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockarticleRepository) Find(ctx context.Context, slug string) (*dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, slug)
+	ret0, _ := ret[0].(*dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockarticleRepositoryMockRecorder) Find(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockarticleRepository)(nil).Find), ctx, slug)
+}
+
+// MockarticleService is a mock of articleService interface.
+type MockarticleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleServiceMockRecorder is the mock recorder for MockarticleService.
+type MockarticleServiceMockRecorder struct {
+	mock *MockarticleService
+}
+
+// NewMockarticleService creates a new mock instance.
+func NewMockarticleService(ctrl *gomock.Controller) *MockarticleService {
+	mock := &MockarticleService{ctrl: ctrl}
+	mock.recorder = &MockarticleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleService) EXPECT() *MockarticleServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByIDs mocks base method.
+func (m *MockarticleService) GetByIDs(ctx context.Context, ids []string, userID string) ([]dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids, userID)
+	ret0, _ := ret[0].([]dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockarticleServiceMockRecorder) GetByIDs(ctx, ids, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockarticleService)(nil).GetByIDs), ctx, ids, userID)
+}
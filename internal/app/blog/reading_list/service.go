@@ -0,0 +1,56 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package reading_list
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+// maxArticles bounds reading lists, since lists are returned with all their articles.
+const maxArticles = 500
+
+type readingListRepository interface {
+	GetByUser(ctx context.Context, userID string) ([]*dto.ReadingList, error)
+	Find(ctx context.Context, id string) (*dto.ReadingList, error)
+	FindShared(ctx context.Context, shareToken string) (*dto.ReadingList, error)
+	// AddDefault creates the default list of the user unless there is one already.
+	AddDefault(ctx context.Context, userID string) error
+	Save(ctx context.Context, list *dto.ReadingList) error
+	// GetItems returns articles of the list in the list order.
+	GetItems(ctx context.Context, listID string) ([]dto.ReadingListItem, error)
+	AddArticle(ctx context.Context, listID, articleID string) error
+	DeleteArticle(ctx context.Context, listID, articleID string) error
+	Reorder(ctx context.Context, listID string, articleIDs []string) error
+}
+
+type articleRepository interface {
+	Find(ctx context.Context, slug string) (*dto.Article, error)
+}
+
+type articleService interface {
+	GetByIDs(ctx context.Context, ids []string, userID string) ([]dto.Article, error)
+}
+
+type Service struct {
+	siteURL               url.URL
+	readingListRepository readingListRepository
+	articleRepository     articleRepository
+	articleService        articleService
+}
+
+// NewService creates the reading list service, siteURL is the public URL of the blog used in share links.
+func NewService(
+	siteURL url.URL,
+	readingListRepository readingListRepository,
+	articleRepository articleRepository,
+	articleService articleService,
+) *Service {
+	return &Service{
+		siteURL:               siteURL,
+		readingListRepository: readingListRepository,
+		articleRepository:     articleRepository,
+		articleService:        articleService,
+	}
+}
@@ -0,0 +1,178 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockarticleRepository is a mock of articleRepository interface.
+type MockarticleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleRepositoryMockRecorder is the mock recorder for MockarticleRepository.
+type MockarticleRepositoryMockRecorder struct {
+	mock *MockarticleRepository
+}
+
+// NewMockarticleRepository creates a new mock instance.
+func NewMockarticleRepository(ctrl *gomock.Controller) *MockarticleRepository {
+	mock := &MockarticleRepository{ctrl: ctrl}
+	mock.recorder = &MockarticleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleRepository) EXPECT() *MockarticleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockarticleRepository) Find(ctx context.Context, slug string) (*dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, slug)
+	ret0, _ := ret[0].(*dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockarticleRepositoryMockRecorder) Find(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockarticleRepository)(nil).Find), ctx, slug)
+}
+
+// GetRelatedIDs mocks base method.
+func (m *MockarticleRepository) GetRelatedIDs(ctx context.Context, articleID string, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedIDs", ctx, articleID, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelatedIDs indicates an expected call of GetRelatedIDs.
+func (mr *MockarticleRepositoryMockRecorder) GetRelatedIDs(ctx, articleID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedIDs", reflect.TypeOf((*MockarticleRepository)(nil).GetRelatedIDs), ctx, articleID, limit)
+}
+
+// MockarticleService is a mock of articleService interface.
+type MockarticleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleServiceMockRecorder is the mock recorder for MockarticleService.
+type MockarticleServiceMockRecorder struct {
+	mock *MockarticleService
+}
+
+// NewMockarticleService creates a new mock instance.
+func NewMockarticleService(ctrl *gomock.Controller) *MockarticleService {
+	mock := &MockarticleService{ctrl: ctrl}
+	mock.recorder = &MockarticleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleService) EXPECT() *MockarticleServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByIDs mocks base method.
+func (m *MockarticleService) GetByIDs(ctx context.Context, ids []string, userID string) ([]dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids, userID)
+	ret0, _ := ret[0].([]dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockarticleServiceMockRecorder) GetByIDs(ctx, ids, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockarticleService)(nil).GetByIDs), ctx, ids, userID)
+}
+
+// MockrelatedCache is a mock of relatedCache interface.
+type MockrelatedCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockrelatedCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockrelatedCacheMockRecorder is the mock recorder for MockrelatedCache.
+type MockrelatedCacheMockRecorder struct {
+	mock *MockrelatedCache
+}
+
+// NewMockrelatedCache creates a new mock instance.
+func NewMockrelatedCache(ctrl *gomock.Controller) *MockrelatedCache {
+	mock := &MockrelatedCache{ctrl: ctrl}
+	mock.recorder = &MockrelatedCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrelatedCache) EXPECT() *MockrelatedCacheMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockrelatedCache) Delete(ctx context.Context, articleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, articleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockrelatedCacheMockRecorder) Delete(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockrelatedCache)(nil).Delete), ctx, articleID)
+}
+
+// Get mocks base method.
+func (m *MockrelatedCache) Get(ctx context.Context, articleID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, articleID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockrelatedCacheMockRecorder) Get(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockrelatedCache)(nil).Get), ctx, articleID)
+}
+
+// Save mocks base method.
+func (m *MockrelatedCache) Save(ctx context.Context, articleID string, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, articleID, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockrelatedCacheMockRecorder) Save(ctx, articleID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockrelatedCache)(nil).Save), ctx, articleID, ids)
+}
@@ -0,0 +1,140 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package related
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/worker"
+)
+
+type articleRepository interface {
+	Find(ctx context.Context, slug string) (*dto.Article, error)
+	// GetRelatedIDs returns IDs of visible articles ranked by similarity to the article.
+	GetRelatedIDs(ctx context.Context, articleID string, limit int) ([]string, error)
+}
+
+type articleService interface {
+	GetByIDs(ctx context.Context, ids []string, userID string) ([]dto.Article, error)
+}
+
+// relatedCache keeps ranked IDs of related articles, missing ones are ranked again on reads.
+type relatedCache interface {
+	// Get returns IDs of articles related to the article, it fails with ErrNoCache if they aren't cached.
+	Get(ctx context.Context, articleID string) ([]string, error)
+	Save(ctx context.Context, articleID string, ids []string) error
+	Delete(ctx context.Context, articleID string) error
+}
+
+// Service recommends articles related to an article to read next.
+//
+// Ranking compares the article with every candidate, so rankings are computed in background when articles
+// are published or their tags change, and kept in the cache. Rankings of other articles pick up the change
+// once they expire.
+type Service struct {
+	size              int
+	articleRepository articleRepository
+	articleService    articleService
+	relatedCache      relatedCache
+	logger            log.Logger
+
+	refresher *worker.Pool[string]
+}
+
+// NewService creates the related articles service, size is the number of related articles of an article.
+func NewService(
+	size int,
+	refresher worker.PoolConfig,
+	articleRepository articleRepository,
+	articleService articleService,
+	relatedCache relatedCache,
+	logger log.Logger,
+) *Service {
+	s := &Service{
+		size:              size,
+		articleRepository: articleRepository,
+		articleService:    articleService,
+		relatedCache:      relatedCache,
+		logger:            logger,
+	}
+	s.refresher = worker.NewPool(refresher, s.refresh, logger)
+	return s
+}
+
+// Get returns articles related to the article, the most related first.
+func (s *Service) Get(ctx context.Context, slug, userID string) ([]dto.Article, error) {
+	article, err := s.articleRepository.Find(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("find article in repository: %w", err)
+	}
+
+	if article == nil || article.Hidden {
+		return nil, apperrors.ErrArticleNotFound
+	}
+
+	ids, err := s.relatedCache.Get(ctx, article.ID)
+	switch {
+	case err == nil:
+	case errors.Is(err, apperrors.ErrNoCache):
+		if ids, err = s.rank(ctx, article.ID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("get related articles from cache: %w", err)
+	}
+
+	articles, err := s.articleService.GetByIDs(ctx, ids, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get articles from article service: %w", err)
+	}
+
+	return articles, nil
+}
+
+// Refresh drops the ranking of the article and queues ranking it again. The ranking is dropped first,
+// so a refresh that doesn't fit into the queue is done on the next read.
+func (s *Service) Refresh(ctx context.Context, articleID string) error {
+	if err := s.relatedCache.Delete(ctx, articleID); err != nil {
+		return fmt.Errorf("delete related articles from cache: %w", err)
+	}
+
+	if err := s.refresher.Push(ctx, articleID); err != nil {
+		return fmt.Errorf("push to refresher: %w", err)
+	}
+
+	return nil
+}
+
+// Start starts the background refresher.
+func (s *Service) Start(ctx context.Context) error {
+	return s.refresher.Start(ctx)
+}
+
+// Stop stops the background refresher after queued refreshes are done.
+func (s *Service) Stop(ctx context.Context) error {
+	return s.refresher.Stop(ctx)
+}
+
+func (s *Service) refresh(ctx context.Context, articleID string) error {
+	_, err := s.rank(ctx, articleID)
+	return err
+}
+
+// rank ranks articles related to the article and caches the ranking. A failed cache write is only logged,
+// the ranking is computed again on the next read.
+func (s *Service) rank(ctx context.Context, articleID string) ([]string, error) {
+	ids, err := s.articleRepository.GetRelatedIDs(ctx, articleID, s.size)
+	if err != nil {
+		return nil, fmt.Errorf("get related article ids from repository: %w", err)
+	}
+
+	if err = s.relatedCache.Save(ctx, articleID, ids); err != nil {
+		s.logger.Error().Err(err).Msg("save related articles in cache error")
+	}
+
+	return ids, nil
+}
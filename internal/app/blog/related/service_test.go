@@ -0,0 +1,199 @@
+package related
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/related/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/worker"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	articleRepository *mock.MockarticleRepository
+	articleService    *mock.MockarticleService
+	relatedCache      *mock.MockrelatedCache
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		articleRepository: mock.NewMockarticleRepository(ctrl),
+		articleService:    mock.NewMockarticleService(ctrl),
+		relatedCache:      mock.NewMockrelatedCache(ctrl),
+	}
+}
+
+func (m serviceMocks) newService(logger log.Logger) *Service {
+	refresher := worker.PoolConfig{Name: "related refresher", Workers: 1, QueueSize: 1, Policy: worker.PolicyBlock}
+	return NewService(3, refresher, m.articleRepository, m.articleService, m.relatedCache, logger)
+}
+
+func TestGet(t *testing.T) {
+	related := []dto.Article{{ID: "bar id", Slug: "bar"}, {ID: "baz id", Slug: "baz"}}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out []dto.Article, err error, logs []string)
+	}{
+		{
+			name: "find article error",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find article in repository: foo error")
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, out []dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "article hidden",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo")).Return(&dto.Article{ID: "foo id", Hidden: true}, nil)
+			},
+			assert: func(t *testing.T, out []dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "get from cache error",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&dto.Article{ID: "foo id"}, nil)
+				m.relatedCache.EXPECT().Get(gomock.Any(), gomock.Eq("foo id")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get related articles from cache: foo error")
+			},
+		},
+		{
+			name: "cached",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&dto.Article{ID: "foo id"}, nil)
+				m.relatedCache.EXPECT().Get(gomock.Any(), gomock.Eq("foo id")).Return([]string{"bar id", "baz id"}, nil)
+				m.articleService.EXPECT().
+					GetByIDs(gomock.Any(), gomock.Eq([]string{"bar id", "baz id"}), gomock.Eq("user id")).
+					Return(related, nil)
+			},
+			assert: func(t *testing.T, out []dto.Article, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, related, out)
+			},
+		},
+		{
+			name: "rank error",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&dto.Article{ID: "foo id"}, nil)
+				m.relatedCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, apperrors.ErrNoCache)
+				m.articleRepository.EXPECT().
+					GetRelatedIDs(gomock.Any(), gomock.Eq("foo id"), gomock.Eq(3)).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get related article ids from repository: foo error")
+			},
+		},
+		{
+			name: "ranked with save to cache error",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&dto.Article{ID: "foo id"}, nil)
+				m.relatedCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, apperrors.ErrNoCache)
+				m.articleRepository.EXPECT().
+					GetRelatedIDs(gomock.Any(), gomock.Eq("foo id"), gomock.Eq(3)).
+					Return([]string{"bar id", "baz id"}, nil)
+				m.relatedCache.EXPECT().
+					Save(gomock.Any(), gomock.Eq("foo id"), gomock.Eq([]string{"bar id", "baz id"})).
+					Return(errors.New("foo error"))
+				m.articleService.EXPECT().
+					GetByIDs(gomock.Any(), gomock.Eq([]string{"bar id", "baz id"}), gomock.Eq("user id")).
+					Return(related, nil)
+			},
+			assert: func(t *testing.T, out []dto.Article, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, related, out)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error","error":"foo error","message":"save related articles in cache error"}`, logs[0])
+			},
+		},
+		{
+			name: "get articles error",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&dto.Article{ID: "foo id"}, nil)
+				m.relatedCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]string{"bar id"}, nil)
+				m.articleService.EXPECT().GetByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []dto.Article, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get articles from article service: foo error")
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			out, err := m.newService(logger).Get(context.Background(), "foo", "user id")
+
+			tt.assert(t, out, err, logger.Logs())
+		})
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	t.Run("delete from cache error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := newServiceMocks(ctrl)
+		m.relatedCache.EXPECT().Delete(gomock.Any(), gomock.Eq("foo id")).Return(errors.New("foo error"))
+
+		err := m.newService(testutil.NewLogger()).Refresh(context.Background(), "foo id")
+
+		assert.EqualError(t, err, "delete related articles from cache: foo error")
+	})
+
+	t.Run("in background", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := newServiceMocks(ctrl)
+		m.relatedCache.EXPECT().Delete(gomock.Any(), gomock.Eq("foo id")).Return(nil).Times(2)
+		m.articleRepository.EXPECT().
+			GetRelatedIDs(gomock.Any(), gomock.Eq("foo id"), gomock.Eq(3)).
+			Return([]string{}, nil)
+		m.relatedCache.EXPECT().Save(gomock.Any(), gomock.Eq("foo id"), gomock.Eq([]string{})).Return(nil)
+
+		logger := testutil.NewLogger()
+		service := m.newService(logger)
+		ctx := context.Background()
+
+		assert.NoError(t, service.Start(ctx))
+		assert.NoError(t, service.Refresh(ctx, "foo id"))
+		assert.NoError(t, service.Stop(ctx))
+		assert.Empty(t, logger.Logs())
+		assert.ErrorIs(t, service.Refresh(ctx, "foo id"), worker.ErrPoolStopped)
+	})
+}
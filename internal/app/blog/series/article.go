@@ -0,0 +1,160 @@
+package series
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// AddArticle appends the article to the series of the user, who must be an owner of the article.
+// An article belongs to one series at most, it has to be deleted from its series before it's added to another one.
+func (s *Service) AddArticle(ctx context.Context, in *dto.SeriesArticleIn) error {
+	series, err := s.findOwnSeries(ctx, in.SeriesID, in.UserID)
+	if err != nil {
+		return err
+	}
+
+	article, err := s.findArticle(ctx, in.ArticleSlug)
+	if err != nil {
+		return err
+	}
+
+	if article.AuthorID != in.UserID {
+		role, err := s.authorRepository.FindRole(ctx, article.ID, in.UserID)
+		if err != nil {
+			return fmt.Errorf("find author role in repository: %w", err)
+		}
+
+		if role != dto.ArticleAuthorRoleOwner {
+			return errors.ErrForbidden
+		}
+	}
+
+	current, err := s.seriesRepository.FindByArticle(ctx, article.ID)
+	if err != nil {
+		return fmt.Errorf("find series of article in repository: %w", err)
+	}
+
+	if current != nil {
+		if current.ID == series.ID {
+			return nil
+		}
+
+		return errors.ErrArticleInOtherSeries
+	}
+
+	if series.ArticlesCount >= maxArticles {
+		return errors.ErrSeriesFull
+	}
+
+	if err = s.seriesRepository.AddArticle(ctx, series.ID, article.ID); err != nil {
+		return fmt.Errorf("add article in repository: %w", err)
+	}
+
+	items, err := s.seriesRepository.GetItems(ctx, series.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("get series items from repository error")
+		return nil
+	}
+
+	s.purgeCache(ctx, items)
+
+	return nil
+}
+
+// DeleteArticle removes the article from the series of the user.
+func (s *Service) DeleteArticle(ctx context.Context, in *dto.SeriesArticleIn) error {
+	series, err := s.findOwnSeries(ctx, in.SeriesID, in.UserID)
+	if err != nil {
+		return err
+	}
+
+	article, err := s.findArticle(ctx, in.ArticleSlug)
+	if err != nil {
+		return err
+	}
+
+	// the deleted article loses its navigation too, so items are taken while it's still in the series
+	items, err := s.seriesRepository.GetItems(ctx, series.ID)
+	if err != nil {
+		return fmt.Errorf("get series items from repository: %w", err)
+	}
+
+	if err = s.seriesRepository.DeleteArticle(ctx, series.ID, article.ID); err != nil {
+		return fmt.Errorf("delete article in repository: %w", err)
+	}
+
+	s.purgeCache(ctx, items)
+
+	return nil
+}
+
+// Reorder puts articles of the series of the user in the given order, which must list all of them.
+func (s *Service) Reorder(ctx context.Context, in *dto.ReorderSeriesIn) error {
+	series, err := s.findOwnSeries(ctx, in.SeriesID, in.UserID)
+	if err != nil {
+		return err
+	}
+
+	items, err := s.seriesRepository.GetItems(ctx, series.ID)
+	if err != nil {
+		return fmt.Errorf("get series items from repository: %w", err)
+	}
+
+	if len(items) != len(in.ArticleSlugs) {
+		return errors.ErrSeriesOrderMismatch
+	}
+
+	idsBySlug := make(map[string]string, len(items))
+	for _, item := range items {
+		idsBySlug[item.ArticleSlug] = item.ArticleID
+	}
+
+	ids := make([]string, 0, len(in.ArticleSlugs))
+	for _, slug := range in.ArticleSlugs {
+		id, ok := idsBySlug[slug]
+		if !ok {
+			return errors.ErrSeriesOrderMismatch
+		}
+
+		// a repeated slug would leave another article out
+		delete(idsBySlug, slug)
+		ids = append(ids, id)
+	}
+
+	if err = s.seriesRepository.Reorder(ctx, series.ID, ids); err != nil {
+		return fmt.Errorf("reorder series in repository: %w", err)
+	}
+
+	s.purgeCache(ctx, items)
+
+	return nil
+}
+
+func (s *Service) findArticle(ctx context.Context, slug string) (*dto.Article, error) {
+	article, err := s.articleRepository.Find(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("find article in repository: %w", err)
+	}
+
+	if article == nil {
+		return nil, errors.ErrArticleNotFound
+	}
+
+	return article, nil
+}
+
+// purgeCache drops cached pages of all articles of the series, since positions and neighbours of all of them change.
+// Failures are only logged: the series is already saved and cached pages expire anyway.
+func (s *Service) purgeCache(ctx context.Context, items []dto.SeriesItem) {
+	slugs := make([]string, 0, len(items))
+	for _, item := range items {
+		slugs = append(slugs, item.ArticleSlug)
+	}
+
+	if err := s.articleCache.PurgeArticles(ctx, slugs...); err != nil {
+		s.logger.Error().Err(err).Msg("purge articles in cache error")
+	}
+}
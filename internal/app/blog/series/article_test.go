@@ -0,0 +1,288 @@
+package series
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestAddArticle(t *testing.T) {
+	in := &dto.SeriesArticleIn{UserID: "user id", SeriesID: "series id", ArticleSlug: "baz"}
+	article := &dto.Article{ID: "3", Slug: "baz", AuthorID: "user id"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error, logs []string)
+	}{
+		{
+			name: "series of another user",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(&dto.Series{ID: "series id", OwnerID: "another user id"}, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("baz")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.ErrorIs(t, err, apperrors.ErrArticleNotFound)
+			},
+		},
+		{
+			name: "article of contributor",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.articleRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("baz")).
+					Return(&dto.Article{ID: "3", Slug: "baz", AuthorID: "owner id"}, nil)
+				m.authorRepository.EXPECT().
+					FindRole(gomock.Any(), gomock.Eq("3"), gomock.Eq("user id")).
+					Return(dto.ArticleAuthorRoleContributor, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "article in another series",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("baz")).Return(article, nil)
+				m.seriesRepository.EXPECT().
+					FindByArticle(gomock.Any(), gomock.Eq("3")).
+					Return(&dto.ArticleSeries{ID: "another series id"}, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.ErrorIs(t, err, apperrors.ErrArticleInOtherSeries)
+			},
+		},
+		{
+			name: "article in the series already",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("baz")).Return(article, nil)
+				m.seriesRepository.EXPECT().
+					FindByArticle(gomock.Any(), gomock.Eq("3")).
+					Return(&dto.ArticleSeries{ID: "series id"}, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "series full",
+			setup: func(m serviceMocks) {
+				full := ownSeries()
+				full.ArticlesCount = maxArticles
+				m.expectFindSeries(full, nil)
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("baz")).Return(article, nil)
+				m.seriesRepository.EXPECT().FindByArticle(gomock.Any(), gomock.Eq("3")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.ErrorIs(t, err, apperrors.ErrSeriesFull)
+			},
+		},
+		{
+			name: "add error",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("baz")).Return(article, nil)
+				m.seriesRepository.EXPECT().FindByArticle(gomock.Any(), gomock.Eq("3")).Return(nil, nil)
+				m.seriesRepository.EXPECT().
+					AddArticle(gomock.Any(), gomock.Eq("series id"), gomock.Eq("3")).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "add article in repository: foo error")
+			},
+		},
+		{
+			name: "ok by co-owner",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.articleRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("baz")).
+					Return(&dto.Article{ID: "3", Slug: "baz", AuthorID: "owner id"}, nil)
+				m.authorRepository.EXPECT().
+					FindRole(gomock.Any(), gomock.Eq("3"), gomock.Eq("user id")).
+					Return(dto.ArticleAuthorRoleOwner, nil)
+				m.seriesRepository.EXPECT().FindByArticle(gomock.Any(), gomock.Eq("3")).Return(nil, nil)
+				m.seriesRepository.EXPECT().
+					AddArticle(gomock.Any(), gomock.Eq("series id"), gomock.Eq("3")).
+					Return(nil)
+				m.expectGetItems(append(seriesItems(), dto.SeriesItem{ArticleID: "3", ArticleSlug: "baz"}), nil)
+				m.articleCache.EXPECT().
+					PurgeArticles(gomock.Any(), gomock.Eq("foo"), gomock.Eq("bar"), gomock.Eq("baz")).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{`{"level":"error","error":"foo error","message":"purge articles in cache error"}`}, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			err := m.newService(logger).AddArticle(context.Background(), in)
+
+			tt.assert(t, err, logger.Logs())
+		})
+	}
+}
+
+func TestDeleteArticle(t *testing.T) {
+	in := &dto.SeriesArticleIn{UserID: "user id", SeriesID: "series id", ArticleSlug: "foo"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "series not found",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(nil, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrSeriesNotFound)
+			},
+		},
+		{
+			name: "delete error",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo")).Return(&dto.Article{ID: "1", Slug: "foo"}, nil)
+				m.expectGetItems(seriesItems(), nil)
+				m.seriesRepository.EXPECT().
+					DeleteArticle(gomock.Any(), gomock.Eq("series id"), gomock.Eq("1")).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "delete article in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.articleRepository.EXPECT().Find(gomock.Any(), gomock.Eq("foo")).Return(&dto.Article{ID: "1", Slug: "foo"}, nil)
+				m.expectGetItems(seriesItems(), nil)
+				m.seriesRepository.EXPECT().
+					DeleteArticle(gomock.Any(), gomock.Eq("series id"), gomock.Eq("1")).
+					Return(nil)
+				m.articleCache.EXPECT().PurgeArticles(gomock.Any(), gomock.Eq("foo"), gomock.Eq("bar")).Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService(testutil.NewLogger()).DeleteArticle(context.Background(), in)
+
+			tt.assert(t, err)
+		})
+	}
+}
+
+func TestReorder(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		slugs  []string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name:  "missing article",
+			slugs: []string{"bar"},
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.expectGetItems(seriesItems(), nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrSeriesOrderMismatch)
+			},
+		},
+		{
+			name:  "repeated article",
+			slugs: []string{"bar", "bar"},
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.expectGetItems(seriesItems(), nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrSeriesOrderMismatch)
+			},
+		},
+		{
+			name:  "reorder error",
+			slugs: []string{"bar", "foo"},
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.expectGetItems(seriesItems(), nil)
+				m.seriesRepository.EXPECT().
+					Reorder(gomock.Any(), gomock.Eq("series id"), gomock.Eq([]string{"2", "1"})).
+					Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "reorder series in repository: foo error")
+			},
+		},
+		{
+			name:  "ok",
+			slugs: []string{"bar", "foo"},
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.expectGetItems(seriesItems(), nil)
+				m.seriesRepository.EXPECT().
+					Reorder(gomock.Any(), gomock.Eq("series id"), gomock.Eq([]string{"2", "1"})).
+					Return(nil)
+				m.articleCache.EXPECT().PurgeArticles(gomock.Any(), gomock.Eq("foo"), gomock.Eq("bar")).Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService(testutil.NewLogger()).Reorder(context.Background(), &dto.ReorderSeriesIn{
+				UserID:       "user id",
+				SeriesID:     "series id",
+				ArticleSlugs: tt.slugs,
+			})
+
+			tt.assert(t, err)
+		})
+	}
+}
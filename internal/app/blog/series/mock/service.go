@@ -0,0 +1,303 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockseriesRepository is a mock of seriesRepository interface.
+type MockseriesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockseriesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockseriesRepositoryMockRecorder is the mock recorder for MockseriesRepository.
+type MockseriesRepositoryMockRecorder struct {
+	mock *MockseriesRepository
+}
+
+// NewMockseriesRepository creates a new mock instance.
+func NewMockseriesRepository(ctrl *gomock.Controller) *MockseriesRepository {
+	mock := &MockseriesRepository{ctrl: ctrl}
+	mock.recorder = &MockseriesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockseriesRepository) EXPECT() *MockseriesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddArticle mocks base method.
+func (m *MockseriesRepository) AddArticle(ctx context.Context, seriesID, articleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddArticle", ctx, seriesID, articleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddArticle indicates an expected call of AddArticle.
+func (mr *MockseriesRepositoryMockRecorder) AddArticle(ctx, seriesID, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddArticle", reflect.TypeOf((*MockseriesRepository)(nil).AddArticle), ctx, seriesID, articleID)
+}
+
+// DeleteArticle mocks base method.
+func (m *MockseriesRepository) DeleteArticle(ctx context.Context, seriesID, articleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticle", ctx, seriesID, articleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticle indicates an expected call of DeleteArticle.
+func (mr *MockseriesRepositoryMockRecorder) DeleteArticle(ctx, seriesID, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticle", reflect.TypeOf((*MockseriesRepository)(nil).DeleteArticle), ctx, seriesID, articleID)
+}
+
+// Find mocks base method.
+func (m *MockseriesRepository) Find(ctx context.Context, id string) (*dto.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(*dto.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockseriesRepositoryMockRecorder) Find(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockseriesRepository)(nil).Find), ctx, id)
+}
+
+// FindByArticle mocks base method.
+func (m *MockseriesRepository) FindByArticle(ctx context.Context, articleID string) (*dto.ArticleSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByArticle", ctx, articleID)
+	ret0, _ := ret[0].(*dto.ArticleSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByArticle indicates an expected call of FindByArticle.
+func (mr *MockseriesRepositoryMockRecorder) FindByArticle(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByArticle", reflect.TypeOf((*MockseriesRepository)(nil).FindByArticle), ctx, articleID)
+}
+
+// GetItems mocks base method.
+func (m *MockseriesRepository) GetItems(ctx context.Context, seriesID string) ([]dto.SeriesItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItems", ctx, seriesID)
+	ret0, _ := ret[0].([]dto.SeriesItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItems indicates an expected call of GetItems.
+func (mr *MockseriesRepositoryMockRecorder) GetItems(ctx, seriesID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItems", reflect.TypeOf((*MockseriesRepository)(nil).GetItems), ctx, seriesID)
+}
+
+// Reorder mocks base method.
+func (m *MockseriesRepository) Reorder(ctx context.Context, seriesID string, articleIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, seriesID, articleIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockseriesRepositoryMockRecorder) Reorder(ctx, seriesID, articleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockseriesRepository)(nil).Reorder), ctx, seriesID, articleIDs)
+}
+
+// Save mocks base method.
+func (m *MockseriesRepository) Save(ctx context.Context, series *dto.Series) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, series)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockseriesRepositoryMockRecorder) Save(ctx, series any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockseriesRepository)(nil).Save), ctx, series)
+}
+
+// MockarticleRepository is a mock of articleRepository interface.
+type MockarticleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleRepositoryMockRecorder is the mock recorder for MockarticleRepository.
+type MockarticleRepositoryMockRecorder struct {
+	mock *MockarticleRepository
+}
+
+// NewMockarticleRepository creates a new mock instance.
+func NewMockarticleRepository(ctrl *gomock.Controller) *MockarticleRepository {
+	mock := &MockarticleRepository{ctrl: ctrl}
+	mock.recorder = &MockarticleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleRepository) EXPECT() *MockarticleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockarticleRepository) Find(ctx context.Context, slug string) (*dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, slug)
+	ret0, _ := ret[0].(*dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockarticleRepositoryMockRecorder) Find(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockarticleRepository)(nil).Find), ctx, slug)
+}
+
+// MockauthorRepository is a mock of authorRepository interface.
+type MockauthorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorRepositoryMockRecorder is the mock recorder for MockauthorRepository.
+type MockauthorRepositoryMockRecorder struct {
+	mock *MockauthorRepository
+}
+
+// NewMockauthorRepository creates a new mock instance.
+func NewMockauthorRepository(ctrl *gomock.Controller) *MockauthorRepository {
+	mock := &MockauthorRepository{ctrl: ctrl}
+	mock.recorder = &MockauthorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthorRepository) EXPECT() *MockauthorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindRole mocks base method.
+func (m *MockauthorRepository) FindRole(ctx context.Context, articleID, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRole", ctx, articleID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRole indicates an expected call of FindRole.
+func (mr *MockauthorRepositoryMockRecorder) FindRole(ctx, articleID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRole", reflect.TypeOf((*MockauthorRepository)(nil).FindRole), ctx, articleID, userID)
+}
+
+// MockarticleService is a mock of articleService interface.
+type MockarticleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleServiceMockRecorder is the mock recorder for MockarticleService.
+type MockarticleServiceMockRecorder struct {
+	mock *MockarticleService
+}
+
+// NewMockarticleService creates a new mock instance.
+func NewMockarticleService(ctrl *gomock.Controller) *MockarticleService {
+	mock := &MockarticleService{ctrl: ctrl}
+	mock.recorder = &MockarticleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleService) EXPECT() *MockarticleServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByIDs mocks base method.
+func (m *MockarticleService) GetByIDs(ctx context.Context, ids []string, userID string) ([]dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids, userID)
+	ret0, _ := ret[0].([]dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockarticleServiceMockRecorder) GetByIDs(ctx, ids, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockarticleService)(nil).GetByIDs), ctx, ids, userID)
+}
+
+// MockarticleCache is a mock of articleCache interface.
+type MockarticleCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleCacheMockRecorder is the mock recorder for MockarticleCache.
+type MockarticleCacheMockRecorder struct {
+	mock *MockarticleCache
+}
+
+// NewMockarticleCache creates a new mock instance.
+func NewMockarticleCache(ctrl *gomock.Controller) *MockarticleCache {
+	mock := &MockarticleCache{ctrl: ctrl}
+	mock.recorder = &MockarticleCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleCache) EXPECT() *MockarticleCacheMockRecorder {
+	return m.recorder
+}
+
+// PurgeArticles mocks base method.
+func (m *MockarticleCache) PurgeArticles(ctx context.Context, slugs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range slugs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeArticles", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeArticles indicates an expected call of PurgeArticles.
+func (mr *MockarticleCacheMockRecorder) PurgeArticles(ctx any, slugs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, slugs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeArticles", reflect.TypeOf((*MockarticleCache)(nil).PurgeArticles), varargs...)
+}
@@ -0,0 +1,78 @@
+package series
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// Create creates an empty series of the user.
+func (s *Service) Create(ctx context.Context, in *dto.CreateSeriesIn) (*dto.Series, error) {
+	series := &dto.Series{
+		OwnerID:     in.UserID,
+		Title:       in.Title,
+		Description: in.Description,
+	}
+
+	if err := s.seriesRepository.Save(ctx, series); err != nil {
+		return nil, fmt.Errorf("save series in repository: %w", err)
+	}
+
+	return series, nil
+}
+
+// Get returns the series with its articles in the series order. Series are public, hidden articles are left out.
+func (s *Service) Get(ctx context.Context, id, userID string) (*dto.GetSeriesOut, error) {
+	series, err := s.findSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.seriesRepository.GetItems(ctx, series.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get series items from repository: %w", err)
+	}
+
+	articles, err := s.articleService.GetByIDs(ctx, itemIDs(items), userID)
+	if err != nil {
+		return nil, fmt.Errorf("get articles: %w", err)
+	}
+
+	return &dto.GetSeriesOut{Series: series, Articles: articles}, nil
+}
+
+func (s *Service) findSeries(ctx context.Context, id string) (*dto.Series, error) {
+	series, err := s.seriesRepository.Find(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find series in repository: %w", err)
+	}
+
+	if series == nil {
+		return nil, errors.ErrSeriesNotFound
+	}
+
+	return series, nil
+}
+
+func (s *Service) findOwnSeries(ctx context.Context, id, userID string) (*dto.Series, error) {
+	series, err := s.findSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if series.OwnerID != userID {
+		return nil, errors.ErrForbidden
+	}
+
+	return series, nil
+}
+
+func itemIDs(items []dto.SeriesItem) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ArticleID)
+	}
+	return ids
+}
@@ -0,0 +1,171 @@
+package series
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/series/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	seriesRepository  *mock.MockseriesRepository
+	articleRepository *mock.MockarticleRepository
+	authorRepository  *mock.MockauthorRepository
+	articleService    *mock.MockarticleService
+	articleCache      *mock.MockarticleCache
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		seriesRepository:  mock.NewMockseriesRepository(ctrl),
+		articleRepository: mock.NewMockarticleRepository(ctrl),
+		authorRepository:  mock.NewMockauthorRepository(ctrl),
+		articleService:    mock.NewMockarticleService(ctrl),
+		articleCache:      mock.NewMockarticleCache(ctrl),
+	}
+}
+
+func (m serviceMocks) newService(logger log.Logger) *Service {
+	return NewService(m.seriesRepository, m.articleRepository, m.authorRepository, m.articleService, m.articleCache, logger)
+}
+
+func (m serviceMocks) expectFindSeries(series *dto.Series, err error) {
+	m.seriesRepository.EXPECT().Find(gomock.Any(), gomock.Eq("series id")).Return(series, err)
+}
+
+func (m serviceMocks) expectGetItems(items []dto.SeriesItem, err error) {
+	m.seriesRepository.EXPECT().GetItems(gomock.Any(), gomock.Eq("series id")).Return(items, err)
+}
+
+func ownSeries() *dto.Series {
+	return &dto.Series{ID: "series id", OwnerID: "user id", Title: "Foo", ArticlesCount: 2}
+}
+
+func seriesItems() []dto.SeriesItem {
+	return []dto.SeriesItem{{ArticleID: "1", ArticleSlug: "foo"}, {ArticleID: "2", ArticleSlug: "bar"}}
+}
+
+func TestCreate(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Series, err error)
+	}{
+		{
+			name: "save error",
+			setup: func(m serviceMocks) {
+				m.seriesRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Series, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "save series in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.seriesRepository.EXPECT().
+					Save(gomock.Any(), gomock.Eq(&dto.Series{OwnerID: "user id", Title: "Foo", Description: "About foo"})).
+					Do(func(_ context.Context, series *dto.Series) {
+						series.ID = "series id"
+					}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.Series, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.Series{ID: "series id", OwnerID: "user id", Title: "Foo", Description: "About foo"}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Create(context.Background(), &dto.CreateSeriesIn{
+				UserID:      "user id",
+				Title:       "Foo",
+				Description: "About foo",
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.GetSeriesOut, err error)
+	}{
+		{
+			name: "find error",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetSeriesOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find series in repository: foo error")
+			},
+		},
+		{
+			name: "not found",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetSeriesOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrSeriesNotFound)
+			},
+		},
+		{
+			name: "get articles error",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.expectGetItems(seriesItems(), nil)
+				m.articleService.EXPECT().GetByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetSeriesOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get articles: foo error")
+			},
+		},
+		{
+			name: "ok by another user",
+			setup: func(m serviceMocks) {
+				m.expectFindSeries(ownSeries(), nil)
+				m.expectGetItems(seriesItems(), nil)
+				m.articleService.EXPECT().
+					GetByIDs(gomock.Any(), gomock.Eq([]string{"1", "2"}), gomock.Eq("reader id")).
+					Return([]dto.Article{{ID: "1"}, {ID: "2"}}, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetSeriesOut, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetSeriesOut{Series: ownSeries(), Articles: []dto.Article{{ID: "1"}, {ID: "2"}}}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Get(context.Background(), "series id", "reader id")
+
+			tt.assert(t, out, err)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package series
+
+import (
+	"context"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+// maxArticles bounds series, since series are returned with all their articles.
+const maxArticles = 200
+
+type seriesRepository interface {
+	Find(ctx context.Context, id string) (*dto.Series, error)
+	Save(ctx context.Context, series *dto.Series) error
+	// GetItems returns articles of the series in the series order.
+	GetItems(ctx context.Context, seriesID string) ([]dto.SeriesItem, error)
+	// FindByArticle returns the series of the article with its neighbours, nil if the article is in no series.
+	FindByArticle(ctx context.Context, articleID string) (*dto.ArticleSeries, error)
+	AddArticle(ctx context.Context, seriesID, articleID string) error
+	DeleteArticle(ctx context.Context, seriesID, articleID string) error
+	Reorder(ctx context.Context, seriesID string, articleIDs []string) error
+}
+
+type articleRepository interface {
+	Find(ctx context.Context, slug string) (*dto.Article, error)
+}
+
+type authorRepository interface {
+	// FindRole returns the role of the user among authors of the article, empty if the user is not one of them.
+	FindRole(ctx context.Context, articleID, userID string) (string, error)
+}
+
+type articleService interface {
+	GetByIDs(ctx context.Context, ids []string, userID string) ([]dto.Article, error)
+}
+
+// articleCache is purged after series change, since single articles are cached with their series navigation.
+type articleCache interface {
+	PurgeArticles(ctx context.Context, slugs ...string) error
+}
+
+type Service struct {
+	seriesRepository  seriesRepository
+	articleRepository articleRepository
+	authorRepository  authorRepository
+	articleService    articleService
+	articleCache      articleCache
+	logger            log.Logger
+}
+
+func NewService(
+	seriesRepository seriesRepository,
+	articleRepository articleRepository,
+	authorRepository authorRepository,
+	articleService articleService,
+	articleCache articleCache,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		seriesRepository:  seriesRepository,
+		articleRepository: articleRepository,
+		authorRepository:  authorRepository,
+		articleService:    articleService,
+		articleCache:      articleCache,
+		logger:            logger,
+	}
+}
@@ -0,0 +1,209 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockarticleRepository is a mock of articleRepository interface.
+type MockarticleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleRepositoryMockRecorder is the mock recorder for MockarticleRepository.
+type MockarticleRepositoryMockRecorder struct {
+	mock *MockarticleRepository
+}
+
+// NewMockarticleRepository creates a new mock instance.
+func NewMockarticleRepository(ctrl *gomock.Controller) *MockarticleRepository {
+	mock := &MockarticleRepository{ctrl: ctrl}
+	mock.recorder = &MockarticleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleRepository) EXPECT() *MockarticleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSitemapArticles mocks base method.
+func (m *MockarticleRepository) GetSitemapArticles(ctx context.Context, offset, limit int) ([]dto.SitemapArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSitemapArticles", ctx, offset, limit)
+	ret0, _ := ret[0].([]dto.SitemapArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSitemapArticles indicates an expected call of GetSitemapArticles.
+func (mr *MockarticleRepositoryMockRecorder) GetSitemapArticles(ctx, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSitemapArticles", reflect.TypeOf((*MockarticleRepository)(nil).GetSitemapArticles), ctx, offset, limit)
+}
+
+// GetSitemapFiles mocks base method.
+func (m *MockarticleRepository) GetSitemapFiles(ctx context.Context, size int) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSitemapFiles", ctx, size)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSitemapFiles indicates an expected call of GetSitemapFiles.
+func (mr *MockarticleRepositoryMockRecorder) GetSitemapFiles(ctx, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSitemapFiles", reflect.TypeOf((*MockarticleRepository)(nil).GetSitemapFiles), ctx, size)
+}
+
+// GetSitemapPosition mocks base method.
+func (m *MockarticleRepository) GetSitemapPosition(ctx context.Context, articleID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSitemapPosition", ctx, articleID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSitemapPosition indicates an expected call of GetSitemapPosition.
+func (mr *MockarticleRepositoryMockRecorder) GetSitemapPosition(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSitemapPosition", reflect.TypeOf((*MockarticleRepository)(nil).GetSitemapPosition), ctx, articleID)
+}
+
+// MocksitemapRenderer is a mock of sitemapRenderer interface.
+type MocksitemapRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksitemapRendererMockRecorder
+	isgomock struct{}
+}
+
+// MocksitemapRendererMockRecorder is the mock recorder for MocksitemapRenderer.
+type MocksitemapRendererMockRecorder struct {
+	mock *MocksitemapRenderer
+}
+
+// NewMocksitemapRenderer creates a new mock instance.
+func NewMocksitemapRenderer(ctrl *gomock.Controller) *MocksitemapRenderer {
+	mock := &MocksitemapRenderer{ctrl: ctrl}
+	mock.recorder = &MocksitemapRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksitemapRenderer) EXPECT() *MocksitemapRendererMockRecorder {
+	return m.recorder
+}
+
+// RenderIndex mocks base method.
+func (m *MocksitemapRenderer) RenderIndex(sitemaps []dto.SitemapEntry) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderIndex", sitemaps)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderIndex indicates an expected call of RenderIndex.
+func (mr *MocksitemapRendererMockRecorder) RenderIndex(sitemaps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderIndex", reflect.TypeOf((*MocksitemapRenderer)(nil).RenderIndex), sitemaps)
+}
+
+// RenderURLSet mocks base method.
+func (m *MocksitemapRenderer) RenderURLSet(urls []dto.SitemapEntry) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderURLSet", urls)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderURLSet indicates an expected call of RenderURLSet.
+func (mr *MocksitemapRendererMockRecorder) RenderURLSet(urls any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderURLSet", reflect.TypeOf((*MocksitemapRenderer)(nil).RenderURLSet), urls)
+}
+
+// MocksitemapCache is a mock of sitemapCache interface.
+type MocksitemapCache struct {
+	ctrl     *gomock.Controller
+	recorder *MocksitemapCacheMockRecorder
+	isgomock struct{}
+}
+
+// MocksitemapCacheMockRecorder is the mock recorder for MocksitemapCache.
+type MocksitemapCacheMockRecorder struct {
+	mock *MocksitemapCache
+}
+
+// NewMocksitemapCache creates a new mock instance.
+func NewMocksitemapCache(ctrl *gomock.Controller) *MocksitemapCache {
+	mock := &MocksitemapCache{ctrl: ctrl}
+	mock.recorder = &MocksitemapCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksitemapCache) EXPECT() *MocksitemapCacheMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MocksitemapCache) Add(ctx context.Context, file int, sitemap []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, file, sitemap)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MocksitemapCacheMockRecorder) Add(ctx, file, sitemap any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MocksitemapCache)(nil).Add), ctx, file, sitemap)
+}
+
+// Delete mocks base method.
+func (m *MocksitemapCache) Delete(ctx context.Context, file int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, file)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MocksitemapCacheMockRecorder) Delete(ctx, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocksitemapCache)(nil).Delete), ctx, file)
+}
+
+// Get mocks base method.
+func (m *MocksitemapCache) Get(ctx context.Context, file int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, file)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MocksitemapCacheMockRecorder) Get(ctx, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MocksitemapCache)(nil).Get), ctx, file)
+}
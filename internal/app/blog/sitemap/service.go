@@ -0,0 +1,268 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package sitemap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/worker"
+)
+
+type articleRepository interface {
+	// GetSitemapArticles returns articles with entries in the window of sitemap elements.
+	GetSitemapArticles(ctx context.Context, offset, limit int) ([]dto.SitemapArticle, error)
+	// GetSitemapFiles returns the last modification time of every file of the size in elements.
+	GetSitemapFiles(ctx context.Context, size int) ([]time.Time, error)
+	// GetSitemapPosition returns the number of sitemap elements listed before the article.
+	GetSitemapPosition(ctx context.Context, articleID string) (int, error)
+}
+
+type sitemapRenderer interface {
+	RenderIndex(sitemaps []dto.SitemapEntry) ([]byte, error)
+	RenderURLSet(urls []dto.SitemapEntry) ([]byte, error)
+}
+
+type sitemapCache interface {
+	Get(ctx context.Context, file int) ([]byte, error)
+	Add(ctx context.Context, file int, sitemap []byte) error
+	Delete(ctx context.Context, file int) error
+}
+
+// refreshElement is a queued refresh of the sitemap files of the article.
+type refreshElement struct {
+	articleID string
+	shifted   bool
+}
+
+// Service serves the sitemap index and sitemap files listing articles.
+// Files are kept rendered in the cache and regenerated in background when articles change.
+type Service struct {
+	siteURL           url.URL
+	fileSize          int
+	articleRepository articleRepository
+	sitemapRenderer   sitemapRenderer
+	sitemapCache      sitemapCache
+	logger            log.Logger
+
+	refresher *worker.Pool[refreshElement]
+}
+
+// NewService creates the sitemap service.
+// siteURL is the public URL of the blog used in sitemaps, fileSize is the number of elements in a sitemap file,
+// see dto.SitemapArticle.EntryElements.
+func NewService(
+	siteURL url.URL,
+	fileSize int,
+	refresher worker.PoolConfig,
+	articleRepository articleRepository,
+	sitemapRenderer sitemapRenderer,
+	sitemapCache sitemapCache,
+	logger log.Logger,
+) *Service {
+	s := &Service{
+		siteURL:           siteURL,
+		fileSize:          fileSize,
+		articleRepository: articleRepository,
+		sitemapRenderer:   sitemapRenderer,
+		sitemapCache:      sitemapCache,
+		logger:            logger,
+	}
+	s.refresher = worker.NewPool(refresher, s.refresh, logger)
+	return s
+}
+
+// Get returns the rendered sitemap file, dto.SitemapIndexFile is the sitemap index.
+func (s *Service) Get(ctx context.Context, file int) ([]byte, error) {
+	if file < dto.SitemapIndexFile {
+		return nil, apperrors.ErrSitemapNotFound
+	}
+
+	sitemap, err := s.sitemapCache.Get(ctx, file)
+	switch {
+	case err == nil:
+		return sitemap, nil
+	case errors.Is(err, apperrors.ErrNoCache):
+		// need to generate
+	default:
+		return nil, fmt.Errorf("get sitemap from cache: %w", err)
+	}
+
+	if file == dto.SitemapIndexFile {
+		sitemap, err = s.renderIndex(ctx)
+	} else {
+		sitemap, err = s.renderFile(ctx, file)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.sitemapCache.Add(ctx, file, sitemap); err != nil {
+		s.logger.Error().Err(err).Msg("add sitemap to cache error")
+	}
+
+	return sitemap, nil
+}
+
+// Refresh queues regeneration of sitemap files affected by the changed article.
+// shifted tells that the article entered or left sitemaps, moved, or changed its published translations,
+// which shifts the elements of the articles listed after it.
+func (s *Service) Refresh(ctx context.Context, articleID string, shifted bool) error {
+	if err := s.refresher.Push(ctx, refreshElement{articleID: articleID, shifted: shifted}); err != nil {
+		return fmt.Errorf("push to refresher: %w", err)
+	}
+
+	return nil
+}
+
+// Start starts the background refresher.
+func (s *Service) Start(ctx context.Context) error {
+	return s.refresher.Start(ctx)
+}
+
+// Stop stops the background refresher after queued refreshes are done.
+func (s *Service) Stop(ctx context.Context) error {
+	return s.refresher.Stop(ctx)
+}
+
+// refresh regenerates the file of the article and the index.
+// Articles are listed in the order they were created, so an edit changes only the file of the article
+// and a new article lands in the last file. A shifting change moves elements of the files after the article,
+// so these are regenerated too, and the file past the last is dropped in case it got emptied.
+func (s *Service) refresh(ctx context.Context, element refreshElement) error {
+	position, err := s.articleRepository.GetSitemapPosition(ctx, element.articleID)
+	if err != nil {
+		return fmt.Errorf("get sitemap position from repository: %w", err)
+	}
+
+	files, err := s.articleRepository.GetSitemapFiles(ctx, s.fileSize)
+	if err != nil {
+		return fmt.Errorf("get sitemap files from repository: %w", err)
+	}
+
+	// an article past the last file isn't listed, so it has no file to regenerate
+	first := position/s.fileSize + 1
+	last := min(first, len(files))
+	if element.shifted {
+		last = len(files)
+	}
+
+	for file := first; file <= last; file++ {
+		sitemap, err := s.renderFile(ctx, file)
+		if err != nil {
+			return err
+		}
+
+		if err = s.sitemapCache.Add(ctx, file, sitemap); err != nil {
+			return fmt.Errorf("add sitemap to cache: %w", err)
+		}
+	}
+
+	if element.shifted {
+		if err = s.sitemapCache.Delete(ctx, len(files)+1); err != nil {
+			return fmt.Errorf("delete sitemap from cache: %w", err)
+		}
+	}
+
+	index, err := s.sitemapRenderer.RenderIndex(s.indexEntries(files))
+	if err != nil {
+		return fmt.Errorf("render sitemap index: %w", err)
+	}
+
+	if err = s.sitemapCache.Add(ctx, dto.SitemapIndexFile, index); err != nil {
+		return fmt.Errorf("add sitemap index to cache: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) renderIndex(ctx context.Context) ([]byte, error) {
+	files, err := s.articleRepository.GetSitemapFiles(ctx, s.fileSize)
+	if err != nil {
+		return nil, fmt.Errorf("get sitemap files from repository: %w", err)
+	}
+
+	index, err := s.sitemapRenderer.RenderIndex(s.indexEntries(files))
+	if err != nil {
+		return nil, fmt.Errorf("render sitemap index: %w", err)
+	}
+
+	return index, nil
+}
+
+func (s *Service) renderFile(ctx context.Context, file int) ([]byte, error) {
+	first := (file - 1) * s.fileSize
+	articles, err := s.articleRepository.GetSitemapArticles(ctx, first, s.fileSize)
+	if err != nil {
+		return nil, fmt.Errorf("get sitemap articles from repository: %w", err)
+	}
+
+	urls := make([]dto.SitemapEntry, 0, len(articles))
+	for _, article := range articles {
+		// entries of a translated article may be split between files, an entry is in the file of its first element
+		for i, entry := range s.articleEntries(article) {
+			if position := article.FirstElement + i*article.EntryElements(); position >= first && position < first+s.fileSize {
+				urls = append(urls, entry)
+			}
+		}
+	}
+
+	if len(urls) == 0 {
+		return nil, apperrors.ErrSitemapNotFound
+	}
+
+	sitemap, err := s.sitemapRenderer.RenderURLSet(urls)
+	if err != nil {
+		return nil, fmt.Errorf("render sitemap: %w", err)
+	}
+
+	return sitemap, nil
+}
+
+// articleEntries lists every language version of the article, each one linking all versions as alternates.
+func (s *Service) articleEntries(article dto.SitemapArticle) []dto.SitemapEntry {
+	if len(article.Translations) == 0 {
+		return []dto.SitemapEntry{{
+			Loc:          s.link("articles", article.Slug),
+			LastModified: article.LastModified,
+		}}
+	}
+
+	alternates := make([]dto.SitemapAlternate, 0, len(article.Translations)+1)
+	alternates = append(alternates, dto.SitemapAlternate{Language: article.Locale, Loc: s.link("articles", article.Slug)})
+	for _, translation := range article.Translations {
+		alternates = append(alternates, dto.SitemapAlternate{Language: translation.Locale, Loc: s.link("articles", translation.Slug)})
+	}
+
+	entries := make([]dto.SitemapEntry, 0, len(alternates))
+	for _, alternate := range alternates {
+		entries = append(entries, dto.SitemapEntry{
+			Loc:          alternate.Loc,
+			LastModified: article.LastModified,
+			Alternates:   alternates,
+		})
+	}
+
+	return entries
+}
+
+func (s *Service) indexEntries(files []time.Time) []dto.SitemapEntry {
+	sitemaps := make([]dto.SitemapEntry, 0, len(files))
+	for i, lastModified := range files {
+		sitemaps = append(sitemaps, dto.SitemapEntry{
+			Loc:          s.link("sitemaps", dto.SitemapFileName(i+1)),
+			LastModified: lastModified,
+		})
+	}
+
+	return sitemaps
+}
+
+func (s *Service) link(elem ...string) string {
+	return s.siteURL.JoinPath(elem...).String()
+}
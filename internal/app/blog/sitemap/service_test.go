@@ -0,0 +1,401 @@
+package sitemap
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/sitemap/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/worker"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	articleRepository *mock.MockarticleRepository
+	sitemapRenderer   *mock.MocksitemapRenderer
+	sitemapCache      *mock.MocksitemapCache
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		articleRepository: mock.NewMockarticleRepository(ctrl),
+		sitemapRenderer:   mock.NewMocksitemapRenderer(ctrl),
+		sitemapCache:      mock.NewMocksitemapCache(ctrl),
+	}
+}
+
+func (m serviceMocks) newService(logger log.Logger) *Service {
+	siteURL, _ := url.Parse("https://example.com/blog")
+	refresher := worker.PoolConfig{Name: "sitemap refresher", Workers: 1, QueueSize: 1, Policy: worker.PolicyBlock}
+	return NewService(*siteURL, 2, refresher, m.articleRepository, m.sitemapRenderer, m.sitemapCache, logger)
+}
+
+var (
+	firstModified  = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondModified = time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	indexEntries   = []dto.SitemapEntry{
+		{Loc: "https://example.com/blog/sitemaps/articles-1.xml", LastModified: firstModified},
+		{Loc: "https://example.com/blog/sitemaps/articles-2.xml", LastModified: secondModified},
+	}
+)
+
+func TestGet(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		file   int
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out []byte, err error, logs []string)
+	}{
+		{
+			name:  "negative file",
+			file:  -1,
+			setup: func(m serviceMocks) {},
+			assert: func(t *testing.T, out []byte, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrSitemapNotFound)
+			},
+		},
+		{
+			name: "cached",
+			file: 2,
+			setup: func(m serviceMocks) {
+				m.sitemapCache.EXPECT().Get(gomock.Any(), gomock.Eq(2)).Return([]byte("cached"), nil)
+			},
+			assert: func(t *testing.T, out []byte, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, "cached", string(out))
+			},
+		},
+		{
+			name: "get from cache error",
+			file: 2,
+			setup: func(m serviceMocks) {
+				m.sitemapCache.EXPECT().Get(gomock.Any(), gomock.Eq(2)).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []byte, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get sitemap from cache: foo error")
+			},
+		},
+		{
+			name: "index",
+			file: dto.SitemapIndexFile,
+			setup: func(m serviceMocks) {
+				m.sitemapCache.EXPECT().Get(gomock.Any(), gomock.Eq(0)).Return(nil, apperrors.ErrNoCache)
+				m.articleRepository.EXPECT().
+					GetSitemapFiles(gomock.Any(), gomock.Eq(2)).
+					Return([]time.Time{firstModified, secondModified}, nil)
+				m.sitemapRenderer.EXPECT().RenderIndex(gomock.Eq(indexEntries)).Return([]byte("index"), nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(0), gomock.Eq([]byte("index"))).Return(nil)
+			},
+			assert: func(t *testing.T, out []byte, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, "index", string(out))
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "get files error",
+			file: dto.SitemapIndexFile,
+			setup: func(m serviceMocks) {
+				m.sitemapCache.EXPECT().Get(gomock.Any(), gomock.Eq(0)).Return(nil, apperrors.ErrNoCache)
+				m.articleRepository.EXPECT().
+					GetSitemapFiles(gomock.Any(), gomock.Eq(2)).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []byte, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get sitemap files from repository: foo error")
+			},
+		},
+		{
+			name: "file",
+			file: 2,
+			setup: func(m serviceMocks) {
+				m.sitemapCache.EXPECT().Get(gomock.Any(), gomock.Eq(2)).Return(nil, apperrors.ErrNoCache)
+				m.articleRepository.EXPECT().
+					GetSitemapArticles(gomock.Any(), gomock.Eq(2), gomock.Eq(2)).
+					Return([]dto.SitemapArticle{{Slug: "foo", LastModified: secondModified, FirstElement: 2}}, nil)
+				m.sitemapRenderer.EXPECT().
+					RenderURLSet(gomock.Eq([]dto.SitemapEntry{
+						{Loc: "https://example.com/blog/articles/foo", LastModified: secondModified},
+					})).
+					Return([]byte("file"), nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(2), gomock.Eq([]byte("file"))).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []byte, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, "file", string(out))
+				assert.Equal(t, []string{`{"level":"error","error":"foo error","message":"add sitemap to cache error"}`}, logs)
+			},
+		},
+		{
+			name: "file with translations",
+			file: 2,
+			setup: func(m serviceMocks) {
+				alternates := []dto.SitemapAlternate{
+					{Language: "en", Loc: "https://example.com/blog/articles/foo"},
+					{Language: "de", Loc: "https://example.com/blog/articles/foo-de"},
+				}
+
+				m.sitemapCache.EXPECT().Get(gomock.Any(), gomock.Eq(2)).Return(nil, apperrors.ErrNoCache)
+				m.articleRepository.EXPECT().
+					GetSitemapArticles(gomock.Any(), gomock.Eq(2), gomock.Eq(2)).
+					Return([]dto.SitemapArticle{{
+						Slug:         "foo",
+						Locale:       "en",
+						LastModified: secondModified,
+						Translations: []dto.SitemapTranslation{{Locale: "de", Slug: "foo-de"}},
+						FirstElement: 2,
+					}}, nil)
+				m.sitemapRenderer.EXPECT().
+					RenderURLSet(gomock.Eq([]dto.SitemapEntry{
+						{Loc: "https://example.com/blog/articles/foo", LastModified: secondModified, Alternates: alternates},
+					})).
+					Return([]byte("file"), nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(2), gomock.Eq([]byte("file"))).Return(nil)
+			},
+			assert: func(t *testing.T, out []byte, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, "file", string(out))
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "entries split between files",
+			file: 2,
+			setup: func(m serviceMocks) {
+				alternates := []dto.SitemapAlternate{
+					{Language: "en", Loc: "https://example.com/blog/articles/foo"},
+					{Language: "de", Loc: "https://example.com/blog/articles/foo-de"},
+				}
+
+				// entries of foo take three elements each, the first one is in the first file
+				m.sitemapCache.EXPECT().Get(gomock.Any(), gomock.Eq(2)).Return(nil, apperrors.ErrNoCache)
+				m.articleRepository.EXPECT().
+					GetSitemapArticles(gomock.Any(), gomock.Eq(2), gomock.Eq(2)).
+					Return([]dto.SitemapArticle{{
+						Slug:         "foo",
+						Locale:       "en",
+						LastModified: secondModified,
+						Translations: []dto.SitemapTranslation{{Locale: "de", Slug: "foo-de"}},
+						FirstElement: 0,
+					}}, nil)
+				m.sitemapRenderer.EXPECT().
+					RenderURLSet(gomock.Eq([]dto.SitemapEntry{
+						{Loc: "https://example.com/blog/articles/foo-de", LastModified: secondModified, Alternates: alternates},
+					})).
+					Return([]byte("file"), nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(2), gomock.Eq([]byte("file"))).Return(nil)
+			},
+			assert: func(t *testing.T, out []byte, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, "file", string(out))
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "no entries in the window",
+			file: 2,
+			setup: func(m serviceMocks) {
+				m.sitemapCache.EXPECT().Get(gomock.Any(), gomock.Eq(2)).Return(nil, apperrors.ErrNoCache)
+				m.articleRepository.EXPECT().
+					GetSitemapArticles(gomock.Any(), gomock.Eq(2), gomock.Eq(2)).
+					Return([]dto.SitemapArticle{{
+						Slug:         "foo",
+						Locale:       "en",
+						Translations: []dto.SitemapTranslation{{Locale: "de", Slug: "foo-de"}, {Locale: "fr", Slug: "foo-fr"}},
+						FirstElement: 0,
+					}}, nil)
+			},
+			assert: func(t *testing.T, out []byte, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrSitemapNotFound)
+			},
+		},
+		{
+			name: "file not found",
+			file: 3,
+			setup: func(m serviceMocks) {
+				m.sitemapCache.EXPECT().Get(gomock.Any(), gomock.Eq(3)).Return(nil, apperrors.ErrNoCache)
+				m.articleRepository.EXPECT().
+					GetSitemapArticles(gomock.Any(), gomock.Eq(4), gomock.Eq(2)).
+					Return([]dto.SitemapArticle{}, nil)
+			},
+			assert: func(t *testing.T, out []byte, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrSitemapNotFound)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			out, err := m.newService(logger).Get(context.Background(), tt.file)
+
+			tt.assert(t, out, err, logger.Logs())
+		})
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		shifted bool
+		setup   func(m serviceMocks)
+		assert  func(t *testing.T, err error)
+	}{
+		{
+			name:    "last file",
+			shifted: true,
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().GetSitemapPosition(gomock.Any(), gomock.Eq("article id")).Return(3, nil)
+				m.articleRepository.EXPECT().
+					GetSitemapFiles(gomock.Any(), gomock.Eq(2)).
+					Return([]time.Time{firstModified, secondModified}, nil)
+				m.articleRepository.EXPECT().
+					GetSitemapArticles(gomock.Any(), gomock.Eq(2), gomock.Eq(2)).
+					Return([]dto.SitemapArticle{{Slug: "foo", LastModified: secondModified, FirstElement: 2}}, nil)
+				m.sitemapRenderer.EXPECT().RenderURLSet(gomock.Any()).Return([]byte("file"), nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(2), gomock.Eq([]byte("file"))).Return(nil)
+				m.sitemapCache.EXPECT().Delete(gomock.Any(), gomock.Eq(3)).Return(nil)
+				m.sitemapRenderer.EXPECT().RenderIndex(gomock.Eq(indexEntries)).Return([]byte("index"), nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(0), gomock.Eq([]byte("index"))).Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "files after the article",
+			shifted: true,
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().GetSitemapPosition(gomock.Any(), gomock.Eq("article id")).Return(0, nil)
+				m.articleRepository.EXPECT().
+					GetSitemapFiles(gomock.Any(), gomock.Eq(2)).
+					Return([]time.Time{firstModified, secondModified}, nil)
+				m.articleRepository.EXPECT().
+					GetSitemapArticles(gomock.Any(), gomock.Eq(0), gomock.Eq(2)).
+					Return([]dto.SitemapArticle{{Slug: "foo"}, {Slug: "bar", FirstElement: 1}}, nil)
+				m.articleRepository.EXPECT().
+					GetSitemapArticles(gomock.Any(), gomock.Eq(2), gomock.Eq(2)).
+					Return([]dto.SitemapArticle{{Slug: "baz", FirstElement: 2}}, nil)
+				m.sitemapRenderer.EXPECT().RenderURLSet(gomock.Any()).Return([]byte("file"), nil).Times(2)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(1), gomock.Any()).Return(nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(2), gomock.Any()).Return(nil)
+				m.sitemapCache.EXPECT().Delete(gomock.Any(), gomock.Eq(3)).Return(nil)
+				m.sitemapRenderer.EXPECT().RenderIndex(gomock.Any()).Return([]byte("index"), nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(0), gomock.Any()).Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "edited article",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().GetSitemapPosition(gomock.Any(), gomock.Eq("article id")).Return(0, nil)
+				m.articleRepository.EXPECT().
+					GetSitemapFiles(gomock.Any(), gomock.Eq(2)).
+					Return([]time.Time{firstModified, secondModified}, nil)
+				m.articleRepository.EXPECT().
+					GetSitemapArticles(gomock.Any(), gomock.Eq(0), gomock.Eq(2)).
+					Return([]dto.SitemapArticle{{Slug: "foo", LastModified: firstModified}, {Slug: "bar", FirstElement: 1}}, nil)
+				m.sitemapRenderer.EXPECT().RenderURLSet(gomock.Any()).Return([]byte("file"), nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(1), gomock.Eq([]byte("file"))).Return(nil)
+				m.sitemapRenderer.EXPECT().RenderIndex(gomock.Eq(indexEntries)).Return([]byte("index"), nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(0), gomock.Eq([]byte("index"))).Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "edited article not listed",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().GetSitemapPosition(gomock.Any(), gomock.Eq("article id")).Return(4, nil)
+				m.articleRepository.EXPECT().
+					GetSitemapFiles(gomock.Any(), gomock.Eq(2)).
+					Return([]time.Time{firstModified, secondModified}, nil)
+				m.sitemapRenderer.EXPECT().RenderIndex(gomock.Eq(indexEntries)).Return([]byte("index"), nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(0), gomock.Eq([]byte("index"))).Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "get position error",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().
+					GetSitemapPosition(gomock.Any(), gomock.Eq("article id")).
+					Return(0, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "get sitemap position from repository: foo error")
+			},
+		},
+		{
+			name: "add to cache error",
+			setup: func(m serviceMocks) {
+				m.articleRepository.EXPECT().GetSitemapPosition(gomock.Any(), gomock.Eq("article id")).Return(3, nil)
+				m.articleRepository.EXPECT().
+					GetSitemapFiles(gomock.Any(), gomock.Eq(2)).
+					Return([]time.Time{firstModified, secondModified}, nil)
+				m.articleRepository.EXPECT().
+					GetSitemapArticles(gomock.Any(), gomock.Eq(2), gomock.Eq(2)).
+					Return([]dto.SitemapArticle{{Slug: "foo", FirstElement: 2}}, nil)
+				m.sitemapRenderer.EXPECT().RenderURLSet(gomock.Any()).Return([]byte("file"), nil)
+				m.sitemapCache.EXPECT().Add(gomock.Any(), gomock.Eq(2), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "add sitemap to cache: foo error")
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService(testutil.NewLogger()).refresh(context.Background(), refreshElement{articleID: "article id", shifted: tt.shifted})
+
+			tt.assert(t, err)
+		})
+	}
+}
+
+func TestRefreshInBackground(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newServiceMocks(ctrl)
+	m.articleRepository.EXPECT().
+		GetSitemapPosition(gomock.Any(), gomock.Eq("article id")).
+		Return(0, errors.New("foo error"))
+
+	logger := testutil.NewLogger()
+	service := m.newService(logger)
+	ctx := context.Background()
+
+	assert.NoError(t, service.Start(ctx))
+	assert.NoError(t, service.Refresh(ctx, "article id", false))
+	assert.NoError(t, service.Stop(ctx))
+	assert.Len(t, logger.Logs(), 1)
+	assert.ErrorIs(t, service.Refresh(ctx, "article id", false), worker.ErrPoolStopped)
+}
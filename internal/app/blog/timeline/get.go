@@ -0,0 +1,113 @@
+package timeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// Get returns a page of the home timeline of the user, which merges pushed and pulled articles in time order.
+// Pushed articles older than the latest size ones are gone, so deep pages may hold pulled articles only.
+func (s *Service) Get(ctx context.Context, in *dto.GetTimelineIn) (*dto.GetTimelineOut, error) {
+	// one extra entry tells whether there is a next page
+	pushed, err := s.getPushed(ctx, in.UserID, in.Cursor, in.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	pulled, err := s.getPulled(ctx, in.UserID, in.Cursor, in.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := merge(pushed, pulled)
+
+	out := &dto.GetTimelineOut{}
+	if len(entries) > in.Limit {
+		entries = entries[:in.Limit]
+		out.NextCursor = &entries[len(entries)-1]
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.ArticleID)
+	}
+
+	if out.Articles, err = s.articleService.GetByIDs(ctx, ids, in.UserID); err != nil {
+		return nil, fmt.Errorf("get articles: %w", err)
+	}
+
+	return out, nil
+}
+
+func (s *Service) getPushed(ctx context.Context, userID string, cursor *dto.TimelineEntry, limit int) ([]dto.TimelineEntry, error) {
+	entries, err := s.timelineCache.Get(ctx, userID, cursor, limit)
+	switch {
+	case err == nil:
+		return entries, nil
+	case errors.Is(err, apperrors.ErrNoCache):
+		// need to rebuild
+	default:
+		return nil, fmt.Errorf("get timeline from cache: %w", err)
+	}
+
+	entries, err = s.articleRepository.GetFollowedTimeline(ctx, userID, s.fanOutThreshold, s.size)
+	if err != nil {
+		return nil, fmt.Errorf("get followed timeline from repository: %w", err)
+	}
+
+	// timelines without entries aren't saved, they are cheap to rebuild
+	if err = s.timelineCache.Save(ctx, userID, entries); err != nil {
+		s.logger.Error().Err(err).Msg("save timeline to cache error")
+	}
+
+	if cursor != nil {
+		entries = slices.DeleteFunc(entries, func(entry dto.TimelineEntry) bool {
+			return !cursor.Before(entry)
+		})
+	}
+
+	return entries[:min(len(entries), limit)], nil
+}
+
+func (s *Service) getPulled(ctx context.Context, userID string, cursor *dto.TimelineEntry, limit int) ([]dto.TimelineEntry, error) {
+	authorIDs, err := s.followRepository.GetPopularAuthorIDs(ctx, userID, s.fanOutThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("get popular authors from repository: %w", err)
+	}
+
+	if len(authorIDs) == 0 {
+		return nil, nil
+	}
+
+	entries, err := s.articleRepository.GetTimeline(ctx, authorIDs, cursor, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get timeline from repository: %w", err)
+	}
+
+	return entries, nil
+}
+
+// merge merges the timelines in time order. Articles of authors who crossed the fan-out threshold
+// may be both pushed and pulled, so duplicates are dropped.
+func merge(a, b []dto.TimelineEntry) []dto.TimelineEntry {
+	out := slices.Concat(a, b)
+	slices.SortFunc(out, func(x, y dto.TimelineEntry) int {
+		switch {
+		case x.Before(y):
+			return -1
+		case y.Before(x):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return slices.CompactFunc(out, func(x, y dto.TimelineEntry) bool {
+		return x.ArticleID == y.ArticleID
+	})
+}
@@ -0,0 +1,164 @@
+package timeline
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/timeline/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/worker"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	articleService    *mock.MockarticleService
+	articleRepository *mock.MockarticleRepository
+	followRepository  *mock.MockfollowRepository
+	timelineCache     *mock.MocktimelineCache
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		articleService:    mock.NewMockarticleService(ctrl),
+		articleRepository: mock.NewMockarticleRepository(ctrl),
+		followRepository:  mock.NewMockfollowRepository(ctrl),
+		timelineCache:     mock.NewMocktimelineCache(ctrl),
+	}
+}
+
+func (m serviceMocks) newService(logger log.Logger) *Service {
+	fanOut := worker.PoolConfig{Name: "timeline fan-out", Workers: 1, QueueSize: 1, Policy: worker.PolicyBlock}
+	return NewService(3, 2, fanOut, m.articleService, m.articleRepository, m.followRepository, m.timelineCache, logger)
+}
+
+func newEntry(id string, minute int) dto.TimelineEntry {
+	return dto.TimelineEntry{ArticleID: id, CreatedAt: time.Date(2024, 1, 1, 0, minute, 0, 0, time.UTC)}
+}
+
+func TestGet(t *testing.T) {
+	in := &dto.GetTimelineIn{UserID: "user id", Limit: 2}
+
+	for _, tt := range []struct {
+		name   string
+		in     *dto.GetTimelineIn
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.GetTimelineOut, err error, logs []string)
+	}{
+		{
+			name: "get from cache error",
+			in:   in,
+			setup: func(m serviceMocks) {
+				m.timelineCache.EXPECT().Get(gomock.Any(), gomock.Eq("user id"), gomock.Nil(), gomock.Eq(3)).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetTimelineOut, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get timeline from cache: foo error")
+			},
+		},
+		{
+			name: "rebuild error",
+			in:   in,
+			setup: func(m serviceMocks) {
+				m.timelineCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, apperrors.ErrNoCache)
+				m.articleRepository.EXPECT().
+					GetFollowedTimeline(gomock.Any(), gomock.Eq("user id"), gomock.Eq(2), gomock.Eq(3)).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetTimelineOut, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get followed timeline from repository: foo error")
+			},
+		},
+		{
+			name: "get popular authors error",
+			in:   in,
+			setup: func(m serviceMocks) {
+				m.timelineCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]dto.TimelineEntry{}, nil)
+				m.followRepository.EXPECT().
+					GetPopularAuthorIDs(gomock.Any(), gomock.Eq("user id"), gomock.Eq(2)).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetTimelineOut, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get popular authors from repository: foo error")
+			},
+		},
+		{
+			name: "get articles error",
+			in:   in,
+			setup: func(m serviceMocks) {
+				m.timelineCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]dto.TimelineEntry{}, nil)
+				m.followRepository.EXPECT().GetPopularAuthorIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.articleService.EXPECT().GetByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetTimelineOut, err error, logs []string) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get articles: foo error")
+			},
+		},
+		{
+			name: "merged",
+			in:   in,
+			setup: func(m serviceMocks) {
+				m.timelineCache.EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]dto.TimelineEntry{newEntry("4", 4), newEntry("2", 2)}, nil)
+				m.followRepository.EXPECT().
+					GetPopularAuthorIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]string{"popular author id"}, nil)
+				m.articleRepository.EXPECT().
+					GetTimeline(gomock.Any(), gomock.Eq([]string{"popular author id"}), gomock.Nil(), gomock.Eq(3)).
+					Return([]dto.TimelineEntry{newEntry("4", 4), newEntry("3", 3), newEntry("1", 1)}, nil)
+				m.articleService.EXPECT().
+					GetByIDs(gomock.Any(), gomock.Eq([]string{"4", "3"}), gomock.Eq("user id")).
+					Return([]dto.Article{{ID: "4"}, {ID: "3"}}, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetTimelineOut, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetTimelineOut{
+					Articles:   []dto.Article{{ID: "4"}, {ID: "3"}},
+					NextCursor: &dto.TimelineEntry{ArticleID: "3", CreatedAt: newEntry("3", 3).CreatedAt},
+				}, out)
+			},
+		},
+		{
+			name: "rebuilt",
+			in:   &dto.GetTimelineIn{UserID: "user id", Cursor: &dto.TimelineEntry{ArticleID: "3", CreatedAt: newEntry("3", 3).CreatedAt}, Limit: 2},
+			setup: func(m serviceMocks) {
+				rebuilt := []dto.TimelineEntry{newEntry("4", 4), newEntry("3", 3), newEntry("2", 2)}
+				m.timelineCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, apperrors.ErrNoCache)
+				m.articleRepository.EXPECT().GetFollowedTimeline(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rebuilt, nil)
+				m.timelineCache.EXPECT().Save(gomock.Any(), gomock.Eq("user id"), gomock.Eq(rebuilt)).Return(errors.New("foo error"))
+				m.followRepository.EXPECT().GetPopularAuthorIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{}, nil)
+				m.articleService.EXPECT().
+					GetByIDs(gomock.Any(), gomock.Eq([]string{"2"}), gomock.Eq("user id")).
+					Return([]dto.Article{{ID: "2"}}, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetTimelineOut, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.GetTimelineOut{Articles: []dto.Article{{ID: "2"}}}, out)
+				assert.Equal(t, []string{`{"level":"error","error":"foo error","message":"save timeline to cache error"}`}, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			out, err := m.newService(logger).Get(context.Background(), tt.in)
+
+			tt.assert(t, out, err, logger.Logs())
+		})
+	}
+}
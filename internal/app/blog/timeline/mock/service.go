@@ -0,0 +1,261 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockarticleService is a mock of articleService interface.
+type MockarticleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleServiceMockRecorder is the mock recorder for MockarticleService.
+type MockarticleServiceMockRecorder struct {
+	mock *MockarticleService
+}
+
+// NewMockarticleService creates a new mock instance.
+func NewMockarticleService(ctrl *gomock.Controller) *MockarticleService {
+	mock := &MockarticleService{ctrl: ctrl}
+	mock.recorder = &MockarticleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleService) EXPECT() *MockarticleServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByIDs mocks base method.
+func (m *MockarticleService) GetByIDs(ctx context.Context, ids []string, userID string) ([]dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids, userID)
+	ret0, _ := ret[0].([]dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockarticleServiceMockRecorder) GetByIDs(ctx, ids, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockarticleService)(nil).GetByIDs), ctx, ids, userID)
+}
+
+// MockarticleRepository is a mock of articleRepository interface.
+type MockarticleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleRepositoryMockRecorder is the mock recorder for MockarticleRepository.
+type MockarticleRepositoryMockRecorder struct {
+	mock *MockarticleRepository
+}
+
+// NewMockarticleRepository creates a new mock instance.
+func NewMockarticleRepository(ctrl *gomock.Controller) *MockarticleRepository {
+	mock := &MockarticleRepository{ctrl: ctrl}
+	mock.recorder = &MockarticleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleRepository) EXPECT() *MockarticleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetFollowedTimeline mocks base method.
+func (m *MockarticleRepository) GetFollowedTimeline(ctx context.Context, followerID string, threshold, limit int) ([]dto.TimelineEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowedTimeline", ctx, followerID, threshold, limit)
+	ret0, _ := ret[0].([]dto.TimelineEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowedTimeline indicates an expected call of GetFollowedTimeline.
+func (mr *MockarticleRepositoryMockRecorder) GetFollowedTimeline(ctx, followerID, threshold, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowedTimeline", reflect.TypeOf((*MockarticleRepository)(nil).GetFollowedTimeline), ctx, followerID, threshold, limit)
+}
+
+// GetTimeline mocks base method.
+func (m *MockarticleRepository) GetTimeline(ctx context.Context, authorIDs []string, cursor *dto.TimelineEntry, limit int) ([]dto.TimelineEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeline", ctx, authorIDs, cursor, limit)
+	ret0, _ := ret[0].([]dto.TimelineEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeline indicates an expected call of GetTimeline.
+func (mr *MockarticleRepositoryMockRecorder) GetTimeline(ctx, authorIDs, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeline", reflect.TypeOf((*MockarticleRepository)(nil).GetTimeline), ctx, authorIDs, cursor, limit)
+}
+
+// MockfollowRepository is a mock of followRepository interface.
+type MockfollowRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockfollowRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockfollowRepositoryMockRecorder is the mock recorder for MockfollowRepository.
+type MockfollowRepositoryMockRecorder struct {
+	mock *MockfollowRepository
+}
+
+// NewMockfollowRepository creates a new mock instance.
+func NewMockfollowRepository(ctrl *gomock.Controller) *MockfollowRepository {
+	mock := &MockfollowRepository{ctrl: ctrl}
+	mock.recorder = &MockfollowRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfollowRepository) EXPECT() *MockfollowRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountFollowers mocks base method.
+func (m *MockfollowRepository) CountFollowers(ctx context.Context, authorID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFollowers", ctx, authorID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFollowers indicates an expected call of CountFollowers.
+func (mr *MockfollowRepositoryMockRecorder) CountFollowers(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFollowers", reflect.TypeOf((*MockfollowRepository)(nil).CountFollowers), ctx, authorID)
+}
+
+// Get mocks base method.
+func (m *MockfollowRepository) Get(ctx context.Context, list, userID string, cursor *dto.FollowCursor, limit int) ([]dto.Follow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, list, userID, cursor, limit)
+	ret0, _ := ret[0].([]dto.Follow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockfollowRepositoryMockRecorder) Get(ctx, list, userID, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockfollowRepository)(nil).Get), ctx, list, userID, cursor, limit)
+}
+
+// GetPopularAuthorIDs mocks base method.
+func (m *MockfollowRepository) GetPopularAuthorIDs(ctx context.Context, followerID string, threshold int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPopularAuthorIDs", ctx, followerID, threshold)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPopularAuthorIDs indicates an expected call of GetPopularAuthorIDs.
+func (mr *MockfollowRepositoryMockRecorder) GetPopularAuthorIDs(ctx, followerID, threshold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPopularAuthorIDs", reflect.TypeOf((*MockfollowRepository)(nil).GetPopularAuthorIDs), ctx, followerID, threshold)
+}
+
+// MocktimelineCache is a mock of timelineCache interface.
+type MocktimelineCache struct {
+	ctrl     *gomock.Controller
+	recorder *MocktimelineCacheMockRecorder
+	isgomock struct{}
+}
+
+// MocktimelineCacheMockRecorder is the mock recorder for MocktimelineCache.
+type MocktimelineCacheMockRecorder struct {
+	mock *MocktimelineCache
+}
+
+// NewMocktimelineCache creates a new mock instance.
+func NewMocktimelineCache(ctrl *gomock.Controller) *MocktimelineCache {
+	mock := &MocktimelineCache{ctrl: ctrl}
+	mock.recorder = &MocktimelineCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktimelineCache) EXPECT() *MocktimelineCacheMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MocktimelineCache) Add(ctx context.Context, userIDs []string, entries []dto.TimelineEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, userIDs, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MocktimelineCacheMockRecorder) Add(ctx, userIDs, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MocktimelineCache)(nil).Add), ctx, userIDs, entries)
+}
+
+// Get mocks base method.
+func (m *MocktimelineCache) Get(ctx context.Context, userID string, cursor *dto.TimelineEntry, limit int) ([]dto.TimelineEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, cursor, limit)
+	ret0, _ := ret[0].([]dto.TimelineEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MocktimelineCacheMockRecorder) Get(ctx, userID, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MocktimelineCache)(nil).Get), ctx, userID, cursor, limit)
+}
+
+// Remove mocks base method.
+func (m *MocktimelineCache) Remove(ctx context.Context, userID string, articleIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, userID, articleIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MocktimelineCacheMockRecorder) Remove(ctx, userID, articleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MocktimelineCache)(nil).Remove), ctx, userID, articleIDs)
+}
+
+// Save mocks base method.
+func (m *MocktimelineCache) Save(ctx context.Context, userID string, entries []dto.TimelineEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, userID, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MocktimelineCacheMockRecorder) Save(ctx, userID, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MocktimelineCache)(nil).Save), ctx, userID, entries)
+}
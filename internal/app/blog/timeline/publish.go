@@ -0,0 +1,112 @@
+package timeline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+// fanOutBatch is the number of followers whose timelines are updated at once.
+const fanOutBatch = 1000
+
+// Publish queues pushing of the new article to timelines of the author's followers.
+func (s *Service) Publish(ctx context.Context, article *dto.Article) error {
+	err := s.fanOut.Push(ctx, publication{
+		authorID: article.AuthorID,
+		entry:    dto.TimelineEntry{ArticleID: article.ID, CreatedAt: article.CreatedAt},
+	})
+	if err != nil {
+		return fmt.Errorf("push to fan-out: %w", err)
+	}
+
+	return nil
+}
+
+// AddAuthor pushes the latest articles of the author to the timeline of the new follower.
+func (s *Service) AddAuthor(ctx context.Context, userID, authorID string) error {
+	pushed, err := s.isPushed(ctx, authorID)
+	if err != nil || !pushed {
+		return err
+	}
+
+	entries, err := s.articleRepository.GetTimeline(ctx, []string{authorID}, nil, s.size)
+	if err != nil {
+		return fmt.Errorf("get timeline from repository: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if err = s.timelineCache.Add(ctx, []string{userID}, entries); err != nil {
+		return fmt.Errorf("add to timeline in cache: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveAuthor removes articles of the author from the timeline of the former follower.
+// The author may have been pushed before crossing the fan-out threshold, so articles are removed regardless.
+func (s *Service) RemoveAuthor(ctx context.Context, userID, authorID string) error {
+	entries, err := s.articleRepository.GetTimeline(ctx, []string{authorID}, nil, s.size)
+	if err != nil {
+		return fmt.Errorf("get timeline from repository: %w", err)
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.ArticleID)
+	}
+
+	if err = s.timelineCache.Remove(ctx, userID, ids); err != nil {
+		return fmt.Errorf("remove from timeline in cache: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) push(ctx context.Context, p publication) error {
+	pushed, err := s.isPushed(ctx, p.authorID)
+	if err != nil || !pushed {
+		return err
+	}
+
+	var cursor *dto.FollowCursor
+	for {
+		followers, err := s.followRepository.Get(ctx, dto.FollowListFollowers, p.authorID, cursor, fanOutBatch)
+		if err != nil {
+			return fmt.Errorf("get followers from repository: %w", err)
+		}
+
+		if len(followers) == 0 {
+			return nil
+		}
+
+		userIDs := make([]string, 0, len(followers))
+		for _, follower := range followers {
+			userIDs = append(userIDs, follower.UserID)
+		}
+
+		if err = s.timelineCache.Add(ctx, userIDs, []dto.TimelineEntry{p.entry}); err != nil {
+			return fmt.Errorf("add to timelines in cache: %w", err)
+		}
+
+		if len(followers) < fanOutBatch {
+			return nil
+		}
+
+		last := followers[len(followers)-1]
+		cursor = &dto.FollowCursor{FollowedAt: last.FollowedAt, UserID: last.UserID}
+	}
+}
+
+// isPushed reports whether articles of the author are pushed to timelines rather than pulled on reads.
+func (s *Service) isPushed(ctx context.Context, authorID string) (bool, error) {
+	count, err := s.followRepository.CountFollowers(ctx, authorID)
+	if err != nil {
+		return false, fmt.Errorf("count followers in repository: %w", err)
+	}
+
+	return count <= s.fanOutThreshold, nil
+}
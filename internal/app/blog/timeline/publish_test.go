@@ -0,0 +1,179 @@
+package timeline
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/worker"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestPush(t *testing.T) {
+	p := publication{authorID: "author id", entry: newEntry("1", 1)}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "count followers error",
+			setup: func(m serviceMocks) {
+				m.followRepository.EXPECT().CountFollowers(gomock.Any(), gomock.Eq("author id")).Return(0, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "count followers in repository: foo error")
+			},
+		},
+		{
+			name: "popular author",
+			setup: func(m serviceMocks) {
+				m.followRepository.EXPECT().CountFollowers(gomock.Any(), gomock.Any()).Return(3, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "get followers error",
+			setup: func(m serviceMocks) {
+				m.followRepository.EXPECT().CountFollowers(gomock.Any(), gomock.Any()).Return(2, nil)
+				m.followRepository.EXPECT().
+					Get(gomock.Any(), gomock.Eq(dto.FollowListFollowers), gomock.Eq("author id"), gomock.Nil(), gomock.Eq(fanOutBatch)).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "get followers from repository: foo error")
+			},
+		},
+		{
+			name: "add to timelines error",
+			setup: func(m serviceMocks) {
+				m.followRepository.EXPECT().CountFollowers(gomock.Any(), gomock.Any()).Return(2, nil)
+				m.followRepository.EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]dto.Follow{{UserID: "1"}}, nil)
+				m.timelineCache.EXPECT().Add(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "add to timelines in cache: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.followRepository.EXPECT().CountFollowers(gomock.Any(), gomock.Any()).Return(2, nil)
+				m.followRepository.EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]dto.Follow{{UserID: "1"}, {UserID: "2"}}, nil)
+				m.timelineCache.EXPECT().
+					Add(gomock.Any(), gomock.Eq([]string{"1", "2"}), gomock.Eq([]dto.TimelineEntry{p.entry})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			tt.assert(t, m.newService(testutil.NewLogger()).push(context.Background(), p))
+		})
+	}
+}
+
+func TestPublishInBackground(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newServiceMocks(ctrl)
+	m.followRepository.EXPECT().CountFollowers(gomock.Any(), gomock.Eq("author id")).Return(0, errors.New("foo error"))
+
+	logger := testutil.NewLogger()
+	service := m.newService(logger)
+	ctx := context.Background()
+	article := &dto.Article{ID: "1", AuthorID: "author id", CreatedAt: time.Now()}
+
+	assert.NoError(t, service.Start(ctx))
+	assert.NoError(t, service.Publish(ctx, article))
+	assert.NoError(t, service.Stop(ctx))
+	assert.Len(t, logger.Logs(), 1)
+	assert.ErrorIs(t, service.Publish(ctx, article), worker.ErrPoolStopped)
+}
+
+func TestAddAuthor(t *testing.T) {
+	entries := []dto.TimelineEntry{newEntry("2", 2), newEntry("1", 1)}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "popular author",
+			setup: func(m serviceMocks) {
+				m.followRepository.EXPECT().CountFollowers(gomock.Any(), gomock.Eq("author id")).Return(3, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "get timeline error",
+			setup: func(m serviceMocks) {
+				m.followRepository.EXPECT().CountFollowers(gomock.Any(), gomock.Any()).Return(1, nil)
+				m.articleRepository.EXPECT().
+					GetTimeline(gomock.Any(), gomock.Eq([]string{"author id"}), gomock.Nil(), gomock.Eq(3)).
+					Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "get timeline from repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.followRepository.EXPECT().CountFollowers(gomock.Any(), gomock.Any()).Return(1, nil)
+				m.articleRepository.EXPECT().GetTimeline(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(entries, nil)
+				m.timelineCache.EXPECT().Add(gomock.Any(), gomock.Eq([]string{"user id"}), gomock.Eq(entries)).Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			tt.assert(t, m.newService(testutil.NewLogger()).AddAuthor(context.Background(), "user id", "author id"))
+		})
+	}
+}
+
+func TestRemoveAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newServiceMocks(ctrl)
+	m.articleRepository.EXPECT().
+		GetTimeline(gomock.Any(), gomock.Eq([]string{"author id"}), gomock.Nil(), gomock.Eq(3)).
+		Return([]dto.TimelineEntry{newEntry("2", 2), newEntry("1", 1)}, nil)
+	m.timelineCache.EXPECT().Remove(gomock.Any(), gomock.Eq("user id"), gomock.Eq([]string{"2", "1"})).Return(nil)
+
+	assert.NoError(t, m.newService(testutil.NewLogger()).RemoveAuthor(context.Background(), "user id", "author id"))
+}
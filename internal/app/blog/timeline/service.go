@@ -0,0 +1,96 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package timeline
+
+import (
+	"context"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/worker"
+)
+
+type articleService interface {
+	GetByIDs(ctx context.Context, ids []string, userID string) ([]dto.Article, error)
+}
+
+type articleRepository interface {
+	// GetTimeline returns a page of the merged timeline of the authors after the cursor entry.
+	GetTimeline(ctx context.Context, authorIDs []string, cursor *dto.TimelineEntry, limit int) ([]dto.TimelineEntry, error)
+	// GetFollowedTimeline returns the latest entries of the merged timeline of authors followed by the user
+	// who have at most threshold followers.
+	GetFollowedTimeline(ctx context.Context, followerID string, threshold, limit int) ([]dto.TimelineEntry, error)
+}
+
+type followRepository interface {
+	Get(ctx context.Context, list, userID string, cursor *dto.FollowCursor, limit int) ([]dto.Follow, error)
+	CountFollowers(ctx context.Context, authorID string) (int, error)
+	// GetPopularAuthorIDs returns authors followed by the user who have more followers than the threshold.
+	GetPopularAuthorIDs(ctx context.Context, followerID string, threshold int) ([]string, error)
+}
+
+// timelineCache keeps timelines of articles pushed to followers, missing timelines are rebuilt from the storage.
+type timelineCache interface {
+	// Get returns a page of the timeline after the cursor entry, it fails with ErrNoCache if there is no timeline.
+	Get(ctx context.Context, userID string, cursor *dto.TimelineEntry, limit int) ([]dto.TimelineEntry, error)
+	Save(ctx context.Context, userID string, entries []dto.TimelineEntry) error
+	// Add adds the entries to existing timelines of the users.
+	Add(ctx context.Context, userIDs []string, entries []dto.TimelineEntry) error
+	Remove(ctx context.Context, userID string, articleIDs []string) error
+}
+
+// publication is an article being pushed to timelines of the author's followers.
+type publication struct {
+	authorID string
+	entry    dto.TimelineEntry
+}
+
+// Service serves home timelines of articles by followed authors.
+//
+// Articles of authors with at most fanOutThreshold followers are pushed to timelines of the followers
+// when they are published. Articles of more popular authors are pulled from the storage on reads instead,
+// so publishing never writes to millions of timelines and reads only merge a few popular authors in.
+type Service struct {
+	size              int
+	fanOutThreshold   int
+	articleService    articleService
+	articleRepository articleRepository
+	followRepository  followRepository
+	timelineCache     timelineCache
+	logger            log.Logger
+
+	fanOut *worker.Pool[publication]
+}
+
+// NewService creates the timeline service, size is the number of the latest pushed articles kept in a timeline.
+func NewService(
+	size int,
+	fanOutThreshold int,
+	fanOut worker.PoolConfig,
+	articleService articleService,
+	articleRepository articleRepository,
+	followRepository followRepository,
+	timelineCache timelineCache,
+	logger log.Logger,
+) *Service {
+	s := &Service{
+		size:              size,
+		fanOutThreshold:   fanOutThreshold,
+		articleService:    articleService,
+		articleRepository: articleRepository,
+		followRepository:  followRepository,
+		timelineCache:     timelineCache,
+		logger:            logger,
+	}
+	s.fanOut = worker.NewPool(fanOut, s.push, logger)
+	return s
+}
+
+// Start starts the background fan-out.
+func (s *Service) Start(ctx context.Context) error {
+	return s.fanOut.Start(ctx)
+}
+
+// Stop stops the background fan-out after queued articles are pushed.
+func (s *Service) Stop(ctx context.Context) error {
+	return s.fanOut.Stop(ctx)
+}
@@ -0,0 +1,98 @@
+package transfer
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/frontmatter"
+)
+
+// documentExt is the extension of article files in archives.
+const documentExt = ".md"
+
+// meta is the front matter of an article file. Author is the email address of the author.
+type meta struct {
+	Title        string    `yaml:"title"`
+	Slug         string    `yaml:"slug"`
+	Tags         []string  `yaml:"tags,omitempty"`
+	Date         time.Time `yaml:"date,omitempty"`
+	Author       string    `yaml:"author"`
+	Description  string    `yaml:"description,omitempty"`
+	Image        string    `yaml:"image,omitempty"`
+	CanonicalURL string    `yaml:"canonical_url,omitempty"`
+}
+
+func formatDocument(article *dto.Article, authorEmail string) ([]byte, error) {
+	m := meta{
+		Title:        article.Title,
+		Slug:         article.Slug,
+		Tags:         article.Tags,
+		Date:         article.CreatedAt.UTC(),
+		Author:       authorEmail,
+		Description:  article.SEO.MetaDescription,
+		Image:        article.SEO.OGImageURL,
+		CanonicalURL: article.SEO.CanonicalURL,
+	}
+
+	return frontmatter.Format(m, []byte(article.Content))
+}
+
+func parseDocument(document []byte) (*meta, string, error) {
+	m := &meta{}
+	body, err := frontmatter.Parse(document, m)
+	if err != nil {
+		return nil, "", err
+	}
+
+	content := string(body)
+	if err = m.validate(content); err != nil {
+		return nil, "", err
+	}
+
+	return m, content, nil
+}
+
+// validate checks the article the same way articles created through the API are checked.
+func (m *meta) validate(content string) error {
+	switch {
+	case m.Title == "":
+		return errors.New("title is required")
+	case utf8.RuneCountInString(m.Title) > 255:
+		return errors.New("title must be at most 255 characters long")
+	case m.Slug == "":
+		return errors.New("slug is required")
+	case utf8.RuneCountInString(m.Slug) > 255 || m.Slug != strings.ToLower(m.Slug) || strings.ContainsAny(m.Slug, " /?#%"):
+		return errors.New("slug must be at most 255 lowercase characters without spaces and any of /?#%")
+	case m.Author == "":
+		return errors.New("author is required")
+	case utf8.RuneCountInString(m.Description) > 300:
+		return errors.New("description must be at most 300 characters long")
+	case !validURL(m.Image):
+		return errors.New("image must be a URL")
+	case !validURL(m.CanonicalURL):
+		return errors.New("canonical_url must be a URL")
+	case strings.TrimSpace(content) == "":
+		return errors.New("content is required")
+	}
+
+	for _, tag := range m.Tags {
+		if strings.TrimSpace(tag) == "" || utf8.RuneCountInString(tag) > 50 {
+			return errors.New("tags must be non-empty and at most 50 characters long")
+		}
+	}
+
+	return nil
+}
+
+func validURL(s string) bool {
+	if s == "" {
+		return true
+	}
+
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && u.Host != "" && len(s) <= 2048
+}
@@ -0,0 +1,73 @@
+package transfer
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+)
+
+// exportPageSize is the number of articles loaded at once during export.
+const exportPageSize = 100
+
+// Export returns a zip archive of all articles, hidden ones included, in the format Import reads.
+func (s *Service) Export(ctx context.Context, userID string) ([]byte, error) {
+	if err := s.checkAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	var (
+		buf     bytes.Buffer
+		archive = zip.NewWriter(&buf)
+		emails  = make(map[string]string)
+		afterID string
+	)
+
+	for {
+		articles, err := s.articleRepository.GetAfter(ctx, afterID, exportPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("get articles from repository: %w", err)
+		}
+
+		for i := range articles {
+			article := &articles[i]
+
+			email, ok := emails[article.AuthorID]
+			if !ok {
+				author, err := s.userRepository.Find(ctx, article.AuthorID)
+				if err != nil {
+					return nil, fmt.Errorf("find author in repository: %w", err)
+				}
+
+				email = author.Email
+				emails[article.AuthorID] = email
+			}
+
+			document, err := formatDocument(article, email)
+			if err != nil {
+				return nil, fmt.Errorf("format article %s: %w", article.Slug, err)
+			}
+
+			w, err := archive.Create(article.Slug + documentExt)
+			if err != nil {
+				return nil, fmt.Errorf("create archive file: %w", err)
+			}
+
+			if _, err = w.Write(document); err != nil {
+				return nil, fmt.Errorf("write archive file: %w", err)
+			}
+		}
+
+		if len(articles) < exportPageSize {
+			break
+		}
+
+		afterID = articles[len(articles)-1].ID
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("close archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
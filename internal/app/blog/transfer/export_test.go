@@ -0,0 +1,131 @@
+package transfer
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+func TestExport(t *testing.T) {
+	createdAt := time.Date(2020, 1, 1, 12, 30, 0, 123000000, time.UTC)
+	article := dto.Article{
+		ID:        "article id",
+		Slug:      "foo-article",
+		Title:     "Foo: the article",
+		Content:   "# Foo\n\n---\n\nfoo content\n",
+		Tags:      []string{"bar"},
+		SEO:       dto.ArticleSEO{MetaDescription: "Foo description", CanonicalURL: "https://example.com/foo"},
+		AuthorID:  "bob id",
+		CreatedAt: createdAt,
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, m serviceMocks, out []byte, err error)
+	}{
+		{
+			name: "not an admin",
+			setup: func(m serviceMocks) {
+				m.expectAdmin(nil)
+			},
+			assert: func(t *testing.T, m serviceMocks, out []byte, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "get articles error",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.articleRepository.EXPECT().GetAfter(gomock.Any(), gomock.Eq(""), gomock.Eq(exportPageSize)).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, m serviceMocks, out []byte, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get articles from repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.articleRepository.EXPECT().GetAfter(gomock.Any(), gomock.Eq(""), gomock.Eq(exportPageSize)).Return([]dto.Article{article}, nil)
+				m.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq("bob id")).Return(&dto.User{ID: "bob id", Email: "bob@example.com"}, nil)
+			},
+			assert: func(t *testing.T, m serviceMocks, out []byte, err error) {
+				require.NoError(t, err)
+
+				archive, err := zip.NewReader(bytes.NewReader(out), int64(len(out)))
+				require.NoError(t, err)
+				require.Len(t, archive.File, 1)
+				assert.Equal(t, "foo-article.md", archive.File[0].Name)
+
+				r, err := archive.File[0].Open()
+				require.NoError(t, err)
+				document, err := io.ReadAll(r)
+				require.NoError(t, err)
+
+				assert.Equal(t, `---
+title: 'Foo: the article'
+slug: foo-article
+tags:
+    - bar
+date: 2020-01-01T12:30:00.123Z
+author: bob@example.com
+description: Foo description
+canonical_url: https://example.com/foo
+---
+
+# Foo
+
+---
+
+foo content
+`, string(document))
+
+				// importing the export again reproduces the article
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.userRepository.EXPECT().FindByEmail(gomock.Any(), gomock.Eq("bob@example.com")).Return(&dto.User{ID: "bob id"}, nil)
+				m.articleImporter.EXPECT().
+					Import(gomock.Any(), gomock.Eq(&dto.ImportArticleIn{
+						Slug:      article.Slug,
+						Title:     article.Title,
+						Content:   article.Content,
+						Tags:      article.Tags,
+						SEO:       article.SEO,
+						AuthorID:  article.AuthorID,
+						CreatedAt: article.CreatedAt,
+					})).
+					Return(dto.ImportStatusUnchanged, nil)
+
+				imported, err := m.newService().Import(context.Background(), &dto.ImportArticlesIn{UserID: "admin id", Archive: out})
+				require.NoError(t, err)
+				assert.Equal(t, []dto.ImportedFile{
+					{Name: "foo-article.md", Slug: "foo-article", Status: dto.ImportStatusUnchanged},
+				}, imported.Files)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService().Export(context.Background(), "admin id")
+			tt.assert(t, m, out, err)
+		})
+	}
+}
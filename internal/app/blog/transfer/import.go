@@ -0,0 +1,180 @@
+package transfer
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// maxDocumentSize limits the size of unpacked article files.
+const maxDocumentSize = 1 << 20
+
+// Import creates articles from Markdown files of the archive and updates articles with the same slugs.
+// Files failing to import are reported with the reason and don't stop the import of other files.
+func (s *Service) Import(ctx context.Context, in *dto.ImportArticlesIn) (*dto.ImportArticlesOut, error) {
+	if err := s.checkAdmin(ctx, in.UserID); err != nil {
+		return nil, err
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(in.Archive), int64(len(in.Archive)))
+	if err != nil {
+		return nil, errors.ErrArchiveInvalid
+	}
+
+	files := slices.DeleteFunc(slices.Clone(archive.File), func(f *zip.File) bool { return !isDocument(f) })
+	slices.SortFunc(files, func(a, b *zip.File) int { return strings.Compare(a.Name, b.Name) })
+
+	imp := &importer{
+		service: s,
+		dryRun:  in.DryRun,
+		authors: make(map[string]string),
+		slugs:   make(map[string]string),
+	}
+
+	out := &dto.ImportArticlesOut{Files: make([]dto.ImportedFile, 0, len(files))}
+	for _, file := range files {
+		result, err := imp.importFile(ctx, file)
+		if err != nil {
+			return nil, fmt.Errorf("import %s: %w", file.Name, err)
+		}
+
+		out.Files = append(out.Files, result)
+	}
+
+	return out, nil
+}
+
+// isDocument tells whether the archive file is an article, skipping directories and hidden files like macOS metadata.
+func isDocument(file *zip.File) bool {
+	if file.FileInfo().IsDir() || path.Ext(file.Name) != documentExt {
+		return false
+	}
+
+	for _, part := range strings.Split(file.Name, "/") {
+		if strings.HasPrefix(part, ".") || strings.HasPrefix(part, "__") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// importer holds the state of a single import.
+type importer struct {
+	service *Service
+	dryRun  bool
+	// authors caches user IDs by email addresses.
+	authors map[string]string
+	// slugs maps slugs to the files they were imported from, to catch files with the same slug.
+	slugs map[string]string
+}
+
+// importFile imports the article of the file. Problems of the file are reported in the result,
+// the error is only returned when the import can't go on.
+func (i *importer) importFile(ctx context.Context, file *zip.File) (dto.ImportedFile, error) {
+	result := dto.ImportedFile{Name: file.Name, Status: dto.ImportStatusFailed}
+
+	document, err := readFile(file)
+	if err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	m, content, err := parseDocument(document)
+	if err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	result.Slug = m.Slug
+
+	if other, ok := i.slugs[m.Slug]; ok {
+		result.Error = fmt.Sprintf("slug is already used by %s", other)
+		return result, nil
+	}
+	i.slugs[m.Slug] = file.Name
+
+	authorID, err := i.findAuthor(ctx, m.Author)
+	if err != nil {
+		return result, err
+	}
+
+	if authorID == "" {
+		result.Error = fmt.Sprintf("author %s not found", m.Author)
+		return result, nil
+	}
+
+	status, err := i.service.articleImporter.Import(ctx, &dto.ImportArticleIn{
+		Slug:      m.Slug,
+		Title:     m.Title,
+		Content:   content,
+		Tags:      m.Tags,
+		SEO:       dto.ArticleSEO{MetaDescription: m.Description, OGImageURL: m.Image, CanonicalURL: m.CanonicalURL},
+		AuthorID:  authorID,
+		CreatedAt: m.Date,
+		DryRun:    i.dryRun,
+	})
+	if err != nil {
+		if goerrors.Is(err, errors.ErrArticleSlugTaken) {
+			result.Error = "slug is taken by an article of another author"
+			return result, nil
+		}
+
+		return result, fmt.Errorf("import article: %w", err)
+	}
+
+	result.Status = status
+	return result, nil
+}
+
+func (i *importer) findAuthor(ctx context.Context, email string) (string, error) {
+	if id, ok := i.authors[email]; ok {
+		return id, nil
+	}
+
+	user, err := i.service.userRepository.FindByEmail(ctx, email)
+	if err != nil {
+		return "", fmt.Errorf("find user by email in repository: %w", err)
+	}
+
+	var id string
+	if user != nil {
+		id = user.ID
+	}
+
+	i.authors[email] = id
+	return id, nil
+}
+
+func readFile(file *zip.File) ([]byte, error) {
+	if file.UncompressedSize64 > maxDocumentSize {
+		return nil, goerrors.New("file is too large")
+	}
+
+	r, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	defer r.Close()
+
+	// the size in the header can't be trusted, so reading is limited too
+	data, err := io.ReadAll(io.LimitReader(r, maxDocumentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	if len(data) > maxDocumentSize {
+		return nil, goerrors.New("file is too large")
+	}
+
+	return data, nil
+}
@@ -0,0 +1,184 @@
+package transfer
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/transfer/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+type serviceMocks struct {
+	articleRepository *mock.MockarticleRepository
+	userRepository    *mock.MockuserRepository
+	articleImporter   *mock.MockarticleImporter
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		articleRepository: mock.NewMockarticleRepository(ctrl),
+		userRepository:    mock.NewMockuserRepository(ctrl),
+		articleImporter:   mock.NewMockarticleImporter(ctrl),
+	}
+}
+
+func (m serviceMocks) newService() *Service {
+	return NewService(m.articleRepository, m.userRepository, m.articleImporter)
+}
+
+func (m serviceMocks) expectAdmin(roles []string) {
+	m.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq("admin id")).Return(&dto.User{ID: "admin id", Roles: roles}, nil)
+}
+
+type archiveFile struct {
+	name    string
+	content string
+}
+
+func newArchive(t *testing.T, files ...archiveFile) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, file := range files {
+		f, err := w.Create(file.name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(file.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestImport(t *testing.T) {
+	const document = `---
+title: Foo
+slug: foo-article
+tags: [bar, baz]
+date: 2020-01-01T00:00:00Z
+author: bob@example.com
+description: Foo description
+---
+
+foo content
+`
+
+	for _, tt := range []struct {
+		name    string
+		archive func(t *testing.T) []byte
+		setup   func(m serviceMocks)
+		assert  func(t *testing.T, out *dto.ImportArticlesOut, err error)
+	}{
+		{
+			name:    "not an admin",
+			archive: func(t *testing.T) []byte { return nil },
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleModerator})
+			},
+			assert: func(t *testing.T, out *dto.ImportArticlesOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name:    "invalid archive",
+			archive: func(t *testing.T) []byte { return []byte("foo") },
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+			},
+			assert: func(t *testing.T, out *dto.ImportArticlesOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrArchiveInvalid)
+			},
+		},
+		{
+			name: "import article error",
+			archive: func(t *testing.T) []byte {
+				return newArchive(t, archiveFile{"foo.md", document})
+			},
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.userRepository.EXPECT().FindByEmail(gomock.Any(), gomock.Eq("bob@example.com")).Return(&dto.User{ID: "bob id"}, nil)
+				m.articleImporter.EXPECT().Import(gomock.Any(), gomock.Any()).Return("", errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.ImportArticlesOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "import foo.md: import article: foo error")
+			},
+		},
+		{
+			name: "ok",
+			archive: func(t *testing.T) []byte {
+				return newArchive(t,
+					archiveFile{"posts/foo.md", document},
+					archiveFile{"posts/copy.md", document},
+					archiveFile{"bar.md", "---\ntitle: Bar\nslug: bar-article\nauthor: alice@example.com\n---\nbar content\n"},
+					archiveFile{"baz.md", "---\ntitle: Baz\nslug: baz-article\nauthor: bob@example.com\n---\nbaz content\n"},
+					archiveFile{"invalid.md", "---\ntitle: Invalid\nslug: Invalid Slug\nauthor: bob@example.com\n---\ncontent\n"},
+					archiveFile{"plain.md", "# Plain\n"},
+					archiveFile{"image.png", "png"},
+					archiveFile{"__MACOSX/posts/._foo.md", "metadata"},
+				)
+			},
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.userRepository.EXPECT().FindByEmail(gomock.Any(), gomock.Eq("alice@example.com")).Return(nil, nil)
+				m.userRepository.EXPECT().FindByEmail(gomock.Any(), gomock.Eq("bob@example.com")).Return(&dto.User{ID: "bob id"}, nil)
+				m.articleImporter.EXPECT().
+					Import(gomock.Any(), gomock.Eq(&dto.ImportArticleIn{
+						Slug:     "baz-article",
+						Title:    "Baz",
+						Content:  "baz content\n",
+						AuthorID: "bob id",
+						DryRun:   true,
+					})).
+					Return("", apperrors.ErrArticleSlugTaken)
+				m.articleImporter.EXPECT().
+					Import(gomock.Any(), gomock.Eq(&dto.ImportArticleIn{
+						Slug:      "foo-article",
+						Title:     "Foo",
+						Content:   "foo content\n",
+						Tags:      []string{"bar", "baz"},
+						SEO:       dto.ArticleSEO{MetaDescription: "Foo description"},
+						AuthorID:  "bob id",
+						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+						DryRun:    true,
+					})).
+					Return(dto.ImportStatusCreated, nil)
+			},
+			assert: func(t *testing.T, out *dto.ImportArticlesOut, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.ImportArticlesOut{Files: []dto.ImportedFile{
+					{Name: "bar.md", Slug: "bar-article", Status: dto.ImportStatusFailed, Error: "author alice@example.com not found"},
+					{Name: "baz.md", Slug: "baz-article", Status: dto.ImportStatusFailed, Error: "slug is taken by an article of another author"},
+					{Name: "invalid.md", Status: dto.ImportStatusFailed, Error: "slug must be at most 255 lowercase characters without spaces and any of /?#%"},
+					{Name: "plain.md", Status: dto.ImportStatusFailed, Error: "front matter is missing"},
+					{Name: "posts/copy.md", Slug: "foo-article", Status: dto.ImportStatusCreated},
+					{Name: "posts/foo.md", Slug: "foo-article", Status: dto.ImportStatusFailed, Error: "slug is already used by posts/copy.md"},
+				}}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService().Import(context.Background(), &dto.ImportArticlesIn{
+				UserID:  "admin id",
+				Archive: tt.archive(t),
+				DryRun:  true,
+			})
+			tt.assert(t, out, err)
+		})
+	}
+}
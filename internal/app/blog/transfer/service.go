@@ -0,0 +1,57 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+type articleRepository interface {
+	GetAfter(ctx context.Context, afterID string, limit int) ([]dto.Article, error)
+}
+
+type userRepository interface {
+	Find(ctx context.Context, id string) (*dto.User, error)
+	FindByEmail(ctx context.Context, email string) (*dto.User, error)
+}
+
+// articleImporter saves imported articles the way edited articles are saved, with revisions and cache purges.
+type articleImporter interface {
+	Import(ctx context.Context, in *dto.ImportArticleIn) (string, error)
+}
+
+// Service imports and exports articles as zip archives of Markdown files with YAML front matter.
+type Service struct {
+	articleRepository articleRepository
+	userRepository    userRepository
+	articleImporter   articleImporter
+}
+
+func NewService(
+	articleRepository articleRepository,
+	userRepository userRepository,
+	articleImporter articleImporter,
+) *Service {
+	return &Service{
+		articleRepository: articleRepository,
+		userRepository:    userRepository,
+		articleImporter:   articleImporter,
+	}
+}
+
+func (s *Service) checkAdmin(ctx context.Context, userID string) error {
+	user, err := s.userRepository.Find(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("find user in repository: %w", err)
+	}
+
+	if !slices.Contains(user.Roles, dto.UserRoleAdmin) {
+		return errors.ErrForbidden
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+// Count counts the view of the article unless the visitor has viewed it today (UTC) already.
+func (s *Service) Count(ctx context.Context, articleID string, visitor dto.Visitor) error {
+	if _, err := s.counterRepository.Add(ctx, articleID, visitorID(visitor), time.Now().UTC()); err != nil {
+		return fmt.Errorf("add view to repository: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/blog/view/mock"
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	viewRepository    *mock.MockviewRepository
+	counterRepository *mock.MockcounterRepository
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		viewRepository:    mock.NewMockviewRepository(ctrl),
+		counterRepository: mock.NewMockcounterRepository(ctrl),
+	}
+}
+
+func (m serviceMocks) newService(logger *testutil.Logger) *Service {
+	return NewService(time.Second, m.viewRepository, m.counterRepository, logger)
+}
+
+func TestCount(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		visitor dto.Visitor
+		setup   func(m serviceMocks)
+		assert  func(t *testing.T, err error)
+	}{
+		{
+			name:    "user",
+			visitor: dto.Visitor{UserID: "user id", ClientIP: "127.0.0.1", UserAgent: "curl"},
+			setup: func(m serviceMocks) {
+				m.counterRepository.EXPECT().
+					Add(gomock.Any(), gomock.Eq("article id"), gomock.Eq("user:user id"), gomock.Any()).
+					Return(true, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "anonymous",
+			visitor: dto.Visitor{ClientIP: "127.0.0.1", UserAgent: "curl"},
+			setup: func(m serviceMocks) {
+				m.counterRepository.EXPECT().
+					Add(gomock.Any(), gomock.Eq("article id"), gomock.Eq("anon:e2548d4186580e427201481eac0d77c9"), gomock.Any()).
+					Do(func(_ context.Context, _, _ string, day time.Time) {
+						assert.Equal(t, time.UTC, day.Location())
+					}).
+					Return(false, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "add view error",
+			visitor: dto.Visitor{UserID: "user id"},
+			setup: func(m serviceMocks) {
+				m.counterRepository.EXPECT().
+					Add(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(false, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "add view to repository: foo error")
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService(testutil.NewLogger()).Count(context.Background(), "article id", tt.visitor)
+
+			tt.assert(t, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RunFlusher periodically moves pending count deltas to the view repository until ctx is done.
+func (s *Service) RunFlusher(ctx context.Context) {
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Flush(ctx); err != nil {
+				s.logger.Error().Err(err).Msg("flush view counters error")
+			}
+		}
+	}
+}
+
+// Flush moves a batch of pending count deltas to the view repository.
+func (s *Service) Flush(ctx context.Context) error {
+	deltas, popErr := s.counterRepository.Pop(ctx)
+	if len(deltas) > 0 {
+		if err := s.viewRepository.SaveCounts(ctx, deltas); err != nil {
+			s.restore(ctx, deltas)
+			return fmt.Errorf("save counts in repository: %w", err)
+		}
+	}
+
+	if popErr != nil {
+		return fmt.Errorf("pop count deltas from repository: %w", popErr)
+	}
+
+	return nil
+}
+
+// restore puts deltas back so they are flushed next time.
+func (s *Service) restore(ctx context.Context, deltas map[string]int64) {
+	for articleID, delta := range deltas {
+		if err := s.counterRepository.Incr(ctx, articleID, delta); err != nil {
+			s.logger.Error().Err(err).
+				Str("article_id", articleID).
+				Msg("restore view count delta error")
+		}
+	}
+}
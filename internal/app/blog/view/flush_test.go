@@ -0,0 +1,90 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestFlush(t *testing.T) {
+	deltas := map[string]int64{"article id": 3}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error, logs []string)
+	}{
+		{
+			name: "nothing to flush",
+			setup: func(m serviceMocks) {
+				m.counterRepository.EXPECT().Pop(gomock.Any()).Return(map[string]int64{}, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "save counts error",
+			setup: func(m serviceMocks) {
+				m.counterRepository.EXPECT().Pop(gomock.Any()).Return(deltas, nil)
+				m.viewRepository.EXPECT().
+					SaveCounts(gomock.Any(), gomock.Eq(deltas)).
+					Return(errors.New("foo error"))
+				m.counterRepository.EXPECT().
+					Incr(gomock.Any(), gomock.Eq("article id"), gomock.Eq(int64(3))).
+					Return(errors.New("bar error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "save counts in repository: foo error")
+				assert.Equal(t, []string{
+					`{"level":"error","error":"bar error","article_id":"article id","message":"restore view count delta error"}`,
+				}, logs)
+			},
+		},
+		{
+			name: "partial pop error",
+			setup: func(m serviceMocks) {
+				m.counterRepository.EXPECT().Pop(gomock.Any()).Return(deltas, errors.New("foo error"))
+				m.viewRepository.EXPECT().
+					SaveCounts(gomock.Any(), gomock.Eq(deltas)).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "pop count deltas from repository: foo error")
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.counterRepository.EXPECT().Pop(gomock.Any()).Return(deltas, nil)
+				m.viewRepository.EXPECT().
+					SaveCounts(gomock.Any(), gomock.Eq(deltas)).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			logger := testutil.NewLogger()
+			err := m.newService(logger).Flush(context.Background())
+
+			tt.assert(t, err, logger.Logs())
+		})
+	}
+}
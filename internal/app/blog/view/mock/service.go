@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockviewRepository is a mock of viewRepository interface.
+type MockviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockviewRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockviewRepositoryMockRecorder is the mock recorder for MockviewRepository.
+type MockviewRepositoryMockRecorder struct {
+	mock *MockviewRepository
+}
+
+// NewMockviewRepository creates a new mock instance.
+func NewMockviewRepository(ctrl *gomock.Controller) *MockviewRepository {
+	mock := &MockviewRepository{ctrl: ctrl}
+	mock.recorder = &MockviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockviewRepository) EXPECT() *MockviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SaveCounts mocks base method.
+func (m *MockviewRepository) SaveCounts(ctx context.Context, deltas map[string]int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCounts", ctx, deltas)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCounts indicates an expected call of SaveCounts.
+func (mr *MockviewRepositoryMockRecorder) SaveCounts(ctx, deltas any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCounts", reflect.TypeOf((*MockviewRepository)(nil).SaveCounts), ctx, deltas)
+}
+
+// MockcounterRepository is a mock of counterRepository interface.
+type MockcounterRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockcounterRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockcounterRepositoryMockRecorder is the mock recorder for MockcounterRepository.
+type MockcounterRepositoryMockRecorder struct {
+	mock *MockcounterRepository
+}
+
+// NewMockcounterRepository creates a new mock instance.
+func NewMockcounterRepository(ctrl *gomock.Controller) *MockcounterRepository {
+	mock := &MockcounterRepository{ctrl: ctrl}
+	mock.recorder = &MockcounterRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcounterRepository) EXPECT() *MockcounterRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockcounterRepository) Add(ctx context.Context, articleID, visitorID string, day time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, articleID, visitorID, day)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockcounterRepositoryMockRecorder) Add(ctx, articleID, visitorID, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockcounterRepository)(nil).Add), ctx, articleID, visitorID, day)
+}
+
+// Incr mocks base method.
+func (m *MockcounterRepository) Incr(ctx context.Context, articleID string, delta int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", ctx, articleID, delta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Incr indicates an expected call of Incr.
+func (mr *MockcounterRepositoryMockRecorder) Incr(ctx, articleID, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockcounterRepository)(nil).Incr), ctx, articleID, delta)
+}
+
+// Pop mocks base method.
+func (m *MockcounterRepository) Pop(ctx context.Context) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pop", ctx)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pop indicates an expected call of Pop.
+func (mr *MockcounterRepositoryMockRecorder) Pop(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pop", reflect.TypeOf((*MockcounterRepository)(nil).Pop), ctx)
+}
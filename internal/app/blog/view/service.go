@@ -0,0 +1,58 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package view
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type viewRepository interface {
+	SaveCounts(ctx context.Context, deltas map[string]int64) error
+}
+
+// counterRepository deduplicates views and keeps count deltas which are not flushed to viewRepository yet.
+type counterRepository interface {
+	// Add counts the view unless the visitor has viewed the article on the day already.
+	Add(ctx context.Context, articleID, visitorID string, day time.Time) (bool, error)
+	Incr(ctx context.Context, articleID string, delta int64) error
+	Pop(ctx context.Context) (map[string]int64, error)
+}
+
+// Service counts article views, one per visitor per day.
+// Views are only buffered on reads and flushed to the view repository in batches by the flusher.
+type Service struct {
+	flushInterval     time.Duration
+	viewRepository    viewRepository
+	counterRepository counterRepository
+	logger            log.Logger
+}
+
+func NewService(
+	flushInterval time.Duration,
+	viewRepository viewRepository,
+	counterRepository counterRepository,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		flushInterval:     flushInterval,
+		viewRepository:    viewRepository,
+		counterRepository: counterRepository,
+		logger:            logger,
+	}
+}
+
+// visitorID identifies users by their ID and anonymous visitors by a hash of the client IP and user agent,
+// so raw addresses are never stored.
+func visitorID(visitor dto.Visitor) string {
+	if visitor.UserID != "" {
+		return "user:" + visitor.UserID
+	}
+
+	hash := sha256.Sum256([]byte(visitor.ClientIP + "\n" + visitor.UserAgent))
+	return "anon:" + hex.EncodeToString(hash[:16])
+}
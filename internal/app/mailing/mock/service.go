@@ -12,8 +12,9 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
-	models "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,23 +42,23 @@ func (m *MockmailRepository) EXPECT() *MockmailRepositoryMockRecorder {
 	return m.recorder
 }
 
-// Get mocks base method.
-func (m *MockmailRepository) Get(ctx context.Context) ([]models.Mail, error) {
+// Claim mocks base method.
+func (m *MockmailRepository) Claim(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]dto.Mail, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx)
-	ret0, _ := ret[0].([]models.Mail)
+	ret := m.ctrl.Call(m, "Claim", ctx, limit, maxAttempts, lease)
+	ret0, _ := ret[0].([]dto.Mail)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Get indicates an expected call of Get.
-func (mr *MockmailRepositoryMockRecorder) Get(ctx any) *gomock.Call {
+// Claim indicates an expected call of Claim.
+func (mr *MockmailRepositoryMockRecorder) Claim(ctx, limit, maxAttempts, lease any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockmailRepository)(nil).Get), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockmailRepository)(nil).Claim), ctx, limit, maxAttempts, lease)
 }
 
 // Save mocks base method.
-func (m *MockmailRepository) Save(ctx context.Context, mails []models.Mail) error {
+func (m *MockmailRepository) Save(ctx context.Context, mails []dto.Mail) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Save", ctx, mails)
 	ret0, _ := ret[0].(error)
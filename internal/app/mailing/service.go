@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/art-es/yet-another-service/internal/app/shared/dto"
@@ -13,7 +15,7 @@ import (
 )
 
 type mailRepository interface {
-	Get(ctx context.Context) ([]dto.Mail, error)
+	Claim(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]dto.Mail, error)
 	Save(ctx context.Context, mails []dto.Mail) error
 }
 
@@ -24,8 +26,18 @@ type mailer interface {
 type Config struct {
 	Delay          time.Duration
 	ProcessTimeout time.Duration
+	// Workers send a claimed batch of BatchSize mails concurrently.
+	Workers   int
+	BatchSize int
+	// Lease hides claimed mails from other instances, it must exceed ProcessTimeout.
+	Lease       time.Duration
+	MaxAttempts int
+	// RetryDelay follows the first failed attempt, the delay doubles after every next one.
+	RetryDelay time.Duration
 }
 
+var getCurrentTime = time.Now
+
 type Service struct {
 	config         Config
 	processRetrier retrier.Retrier
@@ -55,7 +67,7 @@ func NewService(
 }
 
 func (s *Service) Run(ctx context.Context) error {
-	for !s.done {
+	for !s.done && ctx.Err() == nil {
 		err := func() error {
 			pctx, cancel := context.WithTimeout(ctx, s.config.ProcessTimeout)
 			defer cancel()
@@ -74,9 +86,9 @@ func (s *Service) Run(ctx context.Context) error {
 }
 
 func (s *Service) process(ctx context.Context) error {
-	mails, err := s.mailRepository.Get(ctx)
+	mails, err := s.mailRepository.Claim(ctx, s.config.BatchSize, s.config.MaxAttempts, s.config.Lease)
 	if err != nil {
-		return fmt.Errorf("get mails from repository: %w", err)
+		return fmt.Errorf("claim mails in repository: %w", err)
 	}
 
 	if len(mails) == 0 {
@@ -84,25 +96,64 @@ func (s *Service) process(ctx context.Context) error {
 		return nil
 	}
 
-	mailed := 0
-	for i := range mails {
-		if err = s.mailer.MailTo(mails[i].Address, mails[i].Subject, mails[i].Content); err != nil {
-			s.logger.Error().Err(err).Str("mail_id", mails[i].ID).Msg("mail error")
-			continue
-		}
+	var (
+		queue  = make(chan *dto.Mail)
+		mailed atomic.Int64
+		wg     sync.WaitGroup
+	)
+
+	for range max(s.config.Workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for mail := range queue {
+				if s.send(ctx, mail) {
+					mailed.Add(1)
+				}
+			}
+		}()
+	}
 
-		mailed++
-		mails[i].SetMailed()
+	for i := range mails {
+		queue <- &mails[i]
 	}
+	close(queue)
+	wg.Wait()
 
-	if mailed == 0 {
+	if mailed.Load() == 0 {
 		return errors.New("all mails finished with error")
 	}
 
-	err = s.saveRetrier.Process(func() error { return s.mailRepository.Save(ctx, mails) })
+	return nil
+}
+
+// send mails the mail and saves the outcome right away, so a crash can only repeat the mails being sent.
+func (s *Service) send(ctx context.Context, mail *dto.Mail) bool {
+	if err := s.mailer.MailTo(mail.Address, mail.Subject, mail.Content); err != nil {
+		s.logger.Error().Err(err).Str("mail_id", mail.ID).Msg("mail error")
+		mail.SetFailed(err.Error(), getCurrentTime().Add(s.retryDelay(mail.Attempts)))
+
+		if mail.Attempts >= s.config.MaxAttempts {
+			s.logger.Warn().Str("mail_id", mail.ID).Msg("mail is given up after max attempts")
+		}
+	} else {
+		mail.SetMailed()
+	}
+
+	err := s.saveRetrier.Process(func() error { return s.mailRepository.Save(ctx, []dto.Mail{*mail}) })
 	if err != nil {
-		return fmt.Errorf("save in repository: %w", err)
+		s.logger.Error().Err(err).Str("mail_id", mail.ID).Msg("save mail error")
 	}
 
-	return nil
+	return mail.Mailed
+}
+
+func (s *Service) retryDelay(attempts int) time.Duration {
+	delay := s.config.RetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+	}
+
+	return delay
 }
@@ -5,7 +5,9 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -16,7 +18,19 @@ import (
 	"github.com/art-es/yet-another-service/internal/driver/zerolog"
 )
 
+var testConfig = Config{
+	Workers:     1,
+	BatchSize:   20,
+	Lease:       time.Minute,
+	MaxAttempts: 3,
+	RetryDelay:  time.Minute,
+}
+
 func TestRun(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	getCurrentTime = func() time.Time { return now }
+	defer func() { getCurrentTime = time.Now }()
+
 	for _, tt := range []struct {
 		name   string
 		setup  func(mailRepository *mock.MockmailRepository, mailer *mock.Mockmailer)
@@ -26,32 +40,34 @@ func TestRun(t *testing.T) {
 			name: "ok",
 			setup: func(mailRepository *mock.MockmailRepository, mailer *mock.Mockmailer) {
 				gotMails := []dto.Mail{
-					{ID: "mail id 1", Address: "foo@example.com", Subject: "mail 1", Content: "lorem ipsum 1"},
-					{ID: "mail id 2", Address: "bar@example.com", Subject: "mail 2", Content: "lorem ipsum 2"},
+					{ID: "mail id 1", Address: "foo@example.com", Subject: "mail 1", Content: "lorem ipsum 1", Attempts: 1},
+					{ID: "mail id 2", Address: "bar@example.com", Subject: "mail 2", Content: "lorem ipsum 2", Attempts: 1},
 				}
 				mailRepository.EXPECT().
-					Get(gomock.Any()).
+					Claim(gomock.Any(), gomock.Eq(20), gomock.Eq(3), gomock.Eq(time.Minute)).
 					Return(gotMails, nil)
 
 				mailer.EXPECT().
 					MailTo(gomock.Eq("foo@example.com"), gomock.Eq("mail 1"), gomock.Eq("lorem ipsum 1")).
 					Return(nil)
+				mailRepository.EXPECT().
+					Save(gomock.Any(), gomock.Eq([]dto.Mail{
+						{Mailed: true, ID: "mail id 1", Address: "foo@example.com", Subject: "mail 1", Content: "lorem ipsum 1", Attempts: 1},
+					})).
+					Return(nil)
 
 				mailer.EXPECT().
 					MailTo(gomock.Eq("bar@example.com"), gomock.Eq("mail 2"), gomock.Eq("lorem ipsum 2")).
 					Return(nil)
-
-				expectedMails := []dto.Mail{
-					{Mailed: true, ID: "mail id 1", Address: "foo@example.com", Subject: "mail 1", Content: "lorem ipsum 1"},
-					{Mailed: true, ID: "mail id 2", Address: "bar@example.com", Subject: "mail 2", Content: "lorem ipsum 2"},
-				}
 				mailRepository.EXPECT().
-					Save(gomock.Any(), gomock.Eq(expectedMails)).
+					Save(gomock.Any(), gomock.Eq([]dto.Mail{
+						{Mailed: true, ID: "mail id 2", Address: "bar@example.com", Subject: "mail 2", Content: "lorem ipsum 2", Attempts: 1},
+					})).
 					Return(nil)
 
 				// 2nd cycle
 				mailRepository.EXPECT().
-					Get(gomock.Any()).
+					Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, nil)
 			},
 			assert: func(t *testing.T, err error, logs []string) {
@@ -63,32 +79,42 @@ func TestRun(t *testing.T) {
 			name: "partially mails error",
 			setup: func(mailRepository *mock.MockmailRepository, mailer *mock.Mockmailer) {
 				gotMails := []dto.Mail{
-					{ID: "mail id 1", Address: "foo@example.com", Subject: "mail 1", Content: "lorem ipsum 1"},
-					{ID: "mail id 2", Address: "bar@example.com", Subject: "mail 2", Content: "lorem ipsum 2"},
+					{ID: "mail id 1", Address: "foo@example.com", Subject: "mail 1", Content: "lorem ipsum 1", Attempts: 1},
+					{ID: "mail id 2", Address: "bar@example.com", Subject: "mail 2", Content: "lorem ipsum 2", Attempts: 1},
 				}
 				mailRepository.EXPECT().
-					Get(gomock.Any()).
+					Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(gotMails, nil)
 
 				mailer.EXPECT().
 					MailTo(gomock.Eq("foo@example.com"), gomock.Eq("mail 1"), gomock.Eq("lorem ipsum 1")).
 					Return(errors.New("foo error"))
+				mailRepository.EXPECT().
+					Save(gomock.Any(), gomock.Eq([]dto.Mail{
+						{
+							ID:        "mail id 1",
+							Address:   "foo@example.com",
+							Subject:   "mail 1",
+							Content:   "lorem ipsum 1",
+							Attempts:  1,
+							LastError: "foo error",
+							RetryAt:   now.Add(time.Minute),
+						},
+					})).
+					Return(nil)
 
 				mailer.EXPECT().
 					MailTo(gomock.Eq("bar@example.com"), gomock.Eq("mail 2"), gomock.Eq("lorem ipsum 2")).
 					Return(nil)
-
-				expectedMails := []dto.Mail{
-					{Mailed: false, ID: "mail id 1", Address: "foo@example.com", Subject: "mail 1", Content: "lorem ipsum 1"},
-					{Mailed: true, ID: "mail id 2", Address: "bar@example.com", Subject: "mail 2", Content: "lorem ipsum 2"},
-				}
 				mailRepository.EXPECT().
-					Save(gomock.Any(), gomock.Eq(expectedMails)).
+					Save(gomock.Any(), gomock.Eq([]dto.Mail{
+						{Mailed: true, ID: "mail id 2", Address: "bar@example.com", Subject: "mail 2", Content: "lorem ipsum 2", Attempts: 1},
+					})).
 					Return(nil)
 
 				// 2nd cycle
 				mailRepository.EXPECT().
-					Get(gomock.Any()).
+					Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, nil)
 			},
 			assert: func(t *testing.T, err error, logs []string) {
@@ -101,37 +127,89 @@ func TestRun(t *testing.T) {
 			name: "all mails error",
 			setup: func(mailRepository *mock.MockmailRepository, mailer *mock.Mockmailer) {
 				gotMails := []dto.Mail{
-					{ID: "mail id 1", Address: "foo@example.com", Subject: "mail 1", Content: "lorem ipsum 1"},
-					{ID: "mail id 2", Address: "bar@example.com", Subject: "mail 2", Content: "lorem ipsum 2"},
+					{ID: "mail id 1", Address: "foo@example.com", Subject: "mail 1", Content: "lorem ipsum 1", Attempts: 2},
+					{ID: "mail id 2", Address: "bar@example.com", Subject: "mail 2", Content: "lorem ipsum 2", Attempts: 3},
 				}
 				mailRepository.EXPECT().
-					Get(gomock.Any()).
+					Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(gotMails, nil)
 
 				mailer.EXPECT().
 					MailTo(gomock.Eq("foo@example.com"), gomock.Eq("mail 1"), gomock.Eq("lorem ipsum 1")).
 					Return(errors.New("foo error"))
+				mailRepository.EXPECT().
+					Save(gomock.Any(), gomock.Eq([]dto.Mail{
+						{
+							ID:        "mail id 1",
+							Address:   "foo@example.com",
+							Subject:   "mail 1",
+							Content:   "lorem ipsum 1",
+							Attempts:  2,
+							LastError: "foo error",
+							RetryAt:   now.Add(2 * time.Minute),
+						},
+					})).
+					Return(nil)
 
 				mailer.EXPECT().
 					MailTo(gomock.Eq("bar@example.com"), gomock.Eq("mail 2"), gomock.Eq("lorem ipsum 2")).
 					Return(errors.New("bar error"))
+				mailRepository.EXPECT().
+					Save(gomock.Any(), gomock.Eq([]dto.Mail{
+						{
+							ID:        "mail id 2",
+							Address:   "bar@example.com",
+							Subject:   "mail 2",
+							Content:   "lorem ipsum 2",
+							Attempts:  3,
+							LastError: "bar error",
+							RetryAt:   now.Add(4 * time.Minute),
+						},
+					})).
+					Return(nil)
 			},
 			assert: func(t *testing.T, err error, logs []string) {
 				assert.EqualError(t, err, "process: all mails finished with error")
-				assert.Len(t, logs, 2)
+				assert.Len(t, logs, 3)
 				assert.Equal(t, `{"level":"error","error":"foo error","mail_id":"mail id 1","message":"mail error"}`, logs[0])
 				assert.Equal(t, `{"level":"error","error":"bar error","mail_id":"mail id 2","message":"mail error"}`, logs[1])
+				assert.Equal(t, `{"level":"warn","mail_id":"mail id 2","message":"mail is given up after max attempts"}`, logs[2])
 			},
 		},
 		{
-			name: "get mails error",
+			name: "save mail error",
 			setup: func(mailRepository *mock.MockmailRepository, mailer *mock.Mockmailer) {
 				mailRepository.EXPECT().
-					Get(gomock.Any()).
+					Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]dto.Mail{{ID: "mail id 1", Address: "foo@example.com", Subject: "mail 1", Content: "lorem ipsum 1", Attempts: 1}}, nil)
+
+				mailer.EXPECT().
+					MailTo(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+				mailRepository.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(errors.New("dummy error"))
+
+				// 2nd cycle
+				mailRepository.EXPECT().
+					Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Len(t, logs, 1)
+				assert.Equal(t, `{"level":"error","error":"dummy error","mail_id":"mail id 1","message":"save mail error"}`, logs[0])
+			},
+		},
+		{
+			name: "claim mails error",
+			setup: func(mailRepository *mock.MockmailRepository, mailer *mock.Mockmailer) {
+				mailRepository.EXPECT().
+					Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("dummy error"))
 			},
 			assert: func(t *testing.T, err error, logs []string) {
-				assert.EqualError(t, err, "process: get mails from repository: dummy error")
+				assert.EqualError(t, err, "process: claim mails in repository: dummy error")
 				assert.Len(t, logs, 0)
 			},
 		},
@@ -140,29 +218,84 @@ func TestRun(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			retrier := mockretrier.NewMockRetrier(ctrl)
-			retrier.EXPECT().
-				Process(gomock.Any()).
-				DoAndReturn(func(process func() error) error { return process() }).
-				AnyTimes()
-
+			retrier := newRetrier(ctrl)
 			mockMailRepository := mock.NewMockmailRepository(ctrl)
 			mockMailer := mock.NewMockmailer(ctrl)
 			tt.setup(mockMailRepository, mockMailer)
 
-			config := Config{}
 			logbuf := &bytes.Buffer{}
 			logger := zerolog.NewLoggerWithWriter(logbuf)
 
-			service := NewService(config, retrier, retrier, mockMailRepository, mockMailer, logger)
+			service := NewService(testConfig, retrier, retrier, mockMailRepository, mockMailer, logger)
 			err := service.Run(context.Background())
 
-			var logs []string
-			for s := bufio.NewScanner(logbuf); s.Scan(); {
-				logs = append(logs, s.Text())
-			}
-
-			tt.assert(t, err, logs)
+			tt.assert(t, err, readLogs(logbuf))
 		})
 	}
 }
+
+func TestRunWorkers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := testConfig
+	config.Workers = 4
+
+	mails := make([]dto.Mail, 0, 10)
+	for i := range 10 {
+		mails = append(mails, dto.Mail{ID: fmt.Sprintf("mail id %d", i), Address: fmt.Sprintf("user%d@example.com", i), Attempts: 1})
+	}
+
+	retrier := newRetrier(ctrl)
+	mockMailRepository := mock.NewMockmailRepository(ctrl)
+	mockMailer := mock.NewMockmailer(ctrl)
+
+	gomock.InOrder(
+		mockMailRepository.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mails, nil),
+		mockMailRepository.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil),
+	)
+	for _, mail := range mails {
+		mockMailer.EXPECT().MailTo(gomock.Eq(mail.Address), gomock.Any(), gomock.Any()).Return(nil)
+
+		mail.SetMailed()
+		mockMailRepository.EXPECT().Save(gomock.Any(), gomock.Eq([]dto.Mail{mail})).Return(nil)
+	}
+
+	logbuf := &bytes.Buffer{}
+	service := NewService(config, retrier, retrier, mockMailRepository, mockMailer, zerolog.NewLoggerWithWriter(logbuf))
+
+	assert.NoError(t, service.Run(context.Background()))
+	assert.Empty(t, readLogs(logbuf))
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	retrier := newRetrier(ctrl)
+	service := NewService(testConfig, retrier, retrier, mock.NewMockmailRepository(ctrl), mock.NewMockmailer(ctrl), zerolog.NewLoggerWithWriter(&bytes.Buffer{}))
+
+	assert.NoError(t, service.Run(ctx))
+}
+
+func newRetrier(ctrl *gomock.Controller) *mockretrier.MockRetrier {
+	retrier := mockretrier.NewMockRetrier(ctrl)
+	retrier.EXPECT().
+		Process(gomock.Any()).
+		DoAndReturn(func(process func() error) error { return process() }).
+		AnyTimes()
+
+	return retrier
+}
+
+func readLogs(logbuf *bytes.Buffer) []string {
+	var logs []string
+	for s := bufio.NewScanner(logbuf); s.Scan(); {
+		logs = append(logs, s.Text())
+	}
+
+	return logs
+}
@@ -1,15 +1,98 @@
 package dto
 
+import "time"
+
 type Article struct {
-	Slug     string
-	Title    string
-	Content  string
-	AuthorID int64
+	ID          string
+	Slug        string
+	Title       string
+	Content     string
+	ContentHTML string
+	TOC         []TOCEntry
+	// Excerpt, WordCount and ReadingTime are computed from the rendered content on save.
+	Excerpt     string
+	WordCount   int
+	ReadingTime int // minutes
+	Tags        []string
+	Visibility  string
+	// Locale is the BCP 47 language tag of the content, articles served in a translation carry its locale.
+	Locale        string
+	AuthorID      string
+	CommentsCount int
+	// ViewsCount lags behind by views which are not flushed to the storage yet.
+	ViewsCount int64
+	SEO        ArticleSEO
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time
+
+	// Author is the owner of the article, Authors lists the owner first and then co-authors who accepted invitations.
+	Author  *ArticleAuthor
+	Authors []ArticleAuthor
+	// Series is filled for single articles only, listings don't navigate series.
+	Series         *ArticleSeries
+	ReactionCounts map[string]int64
+	OwnReactions   []string
+	// Hidden is set for articles hidden by moderators, they are left out of public reads.
+	// It's only loaded for single articles found by slug, listings leave hidden articles out.
+	Hidden bool
+	// Locked is set per request when the reader may not see the article, its content is truncated to the excerpt.
+	Locked bool
+	// Translations are published translations of the article, Alternates lists all language versions of the article,
+	// the original first. Alternates are set per request and only for articles with translations.
+	Translations []ArticleTranslation
+	Alternates   []ArticleAlternate
+}
 
-	Author *ArticleAuthor
+// Article visibility levels. Gated articles are listed to everyone, only their content is locked.
+const (
+	ArticleVisibilityPublic = "public"
+	// ArticleVisibilityUsers reveals the content to logged-in users.
+	ArticleVisibilityUsers = "users"
+	// ArticleVisibilityMembers reveals the content to members, see UserRoleMember.
+	ArticleVisibilityMembers = "members"
+)
+
+// ArticleSEO holds metadata for search engines and link previews.
+type ArticleSEO struct {
+	MetaDescription string
+	OGImageURL      string
+	// CanonicalURL overrides the URL of the article on the site, e.g. for articles republished from elsewhere.
+	// The article service fills it with the site URL when there is no override.
+	CanonicalURL string
 }
 
+// Article author roles. Both owners and contributors edit the article,
+// owners also manage its co-authors and series.
+const (
+	ArticleAuthorRoleOwner       = "owner"
+	ArticleAuthorRoleContributor = "contributor"
+)
+
 type ArticleAuthor struct {
 	DisplayName string
 	NickName    string
+	// UserID and Role are set for authors of articles, not for authors of comments.
+	UserID string
+	Role   string
+}
+
+// ArticleCoAuthor is a co-author of the article, either invited or accepted.
+type ArticleCoAuthor struct {
+	ArticleID   string
+	ArticleSlug string
+	UserID      string
+	NickName    string
+	Role        string
+	// InvitationToken is empty once the invitation is accepted.
+	InvitationToken string
+	InvitedAt       time.Time
+	AcceptedAt      *time.Time
+}
+
+func (a *ArticleCoAuthor) Accepted() bool {
+	return a.AcceptedAt != nil
+}
+
+func (a *Article) Stored() bool {
+	return a.ID != ""
 }
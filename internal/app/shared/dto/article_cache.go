@@ -0,0 +1,25 @@
+package dto
+
+// ArticleCacheInvalidation describes cached pages of articles which must be dropped.
+type ArticleCacheInvalidation struct {
+	// Slugs drops pages containing any of the articles.
+	Slugs []string
+	// AuthorIDs drops pages containing articles of any of the authors or listing their articles.
+	AuthorIDs []string
+	// Listings drops pages of the listing across all authors.
+	Listings bool
+	// Flush drops all pages.
+	Flush bool
+}
+
+type CacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+// ArticleCacheToken is taken before a page is loaded from storage,
+// so the cache can drop the page if it was flushed or purged while it was loading.
+type ArticleCacheToken struct {
+	Version  int64
+	Sequence int64
+}
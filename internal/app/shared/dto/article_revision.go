@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+type ArticleRevision struct {
+	ID        string
+	ArticleID string
+	Number    int
+	Title     string
+	Content   string
+	AuthorID  string
+	CreatedAt time.Time
+}
+
+type DiffLine struct {
+	Op   string
+	Text string
+}
@@ -0,0 +1,41 @@
+package dto
+
+import "time"
+
+// ArticleTranslation is the article in another language, linked to the original article.
+type ArticleTranslation struct {
+	ID        string
+	ArticleID string
+	// ArticleSlug is the slug of the original article.
+	ArticleSlug string
+	// Locale is a BCP 47 language tag in its canonical form.
+	Locale      string
+	Slug        string
+	Title       string
+	Content     string
+	ContentHTML string
+	TOC         []TOCEntry
+	Excerpt     string
+	WordCount   int
+	ReadingTime int // minutes
+	Status      string
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+func (t *ArticleTranslation) Stored() bool {
+	return t.ID != ""
+}
+
+// Translation statuses. Drafts are only seen by authors of the article.
+const (
+	TranslationStatusDraft     = "draft"
+	TranslationStatusPublished = "published"
+)
+
+// ArticleAlternate is a language version of the article, either the original or a published translation.
+type ArticleAlternate struct {
+	Locale string
+	Slug   string
+	Title  string
+}
@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+type AuthorProfile struct {
+	ID             string
+	DisplayName    string
+	NickName       string
+	Bio            string
+	AvatarURL      *string
+	ArticlesCount  int
+	FollowersCount int
+	FollowingCount int
+	CreatedAt      time.Time
+}
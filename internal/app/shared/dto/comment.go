@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+type Comment struct {
+	ID        string
+	ArticleID string
+	ParentID  *string
+	AuthorID  string
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+	Deleted   bool
+
+	Author  *ArticleAuthor
+	Replies []*Comment
+}
+
+func (c *Comment) Stored() bool {
+	return c.ID != ""
+}
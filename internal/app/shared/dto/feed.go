@@ -0,0 +1,63 @@
+package dto
+
+import "time"
+
+const (
+	FeedFormatRSS  = "rss"
+	FeedFormatAtom = "atom"
+	FeedFormatJSON = "json"
+)
+
+var FeedContentTypes = map[string]string{
+	FeedFormatRSS:  "application/rss+xml; charset=utf-8",
+	FeedFormatAtom: "application/atom+xml; charset=utf-8",
+	FeedFormatJSON: "application/feed+json; charset=utf-8",
+}
+
+type GetFeedIn struct {
+	Format string
+	// AuthorNickName limits the feed to articles of the author.
+	AuthorNickName string
+	// Tag limits the feed to articles with the tag.
+	Tag string
+}
+
+type Feed struct {
+	Title       string
+	Description string
+	// Link is the page the feed is about, FeedLink is the feed itself.
+	Link     string
+	FeedLink string
+	Updated  time.Time
+	Items    []FeedItem
+}
+
+type FeedItem struct {
+	// ID is the permanent link of the article.
+	ID          string
+	Title       string
+	Link        string
+	Excerpt     string
+	ContentHTML string
+	AuthorName  string
+	AuthorLink  string
+	Published   time.Time
+	Updated     time.Time
+	// Language and Alternates are only set for articles with translations,
+	// alternates list all language versions including the item itself.
+	Language   string
+	Alternates []FeedAlternate
+}
+
+type FeedAlternate struct {
+	Language string
+	Link     string
+}
+
+// RenderedFeed is a feed encoded in one of the formats along with its validators for conditional requests.
+type RenderedFeed struct {
+	Body         []byte
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+const (
+	FollowListFollowers = "followers"
+	FollowListFollowing = "following"
+)
+
+// Follow is a user on a follower or following list of an author.
+type Follow struct {
+	UserID     string
+	User       ArticleAuthor
+	FollowedAt time.Time
+}
+
+// FollowCursor is a keyset position in a follower or following list, the lists go from the latest follow.
+type FollowCursor struct {
+	FollowedAt time.Time
+	UserID     string
+}
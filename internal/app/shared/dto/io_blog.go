@@ -1,10 +1,394 @@
 package dto
 
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	ArticleSortNewest = "newest"
+	ArticleSortOldest = "oldest"
+	ArticleSortTitle  = "title"
+)
+
+var ArticleSorts = []string{ArticleSortNewest, ArticleSortOldest, ArticleSortTitle}
+
 type GetArticlesIn struct {
-	FromSlug *string
+	Sort   string
+	Cursor *ArticleCursor
+	Limit  int
+	UserID string
+
+	// AuthorNickName limits the list to articles of the author.
+	// It is resolved to AuthorID by the article service before the list is loaded.
+	AuthorNickName string
+	AuthorID       string
+
+	// Slug limits the list to the single article, which is how single articles are loaded and cached.
+	Slug string
+
+	// Tag limits the list to articles with the tag.
+	Tag string
+
+	// Languages are the language tags preferred by the caller, most preferred first.
+	// Articles are served in the best matching translation, they aren't part of the cache key.
+	Languages []string
+}
+
+// CacheKey identifies the page regardless of the caller, since per-user data is never cached.
+func (in *GetArticlesIn) CacheKey() string {
+	vals := url.Values{}
+	vals.Add("sort", in.Sort)
+	vals.Add("limit", strconv.Itoa(in.Limit))
+	if in.AuthorID != "" {
+		vals.Add("author_id", in.AuthorID)
+	}
+	if in.Slug != "" {
+		vals.Add("slug", in.Slug)
+	}
+	if in.Tag != "" {
+		vals.Add("tag", in.Tag)
+	}
+	if in.Cursor != nil {
+		vals.Add("backward", strconv.FormatBool(in.Cursor.Backward))
+		vals.Add("created_at", in.Cursor.CreatedAt.Format(time.RFC3339Nano))
+		vals.Add("title", in.Cursor.Title)
+		vals.Add("id", in.Cursor.ID)
+	}
+
+	return vals.Encode()
+}
+
+type FindArticleIn struct {
+	// Slug is the slug of the article or of one of its translations, which is served then.
+	Slug      string
+	Visitor   Visitor
+	Languages []string
+}
+
+// Visitor is who requests an article, anonymous visitors are told apart by the client IP and user agent.
+type Visitor struct {
+	UserID    string
+	ClientIP  string
+	UserAgent string
 }
 
 type GetArticlesOut struct {
+	Articles   []Article
+	NextCursor *ArticleCursor
+	PrevCursor *ArticleCursor
+}
+
+// ArticleCursor is a keyset position in the list of articles.
+// Backward cursors point to the page before the position, forward ones to the page after it.
+type ArticleCursor struct {
+	Backward  bool
+	CreatedAt time.Time
+	Title     string
+	ID        string
+}
+
+type FollowIn struct {
+	UserID         string
+	AuthorNickName string
+}
+
+type GetFollowsIn struct {
+	// List is either FollowListFollowers or FollowListFollowing.
+	List           string
+	AuthorNickName string
+	Cursor         *FollowCursor
+	Limit          int
+}
+
+type GetFollowsOut struct {
+	Follows []Follow
+	// Count is the length of the whole list.
+	Count      int
+	NextCursor *FollowCursor
+}
+
+type GetTimelineIn struct {
+	UserID string
+	Cursor *TimelineEntry
+	Limit  int
+}
+
+type GetTimelineOut struct {
+	Articles   []Article
+	NextCursor *TimelineEntry
+}
+
+type CreateReadingListIn struct {
+	UserID string
+	Name   string
+}
+
+// GetReadingListIn identifies the list either by the owner and the ID or by the share token.
+type GetReadingListIn struct {
+	UserID     string
+	ID         string
+	ShareToken string
+}
+
+type GetReadingListOut struct {
+	List     *ReadingList
 	Articles []Article
+}
+
+type ReadingListArticleIn struct {
+	UserID      string
+	ListID      string
+	ArticleSlug string
+}
+
+type ReorderReadingListIn struct {
+	UserID string
+	ListID string
+	// ArticleSlugs are all articles of the list in the new order.
+	ArticleSlugs []string
+}
+
+type ShareReadingListIn struct {
+	UserID string
+	ListID string
+	Shared bool
+}
+
+type GetCommentsIn struct {
+	ArticleSlug string
+	FromID      *string
+}
+
+type GetCommentsOut struct {
+	Comments []*Comment
 	HasMore  bool
 }
+
+type CreateCommentIn struct {
+	ArticleSlug string
+	ParentID    *string
+	UserID      string
+	Content     string
+}
+
+type UpdateCommentIn struct {
+	ID      string
+	UserID  string
+	Content string
+}
+
+type DeleteCommentIn struct {
+	ID     string
+	UserID string
+}
+
+type ReactionIn struct {
+	ArticleSlug string
+	UserID      string
+	Kind        string
+}
+
+type CreateArticleIn struct {
+	UserID  string
+	Slug    string
+	Title   string
+	Content string
+	Tags    []string
+	SEO     ArticleSEO
+	// Visibility defaults to ArticleVisibilityPublic.
+	Visibility string
+	// Locale defaults to the default locale of the site.
+	Locale string
+}
+
+type UpdateArticleIn struct {
+	Slug    string
+	UserID  string
+	Title   string
+	Content string
+	// Tags are left as is when nil, an empty list removes all tags.
+	Tags []string
+	SEO  ArticleSEO
+	// Visibility is left as is when empty.
+	Visibility string
+}
+
+type GetRevisionsIn struct {
+	ArticleSlug string
+	UserID      string
+}
+
+type DiffRevisionsIn struct {
+	ArticleSlug string
+	UserID      string
+	From        int
+	To          int
+}
+
+type DiffRevisionsOut struct {
+	From  *ArticleRevision
+	To    *ArticleRevision
+	Lines []DiffLine
+}
+
+type RestoreRevisionIn struct {
+	ArticleSlug string
+	UserID      string
+	Number      int
+}
+
+type UploadMediaIn struct {
+	UserID string
+	Data   []byte
+}
+
+type CreateReportIn struct {
+	UserID     string
+	TargetType string
+	// Target is the slug of an article or the ID of a comment.
+	Target string
+	Reason string
+}
+
+type GetReportsIn struct {
+	UserID     string
+	State      string
+	TargetType string
+	FromID     *string
+}
+
+type GetReportsOut struct {
+	Reports []*Report
+	HasMore bool
+}
+
+type SetReportStateIn struct {
+	UserID   string
+	ReportID string
+	State    string
+	Note     string
+}
+
+type ModerateIn struct {
+	UserID     string
+	Action     string
+	TargetType string
+	TargetID   string
+	// ReportID links the action to the report which prompted it.
+	ReportID *string
+	Note     string
+}
+
+type GetModerationActionsIn struct {
+	UserID     string
+	TargetType string
+	TargetID   string
+	FromID     *string
+}
+
+type GetModerationActionsOut struct {
+	Actions []*ModerationAction
+	HasMore bool
+}
+
+// Results of importing a file of an article archive.
+const (
+	ImportStatusCreated   = "created"
+	ImportStatusUpdated   = "updated"
+	ImportStatusUnchanged = "unchanged"
+	ImportStatusFailed    = "failed"
+)
+
+type ImportArticlesIn struct {
+	UserID string
+	// Archive is a zip archive of Markdown files with YAML front matter.
+	Archive []byte
+	// DryRun checks the files and reports what would be imported without saving anything.
+	DryRun bool
+}
+
+type ImportArticlesOut struct {
+	Files []ImportedFile
+}
+
+type ImportedFile struct {
+	Name   string
+	Slug   string
+	Status string
+	// Error describes why the file failed to import.
+	Error string
+}
+
+// ImportArticleIn is an article of an archive, created or updated by its slug.
+type ImportArticleIn struct {
+	Slug     string
+	Title    string
+	Content  string
+	Tags     []string
+	SEO      ArticleSEO
+	AuthorID string
+	// CreatedAt is the publication date, zero keeps the date of an existing article.
+	CreatedAt time.Time
+	DryRun    bool
+}
+
+type CreateSeriesIn struct {
+	UserID      string
+	Title       string
+	Description string
+}
+
+type GetSeriesOut struct {
+	Series   *Series
+	Articles []Article
+}
+
+type SeriesArticleIn struct {
+	UserID      string
+	SeriesID    string
+	ArticleSlug string
+}
+
+type ReorderSeriesIn struct {
+	UserID   string
+	SeriesID string
+	// ArticleSlugs are all articles of the series in the new order.
+	ArticleSlugs []string
+}
+
+type InviteArticleAuthorIn struct {
+	UserID      string
+	ArticleSlug string
+	// NickName identifies the invited user.
+	NickName string
+	Role     string
+}
+
+type AcceptArticleAuthorIn struct {
+	UserID string
+	Token  string
+}
+
+type ArticleAuthorIn struct {
+	UserID      string
+	ArticleSlug string
+	NickName    string
+}
+
+type SaveTranslationIn struct {
+	UserID      string
+	ArticleSlug string
+	Locale      string
+	Slug        string
+	Title       string
+	Content     string
+	Status      string
+}
+
+type DeleteTranslationIn struct {
+	UserID      string
+	ArticleSlug string
+	Locale      string
+}
@@ -0,0 +1,38 @@
+package dto
+
+type CreateWebhookIn struct {
+	UserID string
+	URL    string
+	Events []string
+}
+
+type UpdateWebhookIn struct {
+	UserID    string
+	WebhookID string
+	URL       string
+	Events    []string
+	// Active set on an inactive webhook reactivates it and resets its failures.
+	Active bool
+}
+
+type DeleteWebhookIn struct {
+	UserID    string
+	WebhookID string
+}
+
+type GetWebhookDeliveriesIn struct {
+	UserID    string
+	WebhookID string
+	FromID    *string
+}
+
+type GetWebhookDeliveriesOut struct {
+	Deliveries []*WebhookDelivery
+	HasMore    bool
+}
+
+type RedeliverWebhookIn struct {
+	UserID     string
+	WebhookID  string
+	DeliveryID string
+}
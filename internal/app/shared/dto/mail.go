@@ -1,11 +1,18 @@
 package dto
 
+import "time"
+
 type Mail struct {
 	ID      string
 	Address string
 	Subject string
 	Content string
 	Mailed  bool
+	// Attempts counts claims of the mail, including the current one.
+	Attempts int
+	// LastError and RetryAt are set when the attempt failed, the mail isn't claimed again until RetryAt.
+	LastError string
+	RetryAt   time.Time
 }
 
 func (m *Mail) Stored() bool {
@@ -15,3 +22,8 @@ func (m *Mail) Stored() bool {
 func (m *Mail) SetMailed() {
 	m.Mailed = true
 }
+
+func (m *Mail) SetFailed(lastError string, retryAt time.Time) {
+	m.LastError = lastError
+	m.RetryAt = retryAt
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"io"
+	"time"
+)
+
+// MediaContentTypes are the sniffed content types accepted for uploads.
+var MediaContentTypes = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
+
+type Media struct {
+	ID      string
+	OwnerID string
+	Width   int
+	Height  int
+	// Variants are the stored files, the original comes first.
+	Variants  []MediaVariant
+	CreatedAt time.Time
+}
+
+// MediaVariant is one stored file of the media, Name is the file name, e.g. "original.jpg" or "w320.webp".
+type MediaVariant struct {
+	Name        string
+	ContentType string
+	Width       int
+	Height      int
+	Size        int64
+	// URL is filled by the media service.
+	URL string
+}
+
+// ProcessedImage is an uploaded image with its variants, all stripped of metadata.
+type ProcessedImage struct {
+	Width    int
+	Height   int
+	Variants []ProcessedImageVariant
+}
+
+type ProcessedImageVariant struct {
+	MediaVariant
+	Data []byte
+}
+
+type Blob struct {
+	Body        io.ReadCloser
+	ContentType string
+	Size        int64
+}
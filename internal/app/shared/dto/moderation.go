@@ -0,0 +1,71 @@
+package dto
+
+import "time"
+
+const (
+	ModerationTargetArticle = "article"
+	ModerationTargetComment = "comment"
+)
+
+var ModerationTargets = []string{ModerationTargetArticle, ModerationTargetComment}
+
+const (
+	ReportStateOpen      = "open"
+	ReportStateActioned  = "actioned"
+	ReportStateDismissed = "dismissed"
+)
+
+var ReportStates = []string{ReportStateOpen, ReportStateActioned, ReportStateDismissed}
+
+// Moderation actions recorded in the audit trail.
+const (
+	ModerationActionHide    = "hide"
+	ModerationActionRestore = "restore"
+	ModerationActionResolve = "resolve"
+	ModerationActionDismiss = "dismiss"
+	ModerationActionReopen  = "reopen"
+)
+
+// ModerationTargetActions are actions moderators take on content, the rest change report states.
+var ModerationTargetActions = []string{ModerationActionHide, ModerationActionRestore}
+
+type Report struct {
+	ID         string
+	TargetType string
+	TargetID   string
+	ReporterID string
+	Reason     string
+	State      string
+	CreatedAt  time.Time
+	ResolvedAt *time.Time
+
+	// Target is filled for moderators.
+	Target *ModerationTarget
+}
+
+func (r *Report) Stored() bool {
+	return r.ID != ""
+}
+
+// ModerationTarget is reported content, an article or a comment.
+type ModerationTarget struct {
+	Type     string
+	ID       string
+	AuthorID string
+	// ArticleSlug is the slug of the article or of the article the comment belongs to.
+	ArticleSlug string
+	// ArticleTags are the tags of the article or of the article the comment belongs to.
+	ArticleTags []string
+	Hidden      bool
+}
+
+type ModerationAction struct {
+	ID          string
+	ModeratorID string
+	Action      string
+	TargetType  string
+	TargetID    string
+	ReportID    *string
+	Note        string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,18 @@
+package dto
+
+var ReactionKinds = []string{"like", "love", "celebrate", "insightful", "curious"}
+
+type ArticleReactions struct {
+	Counts map[string]int64
+	Own    []string
+}
+
+// ReactionCounts holds reaction counts (or count deltas) by article ID and reaction kind.
+type ReactionCounts map[string]map[string]int64
+
+func (c ReactionCounts) Add(articleID, kind string, delta int64) {
+	if c[articleID] == nil {
+		c[articleID] = make(map[string]int64)
+	}
+	c[articleID][kind] += delta
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// DefaultReadingListName is the name of the list every user has for bookmarks.
+const DefaultReadingListName = "bookmarks"
+
+type ReadingList struct {
+	ID      string
+	UserID  string
+	Name    string
+	Default bool
+	// ShareToken opens the list to anyone with the link, empty for private lists.
+	ShareToken string
+	// ShareURL is filled by the reading list service for shared lists.
+	ShareURL      string
+	ArticlesCount int
+	CreatedAt     time.Time
+}
+
+func (l *ReadingList) Stored() bool {
+	return l.ID != ""
+}
+
+type ReadingListItem struct {
+	ArticleID   string
+	ArticleSlug string
+}
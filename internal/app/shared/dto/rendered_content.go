@@ -0,0 +1,12 @@
+package dto
+
+type RenderedContent struct {
+	HTML string
+	TOC  []TOCEntry
+}
+
+type TOCEntry struct {
+	Level  int
+	Anchor string
+	Title  string
+}
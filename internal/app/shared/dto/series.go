@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+type Series struct {
+	ID            string
+	OwnerID       string
+	Title         string
+	Description   string
+	ArticlesCount int
+	CreatedAt     time.Time
+}
+
+func (s *Series) Stored() bool {
+	return s.ID != ""
+}
+
+type SeriesItem struct {
+	ArticleID   string
+	ArticleSlug string
+}
+
+// ArticleSeries places the article in its series for navigation.
+type ArticleSeries struct {
+	ID    string
+	Title string
+	// Position starts from 1, hidden articles are skipped.
+	Position      int
+	ArticlesCount int
+	Prev          *SeriesNeighbour
+	Next          *SeriesNeighbour
+}
+
+type SeriesNeighbour struct {
+	Slug  string
+	Title string
+}
@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SitemapEntry is a location listed in a sitemap or in a sitemap index.
+type SitemapEntry struct {
+	Loc          string
+	LastModified time.Time
+	// Alternates are language versions of the location including itself, only set for translated articles.
+	Alternates []SitemapAlternate
+}
+
+type SitemapAlternate struct {
+	Language string
+	Loc      string
+}
+
+// SitemapArticle is an article listed in sitemaps.
+type SitemapArticle struct {
+	Slug         string
+	Locale       string
+	LastModified time.Time
+	// Translations are published translations of the article.
+	Translations []SitemapTranslation
+	// FirstElement is the number of sitemap elements listed before the article.
+	FirstElement int
+}
+
+// EntryElements returns the number of elements of every entry of the article: the <loc> and, for translated articles,
+// an <xhtml:link> per language version. Sitemap files are split by elements, so that translations count
+// towards the URL and size limits of a file.
+func (a *SitemapArticle) EntryElements() int {
+	if len(a.Translations) == 0 {
+		return 1
+	}
+
+	return len(a.Translations) + 2
+}
+
+type SitemapTranslation struct {
+	Locale string
+	Slug   string
+}
+
+// SitemapIndexFile is the file number of the sitemap index, files listing articles are numbered from one.
+const SitemapIndexFile = 0
+
+const (
+	sitemapFilePrefix = "articles-"
+	sitemapFileSuffix = ".xml"
+)
+
+// SitemapFileName returns the name the sitemap file is served by.
+func SitemapFileName(file int) string {
+	return sitemapFilePrefix + strconv.Itoa(file) + sitemapFileSuffix
+}
+
+// ParseSitemapFileName returns the number of the sitemap file served by the name.
+func ParseSitemapFileName(name string) (int, bool) {
+	number, ok := strings.CutPrefix(name, sitemapFilePrefix)
+	if !ok {
+		return 0, false
+	}
+
+	if number, ok = strings.CutSuffix(number, sitemapFileSuffix); !ok {
+		return 0, false
+	}
+
+	file, err := strconv.Atoi(number)
+	if err != nil || file <= SitemapIndexFile || SitemapFileName(file) != name {
+		return 0, false
+	}
+
+	return file, true
+}
@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+// TimelineEntry is an article on a home timeline, timelines go from the newest article.
+// The last entry of a page is the cursor of the next one.
+type TimelineEntry struct {
+	ArticleID string
+	CreatedAt time.Time
+}
+
+// Before reports whether the entry goes before the other one on a timeline.
+func (e TimelineEntry) Before(other TimelineEntry) bool {
+	if !e.CreatedAt.Equal(other.CreatedAt) {
+		return e.CreatedAt.After(other.CreatedAt)
+	}
+
+	return e.ArticleID > other.ArticleID
+}
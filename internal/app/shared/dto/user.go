@@ -1,11 +1,22 @@
 package dto
 
+// User roles, granted by operators in the database.
+const (
+	// UserRoleModerator grants access to the report queue and moderation actions.
+	UserRoleModerator = "moderator"
+	// UserRoleAdmin grants access to site administration, e.g. bulk import and export of articles.
+	UserRoleAdmin = "admin"
+	// UserRoleMember grants access to members-only articles, e.g. for subscribers.
+	UserRoleMember = "member"
+)
+
 type User struct {
 	ID           string
 	DisplayName  string
 	NickName     string
 	Email        string
 	PasswordHash string
+	Roles        []string
 }
 
 func (u User) Stored() bool {
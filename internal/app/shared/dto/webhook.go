@@ -0,0 +1,68 @@
+package dto
+
+import "time"
+
+// Webhook events, see the API description for their payloads.
+const (
+	WebhookEventArticlePublished = "article.published"
+	WebhookEventArticleUpdated   = "article.updated"
+	WebhookEventCommentCreated   = "comment.created"
+	WebhookEventUserActivated    = "user.activated"
+)
+
+var WebhookEvents = []string{
+	WebhookEventArticlePublished,
+	WebhookEventArticleUpdated,
+	WebhookEventCommentCreated,
+	WebhookEventUserActivated,
+}
+
+// Webhook subscribes an external endpoint to events.
+type Webhook struct {
+	ID  string
+	URL string
+	// Secret signs payloads, so receivers can verify they come from the service.
+	Secret string
+	Events []string
+	// Active is cleared once Failures, the number of consecutive failed attempts, reaches the limit.
+	Active    bool
+	Failures  int
+	CreatedBy string
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+func (w *Webhook) Stored() bool {
+	return w.ID != ""
+}
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookDelivery is an event queued for a webhook, pending deliveries are attempted
+// until they succeed or run out of attempts.
+type WebhookDelivery struct {
+	ID            string
+	WebhookID     string
+	Event         string
+	Payload       []byte
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	// ResponseStatus and LastError describe the last attempt, ResponseStatus is zero when there was no response.
+	ResponseStatus int
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+
+	// URL and Secret of the webhook are set on claimed deliveries.
+	URL    string
+	Secret string
+}
+
+func (d *WebhookDelivery) Stored() bool {
+	return d.ID != ""
+}
@@ -7,13 +7,54 @@ var (
 	ErrUserNotFound                 = errors.New("user not found")
 	ErrUserActivationNotFound       = errors.New("user activation not found")
 	ErrUserPasswordRecoveryNotFound = errors.New("user password recovery not found")
+	ErrForbidden                    = errors.New("forbidden")
 )
 
 // Auth specific
 var (
-	ErrInvalidAuthToken  = errors.New("invalid auth token")
-	ErrEmailAlreadyTaken = errors.New("email address is already taken")
-	ErrWrongPassword     = errors.New("wrong password")
+	ErrInvalidAuthToken     = errors.New("invalid auth token")
+	ErrEmailAlreadyTaken    = errors.New("email address is already taken")
+	ErrNickNameAlreadyTaken = errors.New("nickname is already taken")
+	ErrWrongPassword        = errors.New("wrong password")
+)
+
+// Blog specific
+var (
+	ErrAuthorNotFound           = errors.New("author not found")
+	ErrArticleNotFound          = errors.New("article not found")
+	ErrArticleSlugTaken         = errors.New("article slug is already taken")
+	ErrRevisionNotFound         = errors.New("revision not found")
+	ErrCommentNotFound          = errors.New("comment not found")
+	ErrCommentEditWindowExpired = errors.New("comment edit window has expired")
+	ErrSitemapNotFound          = errors.New("sitemap not found")
+	ErrFollowSelf               = errors.New("users can't follow themselves")
+	ErrReadingListNotFound      = errors.New("reading list not found")
+	ErrReadingListFull          = errors.New("reading list is full")
+	ErrReadingListOrderMismatch = errors.New("order doesn't match articles of the reading list")
+	ErrMediaNotFound            = errors.New("media not found")
+	ErrMediaTooLarge            = errors.New("media is too large")
+	ErrMediaUnsupportedType     = errors.New("media type is not supported")
+	ErrMediaInvalid             = errors.New("media can't be decoded")
+	ErrReportNotFound           = errors.New("report not found")
+	ErrReportExists             = errors.New("content is already reported by the user")
+	ErrReportStateTransition    = errors.New("report can't be moved to the state")
+	ErrModerationTargetNotFound = errors.New("moderation target not found")
+	ErrArchiveInvalid           = errors.New("archive can't be read")
+	ErrSeriesNotFound           = errors.New("series not found")
+	ErrSeriesFull               = errors.New("series is full")
+	ErrSeriesOrderMismatch      = errors.New("order doesn't match articles of the series")
+	ErrArticleInOtherSeries     = errors.New("article belongs to another series")
+	ErrArticleAuthorNotFound    = errors.New("article author not found")
+	ErrArticleAuthorExists      = errors.New("user is already an author of the article")
+	ErrInvitationNotFound       = errors.New("invitation not found")
+	ErrTranslationNotFound      = errors.New("translation not found")
+	ErrTranslationLocale        = errors.New("translation locale matches the article locale")
+)
+
+// Webhook specific
+var (
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
 )
 
 // Hash specific
@@ -23,5 +64,6 @@ var (
 
 // Cache specific
 var (
-	ErrNoCache = errors.New("no cache")
+	ErrNoCache     = errors.New("no cache")
+	ErrCacheLocked = errors.New("cache is locked")
 )
@@ -42,5 +42,9 @@ func (s *Service) doTransaction(ctx context.Context, tx transaction.Transaction,
 		return fmt.Errorf("delete activation by token in repository: %w", err)
 	}
 
+	if err := s.webhookPublisher.PublishUser(ctx, tx, dto.WebhookEventUserActivated, activation.UserID); err != nil {
+		return fmt.Errorf("publish webhook event: %w", err)
+	}
+
 	return nil
 }
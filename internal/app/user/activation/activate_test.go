@@ -13,12 +13,14 @@ import (
 	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
 	"github.com/art-es/yet-another-service/internal/app/user/activation/mock"
 	"github.com/art-es/yet-another-service/internal/core/transaction"
+	"github.com/art-es/yet-another-service/internal/testutil"
 )
 
 func TestActivate(t *testing.T) {
 	type mocks struct {
 		activationRepository *mock.MockactivationRepository
 		userRepository       *mock.MockuserRepository
+		webhookPublisher     *mock.MockwebhookPublisher
 	}
 
 	for _, tt := range []struct {
@@ -116,11 +118,43 @@ func TestActivate(t *testing.T) {
 						})
 					}).
 					Return(nil)
+
+				m.webhookPublisher.EXPECT().
+					PublishUser(gomock.Any(), gomock.Not(nil), gomock.Eq(dto.WebhookEventUserActivated), gomock.Eq("dummy user id")).
+					Return(nil)
 			},
 			assert: func(t *testing.T, err error) {
 				assert.EqualError(t, err, "commit transaction: dummy error")
 			},
 		},
+		{
+			name: "publish webhook event error",
+			setup: func(t *testing.T, m mocks) {
+				activation := &dto.UserActivation{
+					Token:  "dummy token",
+					UserID: "dummy user id",
+				}
+
+				m.activationRepository.EXPECT().
+					Find(gomock.Any(), gomock.Eq("dummy token")).
+					Return(activation, nil)
+
+				m.userRepository.EXPECT().
+					Activate(gomock.Any(), gomock.Not(nil), gomock.Eq("dummy user id")).
+					Return(nil)
+
+				m.activationRepository.EXPECT().
+					Delete(gomock.Any(), gomock.Not(nil), gomock.Eq("dummy token")).
+					Return(nil)
+
+				m.webhookPublisher.EXPECT().
+					PublishUser(gomock.Any(), gomock.Not(nil), gomock.Eq(dto.WebhookEventUserActivated), gomock.Eq("dummy user id")).
+					Return(errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "publish webhook event: dummy error")
+			},
+		},
 		{
 			name: "ok",
 			setup: func(t *testing.T, m mocks) {
@@ -140,6 +174,10 @@ func TestActivate(t *testing.T) {
 				m.activationRepository.EXPECT().
 					Delete(gomock.Any(), gomock.Not(nil), gomock.Eq("dummy token")).
 					Return(nil)
+
+				m.webhookPublisher.EXPECT().
+					PublishUser(gomock.Any(), gomock.Not(nil), gomock.Eq(dto.WebhookEventUserActivated), gomock.Eq("dummy user id")).
+					Return(nil)
 			},
 			assert: func(t *testing.T, err error) {
 				assert.NoError(t, err)
@@ -153,13 +191,14 @@ func TestActivate(t *testing.T) {
 			m := mocks{
 				activationRepository: mock.NewMockactivationRepository(ctrl),
 				userRepository:       mock.NewMockuserRepository(ctrl),
+				webhookPublisher:     mock.NewMockwebhookPublisher(ctrl),
 			}
 
 			if tt.setup != nil {
 				tt.setup(t, m)
 			}
 
-			service := NewService(url.URL{}, m.activationRepository, m.userRepository, nil)
+			service := NewService(url.URL{}, m.activationRepository, m.userRepository, nil, m.webhookPublisher, testutil.NewLogger())
 			err := service.Activate(context.Background(), "dummy token")
 
 			if tt.assert != nil {
@@ -68,7 +68,7 @@ func TestCreateActivation(t *testing.T) {
 			tx := transaction.New(ctx)
 			user := &dto.User{ID: "user id", Email: "iivan@example.com"}
 
-			service := NewService(*baseAcivationURL, m.activationRepository, nil, m.activationMailer)
+			service := NewService(*baseAcivationURL, m.activationRepository, nil, m.activationMailer, nil, nil)
 			err := service.Create(ctx, tx, user)
 
 			tt.assert(t, err)
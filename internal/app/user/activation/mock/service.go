@@ -13,7 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
-	models "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
 	mail "github.com/art-es/yet-another-service/internal/core/mail"
 	transaction "github.com/art-es/yet-another-service/internal/core/transaction"
 	gomock "go.uber.org/mock/gomock"
@@ -58,10 +58,10 @@ func (mr *MockactivationRepositoryMockRecorder) Delete(ctx, tx, token any) *gomo
 }
 
 // Find mocks base method.
-func (m *MockactivationRepository) Find(ctx context.Context, token string) (*models.UserActivation, error) {
+func (m *MockactivationRepository) Find(ctx context.Context, token string) (*dto.UserActivation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Find", ctx, token)
-	ret0, _ := ret[0].(*models.UserActivation)
+	ret0, _ := ret[0].(*dto.UserActivation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -73,7 +73,7 @@ func (mr *MockactivationRepositoryMockRecorder) Find(ctx, token any) *gomock.Cal
 }
 
 // Save mocks base method.
-func (m *MockactivationRepository) Save(ctx context.Context, tx transaction.Transaction, activation *models.UserActivation) error {
+func (m *MockactivationRepository) Save(ctx context.Context, tx transaction.Transaction, activation *dto.UserActivation) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Save", ctx, tx, activation)
 	ret0, _ := ret[0].(error)
@@ -161,3 +161,41 @@ func (mr *MockactivationMailerMockRecorder) MailTo(ctx, address, data any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MailTo", reflect.TypeOf((*MockactivationMailer)(nil).MailTo), ctx, address, data)
 }
+
+// MockwebhookPublisher is a mock of webhookPublisher interface.
+type MockwebhookPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookPublisherMockRecorder is the mock recorder for MockwebhookPublisher.
+type MockwebhookPublisherMockRecorder struct {
+	mock *MockwebhookPublisher
+}
+
+// NewMockwebhookPublisher creates a new mock instance.
+func NewMockwebhookPublisher(ctrl *gomock.Controller) *MockwebhookPublisher {
+	mock := &MockwebhookPublisher{ctrl: ctrl}
+	mock.recorder = &MockwebhookPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookPublisher) EXPECT() *MockwebhookPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishUser mocks base method.
+func (m *MockwebhookPublisher) PublishUser(ctx context.Context, tx transaction.Transaction, event, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishUser", ctx, tx, event, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishUser indicates an expected call of PublishUser.
+func (mr *MockwebhookPublisherMockRecorder) PublishUser(ctx, tx, event, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishUser", reflect.TypeOf((*MockwebhookPublisher)(nil).PublishUser), ctx, tx, event, userID)
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 
 	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
 	"github.com/art-es/yet-another-service/internal/core/mail"
 	"github.com/art-es/yet-another-service/internal/core/transaction"
 )
@@ -24,11 +25,18 @@ type activationMailer interface {
 	MailTo(ctx context.Context, address string, data mail.UserActivationData) error
 }
 
+// webhookPublisher queues user.activated for webhooks subscribed to it, in the transaction activating the user.
+type webhookPublisher interface {
+	PublishUser(ctx context.Context, tx transaction.Transaction, event, userID string) error
+}
+
 type Service struct {
 	baseActivationURL    url.URL
 	activationRepository activationRepository
 	userRepository       userRepository
 	activationMailer     activationMailer
+	webhookPublisher     webhookPublisher
+	logger               log.Logger
 }
 
 func NewService(
@@ -36,11 +44,15 @@ func NewService(
 	activationRepository activationRepository,
 	userRepository userRepository,
 	activationMailer activationMailer,
+	webhookPublisher webhookPublisher,
+	logger log.Logger,
 ) *Service {
 	return &Service{
 		baseActivationURL:    baseActivationURL,
 		activationRepository: activationRepository,
 		userRepository:       userRepository,
 		activationMailer:     activationMailer,
+		webhookPublisher:     webhookPublisher,
+		logger:               logger,
 	}
 }
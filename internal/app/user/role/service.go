@@ -0,0 +1,40 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package role
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+type userRepository interface {
+	Find(ctx context.Context, id string) (*dto.User, error)
+}
+
+// Service checks roles of users for endpoints which aren't backed by a service of their own, e.g. debug ones.
+type Service struct {
+	userRepository userRepository
+}
+
+func NewService(userRepository userRepository) *Service {
+	return &Service{
+		userRepository: userRepository,
+	}
+}
+
+// CheckAdmin returns ErrForbidden unless the user is an admin.
+func (s *Service) CheckAdmin(ctx context.Context, userID string) error {
+	user, err := s.userRepository.Find(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("find user in repository: %w", err)
+	}
+
+	if !slices.Contains(user.Roles, dto.UserRoleAdmin) {
+		return errors.ErrForbidden
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/app/user/role/mock"
+)
+
+func TestCheckAdmin(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(userRepo *mock.MockuserRepository)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "find user error",
+			setup: func(userRepo *mock.MockuserRepository) {
+				userRepo.EXPECT().Find(gomock.Any(), gomock.Eq("user id")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "find user in repository: foo error")
+			},
+		},
+		{
+			name: "not an admin",
+			setup: func(userRepo *mock.MockuserRepository) {
+				userRepo.EXPECT().
+					Find(gomock.Any(), gomock.Eq("user id")).
+					Return(&dto.User{ID: "user id", Roles: []string{dto.UserRoleModerator}}, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(userRepo *mock.MockuserRepository) {
+				userRepo.EXPECT().
+					Find(gomock.Any(), gomock.Eq("user id")).
+					Return(&dto.User{ID: "user id", Roles: []string{dto.UserRoleModerator, dto.UserRoleAdmin}}, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockuserRepository(ctrl)
+			tt.setup(userRepo)
+
+			err := NewService(userRepo).CheckAdmin(context.Background(), "user id")
+
+			tt.assert(t, err)
+		})
+	}
+}
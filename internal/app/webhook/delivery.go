@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// GetDeliveries returns a page of the delivery log of the webhook, newest first.
+func (s *Service) GetDeliveries(ctx context.Context, in *dto.GetWebhookDeliveriesIn) (*dto.GetWebhookDeliveriesOut, error) {
+	if err := s.checkAdmin(ctx, in.UserID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findWebhook(ctx, in.WebhookID); err != nil {
+		return nil, err
+	}
+
+	out, err := s.webhookRepository.GetDeliveries(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("get deliveries from repository: %w", err)
+	}
+
+	return out, nil
+}
+
+// Redeliver queues the payload of the delivery again as a new delivery, whatever the status of the original.
+// Deliveries of inactive webhooks wait until the webhook is reactivated.
+func (s *Service) Redeliver(ctx context.Context, in *dto.RedeliverWebhookIn) (*dto.WebhookDelivery, error) {
+	if err := s.checkAdmin(ctx, in.UserID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findWebhook(ctx, in.WebhookID); err != nil {
+		return nil, err
+	}
+
+	original, err := s.webhookRepository.FindDelivery(ctx, in.DeliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("find delivery in repository: %w", err)
+	}
+
+	if original == nil || original.WebhookID != in.WebhookID {
+		return nil, errors.ErrWebhookDeliveryNotFound
+	}
+
+	delivery := &dto.WebhookDelivery{
+		WebhookID: original.WebhookID,
+		Event:     original.Event,
+		Payload:   original.Payload,
+	}
+
+	if err = s.webhookRepository.CreateDelivery(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("create delivery in repository: %w", err)
+	}
+
+	return delivery, nil
+}
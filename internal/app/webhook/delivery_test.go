@@ -0,0 +1,224 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestGetDeliveries(t *testing.T) {
+	in := &dto.GetWebhookDeliveriesIn{UserID: "admin id", WebhookID: "webhook id", FromID: pointer.To("delivery id")}
+	deliveries := &dto.GetWebhookDeliveriesOut{
+		Deliveries: []*dto.WebhookDelivery{{ID: "another delivery id", WebhookID: "webhook id"}},
+		HasMore:    true,
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.GetWebhookDeliveriesOut, err error)
+	}{
+		{
+			name: "not an admin",
+			setup: func(m serviceMocks) {
+				m.expectAdmin(nil)
+			},
+			assert: func(t *testing.T, out *dto.GetWebhookDeliveriesOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "webhook not found",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetWebhookDeliveriesOut, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrWebhookNotFound)
+			},
+		},
+		{
+			name: "get deliveries error",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(&dto.Webhook{ID: "webhook id"}, nil)
+				m.webhookRepository.EXPECT().GetDeliveries(gomock.Any(), gomock.Eq(in)).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.GetWebhookDeliveriesOut, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get deliveries from repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(&dto.Webhook{ID: "webhook id"}, nil)
+				m.webhookRepository.EXPECT().GetDeliveries(gomock.Any(), gomock.Eq(in)).Return(deliveries, nil)
+			},
+			assert: func(t *testing.T, out *dto.GetWebhookDeliveriesOut, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, deliveries, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).GetDeliveries(context.Background(), in)
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestRedeliver(t *testing.T) {
+	original := &dto.WebhookDelivery{
+		ID:        "delivery id",
+		WebhookID: "webhook id",
+		Event:     dto.WebhookEventArticlePublished,
+		Payload:   []byte(`{"event": "article.published"}`),
+		Status:    dto.WebhookDeliveryFailed,
+		Attempts:  3,
+		LastError: "unexpected response status 500",
+	}
+	redelivery := &dto.WebhookDelivery{
+		WebhookID: "webhook id",
+		Event:     dto.WebhookEventArticlePublished,
+		Payload:   []byte(`{"event": "article.published"}`),
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.WebhookDelivery, err error)
+	}{
+		{
+			name: "not an admin",
+			setup: func(m serviceMocks) {
+				m.expectAdmin(nil)
+			},
+			assert: func(t *testing.T, out *dto.WebhookDelivery, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "webhook not found",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.WebhookDelivery, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrWebhookNotFound)
+			},
+		},
+		{
+			name: "find delivery error",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(&dto.Webhook{ID: "webhook id"}, nil)
+				m.webhookRepository.EXPECT().FindDelivery(gomock.Any(), gomock.Eq("delivery id")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.WebhookDelivery, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find delivery in repository: foo error")
+			},
+		},
+		{
+			name: "delivery not found",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(&dto.Webhook{ID: "webhook id"}, nil)
+				m.webhookRepository.EXPECT().FindDelivery(gomock.Any(), gomock.Eq("delivery id")).Return(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.WebhookDelivery, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrWebhookDeliveryNotFound)
+			},
+		},
+		{
+			name: "delivery of another webhook",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(&dto.Webhook{ID: "webhook id"}, nil)
+				m.webhookRepository.EXPECT().
+					FindDelivery(gomock.Any(), gomock.Eq("delivery id")).
+					Return(&dto.WebhookDelivery{ID: "delivery id", WebhookID: "another webhook id"}, nil)
+			},
+			assert: func(t *testing.T, out *dto.WebhookDelivery, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrWebhookDeliveryNotFound)
+			},
+		},
+		{
+			name: "create delivery error",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(&dto.Webhook{ID: "webhook id"}, nil)
+				m.webhookRepository.EXPECT().FindDelivery(gomock.Any(), gomock.Eq("delivery id")).Return(original, nil)
+				m.webhookRepository.EXPECT().CreateDelivery(gomock.Any(), gomock.Eq(redelivery)).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.WebhookDelivery, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "create delivery in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(&dto.Webhook{ID: "webhook id"}, nil)
+				m.webhookRepository.EXPECT().FindDelivery(gomock.Any(), gomock.Eq("delivery id")).Return(original, nil)
+				m.webhookRepository.EXPECT().
+					CreateDelivery(gomock.Any(), gomock.Eq(redelivery)).
+					Do(func(_ context.Context, delivery *dto.WebhookDelivery) {
+						delivery.ID = "new delivery id"
+						delivery.Status = dto.WebhookDeliveryPending
+					}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.WebhookDelivery, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &dto.WebhookDelivery{
+					ID:        "new delivery id",
+					WebhookID: "webhook id",
+					Event:     dto.WebhookEventArticlePublished,
+					Payload:   []byte(`{"event": "article.published"}`),
+					Status:    dto.WebhookDeliveryPending,
+				}, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Redeliver(context.Background(), &dto.RedeliverWebhookIn{
+				UserID:     "admin id",
+				WebhookID:  "webhook id",
+				DeliveryID: "delivery id",
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
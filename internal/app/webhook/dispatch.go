@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+const dispatchBatchSize = 20
+
+// RunDispatcher periodically attempts due deliveries until ctx is done.
+func (s *Service) RunDispatcher(ctx context.Context) {
+	ticker := time.NewTicker(s.config.DispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Dispatch(ctx); err != nil {
+				s.logger.Error().Err(err).Msg("dispatch webhook deliveries error")
+			}
+		}
+	}
+}
+
+// Dispatch claims a batch of due deliveries and attempts them concurrently, so a slow receiver doesn't hold up others.
+// Deliveries which fail to be stored are attempted again once their lease expires.
+func (s *Service) Dispatch(ctx context.Context) error {
+	deliveries, err := s.webhookRepository.ClaimDeliveries(ctx, dispatchBatchSize, s.config.Lease)
+	if err != nil {
+		return fmt.Errorf("claim deliveries in repository: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := s.attempt(ctx, delivery); err != nil {
+				s.logger.Error().Err(err).
+					Str("delivery_id", delivery.ID).
+					Msg("attempt webhook delivery error")
+			}
+		}()
+	}
+
+	wg.Wait()
+	return nil
+}
+
+// attempt sends the delivery and stores the outcome. Failed deliveries are retried with exponential backoff
+// until they run out of attempts, every failed attempt counts towards deactivation of the webhook.
+func (s *Service) attempt(ctx context.Context, delivery *dto.WebhookDelivery) error {
+	status, sendErr := s.sender.Send(ctx, delivery)
+	if sendErr != nil && ctx.Err() != nil {
+		// the dispatcher is stopping, the delivery is attempted again once its lease expires
+		return nil
+	}
+
+	now := getCurrentTime()
+	delivery.Attempts++
+	delivery.ResponseStatus = status
+
+	if sendErr == nil {
+		delivery.Status = dto.WebhookDeliveryDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+	} else {
+		delivery.LastError = sendErr.Error()
+		if delivery.Attempts >= s.config.MaxAttempts {
+			delivery.Status = dto.WebhookDeliveryFailed
+		} else {
+			delivery.NextAttemptAt = now.Add(s.retryDelay(delivery.Attempts))
+		}
+	}
+
+	tx := transaction.New(ctx)
+
+	active, err := s.doAttemptTransaction(ctx, tx, delivery, sendErr == nil)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	if !active {
+		s.logger.Warn().
+			Str("webhook_id", delivery.WebhookID).
+			Msg("webhook is deactivated after repeated failures")
+	}
+
+	return nil
+}
+
+func (s *Service) doAttemptTransaction(
+	ctx context.Context,
+	tx transaction.Transaction,
+	delivery *dto.WebhookDelivery,
+	delivered bool,
+) (bool, error) {
+	if err := s.webhookRepository.UpdateDelivery(ctx, tx, delivery); err != nil {
+		return false, fmt.Errorf("update delivery in repository: %w", err)
+	}
+
+	if delivered {
+		if err := s.webhookRepository.ResetFailures(ctx, tx, delivery.WebhookID); err != nil {
+			return false, fmt.Errorf("reset failures in repository: %w", err)
+		}
+
+		return true, nil
+	}
+
+	active, err := s.webhookRepository.AddFailure(ctx, tx, delivery.WebhookID, s.config.DisableAfter)
+	if err != nil {
+		return false, fmt.Errorf("add failure in repository: %w", err)
+	}
+
+	return active, nil
+}
+
+// retryDelay is the delay after the given number of failed attempts.
+func (s *Service) retryDelay(attempts int) time.Duration {
+	delay := s.config.RetryDelay
+	for i := 1; i < attempts && delay < s.config.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, s.config.MaxRetryDelay)
+}
@@ -0,0 +1,187 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestDispatch(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	getCurrentTime = func() time.Time { return now }
+	defer func() { getCurrentTime = time.Now }()
+
+	claimed := func(attempts int) *dto.WebhookDelivery {
+		return &dto.WebhookDelivery{
+			ID:            "delivery id",
+			WebhookID:     "webhook id",
+			Event:         dto.WebhookEventArticlePublished,
+			Payload:       []byte(`{}`),
+			Status:        dto.WebhookDeliveryPending,
+			Attempts:      attempts,
+			NextAttemptAt: now.Add(time.Minute),
+			URL:           "https://example.org/hook",
+			Secret:        "secret",
+		}
+	}
+	expectClaim := func(m serviceMocks, deliveries ...*dto.WebhookDelivery) {
+		m.webhookRepository.EXPECT().
+			ClaimDeliveries(gomock.Any(), gomock.Eq(dispatchBatchSize), gomock.Eq(time.Minute)).
+			Return(deliveries, nil)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks, cancel context.CancelFunc)
+		assert func(t *testing.T, err error, logs []string)
+	}{
+		{
+			name: "claim deliveries error",
+			setup: func(m serviceMocks, _ context.CancelFunc) {
+				m.webhookRepository.EXPECT().ClaimDeliveries(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.EqualError(t, err, "claim deliveries in repository: foo error")
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "delivered",
+			setup: func(m serviceMocks, _ context.CancelFunc) {
+				expectClaim(m, claimed(1))
+				m.sender.EXPECT().Send(gomock.Any(), gomock.Eq(claimed(1))).Return(204, nil)
+
+				delivered := claimed(2)
+				delivered.Status = dto.WebhookDeliveryDelivered
+				delivered.ResponseStatus = 204
+				delivered.DeliveredAt = &now
+				m.webhookRepository.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any(), gomock.Eq(delivered)).Return(nil)
+				m.webhookRepository.EXPECT().ResetFailures(gomock.Any(), gomock.Any(), gomock.Eq("webhook id")).Return(nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "failed and retried",
+			setup: func(m serviceMocks, _ context.CancelFunc) {
+				expectClaim(m, claimed(1))
+				m.sender.EXPECT().Send(gomock.Any(), gomock.Any()).Return(500, errors.New("unexpected response status 500"))
+
+				retried := claimed(2)
+				retried.ResponseStatus = 500
+				retried.LastError = "unexpected response status 500"
+				retried.NextAttemptAt = now.Add(2 * time.Minute)
+				m.webhookRepository.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any(), gomock.Eq(retried)).Return(nil)
+				m.webhookRepository.EXPECT().AddFailure(gomock.Any(), gomock.Any(), gomock.Eq("webhook id"), gomock.Eq(5)).Return(true, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "failed the last attempt and the webhook is deactivated",
+			setup: func(m serviceMocks, _ context.CancelFunc) {
+				expectClaim(m, claimed(2))
+				m.sender.EXPECT().Send(gomock.Any(), gomock.Any()).Return(0, errors.New("connection refused"))
+
+				failed := claimed(3)
+				failed.Status = dto.WebhookDeliveryFailed
+				failed.LastError = "connection refused"
+				m.webhookRepository.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any(), gomock.Eq(failed)).Return(nil)
+				m.webhookRepository.EXPECT().AddFailure(gomock.Any(), gomock.Any(), gomock.Eq("webhook id"), gomock.Eq(5)).Return(false, nil)
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{
+					`{"level":"warn","webhook_id":"webhook id","message":"webhook is deactivated after repeated failures"}`,
+				}, logs)
+			},
+		},
+		{
+			name: "update delivery error",
+			setup: func(m serviceMocks, _ context.CancelFunc) {
+				expectClaim(m, claimed(0))
+				m.sender.EXPECT().Send(gomock.Any(), gomock.Any()).Return(200, nil)
+				m.webhookRepository.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{
+					`{"level":"error","error":"update delivery in repository: foo error","delivery_id":"delivery id","message":"attempt webhook delivery error"}`,
+				}, logs)
+			},
+		},
+		{
+			name: "add failure error",
+			setup: func(m serviceMocks, _ context.CancelFunc) {
+				expectClaim(m, claimed(0))
+				m.sender.EXPECT().Send(gomock.Any(), gomock.Any()).Return(500, errors.New("unexpected response status 500"))
+				m.webhookRepository.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.webhookRepository.EXPECT().AddFailure(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{
+					`{"level":"error","error":"add failure in repository: foo error","delivery_id":"delivery id","message":"attempt webhook delivery error"}`,
+				}, logs)
+			},
+		},
+		{
+			name: "stopped in the middle of an attempt",
+			setup: func(m serviceMocks, cancel context.CancelFunc) {
+				expectClaim(m, claimed(0))
+				m.sender.EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(context.Context, *dto.WebhookDelivery) (int, error) {
+						cancel()
+						return 0, context.Canceled
+					})
+			},
+			assert: func(t *testing.T, err error, logs []string) {
+				assert.NoError(t, err)
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m, cancel)
+
+			logger := testutil.NewLogger()
+			err := m.newService(logger).Dispatch(ctx)
+
+			tt.assert(t, err, logger.Logs())
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	s := &Service{config: Config{RetryDelay: time.Minute, MaxRetryDelay: 10 * time.Minute}}
+
+	for attempts, expected := range map[int]time.Duration{
+		1: time.Minute,
+		2: 2 * time.Minute,
+		3: 4 * time.Minute,
+		4: 8 * time.Minute,
+		5: 10 * time.Minute,
+		9: 10 * time.Minute,
+	} {
+		assert.Equal(t, expected, s.retryDelay(attempts), "attempts: %d", attempts)
+	}
+}
@@ -0,0 +1,296 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	transaction "github.com/art-es/yet-another-service/internal/core/transaction"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookRepository is a mock of webhookRepository interface.
+type MockwebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookRepositoryMockRecorder is the mock recorder for MockwebhookRepository.
+type MockwebhookRepositoryMockRecorder struct {
+	mock *MockwebhookRepository
+}
+
+// NewMockwebhookRepository creates a new mock instance.
+func NewMockwebhookRepository(ctrl *gomock.Controller) *MockwebhookRepository {
+	mock := &MockwebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockwebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookRepository) EXPECT() *MockwebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddFailure mocks base method.
+func (m *MockwebhookRepository) AddFailure(ctx context.Context, tx transaction.Transaction, id string, disableAfter int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFailure", ctx, tx, id, disableAfter)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFailure indicates an expected call of AddFailure.
+func (mr *MockwebhookRepositoryMockRecorder) AddFailure(ctx, tx, id, disableAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFailure", reflect.TypeOf((*MockwebhookRepository)(nil).AddFailure), ctx, tx, id, disableAfter)
+}
+
+// ClaimDeliveries mocks base method.
+func (m *MockwebhookRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*dto.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDeliveries", ctx, limit, lease)
+	ret0, _ := ret[0].([]*dto.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDeliveries indicates an expected call of ClaimDeliveries.
+func (mr *MockwebhookRepositoryMockRecorder) ClaimDeliveries(ctx, limit, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDeliveries", reflect.TypeOf((*MockwebhookRepository)(nil).ClaimDeliveries), ctx, limit, lease)
+}
+
+// CreateDelivery mocks base method.
+func (m *MockwebhookRepository) CreateDelivery(ctx context.Context, delivery *dto.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery.
+func (mr *MockwebhookRepositoryMockRecorder) CreateDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockwebhookRepository)(nil).CreateDelivery), ctx, delivery)
+}
+
+// Delete mocks base method.
+func (m *MockwebhookRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockwebhookRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockwebhookRepository)(nil).Delete), ctx, id)
+}
+
+// Enqueue mocks base method.
+func (m *MockwebhookRepository) Enqueue(ctx context.Context, tx transaction.Transaction, event string, payload []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, tx, event, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockwebhookRepositoryMockRecorder) Enqueue(ctx, tx, event, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockwebhookRepository)(nil).Enqueue), ctx, tx, event, payload)
+}
+
+// Find mocks base method.
+func (m *MockwebhookRepository) Find(ctx context.Context, id string) (*dto.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(*dto.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockwebhookRepositoryMockRecorder) Find(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockwebhookRepository)(nil).Find), ctx, id)
+}
+
+// FindDelivery mocks base method.
+func (m *MockwebhookRepository) FindDelivery(ctx context.Context, id string) (*dto.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDelivery", ctx, id)
+	ret0, _ := ret[0].(*dto.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDelivery indicates an expected call of FindDelivery.
+func (mr *MockwebhookRepositoryMockRecorder) FindDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDelivery", reflect.TypeOf((*MockwebhookRepository)(nil).FindDelivery), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockwebhookRepository) Get(ctx context.Context) ([]*dto.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]*dto.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockwebhookRepositoryMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockwebhookRepository)(nil).Get), ctx)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockwebhookRepository) GetDeliveries(ctx context.Context, in *dto.GetWebhookDeliveriesIn) (*dto.GetWebhookDeliveriesOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, in)
+	ret0, _ := ret[0].(*dto.GetWebhookDeliveriesOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockwebhookRepositoryMockRecorder) GetDeliveries(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockwebhookRepository)(nil).GetDeliveries), ctx, in)
+}
+
+// ResetFailures mocks base method.
+func (m *MockwebhookRepository) ResetFailures(ctx context.Context, tx transaction.Transaction, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetFailures", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetFailures indicates an expected call of ResetFailures.
+func (mr *MockwebhookRepositoryMockRecorder) ResetFailures(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetFailures", reflect.TypeOf((*MockwebhookRepository)(nil).ResetFailures), ctx, tx, id)
+}
+
+// Save mocks base method.
+func (m *MockwebhookRepository) Save(ctx context.Context, webhook *dto.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, webhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockwebhookRepositoryMockRecorder) Save(ctx, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockwebhookRepository)(nil).Save), ctx, webhook)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockwebhookRepository) UpdateDelivery(ctx context.Context, tx transaction.Transaction, delivery *dto.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", ctx, tx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockwebhookRepositoryMockRecorder) UpdateDelivery(ctx, tx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockwebhookRepository)(nil).UpdateDelivery), ctx, tx, delivery)
+}
+
+// MockuserRepository is a mock of userRepository interface.
+type MockuserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepositoryMockRecorder is the mock recorder for MockuserRepository.
+type MockuserRepositoryMockRecorder struct {
+	mock *MockuserRepository
+}
+
+// NewMockuserRepository creates a new mock instance.
+func NewMockuserRepository(ctrl *gomock.Controller) *MockuserRepository {
+	mock := &MockuserRepository{ctrl: ctrl}
+	mock.recorder = &MockuserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepository) EXPECT() *MockuserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockuserRepository) Find(ctx context.Context, id string) (*dto.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(*dto.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockuserRepositoryMockRecorder) Find(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockuserRepository)(nil).Find), ctx, id)
+}
+
+// Mocksender is a mock of sender interface.
+type Mocksender struct {
+	ctrl     *gomock.Controller
+	recorder *MocksenderMockRecorder
+	isgomock struct{}
+}
+
+// MocksenderMockRecorder is the mock recorder for Mocksender.
+type MocksenderMockRecorder struct {
+	mock *Mocksender
+}
+
+// NewMocksender creates a new mock instance.
+func NewMocksender(ctrl *gomock.Controller) *Mocksender {
+	mock := &Mocksender{ctrl: ctrl}
+	mock.recorder = &MocksenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksender) EXPECT() *MocksenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *Mocksender) Send(ctx context.Context, delivery *dto.WebhookDelivery) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, delivery)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MocksenderMockRecorder) Send(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*Mocksender)(nil).Send), ctx, delivery)
+}
@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+// payload is the body of deliveries, Data depends on the event.
+type payload struct {
+	Event     string    `json:"event"`
+	CreatedAt time.Time `json:"createdAt"`
+	Data      any       `json:"data"`
+}
+
+type articleData struct {
+	ID         string     `json:"id"`
+	Slug       string     `json:"slug"`
+	Title      string     `json:"title"`
+	Excerpt    string     `json:"excerpt"`
+	URL        string     `json:"url"`
+	Tags       []string   `json:"tags"`
+	Visibility string     `json:"visibility"`
+	Locale     string     `json:"locale"`
+	AuthorID   string     `json:"authorId"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  *time.Time `json:"updatedAt"`
+}
+
+type commentData struct {
+	ID          string    `json:"id"`
+	ArticleID   string    `json:"articleId"`
+	ArticleSlug string    `json:"articleSlug"`
+	ParentID    *string   `json:"parentId"`
+	AuthorID    string    `json:"authorId"`
+	Content     string    `json:"content"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type userData struct {
+	ID string `json:"id"`
+}
+
+// PublishArticle queues the article event, article.published or article.updated, for webhooks subscribed to it.
+// Events are queued in the transaction of the change they describe, so they are delivered only once it's committed
+// and never lost after.
+func (s *Service) PublishArticle(ctx context.Context, tx transaction.Transaction, event string, article *dto.Article) error {
+	tags := article.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return s.publish(ctx, tx, event, articleData{
+		ID:         article.ID,
+		Slug:       article.Slug,
+		Title:      article.Title,
+		Excerpt:    article.Excerpt,
+		URL:        s.siteURL.JoinPath("articles", article.Slug).String(),
+		Tags:       tags,
+		Visibility: article.Visibility,
+		Locale:     article.Locale,
+		AuthorID:   article.AuthorID,
+		CreatedAt:  article.CreatedAt,
+		UpdatedAt:  article.UpdatedAt,
+	})
+}
+
+// PublishComment queues comment.created for webhooks subscribed to it.
+func (s *Service) PublishComment(ctx context.Context, tx transaction.Transaction, comment *dto.Comment, article *dto.Article) error {
+	return s.publish(ctx, tx, dto.WebhookEventCommentCreated, commentData{
+		ID:          comment.ID,
+		ArticleID:   comment.ArticleID,
+		ArticleSlug: article.Slug,
+		ParentID:    comment.ParentID,
+		AuthorID:    comment.AuthorID,
+		Content:     comment.Content,
+		CreatedAt:   comment.CreatedAt,
+	})
+}
+
+// PublishUser queues the user event, user.activated, for webhooks subscribed to it.
+func (s *Service) PublishUser(ctx context.Context, tx transaction.Transaction, event, userID string) error {
+	return s.publish(ctx, tx, event, userData{ID: userID})
+}
+
+func (s *Service) publish(ctx context.Context, tx transaction.Transaction, event string, data any) error {
+	body, err := json.Marshal(payload{
+		Event:     event,
+		CreatedAt: getCurrentTime().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	if err = s.webhookRepository.Enqueue(ctx, tx, event, body); err != nil {
+		return fmt.Errorf("enqueue deliveries in repository: %w", err)
+	}
+
+	return nil
+}
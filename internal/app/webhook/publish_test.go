@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func expectEnqueue(m serviceMocks, event, payload string, err error) {
+	m.webhookRepository.EXPECT().
+		Enqueue(gomock.Any(), gomock.Any(), gomock.Eq(event), gomock.Cond(func(body []byte) bool {
+			return assert.ObjectsAreEqual(payload, string(body))
+		})).
+		Return(err)
+}
+
+func TestPublishArticle(t *testing.T) {
+	now := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	getCurrentTime = func() time.Time { return now }
+	defer func() { getCurrentTime = time.Now }()
+
+	updatedAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	article := &dto.Article{
+		ID:         "article id",
+		Slug:       "foo-article",
+		Title:      "Foo",
+		Content:    "foo content",
+		Excerpt:    "foo…",
+		Visibility: dto.ArticleVisibilityPublic,
+		Locale:     "en",
+		AuthorID:   "user id",
+		CreatedAt:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:  &updatedAt,
+	}
+	payload := `{"event":"article.updated","createdAt":"2024-01-03T00:00:00Z","data":{"id":"article id",` +
+		`"slug":"foo-article","title":"Foo","excerpt":"foo…","url":"https://example.com/articles/foo-article",` +
+		`"tags":[],"visibility":"public","locale":"en","authorId":"user id","createdAt":"2024-01-01T00:00:00Z",` +
+		`"updatedAt":"2024-01-02T00:00:00Z"}}`
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "enqueue error",
+			setup: func(m serviceMocks) {
+				expectEnqueue(m, dto.WebhookEventArticleUpdated, payload, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "enqueue deliveries in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				expectEnqueue(m, dto.WebhookEventArticleUpdated, payload, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService(testutil.NewLogger()).PublishArticle(context.Background(), transaction.New(context.Background()), dto.WebhookEventArticleUpdated, article)
+
+			tt.assert(t, err)
+		})
+	}
+}
+
+func TestPublishComment(t *testing.T) {
+	now := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	getCurrentTime = func() time.Time { return now }
+	defer func() { getCurrentTime = time.Now }()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newServiceMocks(ctrl)
+	expectEnqueue(m, dto.WebhookEventCommentCreated, `{"event":"comment.created","createdAt":"2024-01-03T00:00:00Z",`+
+		`"data":{"id":"comment id","articleId":"article id","articleSlug":"foo-article","parentId":"parent id",`+
+		`"authorId":"user id","content":"foo comment","createdAt":"2024-01-02T00:00:00Z"}}`, nil)
+
+	err := m.newService(testutil.NewLogger()).PublishComment(context.Background(), transaction.New(context.Background()), &dto.Comment{
+		ID:        "comment id",
+		ArticleID: "article id",
+		ParentID:  pointer.To("parent id"),
+		AuthorID:  "user id",
+		Content:   "foo comment",
+		CreatedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}, &dto.Article{ID: "article id", Slug: "foo-article"})
+
+	assert.NoError(t, err)
+}
+
+func TestPublishUser(t *testing.T) {
+	now := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	getCurrentTime = func() time.Time { return now }
+	defer func() { getCurrentTime = time.Now }()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newServiceMocks(ctrl)
+	expectEnqueue(m, dto.WebhookEventUserActivated,
+		`{"event":"user.activated","createdAt":"2024-01-03T00:00:00Z","data":{"id":"user id"}}`, nil)
+
+	err := m.newService(testutil.NewLogger()).PublishUser(context.Background(), transaction.New(context.Background()), dto.WebhookEventUserActivated, "user id")
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,115 @@
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+var getCurrentTime = time.Now
+
+type webhookRepository interface {
+	Get(ctx context.Context) ([]*dto.Webhook, error)
+	// Find returns the webhook, nil if there is none.
+	Find(ctx context.Context, id string) (*dto.Webhook, error)
+	Save(ctx context.Context, webhook *dto.Webhook) error
+	Delete(ctx context.Context, id string) error
+	// AddFailure counts a failed attempt and deactivates the webhook once the count reaches disableAfter.
+	// It returns whether the webhook is still active.
+	AddFailure(ctx context.Context, tx transaction.Transaction, id string, disableAfter int) (bool, error)
+	ResetFailures(ctx context.Context, tx transaction.Transaction, id string) error
+	// Enqueue adds a delivery of the payload for every active webhook subscribed to the event.
+	Enqueue(ctx context.Context, tx transaction.Transaction, event string, payload []byte) error
+	CreateDelivery(ctx context.Context, delivery *dto.WebhookDelivery) error
+	UpdateDelivery(ctx context.Context, tx transaction.Transaction, delivery *dto.WebhookDelivery) error
+	// FindDelivery returns the delivery, nil if there is none.
+	FindDelivery(ctx context.Context, id string) (*dto.WebhookDelivery, error)
+	GetDeliveries(ctx context.Context, in *dto.GetWebhookDeliveriesIn) (*dto.GetWebhookDeliveriesOut, error)
+	// ClaimDeliveries leases due deliveries of active webhooks, so other dispatchers skip them until the lease expires.
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*dto.WebhookDelivery, error)
+}
+
+type userRepository interface {
+	Find(ctx context.Context, id string) (*dto.User, error)
+}
+
+// sender posts claimed deliveries to URLs of their webhooks. It returns the response status,
+// responses other than 2xx are errors.
+type sender interface {
+	Send(ctx context.Context, delivery *dto.WebhookDelivery) (int, error)
+}
+
+type Config struct {
+	// DispatchInterval is how often due deliveries are claimed.
+	DispatchInterval time.Duration
+	// Lease is how long claimed deliveries are hidden from other dispatchers, it must exceed the send timeout.
+	Lease       time.Duration
+	MaxAttempts int
+	// RetryDelay follows the first failed attempt, the delay doubles after every next one up to MaxRetryDelay.
+	RetryDelay    time.Duration
+	MaxRetryDelay time.Duration
+	// DisableAfter is the number of consecutive failed attempts which deactivates a webhook.
+	DisableAfter int
+}
+
+// Service manages webhooks of admins and delivers events to them through a queue of deliveries.
+type Service struct {
+	config            Config
+	siteURL           url.URL
+	webhookRepository webhookRepository
+	userRepository    userRepository
+	sender            sender
+	logger            log.Logger
+}
+
+func NewService(
+	config Config,
+	siteURL url.URL,
+	webhookRepository webhookRepository,
+	userRepository userRepository,
+	sender sender,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		config:            config,
+		siteURL:           siteURL,
+		webhookRepository: webhookRepository,
+		userRepository:    userRepository,
+		sender:            sender,
+		logger:            logger,
+	}
+}
+
+func (s *Service) checkAdmin(ctx context.Context, userID string) error {
+	user, err := s.userRepository.Find(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("find user in repository: %w", err)
+	}
+
+	if !slices.Contains(user.Roles, dto.UserRoleAdmin) {
+		return errors.ErrForbidden
+	}
+
+	return nil
+}
+
+func (s *Service) findWebhook(ctx context.Context, id string) (*dto.Webhook, error) {
+	webhook, err := s.webhookRepository.Find(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find webhook in repository: %w", err)
+	}
+
+	if webhook == nil {
+		return nil, errors.ErrWebhookNotFound
+	}
+
+	return webhook, nil
+}
@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+// secretSize is the number of random bytes in secrets of webhooks.
+const secretSize = 32
+
+func (s *Service) Get(ctx context.Context, userID string) ([]*dto.Webhook, error) {
+	if err := s.checkAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.webhookRepository.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get webhooks from repository: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// Create subscribes the URL to the events. The returned webhook carries the generated secret,
+// the only time it's shown.
+func (s *Service) Create(ctx context.Context, in *dto.CreateWebhookIn) (*dto.Webhook, error) {
+	if err := s.checkAdmin(ctx, in.UserID); err != nil {
+		return nil, err
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := &dto.Webhook{
+		URL:       in.URL,
+		Secret:    secret,
+		Events:    normalizeEvents(in.Events),
+		Active:    true,
+		CreatedBy: in.UserID,
+	}
+
+	if err = s.webhookRepository.Save(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("save webhook in repository: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (s *Service) Update(ctx context.Context, in *dto.UpdateWebhookIn) (*dto.Webhook, error) {
+	if err := s.checkAdmin(ctx, in.UserID); err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.findWebhook(ctx, in.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Active && !webhook.Active {
+		webhook.Failures = 0
+	}
+
+	webhook.URL = in.URL
+	webhook.Events = normalizeEvents(in.Events)
+	webhook.Active = in.Active
+
+	if err = s.webhookRepository.Save(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("save webhook in repository: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// Delete removes the webhook together with its deliveries.
+func (s *Service) Delete(ctx context.Context, in *dto.DeleteWebhookIn) error {
+	if err := s.checkAdmin(ctx, in.UserID); err != nil {
+		return err
+	}
+
+	if _, err := s.findWebhook(ctx, in.WebhookID); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepository.Delete(ctx, in.WebhookID); err != nil {
+		return fmt.Errorf("delete webhook in repository: %w", err)
+	}
+
+	return nil
+}
+
+func generateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("generate secret: %w", err)
+	}
+
+	return hex.EncodeToString(secret), nil
+}
+
+// normalizeEvents sorts the events and drops duplicates.
+func normalizeEvents(events []string) []string {
+	events = slices.Clone(events)
+	slices.Sort(events)
+	return slices.Compact(events)
+}
@@ -0,0 +1,373 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/app/webhook/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type serviceMocks struct {
+	webhookRepository *mock.MockwebhookRepository
+	userRepository    *mock.MockuserRepository
+	sender            *mock.Mocksender
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		webhookRepository: mock.NewMockwebhookRepository(ctrl),
+		userRepository:    mock.NewMockuserRepository(ctrl),
+		sender:            mock.NewMocksender(ctrl),
+	}
+}
+
+func (m serviceMocks) newService(logger *testutil.Logger) *Service {
+	siteURL, _ := url.Parse("https://example.com")
+	return NewService(Config{
+		DispatchInterval: time.Second,
+		Lease:            time.Minute,
+		MaxAttempts:      3,
+		RetryDelay:       time.Minute,
+		MaxRetryDelay:    time.Hour,
+		DisableAfter:     5,
+	}, *siteURL, m.webhookRepository, m.userRepository, m.sender, logger)
+}
+
+func (m serviceMocks) expectAdmin(roles []string) {
+	m.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq("admin id")).Return(&dto.User{ID: "admin id", Roles: roles}, nil)
+}
+
+func (m serviceMocks) expectFindWebhook(webhook *dto.Webhook, err error) {
+	m.webhookRepository.EXPECT().Find(gomock.Any(), gomock.Eq("webhook id")).Return(webhook, err)
+}
+
+func TestGet(t *testing.T) {
+	webhooks := []*dto.Webhook{{ID: "webhook id", URL: "https://example.org/hook"}}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out []*dto.Webhook, err error)
+	}{
+		{
+			name: "find user error",
+			setup: func(m serviceMocks) {
+				m.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq("admin id")).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []*dto.Webhook, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find user in repository: foo error")
+			},
+		},
+		{
+			name: "not an admin",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleModerator})
+			},
+			assert: func(t *testing.T, out []*dto.Webhook, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "get webhooks error",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.webhookRepository.EXPECT().Get(gomock.Any()).Return(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out []*dto.Webhook, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "get webhooks from repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.webhookRepository.EXPECT().Get(gomock.Any()).Return(webhooks, nil)
+			},
+			assert: func(t *testing.T, out []*dto.Webhook, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, webhooks, out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Get(context.Background(), "admin id")
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	isNewWebhook := gomock.Cond(func(webhook *dto.Webhook) bool {
+		return webhook.ID == "" &&
+			webhook.URL == "https://example.org/hook" &&
+			len(webhook.Secret) == secretSize*2 &&
+			assert.ObjectsAreEqual([]string{dto.WebhookEventArticlePublished, dto.WebhookEventCommentCreated}, webhook.Events) &&
+			webhook.Active &&
+			webhook.CreatedBy == "admin id"
+	})
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Webhook, err error)
+	}{
+		{
+			name: "not an admin",
+			setup: func(m serviceMocks) {
+				m.expectAdmin(nil)
+			},
+			assert: func(t *testing.T, out *dto.Webhook, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "save webhook error",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.webhookRepository.EXPECT().Save(gomock.Any(), isNewWebhook).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Webhook, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "save webhook in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.webhookRepository.EXPECT().
+					Save(gomock.Any(), isNewWebhook).
+					Do(func(_ context.Context, webhook *dto.Webhook) {
+						webhook.ID = "webhook id"
+					}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.Webhook, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "webhook id", out.ID)
+				assert.Regexp(t, "^[0-9a-f]{64}$", out.Secret)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Create(context.Background(), &dto.CreateWebhookIn{
+				UserID: "admin id",
+				URL:    "https://example.org/hook",
+				Events: []string{dto.WebhookEventCommentCreated, dto.WebhookEventArticlePublished, dto.WebhookEventCommentCreated},
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	storedWebhook := func(active bool, failures int) *dto.Webhook {
+		return &dto.Webhook{
+			ID:       "webhook id",
+			URL:      "https://example.org/hook",
+			Secret:   "secret",
+			Events:   []string{dto.WebhookEventArticlePublished},
+			Active:   active,
+			Failures: failures,
+		}
+	}
+	updatedWebhook := func(active bool, failures int) *dto.Webhook {
+		return &dto.Webhook{
+			ID:       "webhook id",
+			URL:      "https://example.org/new-hook",
+			Secret:   "secret",
+			Events:   []string{dto.WebhookEventUserActivated},
+			Active:   active,
+			Failures: failures,
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		active bool
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, out *dto.Webhook, err error)
+	}{
+		{
+			name: "not an admin",
+			setup: func(m serviceMocks) {
+				m.expectAdmin(nil)
+			},
+			assert: func(t *testing.T, out *dto.Webhook, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "find webhook error",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(nil, errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Webhook, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "find webhook in repository: foo error")
+			},
+		},
+		{
+			name: "webhook not found",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(nil, nil)
+			},
+			assert: func(t *testing.T, out *dto.Webhook, err error) {
+				assert.Nil(t, out)
+				assert.ErrorIs(t, err, apperrors.ErrWebhookNotFound)
+			},
+		},
+		{
+			name:   "save webhook error",
+			active: true,
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(storedWebhook(true, 2), nil)
+				m.webhookRepository.EXPECT().Save(gomock.Any(), gomock.Eq(updatedWebhook(true, 2))).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, out *dto.Webhook, err error) {
+				assert.Nil(t, out)
+				assert.EqualError(t, err, "save webhook in repository: foo error")
+			},
+		},
+		{
+			name:   "ok deactivated",
+			active: false,
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(storedWebhook(true, 2), nil)
+				m.webhookRepository.EXPECT().Save(gomock.Any(), gomock.Eq(updatedWebhook(false, 2))).Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.Webhook, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, updatedWebhook(false, 2), out)
+			},
+		},
+		{
+			name:   "ok reactivated",
+			active: true,
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(storedWebhook(false, 5), nil)
+				m.webhookRepository.EXPECT().Save(gomock.Any(), gomock.Eq(updatedWebhook(true, 0))).Return(nil)
+			},
+			assert: func(t *testing.T, out *dto.Webhook, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, updatedWebhook(true, 0), out)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			out, err := m.newService(testutil.NewLogger()).Update(context.Background(), &dto.UpdateWebhookIn{
+				UserID:    "admin id",
+				WebhookID: "webhook id",
+				URL:       "https://example.org/new-hook",
+				Events:    []string{dto.WebhookEventUserActivated},
+				Active:    tt.active,
+			})
+
+			tt.assert(t, out, err)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(m serviceMocks)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "not an admin",
+			setup: func(m serviceMocks) {
+				m.expectAdmin(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrForbidden)
+			},
+		},
+		{
+			name: "webhook not found",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(nil, nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, apperrors.ErrWebhookNotFound)
+			},
+		},
+		{
+			name: "delete webhook error",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(&dto.Webhook{ID: "webhook id"}, nil)
+				m.webhookRepository.EXPECT().Delete(gomock.Any(), gomock.Eq("webhook id")).Return(errors.New("foo error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "delete webhook in repository: foo error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.expectAdmin([]string{dto.UserRoleAdmin})
+				m.expectFindWebhook(&dto.Webhook{ID: "webhook id"}, nil)
+				m.webhookRepository.EXPECT().Delete(gomock.Any(), gomock.Eq("webhook id")).Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newServiceMocks(ctrl)
+			tt.setup(m)
+
+			err := m.newService(testutil.NewLogger()).Delete(context.Background(), &dto.DeleteWebhookIn{
+				UserID:    "admin id",
+				WebhookID: "webhook id",
+			})
+
+			tt.assert(t, err)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package diff
+
+import "strings"
+
+type Op string
+
+const (
+	OpEqual  Op = "equal"
+	OpInsert Op = "insert"
+	OpDelete Op = "delete"
+)
+
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Lines returns a line-level diff which turns a into b.
+// It uses the Myers algorithm, so the result is a shortest edit script.
+func Lines(a, b string) []Line {
+	return compute(splitLines(a), splitLines(b))
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+func compute(a, b []string) []Line {
+	n, m := len(a), len(b)
+	total := n + m
+	offset := total + 1
+
+	v := make([]int, 2*total+3)
+	trace := make([][]int, 0)
+
+	for d := 0; d <= total; d++ {
+		trace = append(trace, append([]int(nil), v...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(a, b, trace, offset)
+			}
+		}
+	}
+
+	return nil
+}
+
+func backtrack(a, b []string, trace [][]int, offset int) []Line {
+	x, y := len(a), len(b)
+	lines := make([]Line, 0, x+y)
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			lines = append(lines, Line{Op: OpEqual, Text: a[x]})
+		}
+
+		if d == 0 {
+			break
+		}
+
+		if x == prevX {
+			y--
+			lines = append(lines, Line{Op: OpInsert, Text: b[y]})
+		} else {
+			x--
+			lines = append(lines, Line{Op: OpDelete, Text: a[x]})
+		}
+	}
+
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+
+	return lines
+}
@@ -0,0 +1,64 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		a, b     string
+		expected []Line
+	}{
+		{
+			name:     "both empty",
+			expected: []Line{},
+		},
+		{
+			name: "equal",
+			a:    "foo\nbar\n",
+			b:    "foo\nbar",
+			expected: []Line{
+				{Op: OpEqual, Text: "foo"},
+				{Op: OpEqual, Text: "bar"},
+			},
+		},
+		{
+			name: "from empty",
+			b:    "foo\nbar",
+			expected: []Line{
+				{Op: OpInsert, Text: "foo"},
+				{Op: OpInsert, Text: "bar"},
+			},
+		},
+		{
+			name: "to empty",
+			a:    "foo",
+			expected: []Line{
+				{Op: OpDelete, Text: "foo"},
+			},
+		},
+		{
+			name: "changes",
+			a:    "a\nb\nc\na\nb\nb\na",
+			b:    "c\nb\na\nb\na\nc",
+			expected: []Line{
+				{Op: OpDelete, Text: "a"},
+				{Op: OpDelete, Text: "b"},
+				{Op: OpEqual, Text: "c"},
+				{Op: OpInsert, Text: "b"},
+				{Op: OpEqual, Text: "a"},
+				{Op: OpEqual, Text: "b"},
+				{Op: OpDelete, Text: "b"},
+				{Op: OpEqual, Text: "a"},
+				{Op: OpInsert, Text: "c"},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Lines(tt.a, tt.b))
+		})
+	}
+}
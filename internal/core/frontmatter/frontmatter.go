@@ -0,0 +1,74 @@
+package frontmatter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const delimiter = "---"
+
+var ErrMissing = errors.New("front matter is missing")
+
+// Parse decodes the YAML front matter of the document into meta and returns the document body after it.
+// The front matter is enclosed in "---" lines at the very beginning of the document.
+func Parse(document []byte, meta any) ([]byte, error) {
+	document = bytes.TrimPrefix(document, []byte("\ufeff"))
+	document = bytes.ReplaceAll(document, []byte("\r\n"), []byte("\n"))
+
+	rest, ok := cutLine(document, delimiter)
+	if !ok {
+		return nil, ErrMissing
+	}
+
+	var header []byte
+	for {
+		if len(rest) == 0 {
+			return nil, fmt.Errorf("front matter is not closed with %q", delimiter)
+		}
+
+		if body, ok := cutLine(rest, delimiter); ok {
+			if err := yaml.Unmarshal(header, meta); err != nil {
+				return nil, fmt.Errorf("unmarshal front matter: %w", err)
+			}
+
+			return bytes.TrimPrefix(body, []byte("\n")), nil
+		}
+
+		line, next, _ := bytes.Cut(rest, []byte("\n"))
+		header = append(append(header, line...), '\n')
+		rest = next
+	}
+}
+
+// Format renders the document with meta as its YAML front matter followed by the body.
+func Format(meta any, body []byte) ([]byte, error) {
+	header, err := yaml.Marshal(meta)
+	if err != nil {
+		return nil, fmt.Errorf("marshal front matter: %w", err)
+	}
+
+	var document bytes.Buffer
+	document.WriteString(delimiter + "\n")
+	document.Write(header)
+	document.WriteString(delimiter + "\n\n")
+	document.Write(body)
+
+	return document.Bytes(), nil
+}
+
+// cutLine cuts the line from the beginning of data if the line is exactly the given one.
+func cutLine(data []byte, line string) ([]byte, bool) {
+	first, rest, found := bytes.Cut(data, []byte("\n"))
+	if string(bytes.TrimRight(first, " \t")) != line {
+		return nil, false
+	}
+
+	if !found {
+		return []byte{}, true
+	}
+
+	return rest, true
+}
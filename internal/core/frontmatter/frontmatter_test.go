@@ -0,0 +1,82 @@
+package frontmatter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type meta struct {
+	Title string   `yaml:"title"`
+	Tags  []string `yaml:"tags,omitempty"`
+}
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		document     string
+		expectedMeta meta
+		expectedBody string
+		expectedErr  string
+	}{
+		{
+			name:         "ok",
+			document:     "---\ntitle: Foo\ntags: [bar, baz]\n---\n\n# Foo\n\nContent\n",
+			expectedMeta: meta{Title: "Foo", Tags: []string{"bar", "baz"}},
+			expectedBody: "# Foo\n\nContent\n",
+		},
+		{
+			name:         "windows line endings",
+			document:     "\ufeff---\r\ntitle: Foo\r\n---\r\nContent",
+			expectedMeta: meta{Title: "Foo"},
+			expectedBody: "Content",
+		},
+		{
+			name:         "empty body",
+			document:     "---\ntitle: Foo\n---",
+			expectedMeta: meta{Title: "Foo"},
+			expectedBody: "",
+		},
+		{
+			name:        "missing",
+			document:    "# Foo\n",
+			expectedErr: "front matter is missing",
+		},
+		{
+			name:        "not closed",
+			document:    "---\ntitle: Foo\n# Foo\n",
+			expectedErr: `front matter is not closed with "---"`,
+		},
+		{
+			name:        "invalid yaml",
+			document:    "---\ntitle: [Foo\n---\n",
+			expectedErr: "unmarshal front matter: yaml: line 1: did not find expected ',' or ']'",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var m meta
+			body, err := Parse([]byte(tt.document), &m)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedMeta, m)
+			assert.Equal(t, tt.expectedBody, string(body))
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	document, err := Format(meta{Title: "Foo", Tags: []string{"bar"}}, []byte("# Foo\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "---\ntitle: Foo\ntags:\n    - bar\n---\n\n# Foo\n", string(document))
+
+	var m meta
+	body, err := Parse(document, &m)
+	assert.NoError(t, err)
+	assert.Equal(t, meta{Title: "Foo", Tags: []string{"bar"}}, m)
+	assert.Equal(t, "# Foo\n", string(body))
+}
@@ -3,6 +3,7 @@ package util
 import (
 	"encoding/json"
 	"errors"
+	"net"
 	"strings"
 
 	"github.com/art-es/yet-another-service/internal/core/http"
@@ -37,3 +38,19 @@ func GetAuthorizationToken(ctx http.Context) (string, bool) {
 	s = strings.TrimSpace(s[len("bearer "):])
 	return s, len(s) > 0
 }
+
+// GetClientIP returns the address of the direct peer without the port.
+// Forwarding headers are ignored, since they can be set by clients at will.
+func GetClientIP(ctx http.Context) string {
+	req := ctx.Request()
+	if req == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+
+	return host
+}
@@ -99,3 +99,28 @@ func TestGetAuthorizationToken(t *testing.T) {
 		})
 	}
 }
+
+func TestGetClientIP(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		remoteAddr string
+		expected   string
+	}{
+		{name: "ipv4", remoteAddr: "192.0.2.1:1234", expected: "192.0.2.1"},
+		{name: "ipv6", remoteAddr: "[2001:db8::1]:1234", expected: "2001:db8::1"},
+		{name: "no port", remoteAddr: "192.0.2.1", expected: "192.0.2.1"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			req.Header.Set("X-Forwarded-For", "198.51.100.1")
+			ctx := mockhttp.NewMockContext(ctrl)
+			ctx.EXPECT().Request().Return(req)
+
+			assert.Equal(t, tt.expected, GetClientIP(ctx))
+		})
+	}
+}
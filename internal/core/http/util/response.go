@@ -18,6 +18,10 @@ func Respond(ctx http2.Context, code int, body any) {
 	_ = json.NewEncoder(w).Encode(body)
 }
 
+func RespondNoContent(ctx http2.Context) {
+	ctx.ResponseWriter().WriteHeader(http.StatusNoContent)
+}
+
 func RespondBadRequest(ctx http2.Context, msg string) {
 	Respond(ctx, http.StatusBadRequest, errorResponseBody{Message: msg})
 }
@@ -26,10 +30,18 @@ func RespondUnauthorized(ctx http2.Context) {
 	Respond(ctx, http.StatusUnauthorized, errorResponseBody{Message: "Unauthorized."})
 }
 
+func RespondForbidden(ctx http2.Context) {
+	Respond(ctx, http.StatusForbidden, errorResponseBody{Message: "Forbidden."})
+}
+
 func RespondNotFound(ctx http2.Context) {
 	Respond(ctx, http.StatusNotFound, errorResponseBody{Message: "Not found."})
 }
 
+func RespondPayloadTooLarge(ctx http2.Context, msg string) {
+	Respond(ctx, http.StatusRequestEntityTooLarge, errorResponseBody{Message: msg})
+}
+
 func RespondInternalError(ctx http2.Context) {
 	Respond(ctx, http.StatusInternalServerError, errorResponseBody{
 		Message: "An unexpected error occurred. Please try again later.",
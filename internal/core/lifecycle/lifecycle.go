@@ -0,0 +1,104 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+// Component is a long-living part of the service.
+// Start must not block, Stop must release everything the component holds before ctx is done.
+type Component interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+type namedComponent struct {
+	name      string
+	component Component
+}
+
+// Manager starts components in the order they are added and stops them in reverse,
+// so every component is stopped before those it depends on.
+type Manager struct {
+	logger     log.Logger
+	components []namedComponent
+	started    int
+}
+
+func NewManager(logger log.Logger) *Manager {
+	return &Manager{logger: logger}
+}
+
+func (m *Manager) Add(name string, component Component) {
+	m.components = append(m.components, namedComponent{name: name, component: component})
+}
+
+// Start starts all components, if one fails the started ones are stopped.
+func (m *Manager) Start(ctx context.Context) error {
+	for _, c := range m.components {
+		if err := c.component.Start(ctx); err != nil {
+			return errors.Join(fmt.Errorf("start %s: %w", c.name, err), m.Stop(ctx))
+		}
+
+		m.started++
+		m.logger.Info().Str("component", c.name).Msg("component started")
+	}
+
+	return nil
+}
+
+// Stop stops started components, a failed component doesn't prevent stopping the rest.
+func (m *Manager) Stop(ctx context.Context) error {
+	var errs []error
+	for ; m.started > 0; m.started-- {
+		c := m.components[m.started-1]
+		if err := c.component.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("stop %s: %w", c.name, err))
+			continue
+		}
+
+		m.logger.Info().Str("component", c.name).Msg("component stopped")
+	}
+
+	return errors.Join(errs...)
+}
+
+// Runner adapts a function running until its context is done to a component.
+type Runner struct {
+	run    func(ctx context.Context)
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+func NewRunner(run func(ctx context.Context)) *Runner {
+	return &Runner{run: run}
+}
+
+func (r *Runner) Start(ctx context.Context) error {
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+		r.run(runCtx)
+	}()
+
+	return nil
+}
+
+func (r *Runner) Stop(ctx context.Context) error {
+	r.once.Do(r.cancel)
+
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,100 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+type testComponent struct {
+	name     string
+	events   *[]string
+	startErr error
+	stopErr  error
+}
+
+func (c *testComponent) Start(context.Context) error {
+	*c.events = append(*c.events, "start "+c.name)
+	return c.startErr
+}
+
+func (c *testComponent) Stop(context.Context) error {
+	*c.events = append(*c.events, "stop "+c.name)
+	return c.stopErr
+}
+
+func TestManager(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		setup     func(m *Manager, events *[]string)
+		expStart  string
+		expStop   string
+		expEvents []string
+	}{
+		{
+			name: "ok",
+			setup: func(m *Manager, events *[]string) {
+				m.Add("foo", &testComponent{name: "foo", events: events})
+				m.Add("bar", &testComponent{name: "bar", events: events})
+			},
+			expEvents: []string{"start foo", "start bar", "stop bar", "stop foo"},
+		},
+		{
+			name: "start error",
+			setup: func(m *Manager, events *[]string) {
+				m.Add("foo", &testComponent{name: "foo", events: events})
+				m.Add("bar", &testComponent{name: "bar", events: events, startErr: errors.New("bar error")})
+				m.Add("baz", &testComponent{name: "baz", events: events})
+			},
+			expStart:  "start bar: bar error",
+			expEvents: []string{"start foo", "start bar", "stop foo"},
+		},
+		{
+			name: "stop error",
+			setup: func(m *Manager, events *[]string) {
+				m.Add("foo", &testComponent{name: "foo", events: events})
+				m.Add("bar", &testComponent{name: "bar", events: events, stopErr: errors.New("bar error")})
+			},
+			expStop:   "stop bar: bar error",
+			expEvents: []string{"start foo", "start bar", "stop bar", "stop foo"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []string
+			m := NewManager(testutil.NewLogger())
+			tt.setup(m, &events)
+
+			err := m.Start(context.Background())
+			if tt.expStart != "" {
+				assert.EqualError(t, err, tt.expStart)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			err = m.Stop(context.Background())
+			if tt.expStop != "" {
+				assert.EqualError(t, err, tt.expStop)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expEvents, events)
+		})
+	}
+}
+
+func TestRunner(t *testing.T) {
+	stopped := false
+	runner := NewRunner(func(ctx context.Context) {
+		<-ctx.Done()
+		stopped = true
+	})
+
+	assert.NoError(t, runner.Start(context.Background()))
+	assert.NoError(t, runner.Stop(context.Background()))
+	assert.True(t, stopped)
+}
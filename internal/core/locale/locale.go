@@ -0,0 +1,59 @@
+package locale
+
+import (
+	"golang.org/x/text/language"
+)
+
+// Canonical returns the canonical form of the BCP 47 language tag, e.g. pt-BR for pt-br.
+func Canonical(tag string) (string, error) {
+	parsed, err := language.Parse(tag)
+	if err != nil {
+		return "", err
+	}
+
+	return parsed.String(), nil
+}
+
+// ParseAcceptLanguage returns language tags of the Accept-Language header, most preferred first.
+// Invalid headers are ignored, as if the caller had no preference.
+func ParseAcceptLanguage(header string) []string {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return nil
+	}
+
+	out := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		out = append(out, tag.String())
+	}
+
+	return out
+}
+
+// Match returns the index of the available locale which matches the preferred ones best.
+// A locale of the same language matches when there is no exact match, e.g. de-AT falls back to de,
+// and the first available locale is the fallback when nothing matches.
+func Match(preferred, available []string) int {
+	if len(preferred) == 0 || len(available) < 2 {
+		return 0
+	}
+
+	supported := make([]language.Tag, 0, len(available))
+	for _, tag := range available {
+		supported = append(supported, language.Make(tag))
+	}
+
+	desired := make([]language.Tag, 0, len(preferred))
+	for _, tag := range preferred {
+		if parsed, err := language.Parse(tag); err == nil {
+			desired = append(desired, parsed)
+		}
+	}
+
+	_, index, confidence := language.NewMatcher(supported).Match(desired...)
+	if confidence == language.No {
+		return 0
+	}
+
+	return index
+}
@@ -0,0 +1,76 @@
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonical(t *testing.T) {
+	tag, err := Canonical("pt-br")
+	assert.NoError(t, err)
+	assert.Equal(t, "pt-BR", tag)
+
+	_, err = Canonical("not a tag")
+	assert.Error(t, err)
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	assert.Equal(t, []string{"de-AT", "en", "fr"}, ParseAcceptLanguage("fr;q=0.5, de-AT, en;q=0.8"))
+	assert.Empty(t, ParseAcceptLanguage(""))
+	assert.Empty(t, ParseAcceptLanguage("en;q=foo"))
+}
+
+func TestMatch(t *testing.T) {
+	available := []string{"en", "de", "pt-BR"}
+
+	for _, tt := range []struct {
+		name      string
+		preferred []string
+		exp       int
+	}{
+		{
+			name: "no preference",
+			exp:  0,
+		},
+		{
+			name:      "exact",
+			preferred: []string{"de"},
+			exp:       1,
+		},
+		{
+			name:      "same language",
+			preferred: []string{"de-AT"},
+			exp:       1,
+		},
+		{
+			name:      "regional variant",
+			preferred: []string{"pt"},
+			exp:       2,
+		},
+		{
+			name:      "first preference wins",
+			preferred: []string{"pt-BR", "de"},
+			exp:       2,
+		},
+		{
+			name:      "later preference",
+			preferred: []string{"ja", "de"},
+			exp:       1,
+		},
+		{
+			name:      "no match",
+			preferred: []string{"ja"},
+			exp:       0,
+		},
+		{
+			name:      "invalid tags are skipped",
+			preferred: []string{"not a tag", "de"},
+			exp:       1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, Match(tt.preferred, available))
+		})
+	}
+}
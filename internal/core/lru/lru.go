@@ -0,0 +1,131 @@
+package lru
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type item[V any] struct {
+	key       string
+	value     V
+	size      int64
+	expiresAt time.Time
+}
+
+// Cache keeps the least recently used values within the byte size limit.
+// Every value expires after ttl regardless of how often it is used.
+type Cache[V any] struct {
+	mu       sync.Mutex
+	maxBytes int64
+	bytes    int64
+	ttl      time.Duration
+	items    map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+}
+
+func New[V any](maxBytes int64, ttl time.Duration) *Cache[V] {
+	return &Cache[V]{
+		maxBytes: maxBytes,
+		ttl:      ttl,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+func (c *Cache[V]) Get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+
+	elem, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+
+	it := elem.Value.(*item[V])
+	if !c.now().Before(it.expiresAt) {
+		c.remove(elem)
+		return zero, false
+	}
+
+	c.order.MoveToFront(elem)
+	return it.value, true
+}
+
+// Add stores the value taking size bytes, evicting the least recently used values to fit it.
+// Values larger than the whole cache are not stored.
+func (c *Cache[V]) Add(key string, value V, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.remove(elem)
+	}
+
+	if size > c.maxBytes {
+		return
+	}
+
+	for c.bytes+size > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+
+	c.items[key] = c.order.PushFront(&item[V]{
+		key:       key,
+		value:     value,
+		size:      size,
+		expiresAt: c.now().Add(c.ttl),
+	})
+	c.bytes += size
+}
+
+// RemoveFunc removes values matched by the function and returns their count.
+func (c *Cache[V]) RemoveFunc(match func(key string, value V) bool) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var removed int
+	for elem := c.order.Front(); elem != nil; {
+		next := elem.Next()
+		if it := elem.Value.(*item[V]); match(it.key, it.value) {
+			c.remove(elem)
+			removed++
+		}
+		elem = next
+	}
+
+	return removed
+}
+
+func (c *Cache[V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(map[string]*list.Element)
+	c.order.Init()
+	c.bytes = 0
+}
+
+func (c *Cache[V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *Cache[V]) Bytes() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.bytes
+}
+
+func (c *Cache[V]) remove(elem *list.Element) {
+	it := c.order.Remove(elem).(*item[V])
+	delete(c.items, it.key)
+	c.bytes -= it.size
+}
@@ -0,0 +1,134 @@
+package lru
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		run    func(c *Cache[string], now *time.Time)
+		assert func(t *testing.T, c *Cache[string])
+	}{
+		{
+			name: "get missing",
+			run:  func(c *Cache[string], now *time.Time) {},
+			assert: func(t *testing.T, c *Cache[string]) {
+				_, ok := c.Get("foo")
+				assert.False(t, ok)
+			},
+		},
+		{
+			name: "get added",
+			run: func(c *Cache[string], now *time.Time) {
+				c.Add("foo", "foo value", 3)
+			},
+			assert: func(t *testing.T, c *Cache[string]) {
+				value, ok := c.Get("foo")
+				assert.True(t, ok)
+				assert.Equal(t, "foo value", value)
+				assert.Equal(t, int64(3), c.Bytes())
+			},
+		},
+		{
+			name: "replace",
+			run: func(c *Cache[string], now *time.Time) {
+				c.Add("foo", "foo value", 3)
+				c.Add("foo", "new foo value", 4)
+			},
+			assert: func(t *testing.T, c *Cache[string]) {
+				value, _ := c.Get("foo")
+				assert.Equal(t, "new foo value", value)
+				assert.Equal(t, 1, c.Len())
+				assert.Equal(t, int64(4), c.Bytes())
+			},
+		},
+		{
+			name: "expired",
+			run: func(c *Cache[string], now *time.Time) {
+				c.Add("foo", "foo value", 3)
+				*now = now.Add(time.Minute)
+			},
+			assert: func(t *testing.T, c *Cache[string]) {
+				_, ok := c.Get("foo")
+				assert.False(t, ok)
+				assert.Equal(t, 0, c.Len())
+				assert.Equal(t, int64(0), c.Bytes())
+			},
+		},
+		{
+			name: "evict least recently used",
+			run: func(c *Cache[string], now *time.Time) {
+				c.Add("foo", "foo value", 4)
+				c.Add("bar", "bar value", 4)
+				c.Get("foo")
+				c.Add("baz", "baz value", 4)
+			},
+			assert: func(t *testing.T, c *Cache[string]) {
+				_, ok := c.Get("bar")
+				assert.False(t, ok)
+				_, ok = c.Get("foo")
+				assert.True(t, ok)
+				_, ok = c.Get("baz")
+				assert.True(t, ok)
+				assert.Equal(t, int64(8), c.Bytes())
+			},
+		},
+		{
+			name: "too large",
+			run: func(c *Cache[string], now *time.Time) {
+				c.Add("foo", "foo value", 4)
+				c.Add("bar", "bar value", 11)
+			},
+			assert: func(t *testing.T, c *Cache[string]) {
+				_, ok := c.Get("bar")
+				assert.False(t, ok)
+				_, ok = c.Get("foo")
+				assert.True(t, ok)
+			},
+		},
+		{
+			name: "remove func",
+			run: func(c *Cache[string], now *time.Time) {
+				c.Add("foo", "foo value", 1)
+				c.Add("bar", "bar value", 1)
+				c.Add("baz", "baz value", 1)
+				c.RemoveFunc(func(key, _ string) bool {
+					return strings.HasPrefix(key, "ba")
+				})
+			},
+			assert: func(t *testing.T, c *Cache[string]) {
+				assert.Equal(t, 1, c.Len())
+				assert.Equal(t, int64(1), c.Bytes())
+				_, ok := c.Get("foo")
+				assert.True(t, ok)
+			},
+		},
+		{
+			name: "purge",
+			run: func(c *Cache[string], now *time.Time) {
+				c.Add("foo", "foo value", 1)
+				c.Add("bar", "bar value", 1)
+				c.Purge()
+			},
+			assert: func(t *testing.T, c *Cache[string]) {
+				assert.Equal(t, 0, c.Len())
+				assert.Equal(t, int64(0), c.Bytes())
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			c := New[string](10, time.Second)
+			c.now = func() time.Time { return now }
+
+			tt.run(c, &now)
+
+			tt.assert(t, c)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"html/template"
+)
+
+const articleInvitationSubject = "Invitation to co-author an article"
+
+var (
+	//go:embed article_invitation_template.html
+	articleInvitationTemplateData []byte
+	articleInvitationTemplate     = template.Must(template.New("").Parse(string(articleInvitationTemplateData)))
+)
+
+type ArticleInvitationData struct {
+	ArticleTitle string
+	// Role is owner or contributor.
+	Role      string
+	AcceptURL string
+}
+
+type ArticleInvitationMailer struct {
+	mailRepository mailRepository
+}
+
+func NewArticleInvitationMailer(mailRepository mailRepository) *ArticleInvitationMailer {
+	return &ArticleInvitationMailer{
+		mailRepository: mailRepository,
+	}
+}
+
+func (s *ArticleInvitationMailer) MailTo(ctx context.Context, address string, data ArticleInvitationData) error {
+	content := &bytes.Buffer{}
+	if err := articleInvitationTemplate.Execute(content, data); err != nil {
+		return fmt.Errorf("execute template: %w", err)
+	}
+
+	return saveMail(s.mailRepository, ctx, address, articleInvitationSubject, content.String())
+}
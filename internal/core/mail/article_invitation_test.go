@@ -0,0 +1,79 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/mail/mock"
+)
+
+func TestArticleInvitationMailer(t *testing.T) {
+	const address = "foo@example.com"
+
+	data := ArticleInvitationData{
+		ArticleTitle: "Foo & Bar",
+		Role:         "contributor",
+		AcceptURL:    "http://example.com/article-invitations?token=foo",
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(mailRepository *mock.MockmailRepository)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "mail error",
+			setup: func(mailRepository *mock.MockmailRepository) {
+				mailRepository.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "save mail: dummy error")
+			},
+		},
+		{
+			name: "ok",
+			setup: func(mailRepository *mock.MockmailRepository) {
+				expectedMails := []dto.Mail{
+					{
+						Address: address,
+						Subject: articleInvitationSubject,
+						Content: `<!DOCTYPE html>
+<html>
+<body>
+    <p>You are invited to co-author the article "Foo &amp; Bar" as contributor.</p>
+    <p>To accept the invitation follow by link http://example.com/article-invitations?token=foo</p>
+</body>
+</html>`,
+					},
+				}
+
+				mailRepository.EXPECT().
+					Save(gomock.Any(), gomock.Eq(expectedMails)).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMailRepository := mock.NewMockmailRepository(ctrl)
+
+			tt.setup(mockMailRepository)
+
+			err := NewArticleInvitationMailer(mockMailRepository).MailTo(context.Background(), address, data)
+
+			tt.assert(t, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"html/template"
+)
+
+const moderationSubject = "Moderation of your content"
+
+var (
+	//go:embed moderation_template.html
+	moderationTemplateData []byte
+	moderationTemplate     = template.Must(template.New("").Parse(string(moderationTemplateData)))
+)
+
+type ModerationData struct {
+	// TargetType is article or comment.
+	TargetType string
+	ContentURL string
+	Hidden     bool
+	Note       string
+}
+
+type ModerationMailer struct {
+	mailRepository mailRepository
+}
+
+func NewModerationMailer(mailRepository mailRepository) *ModerationMailer {
+	return &ModerationMailer{
+		mailRepository: mailRepository,
+	}
+}
+
+func (s *ModerationMailer) MailTo(ctx context.Context, address string, data ModerationData) error {
+	content := &bytes.Buffer{}
+	if err := moderationTemplate.Execute(content, data); err != nil {
+		return fmt.Errorf("execute template: %w", err)
+	}
+
+	return saveMail(s.mailRepository, ctx, address, moderationSubject, content.String())
+}
@@ -0,0 +1,102 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/mail/mock"
+)
+
+func TestModerationMailer(t *testing.T) {
+	const address = "foo@example.com"
+
+	for _, tt := range []struct {
+		name   string
+		data   ModerationData
+		setup  func(mailRepository *mock.MockmailRepository)
+		assert func(t *testing.T, err error)
+	}{
+		{
+			name: "mail error",
+			data: ModerationData{TargetType: "article", ContentURL: "http://example.com/articles/foo", Hidden: true},
+			setup: func(mailRepository *mock.MockmailRepository) {
+				mailRepository.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "save mail: dummy error")
+			},
+		},
+		{
+			name: "hidden",
+			data: ModerationData{TargetType: "article", ContentURL: "http://example.com/articles/foo", Hidden: true, Note: "Spam & ads"},
+			setup: func(mailRepository *mock.MockmailRepository) {
+				expectedMails := []dto.Mail{
+					{
+						Address: address,
+						Subject: moderationSubject,
+						Content: `<!DOCTYPE html>
+<html>
+<body>
+    <p>Your article at http://example.com/articles/foo was hidden by a moderator.</p>
+    <p>Moderator note: Spam &amp; ads</p>
+</body>
+</html>`,
+					},
+				}
+
+				mailRepository.EXPECT().
+					Save(gomock.Any(), gomock.Eq(expectedMails)).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "restored",
+			data: ModerationData{TargetType: "comment", ContentURL: "http://example.com/articles/foo"},
+			setup: func(mailRepository *mock.MockmailRepository) {
+				expectedMails := []dto.Mail{
+					{
+						Address: address,
+						Subject: moderationSubject,
+						Content: `<!DOCTYPE html>
+<html>
+<body>
+    <p>Your comment at http://example.com/articles/foo was restored by a moderator.</p>
+    
+</body>
+</html>`,
+					},
+				}
+
+				mailRepository.EXPECT().
+					Save(gomock.Any(), gomock.Eq(expectedMails)).
+					Return(nil)
+			},
+			assert: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMailRepository := mock.NewMockmailRepository(ctrl)
+
+			tt.setup(mockMailRepository)
+
+			err := NewModerationMailer(mockMailRepository).MailTo(context.Background(), address, tt.data)
+
+			tt.assert(t, err)
+		})
+	}
+}
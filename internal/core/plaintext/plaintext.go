@@ -0,0 +1,73 @@
+package plaintext
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FromHTML returns the text of the rendered content with whitespace collapsed.
+// Heading anchors are skipped, they are links to headings, not a part of the text.
+func FromHTML(contentHTML string) string {
+	var (
+		text   strings.Builder
+		tag    strings.Builder
+		inTag  bool
+		anchor bool
+	)
+
+	for _, r := range contentHTML {
+		switch {
+		case r == '<':
+			inTag, anchor = true, false
+			tag.Reset()
+		case r == '>':
+			inTag = false
+			anchor = strings.Contains(tag.String(), "heading-anchor")
+			if isBlockTag(tag.String()) {
+				text.WriteRune(' ')
+			}
+		case inTag:
+			tag.WriteRune(r)
+		case !anchor:
+			text.WriteRune(r)
+		}
+	}
+
+	return strings.Join(strings.Fields(html.UnescapeString(text.String())), " ")
+}
+
+// Excerpt returns the beginning of the text of at most length runes, cut at a word boundary.
+func Excerpt(text string, length int) string {
+	if utf8.RuneCountInString(text) <= length {
+		return text
+	}
+
+	runes := []rune(text)
+	cut := string(runes[:length])
+	if unicode.IsSpace(runes[length]) {
+		return cut + "…"
+	}
+	if i := strings.LastIndexByte(cut, ' '); i > 0 {
+		cut = cut[:i]
+	}
+
+	return cut + "…"
+}
+
+func WordCount(text string) int {
+	return len(strings.Fields(text))
+}
+
+var blockTags = map[string]bool{
+	"p": true, "br": true, "div": true, "pre": true, "blockquote": true, "hr": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "li": true, "table": true, "tr": true, "th": true, "td": true,
+}
+
+// isBlockTag tells whether the tag separates words, like the end of a paragraph does.
+func isBlockTag(tag string) bool {
+	name, _, _ := strings.Cut(strings.Trim(tag, "/ "), " ")
+	return blockTags[strings.ToLower(name)]
+}
@@ -0,0 +1,85 @@
+package plaintext
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromHTML(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		content string
+		exp     string
+	}{
+		{
+			name:    "plain text",
+			content: `<h1 id="foo">Foo<a href="#foo" class="heading-anchor" aria-hidden="true">#</a></h1><p>Bar &amp; <em>baz</em>.</p>`,
+			exp:     "Foo Bar & baz.",
+		},
+		{
+			name:    "block tags separate words",
+			content: "<ul><li>foo</li><li>bar</li></ul><pre><code>baz\nqux</code></pre>",
+			exp:     "foo bar baz qux",
+		},
+		{
+			name:    "empty",
+			content: "",
+			exp:     "",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, FromHTML(tt.content))
+		})
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		text   string
+		length int
+		exp    string
+	}{
+		{
+			name:   "short text",
+			text:   "foo bar",
+			length: 7,
+			exp:    "foo bar",
+		},
+		{
+			name:   "cut at word boundary",
+			text:   strings.TrimSpace(strings.Repeat("word ", 100)),
+			length: 280,
+			exp:    strings.TrimSpace(strings.Repeat("word ", 56)) + "…",
+		},
+		{
+			name:   "cut right before a space",
+			text:   "hello world foo",
+			length: 11,
+			exp:    "hello world…",
+		},
+		{
+			name:   "cut inside a long word",
+			text:   "foobarbaz qux",
+			length: 6,
+			exp:    "foobar…",
+		},
+		{
+			name:   "runes",
+			text:   "привет мир",
+			length: 8,
+			exp:    "привет…",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, Excerpt(tt.text, tt.length))
+		})
+	}
+}
+
+func TestWordCount(t *testing.T) {
+	assert.Equal(t, 0, WordCount(""))
+	assert.Equal(t, 3, WordCount("foo bar  baz"))
+}
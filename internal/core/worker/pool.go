@@ -0,0 +1,169 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+var (
+	ErrQueueFull   = errors.New("queue is full")
+	ErrPoolStopped = errors.New("pool is stopped")
+)
+
+const (
+	// PolicyDrop rejects items pushed into the full queue.
+	PolicyDrop = "drop"
+	// PolicyBlock makes pushes wait for a free slot in the queue.
+	PolicyBlock = "block"
+)
+
+var Policies = []string{PolicyDrop, PolicyBlock}
+
+type PoolConfig struct {
+	// Name tells pools apart in logs.
+	Name      string
+	Workers   int
+	QueueSize int
+	Policy    string
+}
+
+type PoolStats struct {
+	Queued    int64
+	Pushed    int64
+	Dropped   int64
+	Processed int64
+	Failed    int64
+}
+
+// Pool processes pushed items by a fixed number of workers.
+// Items queued before Stop are still processed, unless the stop context is done first.
+type Pool[T any] struct {
+	config PoolConfig
+	handle func(ctx context.Context, item T) error
+	logger log.Logger
+
+	mu      sync.RWMutex
+	stopped bool
+	queue   chan T
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+
+	pushed    atomic.Int64
+	dropped   atomic.Int64
+	processed atomic.Int64
+	failed    atomic.Int64
+}
+
+func NewPool[T any](config PoolConfig, handle func(ctx context.Context, item T) error, logger log.Logger) *Pool[T] {
+	return &Pool[T]{
+		config: config,
+		handle: handle,
+		logger: logger,
+		queue:  make(chan T, config.QueueSize),
+	}
+}
+
+// Push queues the item. A full queue either rejects it with ErrQueueFull or blocks until ctx is done,
+// depending on the policy.
+func (p *Pool[T]) Push(ctx context.Context, item T) error {
+	// the read lock keeps the queue open while pushing, Stop closes it under the write lock
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.stopped {
+		return ErrPoolStopped
+	}
+
+	if p.config.Policy == PolicyBlock {
+		select {
+		case p.queue <- item:
+			p.pushed.Add(1)
+			return nil
+		case <-ctx.Done():
+			p.dropped.Add(1)
+			return ctx.Err()
+		}
+	}
+
+	select {
+	case p.queue <- item:
+		p.pushed.Add(1)
+		return nil
+	default:
+		p.dropped.Add(1)
+		return ErrQueueFull
+	}
+}
+
+func (p *Pool[T]) Start(ctx context.Context) error {
+	// workers outlive the start context, they are stopped by Stop only
+	workCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	p.cancel = cancel
+
+	for range p.config.Workers {
+		p.wg.Add(1)
+		go p.work(workCtx)
+	}
+
+	return nil
+}
+
+// Stop stops accepting items and waits until the queue is drained.
+// If ctx is done first, items being processed are canceled and the rest are lost.
+func (p *Pool[T]) Stop(ctx context.Context) error {
+	p.mu.Lock()
+	if !p.stopped {
+		p.stopped = true
+		close(p.queue)
+	}
+	p.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		p.cancel()
+		return nil
+	case <-ctx.Done():
+		p.cancel()
+		return fmt.Errorf("drain queue with %d items left: %w", len(p.queue), ctx.Err())
+	}
+}
+
+func (p *Pool[T]) Stats() PoolStats {
+	return PoolStats{
+		Queued:    int64(len(p.queue)),
+		Pushed:    p.pushed.Load(),
+		Dropped:   p.dropped.Load(),
+		Processed: p.processed.Load(),
+		Failed:    p.failed.Load(),
+	}
+}
+
+func (p *Pool[T]) work(ctx context.Context) {
+	defer p.wg.Done()
+
+	for item := range p.queue {
+		if ctx.Err() != nil {
+			// stopping was aborted, the items left are lost
+			continue
+		}
+
+		if err := p.handle(ctx, item); err != nil {
+			p.failed.Add(1)
+			p.logger.Error().Err(err).Str("pool", p.config.Name).Msg("process item error")
+			continue
+		}
+
+		p.processed.Add(1)
+	}
+}
@@ -0,0 +1,90 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestPool(t *testing.T) {
+	t.Run("process and drain", func(t *testing.T) {
+		var (
+			mu        sync.Mutex
+			processed []int
+		)
+		logger := testutil.NewLogger()
+		pool := NewPool(PoolConfig{Name: "test", Workers: 2, QueueSize: 10, Policy: PolicyDrop}, func(_ context.Context, item int) error {
+			if item == 3 {
+				return errors.New("foo error")
+			}
+			mu.Lock()
+			processed = append(processed, item)
+			mu.Unlock()
+			return nil
+		}, logger)
+
+		assert.NoError(t, pool.Start(context.Background()))
+		for i := range 5 {
+			assert.NoError(t, pool.Push(context.Background(), i))
+		}
+		assert.NoError(t, pool.Stop(context.Background()))
+
+		assert.ElementsMatch(t, []int{0, 1, 2, 4}, processed)
+		assert.Equal(t, PoolStats{Pushed: 5, Processed: 4, Failed: 1}, pool.Stats())
+		assert.Equal(t, []string{`{"level":"error","error":"foo error","pool":"test","message":"process item error"}`}, logger.Logs())
+		assert.ErrorIs(t, pool.Push(context.Background(), 5), ErrPoolStopped)
+	})
+
+	t.Run("drop when full", func(t *testing.T) {
+		pool := NewPool(PoolConfig{Workers: 1, QueueSize: 1, Policy: PolicyDrop}, func(context.Context, int) error {
+			return nil
+		}, testutil.NewLogger())
+
+		assert.NoError(t, pool.Push(context.Background(), 1))
+		assert.ErrorIs(t, pool.Push(context.Background(), 2), ErrQueueFull)
+		assert.Equal(t, PoolStats{Queued: 1, Pushed: 1, Dropped: 1}, pool.Stats())
+	})
+
+	t.Run("block when full", func(t *testing.T) {
+		pool := NewPool(PoolConfig{Workers: 1, QueueSize: 1, Policy: PolicyBlock}, func(context.Context, int) error {
+			return nil
+		}, testutil.NewLogger())
+
+		assert.NoError(t, pool.Push(context.Background(), 1))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, pool.Push(ctx, 2), context.DeadlineExceeded)
+
+		assert.NoError(t, pool.Start(context.Background()))
+		assert.NoError(t, pool.Push(context.Background(), 3))
+		assert.NoError(t, pool.Stop(context.Background()))
+		assert.Equal(t, PoolStats{Pushed: 2, Dropped: 1, Processed: 2}, pool.Stats())
+	})
+
+	t.Run("stop timeout", func(t *testing.T) {
+		release := make(chan struct{})
+		pool := NewPool(PoolConfig{Workers: 1, QueueSize: 2, Policy: PolicyDrop}, func(ctx context.Context, _ int) error {
+			select {
+			case <-release:
+			case <-ctx.Done():
+			}
+			return ctx.Err()
+		}, testutil.NewLogger())
+
+		assert.NoError(t, pool.Start(context.Background()))
+		assert.NoError(t, pool.Push(context.Background(), 1))
+		assert.NoError(t, pool.Push(context.Background(), 2))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, pool.Stop(ctx), context.DeadlineExceeded)
+		close(release)
+	})
+}
@@ -0,0 +1,266 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+// Renderer encodes feeds as RSS 2.0, Atom 1.0 or JSON Feed 1.1.
+type Renderer struct{}
+
+func NewRenderer() *Renderer {
+	return &Renderer{}
+}
+
+func (r *Renderer) Render(feed *dto.Feed, format string) ([]byte, error) {
+	var (
+		body []byte
+		err  error
+	)
+
+	switch format {
+	case dto.FeedFormatRSS:
+		body, err = marshalXML(newRSS(feed))
+	case dto.FeedFormatAtom:
+		body, err = marshalXML(newAtom(feed))
+	case dto.FeedFormatJSON:
+		body, err = json.Marshal(newJSONFeed(feed))
+	default:
+		return nil, fmt.Errorf("unknown feed format %q", format)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s feed: %w", format, err)
+	}
+
+	return body, nil
+}
+
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+type rss struct {
+	XMLName      xml.Name   `xml:"rss"`
+	Version      string     `xml:"version,attr"`
+	AtomNS       string     `xml:"xmlns:atom,attr"`
+	ContentNS    string     `xml:"xmlns:content,attr"`
+	DublinCoreNS string     `xml:"xmlns:dc,attr"`
+	Channel      rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	AtomLink      rssLink   `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssLink struct {
+	Href     string `xml:"href,attr"`
+	Rel      string `xml:"rel,attr"`
+	Type     string `xml:"type,attr,omitempty"`
+	Hreflang string `xml:"hreflang,attr,omitempty"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	Description string  `xml:"description"`
+	Content     string  `xml:"content:encoded"`
+	Creator     string  `xml:"dc:creator,omitempty"`
+	PubDate     string  `xml:"pubDate"`
+	// AtomLinks link language versions of the article.
+	AtomLinks []rssLink `xml:"atom:link"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func newRSS(feed *dto.Feed) *rss {
+	out := &rss{
+		Version:      "2.0",
+		AtomNS:       "http://www.w3.org/2005/Atom",
+		ContentNS:    "http://purl.org/rss/1.0/modules/content/",
+		DublinCoreNS: "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:       feed.Title,
+			Link:        feed.Link,
+			Description: feed.Description,
+			AtomLink:    rssLink{Href: feed.FeedLink, Rel: "self", Type: "application/rss+xml"},
+			Items:       make([]rssItem, 0, len(feed.Items)),
+		},
+	}
+
+	if !feed.Updated.IsZero() {
+		out.Channel.LastBuildDate = feed.Updated.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, item := range feed.Items {
+		entry := rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{IsPermaLink: true, Value: item.ID},
+			Description: item.Excerpt,
+			Content:     item.ContentHTML,
+			Creator:     item.AuthorName,
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+		}
+
+		for _, alternate := range item.Alternates {
+			entry.AtomLinks = append(entry.AtomLinks, rssLink{
+				Href:     alternate.Link,
+				Rel:      "alternate",
+				Hreflang: alternate.Language,
+			})
+		}
+
+		out.Channel.Items = append(out.Channel.Items, entry)
+	}
+
+	return out
+}
+
+type atom struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Links   []atomLink  `xml:"link"`
+	Updated string      `xml:"updated"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href     string `xml:"href,attr"`
+	Rel      string `xml:"rel,attr,omitempty"`
+	Type     string `xml:"type,attr,omitempty"`
+	Hreflang string `xml:"hreflang,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title     string      `xml:"title"`
+	ID        string      `xml:"id"`
+	Links     []atomLink  `xml:"link"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Author    atomAuthor  `xml:"author"`
+	Summary   string      `xml:"summary"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func newAtom(feed *dto.Feed) *atom {
+	out := &atom{
+		Title: feed.Title,
+		ID:    feed.Link,
+		Links: []atomLink{
+			{Href: feed.Link},
+			{Href: feed.FeedLink, Rel: "self", Type: "application/atom+xml"},
+		},
+		Updated: feed.Updated.UTC().Format(time.RFC3339),
+		Entries: make([]atomEntry, 0, len(feed.Items)),
+	}
+
+	for _, item := range feed.Items {
+		entry := atomEntry{
+			Title:     item.Title,
+			ID:        item.ID,
+			Links:     []atomLink{{Href: item.Link, Hreflang: item.Language}},
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Updated:   item.Updated.UTC().Format(time.RFC3339),
+			Author:    atomAuthor{Name: item.AuthorName, URI: item.AuthorLink},
+			Summary:   item.Excerpt,
+			Content:   atomContent{Type: "html", Value: item.ContentHTML},
+		}
+
+		// the entry link is the version of the item itself, the other versions are alternates in their languages
+		for _, alternate := range item.Alternates {
+			if alternate.Link != item.Link {
+				entry.Links = append(entry.Links, atomLink{Href: alternate.Link, Rel: "alternate", Hreflang: alternate.Language})
+			}
+		}
+
+		out.Entries = append(out.Entries, entry)
+	}
+
+	return out
+}
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url"`
+	FeedURL     string         `json:"feed_url"`
+	Description string         `json:"description,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url"`
+	Title         string           `json:"title"`
+	ContentHTML   string           `json:"content_html"`
+	Summary       string           `json:"summary,omitempty"`
+	DatePublished string           `json:"date_published"`
+	DateModified  string           `json:"date_modified"`
+	Authors       []jsonFeedAuthor `json:"authors,omitempty"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+func newJSONFeed(feed *dto.Feed) *jsonFeed {
+	out := &jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       feed.Title,
+		HomePageURL: feed.Link,
+		FeedURL:     feed.FeedLink,
+		Description: feed.Description,
+		Items:       make([]jsonFeedItem, 0, len(feed.Items)),
+	}
+
+	for _, item := range feed.Items {
+		jsonItem := jsonFeedItem{
+			ID:            item.ID,
+			URL:           item.Link,
+			Title:         item.Title,
+			ContentHTML:   item.ContentHTML,
+			Summary:       item.Excerpt,
+			DatePublished: item.Published.UTC().Format(time.RFC3339),
+			DateModified:  item.Updated.UTC().Format(time.RFC3339),
+		}
+
+		if item.AuthorName != "" {
+			jsonItem.Authors = []jsonFeedAuthor{{Name: item.AuthorName, URL: item.AuthorLink}}
+		}
+
+		out.Items = append(out.Items, jsonItem)
+	}
+
+	return out
+}
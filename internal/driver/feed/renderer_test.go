@@ -0,0 +1,96 @@
+package feed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+func TestRenderer(t *testing.T) {
+	renderer := NewRenderer()
+	feed := &dto.Feed{
+		Title:       "Blog",
+		Description: "Latest articles",
+		Link:        "https://example.com",
+		FeedLink:    "https://example.com/feed.rss",
+		Updated:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Items: []dto.FeedItem{{
+			ID:          "https://example.com/articles/foo",
+			Title:       "Foo & Bar",
+			Link:        "https://example.com/articles/foo",
+			Excerpt:     "Foo content",
+			ContentHTML: "<p>Foo content</p>",
+			AuthorName:  "Bob",
+			AuthorLink:  "https://example.com/authors/bob",
+			Published:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Updated:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			Language:    "en",
+			Alternates: []dto.FeedAlternate{
+				{Language: "en", Link: "https://example.com/articles/foo"},
+				{Language: "de", Link: "https://example.com/articles/foo-de"},
+			},
+		}},
+	}
+
+	t.Run("rss", func(t *testing.T) {
+		out, err := renderer.Render(feed, dto.FeedFormatRSS)
+		assert.NoError(t, err)
+		body := string(out)
+		assert.Contains(t, body, `<?xml version="1.0" encoding="UTF-8"?>`)
+		assert.Contains(t, body, `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"`)
+		assert.Contains(t, body, `<lastBuildDate>Tue, 02 Jan 2024 00:00:00 +0000</lastBuildDate>`)
+		assert.Contains(t, body, `<atom:link href="https://example.com/feed.rss" rel="self" type="application/rss+xml"></atom:link>`)
+		assert.Contains(t, body, `<title>Foo &amp; Bar</title>`)
+		assert.Contains(t, body, `<guid isPermaLink="true">https://example.com/articles/foo</guid>`)
+		assert.Contains(t, body, `<content:encoded>&lt;p&gt;Foo content&lt;/p&gt;</content:encoded>`)
+		assert.Contains(t, body, `<dc:creator>Bob</dc:creator>`)
+		assert.Contains(t, body, `<pubDate>Mon, 01 Jan 2024 00:00:00 +0000</pubDate>`)
+		assert.Contains(t, body, `<atom:link href="https://example.com/articles/foo" rel="alternate" hreflang="en"></atom:link>`)
+		assert.Contains(t, body, `<atom:link href="https://example.com/articles/foo-de" rel="alternate" hreflang="de"></atom:link>`)
+	})
+
+	t.Run("atom", func(t *testing.T) {
+		out, err := renderer.Render(feed, dto.FeedFormatAtom)
+		assert.NoError(t, err)
+		body := string(out)
+		assert.Contains(t, body, `<feed xmlns="http://www.w3.org/2005/Atom">`)
+		assert.Contains(t, body, `<updated>2024-01-02T00:00:00Z</updated>`)
+		assert.Contains(t, body, `<link href="https://example.com/feed.rss" rel="self" type="application/atom+xml"></link>`)
+		assert.Contains(t, body, `<published>2024-01-01T00:00:00Z</published>`)
+		assert.Contains(t, body, `<link href="https://example.com/articles/foo" hreflang="en"></link>`)
+		assert.Contains(t, body, `<link href="https://example.com/articles/foo-de" rel="alternate" hreflang="de"></link>`)
+		assert.Contains(t, body, `<author><name>Bob</name><uri>https://example.com/authors/bob</uri></author>`)
+		assert.Contains(t, body, `<content type="html">&lt;p&gt;Foo content&lt;/p&gt;</content>`)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		out, err := renderer.Render(feed, dto.FeedFormatJSON)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"version": "https://jsonfeed.org/version/1.1",
+			"title": "Blog",
+			"home_page_url": "https://example.com",
+			"feed_url": "https://example.com/feed.rss",
+			"description": "Latest articles",
+			"items": [{
+				"id": "https://example.com/articles/foo",
+				"url": "https://example.com/articles/foo",
+				"title": "Foo & Bar",
+				"content_html": "<p>Foo content</p>",
+				"summary": "Foo content",
+				"date_published": "2024-01-01T00:00:00Z",
+				"date_modified": "2024-01-02T00:00:00Z",
+				"authors": [{"name": "Bob", "url": "https://example.com/authors/bob"}]
+			}]
+		}`, string(out))
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		out, err := renderer.Render(feed, "foo")
+		assert.Nil(t, out)
+		assert.EqualError(t, err, `unknown feed format "foo"`)
+	})
+}
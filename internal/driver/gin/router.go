@@ -1,27 +1,61 @@
 package gin
 
 import (
+	basecontext "context"
+	"errors"
+	"fmt"
+	"net"
+	nethttp "net/http"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/log"
 )
 
 type Router struct {
 	engine *gin.Engine
+	server *nethttp.Server
+	logger log.Logger
 }
 
-func NewRouter() *Router {
+func NewRouter(logger log.Logger) *Router {
+	engine := gin.New()
+
 	return &Router{
-		engine: gin.New(),
+		engine: engine,
+		server: &nethttp.Server{Addr: ":8080", Handler: engine},
+		logger: logger,
 	}
 }
 
 func (r *Router) Register(method, path string, handle func(ctx http.Context)) {
 	r.engine.Handle(method, path, func(ctx *gin.Context) {
+		for _, param := range ctx.Params {
+			ctx.Request.SetPathValue(param.Key, param.Value)
+		}
+
 		handle(newContext(ctx))
 	})
 }
 
-func (r *Router) Run() error {
-	return r.engine.Run(":8080")
+// Start listens on the port and serves requests in background.
+func (r *Router) Start(_ basecontext.Context) error {
+	listener, err := net.Listen("tcp", r.server.Addr)
+	if err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	go func() {
+		if err := r.server.Serve(listener); err != nil && !errors.Is(err, nethttp.ErrServerClosed) {
+			r.logger.Error().Err(err).Msg("serve http error")
+		}
+	}()
+
+	return nil
+}
+
+// Stop stops accepting connections and waits for active requests to finish.
+func (r *Router) Stop(ctx basecontext.Context) error {
+	return r.server.Shutdown(ctx)
 }
@@ -0,0 +1,175 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/HugoSmits86/nativewebp"
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+const (
+	jpegQuality = 85
+	// maxPixels bounds decoded images, so a small file can't expand into a huge bitmap.
+	maxPixels = 40_000_000
+)
+
+// Processor re-encodes uploaded images and generates their thumbnails.
+// Re-encoding drops all metadata, EXIF included, the EXIF orientation is applied to pixels before.
+// JPEG images stay JPEG, other formats become PNG, and every variant also gets a lossless WebP copy.
+type Processor struct {
+	widths []int
+}
+
+// NewProcessor creates the image processor, widths are the thumbnail widths.
+// Images are never upscaled, so only thumbnails narrower than the image are generated.
+func NewProcessor(widths []int) *Processor {
+	return &Processor{widths: widths}
+}
+
+func (p *Processor) Process(data []byte) (*dto.ProcessedImage, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.ErrMediaInvalid
+	}
+
+	if config.Width < 1 || config.Height < 1 {
+		return nil, errors.ErrMediaInvalid
+	}
+
+	if config.Width*config.Height > maxPixels {
+		return nil, errors.ErrMediaTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.ErrMediaInvalid
+	}
+
+	if format == "jpeg" {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	bounds := img.Bounds()
+	out := &dto.ProcessedImage{Width: bounds.Dx(), Height: bounds.Dy()}
+
+	if err = p.addVariants(out, "original", img, format); err != nil {
+		return nil, err
+	}
+
+	for _, width := range p.widths {
+		if width >= bounds.Dx() {
+			continue
+		}
+
+		name := fmt.Sprintf("w%d", width)
+		if err = p.addVariants(out, name, resize(img, width), format); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// addVariants encodes the image in the output format of the source format and in WebP.
+func (p *Processor) addVariants(out *dto.ProcessedImage, name string, img image.Image, format string) error {
+	buf := &bytes.Buffer{}
+	ext, contentType := "png", "image/png"
+
+	if format == "jpeg" {
+		ext, contentType = "jpg", "image/jpeg"
+		if err := jpeg.Encode(buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return fmt.Errorf("encode jpeg: %w", err)
+		}
+	} else if err := png.Encode(buf, img); err != nil {
+		return fmt.Errorf("encode png: %w", err)
+	}
+
+	out.Variants = append(out.Variants, newVariant(name+"."+ext, contentType, img, buf.Bytes()))
+
+	buf = &bytes.Buffer{}
+	if err := nativewebp.Encode(buf, img, nil); err != nil {
+		return fmt.Errorf("encode webp: %w", err)
+	}
+
+	out.Variants = append(out.Variants, newVariant(name+".webp", "image/webp", img, buf.Bytes()))
+
+	return nil
+}
+
+func newVariant(name, contentType string, img image.Image, data []byte) dto.ProcessedImageVariant {
+	return dto.ProcessedImageVariant{
+		MediaVariant: dto.MediaVariant{
+			Name:        name,
+			ContentType: contentType,
+			Width:       img.Bounds().Dx(),
+			Height:      img.Bounds().Dy(),
+			Size:        int64(len(data)),
+		},
+		Data: data,
+	}
+}
+
+func resize(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	height := max(1, (bounds.Dy()*width+bounds.Dx()/2)/bounds.Dx())
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, xdraw.Src, nil)
+	return dst
+}
+
+// orient turns the image as the EXIF orientation tells, so it displays the same without the tag.
+func orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	src := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+
+	dw, dh := w, h
+	if orientation >= 5 {
+		// orientations from 5 to 8 swap the sides
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], src.Pix[src.PixOffset(sx, sy):src.PixOffset(sx, sy)+4])
+		}
+	}
+
+	return dst
+}
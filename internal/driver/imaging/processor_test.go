@@ -0,0 +1,143 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "golang.org/x/image/webp"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+// newImage draws a red left half and a blue right half.
+func newImage(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image, orientation int) []byte {
+	buf := &bytes.Buffer{}
+	require.NoError(t, jpeg.Encode(buf, img, &jpeg.Options{Quality: 95}))
+	data := buf.Bytes()
+
+	if orientation == 0 {
+		return data
+	}
+
+	// APP1 segment with a little-endian TIFF header and one IFD0 entry for the orientation
+	tiff := []byte{'I', 'I', 42, 0, 8, 0, 0, 0, 1, 0}
+	entry := make([]byte, 12)
+	binary.LittleEndian.PutUint16(entry, exifOrientationTag)
+	binary.LittleEndian.PutUint16(entry[2:], 3)
+	binary.LittleEndian.PutUint32(entry[4:], 1)
+	binary.LittleEndian.PutUint16(entry[8:], uint16(orientation))
+	tiff = append(append(tiff, entry...), 0, 0, 0, 0)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:], uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	return append(append([]byte{0xFF, 0xD8}, app1...), data[2:]...)
+}
+
+func variantNames(out *dto.ProcessedImage) []string {
+	names := make([]string, 0, len(out.Variants))
+	for _, v := range out.Variants {
+		names = append(names, v.Name)
+	}
+	return names
+}
+
+func TestProcessor(t *testing.T) {
+	processor := NewProcessor([]int{40, 200})
+
+	t.Run("jpeg", func(t *testing.T) {
+		out, err := processor.Process(encodeJPEG(t, newImage(100, 50), 0))
+		require.NoError(t, err)
+
+		assert.Equal(t, 100, out.Width)
+		assert.Equal(t, 50, out.Height)
+		assert.Equal(t, []string{"original.jpg", "original.webp", "w40.jpg", "w40.webp"}, variantNames(out))
+
+		thumb := out.Variants[2]
+		assert.Equal(t, "image/jpeg", thumb.ContentType)
+		assert.Equal(t, 40, thumb.Width)
+		assert.Equal(t, 20, thumb.Height)
+		assert.Equal(t, int64(len(thumb.Data)), thumb.Size)
+
+		webp, format, err := image.Decode(bytes.NewReader(out.Variants[3].Data))
+		require.NoError(t, err)
+		assert.Equal(t, "webp", format)
+		assert.Equal(t, image.Rect(0, 0, 40, 20), webp.Bounds())
+	})
+
+	t.Run("exif is stripped and orientation applied", func(t *testing.T) {
+		data := encodeJPEG(t, newImage(100, 50), 6)
+		require.Equal(t, 6, jpegOrientation(data))
+
+		out, err := processor.Process(data)
+		require.NoError(t, err)
+
+		original := out.Variants[0].Data
+		assert.False(t, bytes.Contains(original, []byte("Exif")))
+		assert.Equal(t, 1, jpegOrientation(original))
+
+		// rotated clockwise, the red left half is on top now
+		img, err := jpeg.Decode(bytes.NewReader(original))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 50, 100), img.Bounds())
+		r, _, b, _ := img.At(25, 10).RGBA()
+		assert.Greater(t, r, b)
+		r, _, b, _ = img.At(25, 90).RGBA()
+		assert.Greater(t, b, r)
+	})
+
+	t.Run("png", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, png.Encode(buf, newImage(30, 30)))
+
+		out, err := processor.Process(buf.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"original.png", "original.webp"}, variantNames(out))
+		assert.Equal(t, "image/png", out.Variants[0].ContentType)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		out, err := processor.Process([]byte("\xFF\xD8\xFFnot really a jpeg"))
+		assert.Nil(t, out)
+		assert.ErrorIs(t, err, apperrors.ErrMediaInvalid)
+	})
+
+	t.Run("too many pixels", func(t *testing.T) {
+		// a valid header is enough, the image isn't decoded past it
+		buf := &bytes.Buffer{}
+		require.NoError(t, png.Encode(buf, image.NewGray(image.Rect(0, 0, 1, 1))))
+		data := buf.Bytes()
+		binary.BigEndian.PutUint32(data[16:], 10000)
+		binary.BigEndian.PutUint32(data[20:], 10000)
+		binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+
+		out, err := processor.Process(data)
+		assert.Nil(t, out)
+		assert.ErrorIs(t, err, apperrors.ErrMediaTooLarge)
+	})
+}
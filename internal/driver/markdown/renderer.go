@@ -0,0 +1,155 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+const headingAnchorClass = "heading-anchor"
+
+// Renderer renders CommonMark with GitHub extensions into HTML which is safe to embed into a page.
+type Renderer struct {
+	markdown goldmark.Markdown
+	policy   *bluemonday.Policy
+}
+
+func NewRenderer() *Renderer {
+	return &Renderer{
+		markdown: goldmark.New(
+			goldmark.WithExtensions(extension.GFM),
+			goldmark.WithParserOptions(
+				parser.WithAutoHeadingID(),
+				parser.WithASTTransformers(util.Prioritized(headingAnchorTransformer{}, 100)),
+			),
+		),
+		policy: newPolicy(),
+	}
+}
+
+func (r *Renderer) Render(content string) (*dto.RenderedContent, error) {
+	source := []byte(content)
+	doc := r.markdown.Parser().Parse(text.NewReader(source))
+
+	var buf bytes.Buffer
+	if err := r.markdown.Renderer().Render(&buf, source, doc); err != nil {
+		return nil, fmt.Errorf("render markdown: %w", err)
+	}
+
+	return &dto.RenderedContent{
+		HTML: r.policy.Sanitize(buf.String()),
+		TOC:  collectTOC(doc, source),
+	}, nil
+}
+
+// newPolicy extends the user generated content policy with the markup produced by the renderer itself.
+// Raw HTML from the content is never trusted, the renderer omits it and the policy strips anything left.
+func newPolicy() *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+	policy.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)).
+		OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	policy.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#.-]+$`)).
+		OnElements("code")
+	policy.AllowAttrs("class").Matching(regexp.MustCompile(`^` + headingAnchorClass + `$`)).
+		OnElements("a")
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).
+		OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+	return policy
+}
+
+func collectTOC(doc ast.Node, source []byte) []dto.TOCEntry {
+	toc := make([]dto.TOCEntry, 0)
+
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := node.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		anchor, _ := heading.AttributeString("id")
+		anchorBytes, _ := anchor.([]byte)
+
+		toc = append(toc, dto.TOCEntry{
+			Level:  heading.Level,
+			Anchor: string(anchorBytes),
+			Title:  headingTitle(heading, source),
+		})
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	return toc
+}
+
+func headingTitle(heading *ast.Heading, source []byte) string {
+	var buf bytes.Buffer
+
+	_ = ast.Walk(heading, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		if _, ok := node.(*ast.Link); ok && isHeadingAnchor(node) {
+			return ast.WalkSkipChildren, nil
+		}
+
+		switch n := node.(type) {
+		case *ast.Text:
+			buf.Write(n.Segment.Value(source))
+		case *ast.String:
+			buf.Write(n.Value)
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return buf.String()
+}
+
+// headingAnchorTransformer appends a self link to every heading which has an id.
+type headingAnchorTransformer struct{}
+
+func (headingAnchorTransformer) Transform(doc *ast.Document, _ text.Reader, _ parser.Context) {
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := node.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		id, ok := heading.AttributeString("id")
+		if !ok {
+			return ast.WalkSkipChildren, nil
+		}
+
+		idBytes, _ := id.([]byte)
+
+		link := ast.NewLink()
+		link.Destination = append([]byte("#"), idBytes...)
+		link.SetAttributeString("class", []byte(headingAnchorClass))
+		link.AppendChild(link, ast.NewString([]byte("#")))
+		heading.AppendChild(heading, link)
+
+		return ast.WalkSkipChildren, nil
+	})
+}
+
+func isHeadingAnchor(node ast.Node) bool {
+	class, ok := node.AttributeString("class")
+	if !ok {
+		return false
+	}
+
+	classBytes, _ := class.([]byte)
+	return string(classBytes) == headingAnchorClass
+}
@@ -0,0 +1,54 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+func TestRenderer(t *testing.T) {
+	renderer := NewRenderer()
+
+	t.Run("headings", func(t *testing.T) {
+		out, err := renderer.Render("# Hello *World*\n\n## Usage `go`\n")
+		assert.NoError(t, err)
+		assert.Contains(t, out.HTML, `<h1 id="hello-world">Hello <em>World</em><a href="#hello-world" class="heading-anchor"`)
+		assert.Contains(t, out.HTML, `<h2 id="usage-go">Usage <code>go</code><a href="#usage-go" class="heading-anchor"`)
+		assert.Equal(t, []dto.TOCEntry{
+			{Level: 1, Anchor: "hello-world", Title: "Hello World"},
+			{Level: 2, Anchor: "usage-go", Title: "Usage go"},
+		}, out.TOC)
+	})
+
+	t.Run("code block language", func(t *testing.T) {
+		out, err := renderer.Render("```go\nfmt.Println()\n```\n")
+		assert.NoError(t, err)
+		assert.Equal(t, "<pre><code class=\"language-go\">fmt.Println()\n</code></pre>\n", out.HTML)
+		assert.Empty(t, out.TOC)
+	})
+
+	t.Run("github extensions", func(t *testing.T) {
+		out, err := renderer.Render("- [x] done\n\n~~old~~\n\n| a |\n|---|\n| 1 |\n")
+		assert.NoError(t, err)
+		assert.Contains(t, out.HTML, `<input checked="" disabled="" type="checkbox"> done`)
+		assert.Contains(t, out.HTML, "<del>old</del>")
+		assert.Contains(t, out.HTML, "<td>1</td>")
+	})
+
+	t.Run("unsafe markup", func(t *testing.T) {
+		out, err := renderer.Render("<script>alert(1)</script>\n\n<img src=x onerror=alert(1)>\n\n[link](javascript:alert(1)) <b onclick=\"alert(1)\">b</b>\n")
+		assert.NoError(t, err)
+		assert.NotContains(t, out.HTML, "<script")
+		assert.NotContains(t, out.HTML, "onerror")
+		assert.NotContains(t, out.HTML, "onclick")
+		assert.NotContains(t, out.HTML, "javascript:")
+	})
+
+	t.Run("forged classes", func(t *testing.T) {
+		out, err := renderer.Render("```go\" onclick=\"x\nfoo\n```\n")
+		assert.NoError(t, err)
+		assert.NotContains(t, out.HTML, "onclick")
+	})
+}
@@ -0,0 +1,102 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+const (
+	sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	xhtmlNS   = "http://www.w3.org/1999/xhtml"
+)
+
+// Renderer encodes sitemaps and sitemap indexes in the sitemaps.org 0.9 format.
+type Renderer struct{}
+
+func NewRenderer() *Renderer {
+	return &Renderer{}
+}
+
+func (r *Renderer) RenderIndex(sitemaps []dto.SitemapEntry) ([]byte, error) {
+	index := sitemapIndex{NS: sitemapNS, Sitemaps: make([]location, 0, len(sitemaps))}
+	for _, sitemap := range sitemaps {
+		index.Sitemaps = append(index.Sitemaps, newLocation(sitemap))
+	}
+
+	body, err := marshalXML(index)
+	if err != nil {
+		return nil, fmt.Errorf("marshal sitemap index: %w", err)
+	}
+
+	return body, nil
+}
+
+func (r *Renderer) RenderURLSet(urls []dto.SitemapEntry) ([]byte, error) {
+	set := urlSet{NS: sitemapNS, URLs: make([]location, 0, len(urls))}
+	for _, url := range urls {
+		loc := newLocation(url)
+		for _, alternate := range url.Alternates {
+			loc.Links = append(loc.Links, link{Rel: "alternate", Hreflang: alternate.Language, Href: alternate.Loc})
+		}
+
+		// the namespace of alternate links is only declared when there are any
+		if len(loc.Links) > 0 {
+			set.XHTMLNS = xhtmlNS
+		}
+
+		set.URLs = append(set.URLs, loc)
+	}
+
+	body, err := marshalXML(set)
+	if err != nil {
+		return nil, fmt.Errorf("marshal sitemap: %w", err)
+	}
+
+	return body, nil
+}
+
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name   `xml:"sitemapindex"`
+	NS       string     `xml:"xmlns,attr"`
+	Sitemaps []location `xml:"sitemap"`
+}
+
+type urlSet struct {
+	XMLName xml.Name   `xml:"urlset"`
+	NS      string     `xml:"xmlns,attr"`
+	XHTMLNS string     `xml:"xmlns:xhtml,attr,omitempty"`
+	URLs    []location `xml:"url"`
+}
+
+type location struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+	Links   []link `xml:"xhtml:link"`
+}
+
+type link struct {
+	Rel      string `xml:"rel,attr"`
+	Hreflang string `xml:"hreflang,attr"`
+	Href     string `xml:"href,attr"`
+}
+
+func newLocation(entry dto.SitemapEntry) location {
+	loc := location{Loc: entry.Loc}
+	if !entry.LastModified.IsZero() {
+		loc.LastMod = entry.LastModified.UTC().Format(time.RFC3339)
+	}
+
+	return loc
+}
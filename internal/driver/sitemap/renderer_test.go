@@ -0,0 +1,64 @@
+package sitemap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+func TestRenderer(t *testing.T) {
+	renderer := NewRenderer()
+	entries := []dto.SitemapEntry{
+		{Loc: "https://example.com/articles/foo?a=1&b=2", LastModified: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{Loc: "https://example.com/articles/bar"},
+	}
+
+	t.Run("index", func(t *testing.T) {
+		out, err := renderer.RenderIndex(entries)
+		assert.NoError(t, err)
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+			`<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`+
+			`<sitemap><loc>https://example.com/articles/foo?a=1&amp;b=2</loc><lastmod>2024-01-02T03:04:05Z</lastmod></sitemap>`+
+			`<sitemap><loc>https://example.com/articles/bar</loc></sitemap>`+
+			`</sitemapindex>`, string(out))
+	})
+
+	t.Run("url set", func(t *testing.T) {
+		out, err := renderer.RenderURLSet(entries)
+		assert.NoError(t, err)
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+			`<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`+
+			`<url><loc>https://example.com/articles/foo?a=1&amp;b=2</loc><lastmod>2024-01-02T03:04:05Z</lastmod></url>`+
+			`<url><loc>https://example.com/articles/bar</loc></url>`+
+			`</urlset>`, string(out))
+	})
+
+	t.Run("url set with alternates", func(t *testing.T) {
+		alternates := []dto.SitemapAlternate{
+			{Language: "en", Loc: "https://example.com/articles/foo"},
+			{Language: "de", Loc: "https://example.com/articles/foo-de"},
+		}
+		out, err := renderer.RenderURLSet([]dto.SitemapEntry{
+			{Loc: "https://example.com/articles/foo", Alternates: alternates},
+			{Loc: "https://example.com/articles/foo-de", Alternates: alternates},
+		})
+		assert.NoError(t, err)
+
+		links := `<xhtml:link rel="alternate" hreflang="en" href="https://example.com/articles/foo"></xhtml:link>` +
+			`<xhtml:link rel="alternate" hreflang="de" href="https://example.com/articles/foo-de"></xhtml:link>`
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+			`<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" xmlns:xhtml="http://www.w3.org/1999/xhtml">`+
+			`<url><loc>https://example.com/articles/foo</loc>`+links+`</url>`+
+			`<url><loc>https://example.com/articles/foo-de</loc>`+links+`</url>`+
+			`</urlset>`, string(out))
+	})
+
+	t.Run("empty url set", func(t *testing.T) {
+		out, err := renderer.RenderURLSet(nil)
+		assert.NoError(t, err)
+		assert.Contains(t, string(out), `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></urlset>`)
+	})
+}
@@ -1,7 +1,20 @@
 package validator
 
-import validatorV10 "github.com/go-playground/validator/v10"
+import (
+	"regexp"
+
+	validatorV10 "github.com/go-playground/validator/v10"
+)
+
+var nickNameRegexp = regexp.MustCompile(`^[a-z0-9_]{3,32}$`)
 
 func New() *validatorV10.Validate {
-	return validatorV10.New()
+	v := validatorV10.New()
+
+	// nicknames are public handles used in URLs, so only lowercase letters, digits and underscores are allowed
+	_ = v.RegisterValidation("nickname", func(fl validatorV10.FieldLevel) bool {
+		return nickNameRegexp.MatchString(fl.Field().String())
+	})
+
+	return v
 }
@@ -0,0 +1,19 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNickName(t *testing.T) {
+	v := New()
+
+	for _, nickName := range []string{"bob", "james_bond007", "a_b_c"} {
+		assert.NoError(t, v.Var(nickName, "nickname"), nickName)
+	}
+
+	for _, nickName := range []string{"", "ab", "Bob", "bob smith", "bob/../x", "bob-smith", "abcdefghijklmnopqrstuvwxyz0123456"} {
+		assert.Error(t, v.Var(nickName, "nickname"), nickName)
+	}
+}
@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+// Headers of deliveries. The signature is "t=<unix timestamp>,v1=<signature>", see Sign.
+const (
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+// maxResponseSize limits how much of a response is drained, so connections to receivers are reused.
+const maxResponseSize = 64 << 10
+
+// Sender posts deliveries as JSON signed with secrets of their webhooks.
+type Sender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewSender creates the sender, the client should time out and not follow redirects.
+func NewSender(client *http.Client) *Sender {
+	return &Sender{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// Send posts the delivery and returns the response status, responses other than 2xx are errors.
+func (s *Sender) Send(ctx context.Context, delivery *dto.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("create request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(SignatureHeader, "t="+timestamp+",v1="+Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("send request: %w", err)
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxResponseSize))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("unexpected response status %d", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of "<timestamp>.<payload>" keyed with the secret.
+// Receivers compute it from the raw body and reject stale timestamps, so captured deliveries can't be replayed.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+// receiver is a local endpoint which verifies signatures the way receivers are told to.
+type receiver struct {
+	secret string
+	status int
+	header http.Header
+	body   string
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.header = req.Header
+	r.body = string(body)
+
+	timestamp, signature, _ := strings.Cut(req.Header.Get(SignatureHeader), ",")
+	if signature != "v1="+Sign(r.secret, strings.TrimPrefix(timestamp, "t="), body) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	w.WriteHeader(r.status)
+	_, _ = w.Write([]byte("ok"))
+}
+
+// TestSign checks the signature against one computed with openssl dgst -sha256 -hmac.
+func TestSign(t *testing.T) {
+	assert.Equal(t,
+		"67dc7df7c2ada5e74f788ffaf9c33ffd40fc475e030b3e4c24c4095dd0043e50",
+		Sign("secret", "1704067200", []byte(`{"event":"user.activated"}`)))
+}
+
+func TestSender(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	delivery := func(url string) *dto.WebhookDelivery {
+		return &dto.WebhookDelivery{
+			ID:      "delivery id",
+			Event:   dto.WebhookEventUserActivated,
+			Payload: []byte(`{"event":"user.activated"}`),
+			URL:     url,
+			Secret:  "secret",
+		}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		receiver *receiver
+		assert   func(t *testing.T, r *receiver, status int, err error)
+	}{
+		{
+			name:     "delivered",
+			receiver: &receiver{secret: "secret", status: http.StatusNoContent},
+			assert: func(t *testing.T, r *receiver, status int, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusNoContent, status)
+				assert.Equal(t, `{"event":"user.activated"}`, r.body)
+				assert.Equal(t, "application/json", r.header.Get("Content-Type"))
+				assert.Equal(t, "user.activated", r.header.Get(EventHeader))
+				assert.Equal(t, "delivery id", r.header.Get(DeliveryHeader))
+				assert.True(t, strings.HasPrefix(r.header.Get(SignatureHeader), "t=1704067200,v1="))
+			},
+		},
+		{
+			name:     "signed with another secret",
+			receiver: &receiver{secret: "another secret", status: http.StatusOK},
+			assert: func(t *testing.T, r *receiver, status int, err error) {
+				assert.EqualError(t, err, "unexpected response status 401")
+				assert.Equal(t, http.StatusUnauthorized, status)
+			},
+		},
+		{
+			name:     "server error",
+			receiver: &receiver{secret: "secret", status: http.StatusServiceUnavailable},
+			assert: func(t *testing.T, r *receiver, status int, err error) {
+				assert.EqualError(t, err, "unexpected response status 503")
+				assert.Equal(t, http.StatusServiceUnavailable, status)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.receiver)
+			defer server.Close()
+
+			sender := NewSender(server.Client())
+			sender.now = func() time.Time { return now }
+
+			status, err := sender.Send(context.Background(), delivery(server.URL))
+
+			tt.assert(t, tt.receiver, status, err)
+		})
+	}
+
+	t.Run("no response", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		status, err := NewSender(server.Client()).Send(context.Background(), delivery(server.URL))
+
+		assert.ErrorContains(t, err, "send request: ")
+		assert.Zero(t, status)
+	})
+}
@@ -0,0 +1,98 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+// BlobStorage keeps blobs as files under the directory, keys are slash separated paths.
+// Content types aren't stored, they are derived from key extensions.
+type BlobStorage struct {
+	dir string
+}
+
+func NewBlobStorage(dir string) *BlobStorage {
+	return &BlobStorage{dir: dir}
+}
+
+// Put writes the blob to a temporary file first, so readers never see a partially written blob.
+func (s *BlobStorage) Put(_ context.Context, key, _ string, data []byte) error {
+	name := s.path(key)
+
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return fmt.Errorf("make directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write temporary file: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("close temporary file: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), name); err != nil {
+		return fmt.Errorf("rename temporary file: %w", err)
+	}
+
+	return nil
+}
+
+// Get opens the blob, nil is returned if there is no blob with the key.
+func (s *BlobStorage) Get(_ context.Context, key string) (*dto.Blob, error) {
+	file, err := os.Open(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("stat file: %w", err)
+	}
+
+	if info.IsDir() {
+		file.Close()
+		return nil, nil
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return &dto.Blob{Body: file, ContentType: contentType, Size: info.Size()}, nil
+}
+
+// Delete removes the blob, deleting a missing blob is a no-op.
+func (s *BlobStorage) Delete(_ context.Context, key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove file: %w", err)
+	}
+
+	return nil
+}
+
+// path maps the key to a file name, cleaning the key as rooted keeps it inside the directory.
+func (s *BlobStorage) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(path.Clean("/"+key)))
+}
@@ -0,0 +1,53 @@
+package filesystem
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobStorage(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	storage := NewBlobStorage(dir)
+
+	t.Run("missing", func(t *testing.T) {
+		blob, err := storage.Get(ctx, "media/foo/original.png")
+		assert.NoError(t, err)
+		assert.Nil(t, blob)
+		assert.NoError(t, storage.Delete(ctx, "media/foo/original.png"))
+	})
+
+	t.Run("put, get and delete", func(t *testing.T) {
+		require.NoError(t, storage.Put(ctx, "media/foo/original.png", "image/png", []byte("foo")))
+		require.NoError(t, storage.Put(ctx, "media/foo/original.png", "image/png", []byte("bar")))
+
+		blob, err := storage.Get(ctx, "media/foo/original.png")
+		require.NoError(t, err)
+		data, _ := io.ReadAll(blob.Body)
+		blob.Body.Close()
+		assert.Equal(t, "bar", string(data))
+		assert.Equal(t, "image/png", blob.ContentType)
+		assert.Equal(t, int64(3), blob.Size)
+
+		entries, _ := os.ReadDir(filepath.Join(dir, "media", "foo"))
+		assert.Len(t, entries, 1)
+
+		require.NoError(t, storage.Delete(ctx, "media/foo/original.png"))
+		blob, err = storage.Get(ctx, "media/foo/original.png")
+		assert.NoError(t, err)
+		assert.Nil(t, blob)
+	})
+
+	t.Run("keys stay inside the directory", func(t *testing.T) {
+		require.NoError(t, storage.Put(ctx, "../../escaped.txt", "text/plain", []byte("foo")))
+
+		_, err := os.Stat(filepath.Join(dir, "escaped.txt"))
+		assert.NoError(t, err)
+	})
+}
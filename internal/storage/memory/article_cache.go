@@ -0,0 +1,222 @@
+//go:generate mockgen -source=article_cache.go -destination=mock/article_cache.go -package=mock
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/lru"
+)
+
+// articleEntryOverhead approximates the memory taken by a page besides its strings.
+const articleEntryOverhead = 256
+
+type articleCache interface {
+	Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, bool, error)
+	Token(ctx context.Context) (*dto.ArticleCacheToken, error)
+	Add(ctx context.Context, in *dto.GetArticlesIn, token *dto.ArticleCacheToken, out *dto.GetArticlesOut) error
+	Lock(ctx context.Context, in *dto.GetArticlesIn) (func(), error)
+	Await(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error)
+	PurgeArticles(ctx context.Context, slugs ...string) error
+	PurgeAuthors(ctx context.Context, authorIDs ...string) error
+	PurgeListings(ctx context.Context) error
+	Flush(ctx context.Context) error
+}
+
+type invalidationBus interface {
+	Publish(ctx context.Context, invalidation *dto.ArticleCacheInvalidation) error
+	Subscribe(ctx context.Context, handle func(invalidation *dto.ArticleCacheInvalidation))
+}
+
+type articleEntry struct {
+	in  *dto.GetArticlesIn
+	out *dto.GetArticlesOut
+}
+
+// ArticleCache keeps recently used pages of articles in process memory in front of another cache.
+// Only fresh pages of the next cache are kept, so stale ones still reach the caller and get refreshed.
+// Purges are broadcast to all instances; as a broadcast may be lost, pages live only for a short ttl.
+type ArticleCache struct {
+	next            articleCache
+	invalidationBus invalidationBus
+	logger          log.Logger
+
+	entries *lru.Cache[*articleEntry]
+	hits    atomic.Int64
+	misses  atomic.Int64
+}
+
+func NewArticleCache(
+	next articleCache,
+	invalidationBus invalidationBus,
+	logger log.Logger,
+	maxBytes int64,
+	ttl time.Duration,
+) *ArticleCache {
+	return &ArticleCache{
+		next:            next,
+		invalidationBus: invalidationBus,
+		logger:          logger,
+		entries:         lru.New[*articleEntry](maxBytes, ttl),
+	}
+}
+
+func (c *ArticleCache) Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, bool, error) {
+	key := in.CacheKey()
+	if entry, ok := c.entries.Get(key); ok {
+		c.hits.Add(1)
+		return entry.out, false, nil
+	}
+
+	c.misses.Add(1)
+
+	out, stale, err := c.next.Get(ctx, in)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !stale {
+		c.entries.Add(key, &articleEntry{in: in, out: out}, articleEntrySize(out))
+	}
+
+	return out, stale, nil
+}
+
+func (c *ArticleCache) Token(ctx context.Context) (*dto.ArticleCacheToken, error) {
+	return c.next.Token(ctx)
+}
+
+func (c *ArticleCache) Add(ctx context.Context, in *dto.GetArticlesIn, token *dto.ArticleCacheToken, out *dto.GetArticlesOut) error {
+	return c.next.Add(ctx, in, token, out)
+}
+
+func (c *ArticleCache) Lock(ctx context.Context, in *dto.GetArticlesIn) (func(), error) {
+	return c.next.Lock(ctx, in)
+}
+
+func (c *ArticleCache) Await(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
+	return c.next.Await(ctx, in)
+}
+
+func (c *ArticleCache) PurgeArticles(ctx context.Context, slugs ...string) error {
+	if err := c.next.PurgeArticles(ctx, slugs...); err != nil {
+		return err
+	}
+
+	return c.broadcast(ctx, &dto.ArticleCacheInvalidation{Slugs: slugs})
+}
+
+func (c *ArticleCache) PurgeAuthors(ctx context.Context, authorIDs ...string) error {
+	if err := c.next.PurgeAuthors(ctx, authorIDs...); err != nil {
+		return err
+	}
+
+	return c.broadcast(ctx, &dto.ArticleCacheInvalidation{AuthorIDs: authorIDs})
+}
+
+func (c *ArticleCache) PurgeListings(ctx context.Context) error {
+	if err := c.next.PurgeListings(ctx); err != nil {
+		return err
+	}
+
+	return c.broadcast(ctx, &dto.ArticleCacheInvalidation{Listings: true})
+}
+
+func (c *ArticleCache) Flush(ctx context.Context) error {
+	if err := c.next.Flush(ctx); err != nil {
+		return err
+	}
+
+	return c.broadcast(ctx, &dto.ArticleCacheInvalidation{Flush: true})
+}
+
+func (c *ArticleCache) Stats() dto.CacheStats {
+	return dto.CacheStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
+
+// RunInvalidator drops pages purged by any instance until the context is done.
+func (c *ArticleCache) RunInvalidator(ctx context.Context) {
+	c.invalidationBus.Subscribe(ctx, c.invalidate)
+}
+
+// broadcast drops the pages in this instance right away, so it doesn't depend on its own message.
+func (c *ArticleCache) broadcast(ctx context.Context, invalidation *dto.ArticleCacheInvalidation) error {
+	c.invalidate(invalidation)
+
+	if err := c.invalidationBus.Publish(ctx, invalidation); err != nil {
+		return fmt.Errorf("publish invalidation: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ArticleCache) invalidate(invalidation *dto.ArticleCacheInvalidation) {
+	if invalidation.Flush {
+		c.entries.Purge()
+		return
+	}
+
+	c.entries.RemoveFunc(func(_ string, entry *articleEntry) bool {
+		return articleEntryMatches(entry, invalidation)
+	})
+}
+
+func articleEntryMatches(entry *articleEntry, invalidation *dto.ArticleCacheInvalidation) bool {
+	if entry.in.Slug != "" {
+		if slices.Contains(invalidation.Slugs, entry.in.Slug) {
+			return true
+		}
+	} else if invalidation.Listings && entry.in.AuthorID == "" {
+		return true
+	}
+
+	if slices.Contains(invalidation.AuthorIDs, entry.in.AuthorID) {
+		return true
+	}
+
+	for _, article := range entry.out.Articles {
+		if slices.Contains(invalidation.Slugs, article.Slug) || slices.Contains(invalidation.AuthorIDs, article.AuthorID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func articleEntrySize(out *dto.GetArticlesOut) int64 {
+	size := int64(articleEntryOverhead)
+	for _, article := range out.Articles {
+		size += int64(articleEntryOverhead + len(article.ID) + len(article.Slug) + len(article.Title) +
+			len(article.Content) + len(article.ContentHTML) + len(article.Excerpt) + len(article.AuthorID))
+		for _, entry := range article.TOC {
+			size += int64(len(entry.Anchor) + len(entry.Title))
+		}
+		for _, tag := range article.Tags {
+			size += int64(len(tag))
+		}
+		for _, author := range article.Authors {
+			size += int64(len(author.DisplayName) + len(author.NickName) + len(author.Role))
+		}
+		if article.Series != nil {
+			size += int64(articleEntryOverhead + len(article.Series.ID) + len(article.Series.Title))
+		}
+		// every translation carries its own content
+		for _, translation := range article.Translations {
+			size += int64(articleEntryOverhead + len(translation.ID) + len(translation.ArticleID) +
+				len(translation.ArticleSlug) + len(translation.Locale) + len(translation.Slug) + len(translation.Title) +
+				len(translation.Content) + len(translation.ContentHTML) + len(translation.Excerpt) + len(translation.Status))
+			for _, entry := range translation.TOC {
+				size += int64(len(entry.Anchor) + len(entry.Title))
+			}
+		}
+	}
+	return size
+}
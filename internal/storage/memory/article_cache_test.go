@@ -0,0 +1,236 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/storage/memory/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+)
+
+func TestArticleCacheGet(t *testing.T) {
+	in := &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20}
+	page := &dto.GetArticlesOut{Articles: []dto.Article{{ID: "1", Slug: "foo-article", AuthorID: "author 1"}}}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(next *mock.MockarticleCache)
+		assert func(t *testing.T, c *ArticleCache)
+	}{
+		{
+			name: "next cache error",
+			setup: func(next *mock.MockarticleCache) {
+				next.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(nil, false, apperrors.ErrNoCache).Times(2)
+			},
+			assert: func(t *testing.T, c *ArticleCache) {
+				for range 2 {
+					_, _, err := c.Get(context.Background(), in)
+					assert.ErrorIs(t, err, apperrors.ErrNoCache)
+				}
+				assert.Equal(t, dto.CacheStats{Misses: 2}, c.Stats())
+			},
+		},
+		{
+			name: "fresh page is kept",
+			setup: func(next *mock.MockarticleCache) {
+				next.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(page, false, nil)
+			},
+			assert: func(t *testing.T, c *ArticleCache) {
+				for range 2 {
+					out, stale, err := c.Get(context.Background(), in)
+					assert.NoError(t, err)
+					assert.False(t, stale)
+					assert.Equal(t, page, out)
+				}
+				assert.Equal(t, dto.CacheStats{Hits: 1, Misses: 1}, c.Stats())
+			},
+		},
+		{
+			name: "stale page is not kept",
+			setup: func(next *mock.MockarticleCache) {
+				next.EXPECT().Get(gomock.Any(), gomock.Eq(in)).Return(page, true, nil).Times(2)
+			},
+			assert: func(t *testing.T, c *ArticleCache) {
+				for range 2 {
+					out, stale, err := c.Get(context.Background(), in)
+					assert.NoError(t, err)
+					assert.True(t, stale)
+					assert.Equal(t, page, out)
+				}
+				assert.Equal(t, dto.CacheStats{Misses: 2}, c.Stats())
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			next := mock.NewMockarticleCache(ctrl)
+			tt.setup(next)
+
+			c := NewArticleCache(next, mock.NewMockinvalidationBus(ctrl), testutil.NewLogger(), 1<<20, time.Minute)
+
+			tt.assert(t, c)
+		})
+	}
+}
+
+func TestArticleCachePurge(t *testing.T) {
+	listing := &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20}
+	listingPage := &dto.GetArticlesOut{Articles: []dto.Article{{Slug: "foo-article", AuthorID: "author 1"}}}
+	authorListing := &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20, AuthorID: "author 2"}
+	authorListingPage := &dto.GetArticlesOut{Articles: []dto.Article{{Slug: "bar-article", AuthorID: "author 2"}}}
+	missingArticle := &dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 1, Slug: "baz-article"}
+	missingArticlePage := &dto.GetArticlesOut{Articles: []dto.Article{}}
+
+	for _, tt := range []struct {
+		name     string
+		purge    func(c *ArticleCache) error
+		setup    func(next *mock.MockarticleCache, bus *mock.MockinvalidationBus)
+		expError string
+		expKept  []*dto.GetArticlesIn
+	}{
+		{
+			name: "purge articles",
+			purge: func(c *ArticleCache) error {
+				return c.PurgeArticles(context.Background(), "foo-article")
+			},
+			setup: func(next *mock.MockarticleCache, bus *mock.MockinvalidationBus) {
+				next.EXPECT().PurgeArticles(gomock.Any(), gomock.Eq("foo-article")).Return(nil)
+				bus.EXPECT().
+					Publish(gomock.Any(), gomock.Eq(&dto.ArticleCacheInvalidation{Slugs: []string{"foo-article"}})).
+					Return(nil)
+			},
+			expKept: []*dto.GetArticlesIn{authorListing, missingArticle},
+		},
+		{
+			name: "purge missing article",
+			purge: func(c *ArticleCache) error {
+				return c.PurgeArticles(context.Background(), "baz-article")
+			},
+			setup: func(next *mock.MockarticleCache, bus *mock.MockinvalidationBus) {
+				next.EXPECT().PurgeArticles(gomock.Any(), gomock.Eq("baz-article")).Return(nil)
+				bus.EXPECT().
+					Publish(gomock.Any(), gomock.Eq(&dto.ArticleCacheInvalidation{Slugs: []string{"baz-article"}})).
+					Return(nil)
+			},
+			expKept: []*dto.GetArticlesIn{listing, authorListing},
+		},
+		{
+			name: "purge authors",
+			purge: func(c *ArticleCache) error {
+				return c.PurgeAuthors(context.Background(), "author 2")
+			},
+			setup: func(next *mock.MockarticleCache, bus *mock.MockinvalidationBus) {
+				next.EXPECT().PurgeAuthors(gomock.Any(), gomock.Eq("author 2")).Return(nil)
+				bus.EXPECT().
+					Publish(gomock.Any(), gomock.Eq(&dto.ArticleCacheInvalidation{AuthorIDs: []string{"author 2"}})).
+					Return(nil)
+			},
+			expKept: []*dto.GetArticlesIn{listing, missingArticle},
+		},
+		{
+			name: "purge listings",
+			purge: func(c *ArticleCache) error {
+				return c.PurgeListings(context.Background())
+			},
+			setup: func(next *mock.MockarticleCache, bus *mock.MockinvalidationBus) {
+				next.EXPECT().PurgeListings(gomock.Any()).Return(nil)
+				bus.EXPECT().
+					Publish(gomock.Any(), gomock.Eq(&dto.ArticleCacheInvalidation{Listings: true})).
+					Return(nil)
+			},
+			expKept: []*dto.GetArticlesIn{authorListing, missingArticle},
+		},
+		{
+			name: "flush",
+			purge: func(c *ArticleCache) error {
+				return c.Flush(context.Background())
+			},
+			setup: func(next *mock.MockarticleCache, bus *mock.MockinvalidationBus) {
+				next.EXPECT().Flush(gomock.Any()).Return(nil)
+				bus.EXPECT().
+					Publish(gomock.Any(), gomock.Eq(&dto.ArticleCacheInvalidation{Flush: true})).
+					Return(nil)
+			},
+		},
+		{
+			name: "next cache error",
+			purge: func(c *ArticleCache) error {
+				return c.PurgeListings(context.Background())
+			},
+			setup: func(next *mock.MockarticleCache, bus *mock.MockinvalidationBus) {
+				next.EXPECT().PurgeListings(gomock.Any()).Return(errors.New("foo error"))
+			},
+			expError: "foo error",
+			expKept:  []*dto.GetArticlesIn{listing, authorListing, missingArticle},
+		},
+		{
+			name: "publish error",
+			purge: func(c *ArticleCache) error {
+				return c.PurgeListings(context.Background())
+			},
+			setup: func(next *mock.MockarticleCache, bus *mock.MockinvalidationBus) {
+				next.EXPECT().PurgeListings(gomock.Any()).Return(nil)
+				bus.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("foo error"))
+			},
+			expError: "publish invalidation: foo error",
+			expKept:  []*dto.GetArticlesIn{authorListing, missingArticle},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			next := mock.NewMockarticleCache(ctrl)
+			bus := mock.NewMockinvalidationBus(ctrl)
+			c := NewArticleCache(next, bus, testutil.NewLogger(), 1<<20, time.Minute)
+
+			next.EXPECT().Get(gomock.Any(), gomock.Eq(listing)).Return(listingPage, false, nil)
+			next.EXPECT().Get(gomock.Any(), gomock.Eq(authorListing)).Return(authorListingPage, false, nil)
+			_, _, _ = c.Get(context.Background(), listing)
+			next.EXPECT().Get(gomock.Any(), gomock.Eq(missingArticle)).Return(missingArticlePage, false, nil)
+			_, _, _ = c.Get(context.Background(), authorListing)
+			_, _, _ = c.Get(context.Background(), missingArticle)
+
+			tt.setup(next, bus)
+
+			err := tt.purge(c)
+			if tt.expError != "" {
+				assert.EqualError(t, err, tt.expError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, len(tt.expKept), c.entries.Len())
+			for _, in := range tt.expKept {
+				_, ok := c.entries.Get(in.CacheKey())
+				assert.True(t, ok)
+			}
+		})
+	}
+}
+
+func TestArticleEntrySize(t *testing.T) {
+	article := dto.Article{ID: "1", Slug: "foo-article", Content: "foo content", ContentHTML: "<p>foo content</p>"}
+	size := articleEntrySize(&dto.GetArticlesOut{Articles: []dto.Article{article}})
+
+	article.Translations = []dto.ArticleTranslation{{
+		Locale:      "de",
+		Slug:        "foo-artikel",
+		Content:     "foo inhalt",
+		ContentHTML: "<p>foo inhalt</p>",
+		TOC:         []dto.TOCEntry{{Anchor: "inhalt", Title: "Inhalt"}},
+	}}
+	translatedSize := articleEntrySize(&dto.GetArticlesOut{Articles: []dto.Article{article}})
+
+	assert.GreaterOrEqual(t, translatedSize-size, int64(len("de")+len("foo-artikel")+len("foo inhalt")+
+		len("<p>foo inhalt</p>")+len("inhalt")+len("Inhalt")))
+}
@@ -0,0 +1,233 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: article_cache.go
+//
+// Generated by this command:
+//
+//	mockgen -source=article_cache.go -destination=mock/article_cache.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockarticleCache is a mock of articleCache interface.
+type MockarticleCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockarticleCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockarticleCacheMockRecorder is the mock recorder for MockarticleCache.
+type MockarticleCacheMockRecorder struct {
+	mock *MockarticleCache
+}
+
+// NewMockarticleCache creates a new mock instance.
+func NewMockarticleCache(ctrl *gomock.Controller) *MockarticleCache {
+	mock := &MockarticleCache{ctrl: ctrl}
+	mock.recorder = &MockarticleCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarticleCache) EXPECT() *MockarticleCacheMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockarticleCache) Add(ctx context.Context, in *dto.GetArticlesIn, token *dto.ArticleCacheToken, out *dto.GetArticlesOut) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, in, token, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockarticleCacheMockRecorder) Add(ctx, in, token, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockarticleCache)(nil).Add), ctx, in, token, out)
+}
+
+// Await mocks base method.
+func (m *MockarticleCache) Await(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Await", ctx, in)
+	ret0, _ := ret[0].(*dto.GetArticlesOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Await indicates an expected call of Await.
+func (mr *MockarticleCacheMockRecorder) Await(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Await", reflect.TypeOf((*MockarticleCache)(nil).Await), ctx, in)
+}
+
+// Flush mocks base method.
+func (m *MockarticleCache) Flush(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockarticleCacheMockRecorder) Flush(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockarticleCache)(nil).Flush), ctx)
+}
+
+// Get mocks base method.
+func (m *MockarticleCache) Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, in)
+	ret0, _ := ret[0].(*dto.GetArticlesOut)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockarticleCacheMockRecorder) Get(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockarticleCache)(nil).Get), ctx, in)
+}
+
+// Lock mocks base method.
+func (m *MockarticleCache) Lock(ctx context.Context, in *dto.GetArticlesIn) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, in)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockarticleCacheMockRecorder) Lock(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockarticleCache)(nil).Lock), ctx, in)
+}
+
+// PurgeArticles mocks base method.
+func (m *MockarticleCache) PurgeArticles(ctx context.Context, slugs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range slugs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeArticles", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeArticles indicates an expected call of PurgeArticles.
+func (mr *MockarticleCacheMockRecorder) PurgeArticles(ctx any, slugs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, slugs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeArticles", reflect.TypeOf((*MockarticleCache)(nil).PurgeArticles), varargs...)
+}
+
+// PurgeAuthors mocks base method.
+func (m *MockarticleCache) PurgeAuthors(ctx context.Context, authorIDs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range authorIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeAuthors", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeAuthors indicates an expected call of PurgeAuthors.
+func (mr *MockarticleCacheMockRecorder) PurgeAuthors(ctx any, authorIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, authorIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAuthors", reflect.TypeOf((*MockarticleCache)(nil).PurgeAuthors), varargs...)
+}
+
+// PurgeListings mocks base method.
+func (m *MockarticleCache) PurgeListings(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeListings", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeListings indicates an expected call of PurgeListings.
+func (mr *MockarticleCacheMockRecorder) PurgeListings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeListings", reflect.TypeOf((*MockarticleCache)(nil).PurgeListings), ctx)
+}
+
+// Token mocks base method.
+func (m *MockarticleCache) Token(ctx context.Context) (*dto.ArticleCacheToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token", ctx)
+	ret0, _ := ret[0].(*dto.ArticleCacheToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockarticleCacheMockRecorder) Token(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockarticleCache)(nil).Token), ctx)
+}
+
+// MockinvalidationBus is a mock of invalidationBus interface.
+type MockinvalidationBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockinvalidationBusMockRecorder
+	isgomock struct{}
+}
+
+// MockinvalidationBusMockRecorder is the mock recorder for MockinvalidationBus.
+type MockinvalidationBusMockRecorder struct {
+	mock *MockinvalidationBus
+}
+
+// NewMockinvalidationBus creates a new mock instance.
+func NewMockinvalidationBus(ctrl *gomock.Controller) *MockinvalidationBus {
+	mock := &MockinvalidationBus{ctrl: ctrl}
+	mock.recorder = &MockinvalidationBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinvalidationBus) EXPECT() *MockinvalidationBusMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockinvalidationBus) Publish(ctx context.Context, invalidation *dto.ArticleCacheInvalidation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, invalidation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockinvalidationBusMockRecorder) Publish(ctx, invalidation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockinvalidationBus)(nil).Publish), ctx, invalidation)
+}
+
+// Subscribe mocks base method.
+func (m *MockinvalidationBus) Subscribe(ctx context.Context, handle func(*dto.ArticleCacheInvalidation)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", ctx, handle)
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockinvalidationBusMockRecorder) Subscribe(ctx, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockinvalidationBus)(nil).Subscribe), ctx, handle)
+}
@@ -3,13 +3,19 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
 
 	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
 )
 
-const limit = 21
-
 type ArticleStorage struct {
 	db *sql.DB
 }
@@ -18,31 +24,480 @@ func NewArticleStorage(db *sql.DB) *ArticleStorage {
 	return &ArticleStorage{db: db}
 }
 
+// articleKeyset describes the columns an article list is ordered by for each sort.
+type articleKeyset struct {
+	columns    string
+	descending bool
+	values     func(c *dto.ArticleCursor) []any
+	cursor     func(a *dto.Article) *dto.ArticleCursor
+}
+
+var articleKeysets = map[string]articleKeyset{
+	dto.ArticleSortNewest: {
+		columns:    "a.created_at, a.id",
+		descending: true,
+		values:     func(c *dto.ArticleCursor) []any { return []any{c.CreatedAt, c.ID} },
+		cursor:     func(a *dto.Article) *dto.ArticleCursor { return &dto.ArticleCursor{CreatedAt: a.CreatedAt, ID: a.ID} },
+	},
+	dto.ArticleSortOldest: {
+		columns:    "a.created_at, a.id",
+		descending: false,
+		values:     func(c *dto.ArticleCursor) []any { return []any{c.CreatedAt, c.ID} },
+		cursor:     func(a *dto.Article) *dto.ArticleCursor { return &dto.ArticleCursor{CreatedAt: a.CreatedAt, ID: a.ID} },
+	},
+	dto.ArticleSortTitle: {
+		columns:    "a.title, a.id",
+		descending: false,
+		values:     func(c *dto.ArticleCursor) []any { return []any{c.Title, c.ID} },
+		cursor:     func(a *dto.Article) *dto.ArticleCursor { return &dto.ArticleCursor{Title: a.Title, ID: a.ID} },
+	},
+}
+
+// Get returns a page of articles after (or before, for backward cursors) the cursor position.
+// One extra row is fetched to find out whether there is a page beyond the returned one.
 func (s *ArticleStorage) Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
-	var args []any
-	query := `SELECT slug, title, content, author_id FROM articles`
+	keyset, ok := articleKeysets[in.Sort]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort %q", in.Sort)
+	}
+
+	backward := in.Cursor != nil && in.Cursor.Backward
+	descending := keyset.descending != backward
+
+	var (
+		args       []any
+		conditions = []string{"a.hidden_at IS NULL"}
+	)
+	query := `SELECT a.id, a.slug, a.title, a.content, a.content_html, a.toc, a.excerpt, a.word_count, a.reading_time, a.tags,
+		a.seo_meta_description, a.seo_og_image_url, a.seo_canonical_url, a.visibility, a.locale, a.author_id, a.created_at, a.updated_at,
+		a.views_count, (SELECT COUNT(*) FROM comments c WHERE c.article_id=a.id AND c.deleted_at IS NULL AND c.hidden_at IS NULL)
+		FROM articles a`
+
+	if in.AuthorID != "" {
+		args = append(args, in.AuthorID)
+		conditions = append(conditions, fmt.Sprintf("a.author_id=$%d", len(args)))
+	}
 
-	if in.FromSlug != nil {
-		query += " WHERE slug >= $1"
-		args = append(args, *in.FromSlug)
+	if in.Slug != "" {
+		args = append(args, in.Slug)
+		conditions = append(conditions, fmt.Sprintf("a.slug=$%d", len(args)))
 	}
 
-	query += fmt.Sprintf(" LIMIT $%d", len(args))
+	if in.Tag != "" {
+		args = append(args, in.Tag)
+		conditions = append(conditions, fmt.Sprintf("$%d=ANY(a.tags)", len(args)))
+	}
+
+	if in.Cursor != nil {
+		operator := ">"
+		if descending {
+			operator = "<"
+		}
+
+		args = append(args, keyset.values(in.Cursor)...)
+		conditions = append(conditions, fmt.Sprintf("(%s) %s ($%d, $%d)", keyset.columns, operator, len(args)-1, len(args)))
+	}
+
+	query += " WHERE " + strings.Join(conditions, " AND ")
+
+	direction := "ASC"
+	if descending {
+		direction = "DESC"
+	}
+
+	query += fmt.Sprintf(" ORDER BY %s LIMIT $%d", orderBy(keyset.columns, direction), len(args)+1)
+	args = append(args, in.Limit+1)
+
+	articles, err := s.query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(articles) > in.Limit
+	if hasMore {
+		articles = articles[:in.Limit]
+	}
+
+	if backward {
+		slices.Reverse(articles)
+	}
+
+	out := &dto.GetArticlesOut{Articles: articles}
+	if len(articles) == 0 {
+		return out, nil
+	}
+
+	// a backward page always has one after it, and a forward page reached by a cursor always has one before it
+	if backward || hasMore {
+		out.NextCursor = keyset.cursor(&articles[len(articles)-1])
+	}
+
+	if backward && hasMore || !backward && in.Cursor != nil {
+		out.PrevCursor = keyset.cursor(&articles[0])
+		out.PrevCursor.Backward = true
+	}
+
+	return out, nil
+}
+
+// GetByIDs returns articles by their IDs in no particular order. Unknown and hidden IDs are absent in the result.
+func (s *ArticleStorage) GetByIDs(ctx context.Context, ids []string) ([]dto.Article, error) {
+	const query = `SELECT a.id, a.slug, a.title, a.content, a.content_html, a.toc, a.excerpt, a.word_count, a.reading_time, a.tags,
+		a.seo_meta_description, a.seo_og_image_url, a.seo_canonical_url, a.visibility, a.locale, a.author_id, a.created_at, a.updated_at,
+		a.views_count, (SELECT COUNT(*) FROM comments c WHERE c.article_id=a.id AND c.deleted_at IS NULL AND c.hidden_at IS NULL)
+		FROM articles a WHERE a.id=ANY($1) AND a.hidden_at IS NULL`
+
+	return s.query(ctx, query, pq.Array(ids))
+}
+
+// GetRelatedIDs returns IDs of visible articles ranked by similarity to the article, none for a hidden article.
+// Articles sharing tags, an author or similar titles and excerpts are the candidates. Shared tags weigh most,
+// then title and excerpt trigram similarity and the same author. Whole contents are not compared,
+// the excerpt is their opening and keeps the comparison cheap.
+func (s *ArticleStorage) GetRelatedIDs(ctx context.Context, articleID string, limit int) ([]string, error) {
+	const query = `WITH source AS (
+			SELECT id, title, excerpt, tags, author_id FROM articles WHERE id=$1 AND hidden_at IS NULL
+		)
+		SELECT a.id FROM source s
+		JOIN articles a ON a.id<>s.id AND a.hidden_at IS NULL
+			AND (a.tags && s.tags OR a.author_id=s.author_id OR a.title % s.title OR a.excerpt % s.excerpt)
+		ORDER BY 3 * cardinality(ARRAY(SELECT unnest(a.tags) INTERSECT SELECT unnest(s.tags)))
+			+ 2 * similarity(a.title, s.title)
+			+ similarity(a.excerpt, s.excerpt)
+			+ CASE WHEN a.author_id=s.author_id THEN 1 ELSE 0 END DESC,
+			a.created_at DESC
+		LIMIT $2`
+
+	rows, err := s.db.QueryContext(ctx, query, articleID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0, limit)
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetAfter returns articles with IDs greater than afterID in the order of IDs, hidden articles included.
+// It's used to walk over all articles, e.g. for export.
+func (s *ArticleStorage) GetAfter(ctx context.Context, afterID string, limit int) ([]dto.Article, error) {
+	const query = `SELECT a.id, a.slug, a.title, a.content, a.content_html, a.toc, a.excerpt, a.word_count, a.reading_time, a.tags,
+		a.seo_meta_description, a.seo_og_image_url, a.seo_canonical_url, a.visibility, a.locale, a.author_id, a.created_at, a.updated_at,
+		a.views_count, 0
+		FROM articles a WHERE $1::uuid IS NULL OR a.id>$1::uuid ORDER BY a.id LIMIT $2`
+
+	var after *string
+	if afterID != "" {
+		after = &afterID
+	}
+
+	return s.query(ctx, query, after, limit)
+}
+
+// GetTimeline returns a page of the merged timeline of the authors after the cursor entry.
+func (s *ArticleStorage) GetTimeline(ctx context.Context, authorIDs []string, cursor *dto.TimelineEntry, limit int) ([]dto.TimelineEntry, error) {
+	query := "SELECT id, created_at FROM articles WHERE author_id=ANY($1) AND hidden_at IS NULL"
+	args := []any{pq.Array(authorIDs)}
+
+	if cursor != nil {
+		query += " AND (created_at, id) < ($2, $3)"
+		args = append(args, cursor.CreatedAt, cursor.ArticleID)
+	}
+
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args)+1)
 	args = append(args, limit)
 
+	return s.queryTimeline(ctx, query, args...)
+}
+
+// GetFollowedTimeline returns the latest entries of the merged timeline of authors followed by the user
+// who have at most threshold followers.
+func (s *ArticleStorage) GetFollowedTimeline(ctx context.Context, followerID string, threshold, limit int) ([]dto.TimelineEntry, error) {
+	const query = `SELECT a.id, a.created_at FROM follows f
+		JOIN users u ON u.id=f.author_id AND u.followers_count<=$2
+		JOIN articles a ON a.author_id=f.author_id AND a.hidden_at IS NULL
+		WHERE f.follower_id=$1 ORDER BY a.created_at DESC, a.id DESC LIMIT $3`
+
+	return s.queryTimeline(ctx, query, followerID, threshold, limit)
+}
+
+func (s *ArticleStorage) queryTimeline(ctx context.Context, query string, args ...any) ([]dto.TimelineEntry, error) {
 	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("execute query: %w", err)
 	}
 	defer rows.Close()
 
-	articles := make([]dto.Article, 0, limit)
+	entries := make([]dto.TimelineEntry, 0)
 	for rows.Next() {
-		var article dto.Article
-		if err = rows.Scan(&article.Slug, &article.Title, &article.Content, &article.AuthorID); err != nil {
+		var entry dto.TimelineEntry
+		if err = rows.Scan(&entry.ArticleID, &entry.CreatedAt); err != nil {
 			return nil, fmt.Errorf("scan row: %w", err)
 		}
 
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (s *ArticleStorage) query(ctx context.Context, query string, args ...any) ([]dto.Article, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	articles := make([]dto.Article, 0)
+	for rows.Next() {
+		var (
+			article dto.Article
+			toc     []byte
+		)
+		err = rows.Scan(
+			&article.ID,
+			&article.Slug,
+			&article.Title,
+			&article.Content,
+			&article.ContentHTML,
+			&toc,
+			&article.Excerpt,
+			&article.WordCount,
+			&article.ReadingTime,
+			pq.Array(&article.Tags),
+			&article.SEO.MetaDescription,
+			&article.SEO.OGImageURL,
+			&article.SEO.CanonicalURL,
+			&article.Visibility,
+			&article.Locale,
+			&article.AuthorID,
+			&article.CreatedAt,
+			&article.UpdatedAt,
+			&article.ViewsCount,
+			&article.CommentsCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		if err = json.Unmarshal(toc, &article.TOC); err != nil {
+			return nil, fmt.Errorf("unmarshal toc: %w", err)
+		}
+
+		articles = append(articles, article)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return articles, nil
+}
+
+func orderBy(columns, direction string) string {
+	parts := strings.Split(columns, ", ")
+	for i := range parts {
+		parts[i] += " " + direction
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func (s *ArticleStorage) Find(ctx context.Context, slug string) (*dto.Article, error) {
+	const query = `SELECT id, slug, title, content, content_html, toc, excerpt, word_count, reading_time, tags,
+		seo_meta_description, seo_og_image_url, seo_canonical_url, visibility, locale,
+		author_id, created_at, updated_at, hidden_at IS NOT NULL
+		FROM articles WHERE slug=$1`
+
+	var (
+		article = &dto.Article{}
+		toc     []byte
+	)
+	err := s.db.QueryRowContext(ctx, query, slug).
+		Scan(
+			&article.ID,
+			&article.Slug,
+			&article.Title,
+			&article.Content,
+			&article.ContentHTML,
+			&toc,
+			&article.Excerpt,
+			&article.WordCount,
+			&article.ReadingTime,
+			pq.Array(&article.Tags),
+			&article.SEO.MetaDescription,
+			&article.SEO.OGImageURL,
+			&article.SEO.CanonicalURL,
+			&article.Visibility,
+			&article.Locale,
+			&article.AuthorID,
+			&article.CreatedAt,
+			&article.UpdatedAt,
+			&article.Hidden,
+		)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	if err = json.Unmarshal(toc, &article.TOC); err != nil {
+		return nil, fmt.Errorf("unmarshal toc: %w", err)
+	}
+
+	return article, nil
+}
+
+func (s *ArticleStorage) Save(ctx context.Context, tx transaction.Transaction, article *dto.Article) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	toc, err := json.Marshal(article.TOC)
+	if err != nil {
+		return fmt.Errorf("marshal toc: %w", err)
+	}
+
+	if !article.Stored() {
+		const query = `INSERT INTO articles (slug, title, content, content_html, toc, excerpt, word_count, reading_time, tags,
+			seo_meta_description, seo_og_image_url, seo_canonical_url, visibility, locale, author_id, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, COALESCE($16, CURRENT_TIMESTAMP))
+			RETURNING id, created_at`
+
+		// imported articles keep their original publication date
+		var createdAt *time.Time
+		if !article.CreatedAt.IsZero() {
+			createdAt = &article.CreatedAt
+		}
+
+		err = sqlTx.QueryRowContext(ctx, query,
+			article.Slug,
+			article.Title,
+			article.Content,
+			article.ContentHTML,
+			toc,
+			article.Excerpt,
+			article.WordCount,
+			article.ReadingTime,
+			pq.Array(article.Tags),
+			article.SEO.MetaDescription,
+			article.SEO.OGImageURL,
+			article.SEO.CanonicalURL,
+			article.Visibility,
+			article.Locale,
+			article.AuthorID,
+			createdAt,
+		).Scan(&article.ID, &article.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("execute query: %w", err)
+		}
+
+		return nil
+	}
+
+	const query = `UPDATE articles SET title=$1, content=$2, content_html=$3, toc=$4,
+		excerpt=$5, word_count=$6, reading_time=$7, tags=$8,
+		seo_meta_description=$9, seo_og_image_url=$10, seo_canonical_url=$11, visibility=$12, created_at=$13,
+		updated_at=CURRENT_TIMESTAMP
+		WHERE id=$14`
+
+	_, err = sqlTx.ExecContext(ctx, query,
+		article.Title,
+		article.Content,
+		article.ContentHTML,
+		toc,
+		article.Excerpt,
+		article.WordCount,
+		article.ReadingTime,
+		pq.Array(article.Tags),
+		article.SEO.MetaDescription,
+		article.SEO.OGImageURL,
+		article.SEO.CanonicalURL,
+		article.Visibility,
+		article.CreatedAt,
+		article.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// Articles are listed in sitemaps in the order they were created, so new articles only land in the last file.
+// Articles canonical elsewhere and hidden articles are left out.
+// An article is listed with its published translations, so these count as its modifications.
+const sitemapArticlesQuery = `SELECT id, slug, locale, GREATEST(COALESCE(updated_at, created_at), (
+		SELECT MAX(COALESCE(t.updated_at, t.created_at)) FROM article_translations t
+		WHERE t.article_id=articles.id AND t.status='published'
+	)) AS last_modified, created_at
+	FROM articles WHERE seo_canonical_url='' AND hidden_at IS NULL`
+
+// sitemapElementsQuery numbers sitemap elements of articles, see dto.SitemapArticle.EntryElements.
+// An article has an entry per language version, first_element is the number of elements listed before the article.
+const sitemapElementsQuery = `SELECT e.*, (SUM(e.elements) OVER (ORDER BY e.created_at, e.id) - e.elements)::BIGINT AS first_element
+	FROM (
+		SELECT s.*, t.translations + 1 AS entries,
+			CASE WHEN t.translations=0 THEN 1 ELSE t.translations + 2 END AS entry_elements,
+			CASE WHEN t.translations=0 THEN 1 ELSE (t.translations + 1) * (t.translations + 2) END AS elements
+		FROM (` + sitemapArticlesQuery + `) s, LATERAL (
+			SELECT COUNT(*) AS translations FROM article_translations WHERE article_id=s.id AND status='published'
+		) t
+	) e`
+
+// GetSitemapArticles returns articles listed in sitemaps which have elements in the window.
+func (s *ArticleStorage) GetSitemapArticles(ctx context.Context, offset, limit int) ([]dto.SitemapArticle, error) {
+	const query = `SELECT slug, locale, last_modified,
+			ARRAY(SELECT t.locale FROM article_translations t WHERE t.article_id=a.id AND t.status='published' ORDER BY t.locale),
+			ARRAY(SELECT t.slug FROM article_translations t WHERE t.article_id=a.id AND t.status='published' ORDER BY t.locale),
+			first_element
+		FROM (` + sitemapElementsQuery + `) a
+		WHERE first_element < $1::BIGINT + $2::BIGINT AND first_element + elements > $1
+		ORDER BY created_at, id`
+
+	rows, err := s.db.QueryContext(ctx, query, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	articles := make([]dto.SitemapArticle, 0)
+	for rows.Next() {
+		var (
+			article dto.SitemapArticle
+			locales []string
+			slugs   []string
+		)
+
+		err = rows.Scan(&article.Slug, &article.Locale, &article.LastModified, pq.Array(&locales), pq.Array(&slugs), &article.FirstElement)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		for i := range locales {
+			article.Translations = append(article.Translations, dto.SitemapTranslation{Locale: locales[i], Slug: slugs[i]})
+		}
+
 		articles = append(articles, article)
 	}
 
@@ -50,14 +505,49 @@ func (s *ArticleStorage) Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.G
 		return nil, fmt.Errorf("rows error: %w", err)
 	}
 
-	var hasMore bool
-	if len(articles) >= limit {
-		articles = articles[:limit]
-		hasMore = true
+	return articles, nil
+}
+
+// GetSitemapFiles splits sitemap elements of articles into files of the size
+// and returns the last modification time of every file. An entry is in the file of its first element.
+func (s *ArticleStorage) GetSitemapFiles(ctx context.Context, size int) ([]time.Time, error) {
+	const query = `SELECT MAX(last_modified) FROM (
+		SELECT (first_element + entry * entry_elements) / $1 AS file, last_modified
+		FROM (` + sitemapElementsQuery + `) a, generate_series(0, a.entries - 1) entry
+	) f GROUP BY file ORDER BY file`
+
+	rows, err := s.db.QueryContext(ctx, query, size)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	files := make([]time.Time, 0)
+	for rows.Next() {
+		var lastModified time.Time
+		if err = rows.Scan(&lastModified); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		files = append(files, lastModified)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return files, nil
+}
+
+// GetSitemapPosition returns the number of sitemap elements listed before the article, whether it's listed or not.
+func (s *ArticleStorage) GetSitemapPosition(ctx context.Context, articleID string) (int, error) {
+	const query = `SELECT COALESCE(SUM(elements), 0)::BIGINT FROM (` + sitemapElementsQuery + `) a
+		WHERE (a.created_at, a.id) < (SELECT created_at, id FROM articles WHERE id=$1)`
+
+	var position int
+	if err := s.db.QueryRowContext(ctx, query, articleID).Scan(&position); err != nil {
+		return 0, fmt.Errorf("execute query: %w", err)
 	}
 
-	return &dto.GetArticlesOut{
-		Articles: articles,
-		HasMore:  hasMore,
-	}, nil
+	return position, nil
 }
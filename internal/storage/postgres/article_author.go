@@ -3,6 +3,11 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
 
 	"github.com/art-es/yet-another-service/internal/app/shared/dto"
 )
@@ -15,6 +20,254 @@ func NewArticleAuthorStorage(db *sql.DB) *ArticleAuthorStorage {
 	return &ArticleAuthorStorage{db: db}
 }
 
-func (s *ArticleAuthorStorage) Get(ctx context.Context, authorIDs []int64) (map[int64]*dto.ArticleAuthor, error) {
-	return nil, nil
+// Get returns authors by their IDs in a single query. Unknown IDs are absent in the result.
+func (s *ArticleAuthorStorage) Get(ctx context.Context, authorIDs []string) (map[string]*dto.ArticleAuthor, error) {
+	const query = "SELECT id, name, nickname FROM users WHERE id=ANY($1)"
+
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(authorIDs))
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	authors := make(map[string]*dto.ArticleAuthor, len(authorIDs))
+	for rows.Next() {
+		var (
+			id     string
+			author dto.ArticleAuthor
+		)
+
+		if err = rows.Scan(&id, &author.DisplayName, &author.NickName); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		authors[id] = &author
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return authors, nil
+}
+
+func (s *ArticleAuthorStorage) Find(ctx context.Context, nickName string) (*dto.AuthorProfile, error) {
+	const query = `SELECT u.id, u.name, u.nickname, u.bio, u.avatar_url, u.created_at, u.followers_count, u.following_count,
+		(SELECT COUNT(*) FROM articles a WHERE a.author_id=u.id AND a.hidden_at IS NULL)
+		FROM users u WHERE u.nickname=$1`
+
+	var (
+		profile   dto.AuthorProfile
+		avatarURL sql.NullString
+	)
+
+	err := s.db.QueryRowContext(ctx, query, nickName).Scan(
+		&profile.ID,
+		&profile.DisplayName,
+		&profile.NickName,
+		&profile.Bio,
+		&avatarURL,
+		&profile.CreatedAt,
+		&profile.FollowersCount,
+		&profile.FollowingCount,
+		&profile.ArticlesCount,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	if avatarURL.Valid {
+		profile.AvatarURL = &avatarURL.String
+	}
+
+	return &profile, nil
+}
+
+// GetByArticles returns authors of the articles in a single query: the owner first, then co-authors in the order they joined.
+// Pending invitations are left out.
+func (s *ArticleAuthorStorage) GetByArticles(ctx context.Context, articleIDs []string) (map[string][]dto.ArticleAuthor, error) {
+	const query = `SELECT a.id, u.name, u.nickname, u.id, $2::text, 0 AS rank, a.created_at AS since
+		FROM articles a JOIN users u ON u.id=a.author_id WHERE a.id=ANY($1)
+		UNION ALL
+		SELECT aa.article_id, u.name, u.nickname, u.id, aa.role, 1 AS rank, aa.accepted_at AS since
+		FROM article_authors aa JOIN users u ON u.id=aa.user_id WHERE aa.article_id=ANY($1) AND aa.accepted_at IS NOT NULL
+		ORDER BY rank, since`
+
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(articleIDs), dto.ArticleAuthorRoleOwner)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	authors := make(map[string][]dto.ArticleAuthor, len(articleIDs))
+	for rows.Next() {
+		var (
+			articleID string
+			author    dto.ArticleAuthor
+			rank      int
+			since     time.Time
+		)
+
+		if err = rows.Scan(&articleID, &author.DisplayName, &author.NickName, &author.UserID, &author.Role, &rank, &since); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		authors[articleID] = append(authors[articleID], author)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return authors, nil
+}
+
+// FindRole returns the role of the user among authors of the article, empty if the user is not one of them.
+func (s *ArticleAuthorStorage) FindRole(ctx context.Context, articleID, userID string) (string, error) {
+	const query = `SELECT $3::text FROM articles WHERE id=$1 AND author_id=$2
+		UNION ALL
+		SELECT role FROM article_authors WHERE article_id=$1 AND user_id=$2 AND accepted_at IS NOT NULL
+		LIMIT 1`
+
+	var role string
+	if err := s.db.QueryRowContext(ctx, query, articleID, userID, dto.ArticleAuthorRoleOwner).Scan(&role); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("execute query: %w", err)
+	}
+
+	return role, nil
+}
+
+const coAuthorColumns = `aa.article_id, a.slug, aa.user_id, u.nickname, aa.role, COALESCE(aa.invitation_token::text, ''),
+	aa.invited_at, aa.accepted_at`
+
+// GetCoAuthors returns co-authors of the article including pending invitations, in the order they were invited.
+func (s *ArticleAuthorStorage) GetCoAuthors(ctx context.Context, articleID string) ([]*dto.ArticleCoAuthor, error) {
+	const query = "SELECT " + coAuthorColumns + ` FROM article_authors aa
+		JOIN articles a ON a.id=aa.article_id JOIN users u ON u.id=aa.user_id
+		WHERE aa.article_id=$1 ORDER BY aa.invited_at, aa.user_id`
+
+	rows, err := s.db.QueryContext(ctx, query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	coAuthors := make([]*dto.ArticleCoAuthor, 0)
+	for rows.Next() {
+		coAuthor := &dto.ArticleCoAuthor{}
+		if err = scanCoAuthor(rows, coAuthor); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		coAuthors = append(coAuthors, coAuthor)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return coAuthors, nil
+}
+
+func (s *ArticleAuthorStorage) FindCoAuthor(ctx context.Context, articleID, userID string) (*dto.ArticleCoAuthor, error) {
+	const query = "SELECT " + coAuthorColumns + ` FROM article_authors aa
+		JOIN articles a ON a.id=aa.article_id JOIN users u ON u.id=aa.user_id
+		WHERE aa.article_id=$1 AND aa.user_id=$2`
+
+	return s.findCoAuthor(ctx, query, articleID, userID)
+}
+
+// FindInvitation returns the pending invitation with the token.
+func (s *ArticleAuthorStorage) FindInvitation(ctx context.Context, token string) (*dto.ArticleCoAuthor, error) {
+	const query = "SELECT " + coAuthorColumns + ` FROM article_authors aa
+		JOIN articles a ON a.id=aa.article_id JOIN users u ON u.id=aa.user_id
+		WHERE aa.invitation_token=$1`
+
+	return s.findCoAuthor(ctx, query, token)
+}
+
+// SaveInvitation invites the user with a new token, a pending invitation of the user is replaced.
+// Accepted co-authors are left as they are.
+func (s *ArticleAuthorStorage) SaveInvitation(ctx context.Context, coAuthor *dto.ArticleCoAuthor) error {
+	const query = `INSERT INTO article_authors (article_id, user_id, role) VALUES ($1, $2, $3)
+		ON CONFLICT (article_id, user_id) DO UPDATE
+		SET role=EXCLUDED.role, invitation_token=gen_random_uuid(), invited_at=CURRENT_TIMESTAMP
+		WHERE article_authors.accepted_at IS NULL
+		RETURNING invitation_token, invited_at`
+
+	err := s.db.QueryRowContext(ctx, query, coAuthor.ArticleID, coAuthor.UserID, coAuthor.Role).
+		Scan(&coAuthor.InvitationToken, &coAuthor.InvitedAt)
+	if err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// Accept turns the pending invitation of the user into co-authorship, the token stops working.
+func (s *ArticleAuthorStorage) Accept(ctx context.Context, articleID, userID string) error {
+	const query = `UPDATE article_authors SET invitation_token=NULL, accepted_at=CURRENT_TIMESTAMP
+		WHERE article_id=$1 AND user_id=$2 AND accepted_at IS NULL`
+
+	if _, err := s.db.ExecContext(ctx, query, articleID, userID); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteCoAuthor removes the co-author or the pending invitation of the user.
+func (s *ArticleAuthorStorage) DeleteCoAuthor(ctx context.Context, articleID, userID string) error {
+	const query = "DELETE FROM article_authors WHERE article_id=$1 AND user_id=$2"
+
+	if _, err := s.db.ExecContext(ctx, query, articleID, userID); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ArticleAuthorStorage) findCoAuthor(ctx context.Context, query string, args ...any) (*dto.ArticleCoAuthor, error) {
+	coAuthor := &dto.ArticleCoAuthor{}
+	if err := scanCoAuthor(s.db.QueryRowContext(ctx, query, args...), coAuthor); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	return coAuthor, nil
+}
+
+func scanCoAuthor(row interface{ Scan(dest ...any) error }, coAuthor *dto.ArticleCoAuthor) error {
+	var acceptedAt sql.NullTime
+
+	err := row.Scan(
+		&coAuthor.ArticleID,
+		&coAuthor.ArticleSlug,
+		&coAuthor.UserID,
+		&coAuthor.NickName,
+		&coAuthor.Role,
+		&coAuthor.InvitationToken,
+		&coAuthor.InvitedAt,
+		&acceptedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if acceptedAt.Valid {
+		coAuthor.AcceptedAt = &acceptedAt.Time
+	}
+
+	return nil
 }
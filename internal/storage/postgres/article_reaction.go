@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+type ArticleReactionStorage struct {
+	db *sql.DB
+}
+
+func NewArticleReactionStorage(db *sql.DB) *ArticleReactionStorage {
+	return &ArticleReactionStorage{db: db}
+}
+
+// Add stores the reaction and reports whether it was not stored before.
+func (s *ArticleReactionStorage) Add(ctx context.Context, articleID, userID, kind string) (bool, error) {
+	const query = `INSERT INTO article_reactions (article_id, user_id, kind) VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING`
+
+	return s.exec(ctx, query, articleID, userID, kind)
+}
+
+// Delete removes the reaction and reports whether it was stored before.
+func (s *ArticleReactionStorage) Delete(ctx context.Context, articleID, userID, kind string) (bool, error) {
+	const query = "DELETE FROM article_reactions WHERE article_id=$1 AND user_id=$2 AND kind=$3"
+
+	return s.exec(ctx, query, articleID, userID, kind)
+}
+
+func (s *ArticleReactionStorage) GetCounts(ctx context.Context, articleIDs []string) (dto.ReactionCounts, error) {
+	const query = "SELECT article_id, kind, count FROM article_reaction_counters WHERE article_id=ANY($1)"
+
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(articleIDs))
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(dto.ReactionCounts)
+	for rows.Next() {
+		var (
+			articleID, kind string
+			count           int64
+		)
+
+		if err = rows.Scan(&articleID, &kind, &count); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		counts.Add(articleID, kind, count)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return counts, nil
+}
+
+func (s *ArticleReactionStorage) GetUserReactions(ctx context.Context, articleIDs []string, userID string) (map[string][]string, error) {
+	const query = `SELECT article_id, kind FROM article_reactions
+		WHERE article_id=ANY($1) AND user_id=$2 ORDER BY created_at`
+
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(articleIDs), userID)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	reactions := make(map[string][]string)
+	for rows.Next() {
+		var articleID, kind string
+		if err = rows.Scan(&articleID, &kind); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		reactions[articleID] = append(reactions[articleID], kind)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return reactions, nil
+}
+
+// Recount sets counters of the articles to the number of their stored reactions,
+// counters of kinds without reactions are reset to zero.
+func (s *ArticleReactionStorage) Recount(ctx context.Context, articleIDs []string) error {
+	if len(articleIDs) == 0 {
+		return errors.New("nothing to recount")
+	}
+
+	const query = `WITH counts AS (
+			SELECT article_id, kind, COUNT(*) AS count FROM article_reactions
+			WHERE article_id=ANY($1) GROUP BY article_id, kind
+		), reset AS (
+			UPDATE article_reaction_counters c SET count=0
+			WHERE c.article_id=ANY($1) AND NOT EXISTS (SELECT 1 FROM counts WHERE counts.article_id=c.article_id AND counts.kind=c.kind)
+		)
+		INSERT INTO article_reaction_counters (article_id, kind, count) SELECT article_id, kind, count FROM counts
+		ON CONFLICT (article_id, kind) DO UPDATE SET count=EXCLUDED.count`
+
+	if _, err := s.db.ExecContext(ctx, query, pq.Array(articleIDs)); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ArticleReactionStorage) exec(ctx context.Context, query string, args ...any) (bool, error) {
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("execute query: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("get affected rows: %w", err)
+	}
+
+	return affected > 0, nil
+}
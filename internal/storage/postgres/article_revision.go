@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+const articleRevisionColumns = "id, article_id, number, title, content, author_id, created_at"
+
+type ArticleRevisionStorage struct {
+	db *sql.DB
+}
+
+func NewArticleRevisionStorage(db *sql.DB) *ArticleRevisionStorage {
+	return &ArticleRevisionStorage{db: db}
+}
+
+// Save stores a new revision of the article. The revision number is the next one after the latest stored.
+// Concurrent saves of the same article are rejected by the unique (article_id, number) constraint.
+func (s *ArticleRevisionStorage) Save(ctx context.Context, tx transaction.Transaction, revision *dto.ArticleRevision) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	const query = `INSERT INTO article_revisions (article_id, number, title, content, author_id)
+		VALUES ($1, (SELECT COALESCE(MAX(number), 0) + 1 FROM article_revisions WHERE article_id=$1), $2, $3, $4)
+		RETURNING id, number, created_at`
+
+	err = sqlTx.QueryRowContext(ctx, query, revision.ArticleID, revision.Title, revision.Content, revision.AuthorID).
+		Scan(&revision.ID, &revision.Number, &revision.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns revisions of the article, newest first.
+func (s *ArticleRevisionStorage) Get(ctx context.Context, articleID string) ([]*dto.ArticleRevision, error) {
+	const query = "SELECT " + articleRevisionColumns + " FROM article_revisions WHERE article_id=$1 ORDER BY number DESC"
+
+	rows, err := s.db.QueryContext(ctx, query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := make([]*dto.ArticleRevision, 0)
+	for rows.Next() {
+		revision, err := scanArticleRevision(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return revisions, nil
+}
+
+func (s *ArticleRevisionStorage) Find(ctx context.Context, articleID string, number int) (*dto.ArticleRevision, error) {
+	const query = "SELECT " + articleRevisionColumns + " FROM article_revisions WHERE article_id=$1 AND number=$2"
+
+	revision, err := scanArticleRevision(s.db.QueryRowContext(ctx, query, articleID, number))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	return revision, nil
+}
+
+// Prune deletes all revisions of the article except the latest keep ones.
+func (s *ArticleRevisionStorage) Prune(ctx context.Context, tx transaction.Transaction, articleID string, keep int) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	const query = `DELETE FROM article_revisions WHERE article_id=$1 AND number <= (
+			SELECT MAX(number) - $2 FROM article_revisions WHERE article_id=$1
+		)`
+
+	if _, err = sqlTx.ExecContext(ctx, query, articleID, keep); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func scanArticleRevision(row rowScanner) (*dto.ArticleRevision, error) {
+	var revision dto.ArticleRevision
+
+	err := row.Scan(
+		&revision.ID,
+		&revision.ArticleID,
+		&revision.Number,
+		&revision.Title,
+		&revision.Content,
+		&revision.AuthorID,
+		&revision.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &revision, nil
+}
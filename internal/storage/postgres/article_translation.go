@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+const translationColumns = `t.id, t.article_id, a.slug, t.locale, t.slug, t.title, t.content, t.content_html, t.toc,
+	t.excerpt, t.word_count, t.reading_time, t.status, t.created_at, t.updated_at`
+
+type ArticleTranslationStorage struct {
+	db *sql.DB
+}
+
+func NewArticleTranslationStorage(db *sql.DB) *ArticleTranslationStorage {
+	return &ArticleTranslationStorage{db: db}
+}
+
+// Find returns the translation of the article to the locale, nil when there is none.
+func (s *ArticleTranslationStorage) Find(ctx context.Context, articleID, locale string) (*dto.ArticleTranslation, error) {
+	const query = `SELECT ` + translationColumns + `
+		FROM article_translations t JOIN articles a ON a.id=t.article_id
+		WHERE t.article_id=$1 AND t.locale=$2`
+
+	return s.find(ctx, query, articleID, locale)
+}
+
+// FindBySlug returns the translation with the slug whatever its status, nil when there is none.
+func (s *ArticleTranslationStorage) FindBySlug(ctx context.Context, slug string) (*dto.ArticleTranslation, error) {
+	const query = `SELECT ` + translationColumns + `
+		FROM article_translations t JOIN articles a ON a.id=t.article_id
+		WHERE t.slug=$1`
+
+	return s.find(ctx, query, slug)
+}
+
+func (s *ArticleTranslationStorage) find(ctx context.Context, query string, args ...any) (*dto.ArticleTranslation, error) {
+	translation, err := scanTranslation(s.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	return translation, nil
+}
+
+// GetByArticles returns published translations of the articles in a single query, ordered by locale.
+func (s *ArticleTranslationStorage) GetByArticles(ctx context.Context, articleIDs []string) (map[string][]dto.ArticleTranslation, error) {
+	const query = `SELECT ` + translationColumns + `
+		FROM article_translations t JOIN articles a ON a.id=t.article_id
+		WHERE t.article_id=ANY($1) AND t.status=$2
+		ORDER BY t.locale`
+
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(articleIDs), dto.TranslationStatusPublished)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	translations := make(map[string][]dto.ArticleTranslation, len(articleIDs))
+	for rows.Next() {
+		translation, err := scanTranslation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		translations[translation.ArticleID] = append(translations[translation.ArticleID], *translation)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return translations, nil
+}
+
+func (s *ArticleTranslationStorage) Save(ctx context.Context, tx transaction.Transaction, translation *dto.ArticleTranslation) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	toc, err := json.Marshal(translation.TOC)
+	if err != nil {
+		return fmt.Errorf("marshal toc: %w", err)
+	}
+
+	if !translation.Stored() {
+		const query = `INSERT INTO article_translations (article_id, locale, slug, title, content, content_html, toc,
+			excerpt, word_count, reading_time, status)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			RETURNING id, created_at`
+
+		err = sqlTx.QueryRowContext(ctx, query,
+			translation.ArticleID,
+			translation.Locale,
+			translation.Slug,
+			translation.Title,
+			translation.Content,
+			translation.ContentHTML,
+			toc,
+			translation.Excerpt,
+			translation.WordCount,
+			translation.ReadingTime,
+			translation.Status,
+		).Scan(&translation.ID, &translation.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("execute query: %w", err)
+		}
+
+		return nil
+	}
+
+	const query = `UPDATE article_translations SET slug=$1, title=$2, content=$3, content_html=$4, toc=$5,
+		excerpt=$6, word_count=$7, reading_time=$8, status=$9, updated_at=CURRENT_TIMESTAMP
+		WHERE id=$10
+		RETURNING updated_at`
+
+	err = sqlTx.QueryRowContext(ctx, query,
+		translation.Slug,
+		translation.Title,
+		translation.Content,
+		translation.ContentHTML,
+		toc,
+		translation.Excerpt,
+		translation.WordCount,
+		translation.ReadingTime,
+		translation.Status,
+		translation.ID,
+	).Scan(&translation.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ArticleTranslationStorage) Delete(ctx context.Context, tx transaction.Transaction, id string) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	if _, err = sqlTx.ExecContext(ctx, "DELETE FROM article_translations WHERE id=$1", id); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func scanTranslation(row interface{ Scan(dest ...any) error }) (*dto.ArticleTranslation, error) {
+	var (
+		translation = &dto.ArticleTranslation{}
+		toc         []byte
+	)
+
+	err := row.Scan(
+		&translation.ID,
+		&translation.ArticleID,
+		&translation.ArticleSlug,
+		&translation.Locale,
+		&translation.Slug,
+		&translation.Title,
+		&translation.Content,
+		&translation.ContentHTML,
+		&toc,
+		&translation.Excerpt,
+		&translation.WordCount,
+		&translation.ReadingTime,
+		&translation.Status,
+		&translation.CreatedAt,
+		&translation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(toc, &translation.TOC); err != nil {
+		return nil, fmt.Errorf("unmarshal toc: %w", err)
+	}
+
+	return translation, nil
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+type ArticleViewStorage struct {
+	db *sql.DB
+}
+
+func NewArticleViewStorage(db *sql.DB) *ArticleViewStorage {
+	return &ArticleViewStorage{db: db}
+}
+
+// SaveCounts adds the count deltas to the view counters of articles in a single statement.
+func (s *ArticleViewStorage) SaveCounts(ctx context.Context, deltas map[string]int64) error {
+	if len(deltas) == 0 {
+		return errors.New("nothing to save")
+	}
+
+	articleIDs := make([]string, 0, len(deltas))
+	counts := make([]int64, 0, len(deltas))
+	for articleID, delta := range deltas {
+		articleIDs = append(articleIDs, articleID)
+		counts = append(counts, delta)
+	}
+
+	const query = `UPDATE articles a SET views_count=a.views_count+d.delta
+		FROM UNNEST($1::uuid[], $2::bigint[]) AS d(id, delta)
+		WHERE a.id=d.id`
+
+	if _, err := s.db.ExecContext(ctx, query, pq.Array(articleIDs), pq.Array(counts)); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+const commentsLimit = 20
+
+// hidden comments are returned as deleted ones
+const commentColumns = "id, article_id, parent_id, author_id, content, created_at, updated_at, (deleted_at IS NOT NULL OR hidden_at IS NOT NULL)"
+
+type CommentStorage struct {
+	db *sql.DB
+}
+
+func NewCommentStorage(db *sql.DB) *CommentStorage {
+	return &CommentStorage{db: db}
+}
+
+// Get returns a page of root comments of the article together with all their replies.
+// Comments are returned as a flat list ordered by creation time.
+func (s *CommentStorage) Get(ctx context.Context, articleID string, fromID *string) (*dto.GetCommentsOut, error) {
+	args := []any{articleID}
+	query := "SELECT " + commentColumns + " FROM comments WHERE article_id=$1 AND parent_id IS NULL"
+
+	if fromID != nil {
+		query += " AND (created_at, id) > (SELECT created_at, id FROM comments WHERE id=$2)"
+		args = append(args, *fromID)
+	}
+
+	query += fmt.Sprintf(" ORDER BY created_at, id LIMIT $%d", len(args)+1)
+	args = append(args, commentsLimit+1)
+
+	roots, err := s.query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var hasMore bool
+	if len(roots) > commentsLimit {
+		roots = roots[:commentsLimit]
+		hasMore = true
+	}
+
+	if len(roots) == 0 {
+		return &dto.GetCommentsOut{Comments: roots}, nil
+	}
+
+	rootIDs := make([]string, 0, len(roots))
+	for _, root := range roots {
+		rootIDs = append(rootIDs, root.ID)
+	}
+
+	const repliesQuery = `WITH RECURSIVE replies AS (
+			SELECT * FROM comments WHERE parent_id=ANY($1)
+			UNION ALL
+			SELECT c.* FROM comments c JOIN replies r ON c.parent_id=r.id
+		)
+		SELECT ` + commentColumns + ` FROM replies ORDER BY created_at, id`
+
+	replies, err := s.query(ctx, repliesQuery, pq.Array(rootIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GetCommentsOut{
+		Comments: append(roots, replies...),
+		HasMore:  hasMore,
+	}, nil
+}
+
+func (s *CommentStorage) Find(ctx context.Context, id string) (*dto.Comment, error) {
+	query := "SELECT " + commentColumns + " FROM comments WHERE id=$1"
+
+	comment, err := scanComment(s.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	return comment, nil
+}
+
+func (s *CommentStorage) Save(ctx context.Context, tx transaction.Transaction, comment *dto.Comment) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	if !comment.Stored() {
+		return s.insert(ctx, sqlTx, comment)
+	}
+
+	return s.update(ctx, sqlTx, comment)
+}
+
+func (s *CommentStorage) Delete(ctx context.Context, id string) error {
+	const query = "UPDATE comments SET deleted_at=CURRENT_TIMESTAMP WHERE id=$1 AND deleted_at IS NULL"
+
+	if _, err := s.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (s *CommentStorage) insert(ctx context.Context, sqlTx *sql.Tx, comment *dto.Comment) error {
+	const query = `INSERT INTO comments (article_id, parent_id, author_id, content)
+		VALUES ($1, $2, $3, $4) RETURNING id, created_at`
+
+	err := sqlTx.QueryRowContext(ctx, query, comment.ArticleID, comment.ParentID, comment.AuthorID, comment.Content).
+		Scan(&comment.ID, &comment.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (s *CommentStorage) update(ctx context.Context, sqlTx *sql.Tx, comment *dto.Comment) error {
+	const query = "UPDATE comments SET content=$1, updated_at=CURRENT_TIMESTAMP WHERE id=$2 RETURNING updated_at"
+
+	err := sqlTx.QueryRowContext(ctx, query, comment.Content, comment.ID).
+		Scan(&comment.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (s *CommentStorage) query(ctx context.Context, query string, args ...any) ([]*dto.Comment, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	comments := make([]*dto.Comment, 0)
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		comments = append(comments, comment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return comments, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanComment(row rowScanner) (*dto.Comment, error) {
+	var (
+		comment   dto.Comment
+		parentID  sql.NullString
+		updatedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&comment.ID,
+		&comment.ArticleID,
+		&parentID,
+		&comment.AuthorID,
+		&comment.Content,
+		&comment.CreatedAt,
+		&updatedAt,
+		&comment.Deleted,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID.Valid {
+		comment.ParentID = &parentID.String
+	}
+	if updatedAt.Valid {
+		comment.UpdatedAt = &updatedAt.Time
+	}
+
+	return &comment, nil
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+type FollowStorage struct {
+	db *sql.DB
+}
+
+func NewFollowStorage(db *sql.DB) *FollowStorage {
+	return &FollowStorage{db: db}
+}
+
+// Add stores the follow and reports whether it was not stored before.
+func (s *FollowStorage) Add(ctx context.Context, tx transaction.Transaction, followerID, authorID string) (bool, error) {
+	const query = "INSERT INTO follows (follower_id, author_id) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+
+	return s.exec(ctx, tx, query, followerID, authorID)
+}
+
+// Delete removes the follow and reports whether it was stored before.
+func (s *FollowStorage) Delete(ctx context.Context, tx transaction.Transaction, followerID, authorID string) (bool, error) {
+	const query = "DELETE FROM follows WHERE follower_id=$1 AND author_id=$2"
+
+	return s.exec(ctx, tx, query, followerID, authorID)
+}
+
+// UpdateCounts adds the delta to the following count of the follower and to the followers count of the author.
+func (s *FollowStorage) UpdateCounts(ctx context.Context, tx transaction.Transaction, followerID, authorID string, delta int) error {
+	const query = `UPDATE users SET
+		following_count=following_count+CASE WHEN id=$1 THEN $3 ELSE 0 END,
+		followers_count=followers_count+CASE WHEN id=$2 THEN $3 ELSE 0 END
+		WHERE id IN ($1, $2)`
+
+	if _, err := s.exec(ctx, tx, query, followerID, authorID, delta); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Get returns a page of the followers or following list of the user after the cursor position.
+func (s *FollowStorage) Get(ctx context.Context, list, userID string, cursor *dto.FollowCursor, limit int) ([]dto.Follow, error) {
+	// followers are users on the follower side of follows of the author, and the other way round
+	userColumn, listColumn := "follower_id", "author_id"
+	if list == dto.FollowListFollowing {
+		userColumn, listColumn = listColumn, userColumn
+	}
+
+	query := fmt.Sprintf(`SELECT u.id, u.name, u.nickname, f.created_at
+		FROM follows f JOIN users u ON u.id=f.%s WHERE f.%s=$1`, userColumn, listColumn)
+	args := []any{userID}
+
+	if cursor != nil {
+		query += fmt.Sprintf(" AND (f.created_at, f.%s) < ($2, $3)", userColumn)
+		args = append(args, cursor.FollowedAt, cursor.UserID)
+	}
+
+	query += fmt.Sprintf(" ORDER BY f.created_at DESC, f.%s DESC LIMIT $%d", userColumn, len(args)+1)
+	args = append(args, limit)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	follows := make([]dto.Follow, 0)
+	for rows.Next() {
+		var follow dto.Follow
+		if err = rows.Scan(&follow.UserID, &follow.User.DisplayName, &follow.User.NickName, &follow.FollowedAt); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		follows = append(follows, follow)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return follows, nil
+}
+
+// CountFollowers returns the number of followers of the author.
+func (s *FollowStorage) CountFollowers(ctx context.Context, authorID string) (int, error) {
+	const query = "SELECT followers_count FROM users WHERE id=$1"
+
+	var count int
+	if err := s.db.QueryRowContext(ctx, query, authorID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("execute query: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetPopularAuthorIDs returns authors followed by the user who have more followers than the threshold.
+func (s *FollowStorage) GetPopularAuthorIDs(ctx context.Context, followerID string, threshold int) ([]string, error) {
+	const query = `SELECT f.author_id FROM follows f JOIN users u ON u.id=f.author_id
+		WHERE f.follower_id=$1 AND u.followers_count>$2`
+
+	rows, err := s.db.QueryContext(ctx, query, followerID, threshold)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (s *FollowStorage) exec(ctx context.Context, tx transaction.Transaction, query string, args ...any) (bool, error) {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := sqlTx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("execute query: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("get affected rows: %w", err)
+	}
+
+	return affected > 0, nil
+}
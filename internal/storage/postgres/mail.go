@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/lib/pq"
 
@@ -21,10 +22,20 @@ func NewMailStorage(db *sql.DB) *MailStorage {
 	}
 }
 
-func (s *MailStorage) Get(ctx context.Context) ([]dto.Mail, error) {
-	const query = "SELECT id, address, subject, content FROM mails LIMIT 20"
-
-	rows, err := s.db.QueryContext(ctx, query)
+// Claim leases up to limit unsent mails, oldest first, and counts the attempt.
+// Mails leased by other workers are skipped, a lease which has expired, e.g. after a crash, is claimed again.
+func (s *MailStorage) Claim(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]dto.Mail, error) {
+	const query = `UPDATE mails SET attempts=attempts+1, locked_until=CURRENT_TIMESTAMP + make_interval(secs => $2)
+		WHERE id IN (
+			SELECT id FROM mails
+			WHERE mailed_at IS NULL AND attempts<$3 AND (locked_until IS NULL OR locked_until<=CURRENT_TIMESTAMP)
+			ORDER BY created_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, address, subject, content, attempts, last_error`
+
+	rows, err := s.db.QueryContext(ctx, query, limit, lease.Seconds(), maxAttempts)
 	if err != nil {
 		return nil, fmt.Errorf("execute query: %w", err)
 	}
@@ -34,7 +45,7 @@ func (s *MailStorage) Get(ctx context.Context) ([]dto.Mail, error) {
 	for rows.Next() {
 		var mail dto.Mail
 
-		err = rows.Scan(&mail.ID, &mail.Address, &mail.Subject, &mail.Content)
+		err = rows.Scan(&mail.ID, &mail.Address, &mail.Subject, &mail.Content, &mail.Attempts, &mail.LastError)
 		if err != nil {
 			return nil, fmt.Errorf("scan row: %w", err)
 		}
@@ -66,7 +77,7 @@ func (s *MailStorage) insert(ctx context.Context, mails []dto.Mail) error {
 	args := make([]any, 0, len(mails)*3)
 	for _, mail := range mails {
 		index := len(args)
-		query += fmt.Sprintf("($%d, $%d, $%d),", index, index+1, index+2)
+		query += fmt.Sprintf("($%d, $%d, $%d),", index+1, index+2, index+3)
 		args = append(args, mail.Address, mail.Subject, mail.Content)
 	}
 
@@ -85,14 +96,22 @@ func (s *MailStorage) update(ctx context.Context, mails []dto.Mail) error {
 	for _, mail := range mails {
 		if mail.Mailed {
 			mailedIDs = append(mailedIDs, mail.ID)
+			continue
+		}
+
+		// the failed mail stays leased until it may be retried
+		const query = "UPDATE mails SET last_error=$2, locked_until=$3 WHERE id=$1"
+
+		if _, err := s.db.ExecContext(ctx, query, mail.ID, mail.LastError, mail.RetryAt); err != nil {
+			return fmt.Errorf("execute query: %w", err)
 		}
 	}
 
 	if len(mailedIDs) == 0 {
-		return errors.New("nothing to update")
+		return nil
 	}
 
-	const query = "UPDATE mails SET mailed_at=CURRENT_TIMESTAMP WHERE id=ANY($1)"
+	const query = "UPDATE mails SET mailed_at=CURRENT_TIMESTAMP, locked_until=NULL WHERE id=ANY($1)"
 
 	_, err := s.db.ExecContext(ctx, query, pq.Array(mailedIDs))
 	if err != nil {
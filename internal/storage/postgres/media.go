@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+type MediaStorage struct {
+	db *sql.DB
+}
+
+func NewMediaStorage(db *sql.DB) *MediaStorage {
+	return &MediaStorage{db: db}
+}
+
+// mediaVariant is the stored form of dto.MediaVariant, URLs aren't stored.
+type mediaVariant struct {
+	Name        string `json:"name"`
+	ContentType string `json:"contentType"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Size        int64  `json:"size"`
+}
+
+func (s *MediaStorage) Save(ctx context.Context, media *dto.Media) error {
+	variants := make([]mediaVariant, 0, len(media.Variants))
+	for _, v := range media.Variants {
+		variants = append(variants, mediaVariant{
+			Name:        v.Name,
+			ContentType: v.ContentType,
+			Width:       v.Width,
+			Height:      v.Height,
+			Size:        v.Size,
+		})
+	}
+
+	rawVariants, err := json.Marshal(variants)
+	if err != nil {
+		return fmt.Errorf("marshal variants: %w", err)
+	}
+
+	const query = `INSERT INTO media (owner_id, width, height, variants) VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at`
+
+	err = s.db.QueryRowContext(ctx, query, media.OwnerID, media.Width, media.Height, rawVariants).
+		Scan(&media.ID, &media.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// SetReferences replaces media referenced by the article or by its translation to the locale,
+// the article itself has an empty locale. IDs of missing media are skipped.
+func (s *MediaStorage) SetReferences(ctx context.Context, tx transaction.Transaction, articleID, locale string, mediaIDs []string) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	if _, err = sqlTx.ExecContext(ctx, "DELETE FROM media_references WHERE article_id=$1 AND locale=$2", articleID, locale); err != nil {
+		return fmt.Errorf("execute delete query: %w", err)
+	}
+
+	if len(mediaIDs) == 0 {
+		return nil
+	}
+
+	const query = `INSERT INTO media_references (article_id, locale, media_id)
+		SELECT $1, $2, id FROM media WHERE id = ANY($3::uuid[])`
+
+	if _, err = sqlTx.ExecContext(ctx, query, articleID, locale, pq.Array(mediaIDs)); err != nil {
+		return fmt.Errorf("execute insert query: %w", err)
+	}
+
+	return nil
+}
+
+// GetOrphans returns media created before the time which no article references, oldest first.
+func (s *MediaStorage) GetOrphans(ctx context.Context, createdBefore time.Time, limit int) ([]*dto.Media, error) {
+	const query = `SELECT m.id, m.owner_id, m.width, m.height, m.variants, m.created_at FROM media m
+		WHERE m.created_at < $1 AND NOT EXISTS (SELECT 1 FROM media_references r WHERE r.media_id=m.id)
+		ORDER BY m.created_at LIMIT $2`
+
+	rows, err := s.db.QueryContext(ctx, query, createdBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	orphans := make([]*dto.Media, 0)
+	for rows.Next() {
+		media := &dto.Media{}
+		if err = scanMedia(rows, media); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		orphans = append(orphans, media)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return orphans, nil
+}
+
+func (s *MediaStorage) Delete(ctx context.Context, id string) error {
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM media WHERE id=$1", id); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func scanMedia(row interface{ Scan(dest ...any) error }, media *dto.Media) error {
+	var rawVariants []byte
+	if err := row.Scan(&media.ID, &media.OwnerID, &media.Width, &media.Height, &rawVariants, &media.CreatedAt); err != nil {
+		return err
+	}
+
+	var variants []mediaVariant
+	if err := json.Unmarshal(rawVariants, &variants); err != nil {
+		return fmt.Errorf("unmarshal variants: %w", err)
+	}
+
+	media.Variants = make([]dto.MediaVariant, 0, len(variants))
+	for _, v := range variants {
+		media.Variants = append(media.Variants, dto.MediaVariant{
+			Name:        v.Name,
+			ContentType: v.ContentType,
+			Width:       v.Width,
+			Height:      v.Height,
+			Size:        v.Size,
+		})
+	}
+
+	return nil
+}
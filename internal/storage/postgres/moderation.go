@@ -0,0 +1,366 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+const moderationPageSize = 20
+
+// reportColumns selects reports together with their targets, the target columns are NULL for deleted content.
+const reportColumns = `r.id, r.target_type, r.target_id, r.reporter_id, r.reason, r.state, r.created_at, r.resolved_at,
+	COALESCE(a.author_id, c.author_id), COALESCE(a.slug, ca.slug), COALESCE(a.hidden_at, c.hidden_at) IS NOT NULL
+	FROM reports r
+	LEFT JOIN articles a ON r.target_type='article' AND a.id=r.target_id
+	LEFT JOIN comments c ON r.target_type='comment' AND c.id=r.target_id
+	LEFT JOIN articles ca ON ca.id=c.article_id`
+
+const moderationActionColumns = "id, moderator_id, action, target_type, target_id, report_id, note, created_at"
+
+type ModerationStorage struct {
+	db *sql.DB
+}
+
+func NewModerationStorage(db *sql.DB) *ModerationStorage {
+	return &ModerationStorage{db: db}
+}
+
+// SaveReport stores a new report. It returns false when the user has an open report of the content already.
+func (s *ModerationStorage) SaveReport(ctx context.Context, report *dto.Report) (bool, error) {
+	const query = `INSERT INTO reports (target_type, target_id, reporter_id, reason) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (reporter_id, target_type, target_id) WHERE state='open' DO NOTHING
+		RETURNING id, state, created_at`
+
+	err := s.db.QueryRowContext(ctx, query, report.TargetType, report.TargetID, report.ReporterID, report.Reason).
+		Scan(&report.ID, &report.State, &report.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("execute query: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *ModerationStorage) FindReport(ctx context.Context, id string) (*dto.Report, error) {
+	const query = "SELECT " + reportColumns + " WHERE r.id=$1"
+
+	report, err := scanReport(s.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	return report, nil
+}
+
+// GetReports returns a page of reports after the report with fromID, oldest first.
+func (s *ModerationStorage) GetReports(ctx context.Context, in *dto.GetReportsIn) (*dto.GetReportsOut, error) {
+	var (
+		args       []any
+		conditions []string
+	)
+
+	if in.State != "" {
+		args = append(args, in.State)
+		conditions = append(conditions, fmt.Sprintf("r.state=$%d", len(args)))
+	}
+
+	if in.TargetType != "" {
+		args = append(args, in.TargetType)
+		conditions = append(conditions, fmt.Sprintf("r.target_type=$%d", len(args)))
+	}
+
+	if in.FromID != nil {
+		args = append(args, *in.FromID)
+		conditions = append(conditions, fmt.Sprintf("(r.created_at, r.id) > (SELECT created_at, id FROM reports WHERE id=$%d)", len(args)))
+	}
+
+	query := "SELECT " + reportColumns
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query += fmt.Sprintf(" ORDER BY r.created_at, r.id LIMIT $%d", len(args)+1)
+	args = append(args, moderationPageSize+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	reports := make([]*dto.Report, 0)
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		reports = append(reports, report)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	out := &dto.GetReportsOut{Reports: reports}
+	if len(reports) > moderationPageSize {
+		out.Reports = reports[:moderationPageSize]
+		out.HasMore = true
+	}
+
+	return out, nil
+}
+
+// UpdateReportState saves the state of the report and sets its resolution time.
+func (s *ModerationStorage) UpdateReportState(ctx context.Context, tx transaction.Transaction, report *dto.Report) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	const query = `UPDATE reports SET state=$1, resolved_at=CASE WHEN $2 THEN CURRENT_TIMESTAMP END
+		WHERE id=$3 RETURNING resolved_at`
+
+	resolved := report.State != dto.ReportStateOpen
+
+	var resolvedAt sql.NullTime
+	if err = sqlTx.QueryRowContext(ctx, query, report.State, resolved, report.ID).Scan(&resolvedAt); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	report.ResolvedAt = nil
+	if resolvedAt.Valid {
+		report.ResolvedAt = &resolvedAt.Time
+	}
+
+	return nil
+}
+
+// ResolveReports marks all open reports of the content as actioned.
+func (s *ModerationStorage) ResolveReports(ctx context.Context, tx transaction.Transaction, targetType, targetID string) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	const query = `UPDATE reports SET state='actioned', resolved_at=CURRENT_TIMESTAMP
+		WHERE target_type=$1 AND target_id=$2 AND state='open'`
+
+	if _, err = sqlTx.ExecContext(ctx, query, targetType, targetID); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// FindTarget returns the article or the comment by its ID, nil when there is none.
+func (s *ModerationStorage) FindTarget(ctx context.Context, targetType, id string) (*dto.ModerationTarget, error) {
+	var query string
+	switch targetType {
+	case dto.ModerationTargetArticle:
+		query = "SELECT id, author_id, slug, tags, hidden_at IS NOT NULL FROM articles WHERE id=$1"
+	case dto.ModerationTargetComment:
+		query = `SELECT c.id, c.author_id, a.slug, a.tags, c.hidden_at IS NOT NULL FROM comments c
+			JOIN articles a ON a.id=c.article_id WHERE c.id=$1`
+	default:
+		return nil, fmt.Errorf("unknown target type %q", targetType)
+	}
+
+	target := &dto.ModerationTarget{Type: targetType}
+	err := s.db.QueryRowContext(ctx, query, id).
+		Scan(&target.ID, &target.AuthorID, &target.ArticleSlug, pq.Array(&target.ArticleTags), &target.Hidden)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	return target, nil
+}
+
+// SetHidden hides the article or the comment from public reads, or shows it again.
+func (s *ModerationStorage) SetHidden(ctx context.Context, tx transaction.Transaction, target *dto.ModerationTarget) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	var table string
+	switch target.Type {
+	case dto.ModerationTargetArticle:
+		table = "articles"
+	case dto.ModerationTargetComment:
+		table = "comments"
+	default:
+		return fmt.Errorf("unknown target type %q", target.Type)
+	}
+
+	query := "UPDATE " + table + " SET hidden_at=CASE WHEN $1 THEN COALESCE(hidden_at, CURRENT_TIMESTAMP) END WHERE id=$2"
+
+	if _, err = sqlTx.ExecContext(ctx, query, target.Hidden, target.ID); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ModerationStorage) SaveAction(ctx context.Context, tx transaction.Transaction, action *dto.ModerationAction) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	const query = `INSERT INTO moderation_actions (moderator_id, action, target_type, target_id, report_id, note)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at`
+
+	err = sqlTx.QueryRowContext(ctx, query,
+		action.ModeratorID,
+		action.Action,
+		action.TargetType,
+		action.TargetID,
+		action.ReportID,
+		action.Note,
+	).Scan(&action.ID, &action.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetActions returns a page of the audit trail after the action with fromID, newest first.
+func (s *ModerationStorage) GetActions(ctx context.Context, in *dto.GetModerationActionsIn) (*dto.GetModerationActionsOut, error) {
+	var (
+		args       []any
+		conditions []string
+	)
+
+	if in.TargetType != "" {
+		args = append(args, in.TargetType)
+		conditions = append(conditions, fmt.Sprintf("target_type=$%d", len(args)))
+	}
+
+	if in.TargetID != "" {
+		args = append(args, in.TargetID)
+		conditions = append(conditions, fmt.Sprintf("target_id=$%d", len(args)))
+	}
+
+	if in.FromID != nil {
+		args = append(args, *in.FromID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < (SELECT created_at, id FROM moderation_actions WHERE id=$%d)", len(args)))
+	}
+
+	query := "SELECT " + moderationActionColumns + " FROM moderation_actions"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args)+1)
+	args = append(args, moderationPageSize+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	actions := make([]*dto.ModerationAction, 0)
+	for rows.Next() {
+		var (
+			action   dto.ModerationAction
+			reportID sql.NullString
+		)
+
+		err = rows.Scan(
+			&action.ID,
+			&action.ModeratorID,
+			&action.Action,
+			&action.TargetType,
+			&action.TargetID,
+			&reportID,
+			&action.Note,
+			&action.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		if reportID.Valid {
+			action.ReportID = &reportID.String
+		}
+
+		actions = append(actions, &action)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	out := &dto.GetModerationActionsOut{Actions: actions}
+	if len(actions) > moderationPageSize {
+		out.Actions = actions[:moderationPageSize]
+		out.HasMore = true
+	}
+
+	return out, nil
+}
+
+func scanReport(row rowScanner) (*dto.Report, error) {
+	var (
+		report      dto.Report
+		resolvedAt  sql.NullTime
+		authorID    sql.NullString
+		articleSlug sql.NullString
+		hidden      bool
+	)
+
+	err := row.Scan(
+		&report.ID,
+		&report.TargetType,
+		&report.TargetID,
+		&report.ReporterID,
+		&report.Reason,
+		&report.State,
+		&report.CreatedAt,
+		&resolvedAt,
+		&authorID,
+		&articleSlug,
+		&hidden,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolvedAt.Valid {
+		report.ResolvedAt = &resolvedAt.Time
+	}
+
+	if authorID.Valid {
+		report.Target = &dto.ModerationTarget{
+			Type:        report.TargetType,
+			ID:          report.TargetID,
+			AuthorID:    authorID.String,
+			ArticleSlug: articleSlug.String,
+			Hidden:      hidden,
+		}
+	}
+
+	return &report, nil
+}
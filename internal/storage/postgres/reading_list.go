@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+type ReadingListStorage struct {
+	db *sql.DB
+}
+
+func NewReadingListStorage(db *sql.DB) *ReadingListStorage {
+	return &ReadingListStorage{db: db}
+}
+
+const readingListColumns = `l.id, l.user_id, l.name, l.is_default, COALESCE(l.share_token, ''), l.created_at,
+	(SELECT COUNT(*) FROM reading_list_items i WHERE i.list_id=l.id)`
+
+// GetByUser returns lists of the user, the default one first.
+func (s *ReadingListStorage) GetByUser(ctx context.Context, userID string) ([]*dto.ReadingList, error) {
+	const query = "SELECT " + readingListColumns + ` FROM reading_lists l
+		WHERE l.user_id=$1 ORDER BY l.is_default DESC, l.created_at, l.id`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	lists := make([]*dto.ReadingList, 0)
+	for rows.Next() {
+		list := &dto.ReadingList{}
+		if err = scanReadingList(rows, list); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		lists = append(lists, list)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return lists, nil
+}
+
+func (s *ReadingListStorage) Find(ctx context.Context, id string) (*dto.ReadingList, error) {
+	const query = "SELECT " + readingListColumns + " FROM reading_lists l WHERE l.id=$1"
+
+	return s.find(ctx, query, id)
+}
+
+func (s *ReadingListStorage) FindShared(ctx context.Context, shareToken string) (*dto.ReadingList, error) {
+	const query = "SELECT " + readingListColumns + " FROM reading_lists l WHERE l.share_token=$1"
+
+	return s.find(ctx, query, shareToken)
+}
+
+// AddDefault creates the default list of the user unless there is one already.
+func (s *ReadingListStorage) AddDefault(ctx context.Context, userID string) error {
+	const query = `INSERT INTO reading_lists (user_id, name, is_default) VALUES ($1, $2, TRUE)
+		ON CONFLICT (user_id) WHERE is_default DO NOTHING`
+
+	if _, err := s.db.ExecContext(ctx, query, userID, dto.DefaultReadingListName); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ReadingListStorage) Save(ctx context.Context, list *dto.ReadingList) error {
+	shareToken := sql.NullString{String: list.ShareToken, Valid: list.ShareToken != ""}
+
+	if !list.Stored() {
+		const query = `INSERT INTO reading_lists (user_id, name, share_token) VALUES ($1, $2, $3)
+			RETURNING id, created_at`
+
+		err := s.db.QueryRowContext(ctx, query, list.UserID, list.Name, shareToken).Scan(&list.ID, &list.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("execute query: %w", err)
+		}
+
+		return nil
+	}
+
+	const query = "UPDATE reading_lists SET name=$1, share_token=$2 WHERE id=$3"
+
+	if _, err := s.db.ExecContext(ctx, query, list.Name, shareToken, list.ID); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetItems returns articles of the list in the list order.
+func (s *ReadingListStorage) GetItems(ctx context.Context, listID string) ([]dto.ReadingListItem, error) {
+	const query = `SELECT a.id, a.slug FROM reading_list_items i JOIN articles a ON a.id=i.article_id
+		WHERE i.list_id=$1 ORDER BY i.position, i.created_at`
+
+	rows, err := s.db.QueryContext(ctx, query, listID)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]dto.ReadingListItem, 0)
+	for rows.Next() {
+		var item dto.ReadingListItem
+		if err = rows.Scan(&item.ArticleID, &item.ArticleSlug); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return items, nil
+}
+
+// AddArticle appends the article to the list, articles in the list already stay where they are.
+func (s *ReadingListStorage) AddArticle(ctx context.Context, listID, articleID string) error {
+	const query = `INSERT INTO reading_list_items (list_id, article_id, position)
+		SELECT $1, $2, COALESCE(MAX(position), 0)+1 FROM reading_list_items WHERE list_id=$1
+		ON CONFLICT DO NOTHING`
+
+	return s.exec(ctx, query, listID, articleID)
+}
+
+func (s *ReadingListStorage) DeleteArticle(ctx context.Context, listID, articleID string) error {
+	const query = "DELETE FROM reading_list_items WHERE list_id=$1 AND article_id=$2"
+
+	return s.exec(ctx, query, listID, articleID)
+}
+
+// Reorder sets positions of articles of the list to their positions in articleIDs.
+func (s *ReadingListStorage) Reorder(ctx context.Context, listID string, articleIDs []string) error {
+	const query = `UPDATE reading_list_items i SET position=o.position
+		FROM UNNEST($2::uuid[]) WITH ORDINALITY AS o(article_id, position)
+		WHERE i.list_id=$1 AND i.article_id=o.article_id`
+
+	if _, err := s.db.ExecContext(ctx, query, listID, pq.Array(articleIDs)); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ReadingListStorage) find(ctx context.Context, query string, args ...any) (*dto.ReadingList, error) {
+	list := &dto.ReadingList{}
+	if err := scanReadingList(s.db.QueryRowContext(ctx, query, args...), list); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	return list, nil
+}
+
+func (s *ReadingListStorage) exec(ctx context.Context, query string, args ...any) error {
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func scanReadingList(row interface{ Scan(dest ...any) error }, list *dto.ReadingList) error {
+	return row.Scan(
+		&list.ID,
+		&list.UserID,
+		&list.Name,
+		&list.Default,
+		&list.ShareToken,
+		&list.CreatedAt,
+		&list.ArticlesCount,
+	)
+}
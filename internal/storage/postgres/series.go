@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+type SeriesStorage struct {
+	db *sql.DB
+}
+
+func NewSeriesStorage(db *sql.DB) *SeriesStorage {
+	return &SeriesStorage{db: db}
+}
+
+func (s *SeriesStorage) Find(ctx context.Context, id string) (*dto.Series, error) {
+	const query = `SELECT s.id, s.owner_id, s.title, s.description, s.created_at,
+		(SELECT COUNT(*) FROM series_articles sa WHERE sa.series_id=s.id)
+		FROM series s WHERE s.id=$1`
+
+	var series dto.Series
+	err := s.db.QueryRowContext(ctx, query, id).Scan(
+		&series.ID,
+		&series.OwnerID,
+		&series.Title,
+		&series.Description,
+		&series.CreatedAt,
+		&series.ArticlesCount,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	return &series, nil
+}
+
+func (s *SeriesStorage) Save(ctx context.Context, series *dto.Series) error {
+	if !series.Stored() {
+		const query = `INSERT INTO series (owner_id, title, description) VALUES ($1, $2, $3)
+			RETURNING id, created_at`
+
+		err := s.db.QueryRowContext(ctx, query, series.OwnerID, series.Title, series.Description).
+			Scan(&series.ID, &series.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("execute query: %w", err)
+		}
+
+		return nil
+	}
+
+	const query = "UPDATE series SET title=$1, description=$2 WHERE id=$3"
+
+	if _, err := s.db.ExecContext(ctx, query, series.Title, series.Description, series.ID); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetItems returns articles of the series in the series order.
+func (s *SeriesStorage) GetItems(ctx context.Context, seriesID string) ([]dto.SeriesItem, error) {
+	const query = `SELECT a.id, a.slug FROM series_articles sa JOIN articles a ON a.id=sa.article_id
+		WHERE sa.series_id=$1 ORDER BY sa.position, a.created_at`
+
+	rows, err := s.db.QueryContext(ctx, query, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]dto.SeriesItem, 0)
+	for rows.Next() {
+		var item dto.SeriesItem
+		if err = rows.Scan(&item.ArticleID, &item.ArticleSlug); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return items, nil
+}
+
+// FindByArticle returns the series of the article with its neighbours, nil if the article is in no series.
+// Hidden articles are skipped by the navigation, unless it's the article itself.
+func (s *SeriesStorage) FindByArticle(ctx context.Context, articleID string) (*dto.ArticleSeries, error) {
+	const query = `SELECT s.id, s.title, n.position, n.count, n.prev_slug, n.prev_title, n.next_slug, n.next_title
+		FROM (
+			SELECT sa.article_id, sa.series_id,
+				ROW_NUMBER() OVER w AS position,
+				COUNT(*) OVER () AS count,
+				COALESCE(LAG(a.slug) OVER w, '') AS prev_slug,
+				COALESCE(LAG(a.title) OVER w, '') AS prev_title,
+				COALESCE(LEAD(a.slug) OVER w, '') AS next_slug,
+				COALESCE(LEAD(a.title) OVER w, '') AS next_title
+			FROM series_articles sa JOIN articles a ON a.id=sa.article_id
+			WHERE sa.series_id=(SELECT series_id FROM series_articles WHERE article_id=$1)
+				AND (a.hidden_at IS NULL OR a.id=$1)
+			WINDOW w AS (ORDER BY sa.position, a.created_at)
+		) n JOIN series s ON s.id=n.series_id
+		WHERE n.article_id=$1`
+
+	var (
+		series              dto.ArticleSeries
+		prevSlug, prevTitle string
+		nextSlug, nextTitle string
+	)
+
+	err := s.db.QueryRowContext(ctx, query, articleID).Scan(
+		&series.ID,
+		&series.Title,
+		&series.Position,
+		&series.ArticlesCount,
+		&prevSlug,
+		&prevTitle,
+		&nextSlug,
+		&nextTitle,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	if prevSlug != "" {
+		series.Prev = &dto.SeriesNeighbour{Slug: prevSlug, Title: prevTitle}
+	}
+
+	if nextSlug != "" {
+		series.Next = &dto.SeriesNeighbour{Slug: nextSlug, Title: nextTitle}
+	}
+
+	return &series, nil
+}
+
+// AddArticle appends the article to the series, an article of any series already stays where it is.
+func (s *SeriesStorage) AddArticle(ctx context.Context, seriesID, articleID string) error {
+	const query = `INSERT INTO series_articles (series_id, article_id, position)
+		SELECT $1, $2, COALESCE(MAX(position), 0)+1 FROM series_articles WHERE series_id=$1
+		ON CONFLICT DO NOTHING`
+
+	return s.exec(ctx, query, seriesID, articleID)
+}
+
+func (s *SeriesStorage) DeleteArticle(ctx context.Context, seriesID, articleID string) error {
+	const query = "DELETE FROM series_articles WHERE series_id=$1 AND article_id=$2"
+
+	return s.exec(ctx, query, seriesID, articleID)
+}
+
+// Reorder sets positions of articles of the series to their positions in articleIDs.
+func (s *SeriesStorage) Reorder(ctx context.Context, seriesID string, articleIDs []string) error {
+	const query = `UPDATE series_articles sa SET position=o.position
+		FROM UNNEST($2::uuid[]) WITH ORDINALITY AS o(article_id, position)
+		WHERE sa.series_id=$1 AND sa.article_id=o.article_id`
+
+	return s.exec(ctx, query, seriesID, pq.Array(articleIDs))
+}
+
+func (s *SeriesStorage) exec(ctx context.Context, query string, args ...any) error {
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
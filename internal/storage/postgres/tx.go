@@ -31,6 +31,8 @@ func getSQLTxOrBegin(tx transaction.Transaction, db *sql.DB) (*sql.Tx, error) {
 		return nil, fmt.Errorf("begin transaction: %w", err)
 	}
 
+	tx.AddCommit(sqlTx.Commit)
+	tx.AddRollback(func() { _ = sqlTx.Rollback() })
 	tx.WithContext(setTxToContext(ctx, sqlTx))
 	return sqlTx, nil
 }
@@ -3,8 +3,11 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
+	"github.com/lib/pq"
+
 	"github.com/art-es/yet-another-service/internal/app/shared/dto"
 	"github.com/art-es/yet-another-service/internal/core/transaction"
 )
@@ -35,7 +38,7 @@ func (s *UserStorage) Activate(ctx context.Context, tx transaction.Transaction,
 }
 
 func (s *UserStorage) Exists(ctx context.Context, email string) (bool, error) {
-	const query = "SELECT EXISTS(email) FROM users WHERE email=$1"
+	const query = "SELECT EXISTS(SELECT 1 FROM users WHERE email=$1)"
 
 	exists := false
 	err := s.db.QueryRowContext(ctx, query, email).
@@ -47,12 +50,25 @@ func (s *UserStorage) Exists(ctx context.Context, email string) (bool, error) {
 	return exists, nil
 }
 
+func (s *UserStorage) NickNameExists(ctx context.Context, nickName string) (bool, error) {
+	const query = "SELECT EXISTS(SELECT 1 FROM users WHERE nickname=$1)"
+
+	exists := false
+	err := s.db.QueryRowContext(ctx, query, nickName).
+		Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("execute query: %w", err)
+	}
+
+	return exists, nil
+}
+
 func (s *UserStorage) Find(ctx context.Context, id string) (*dto.User, error) {
-	const query = "SELECT id, name, email, password_hash FROM users WHERE id=$1"
+	const query = "SELECT id, name, nickname, email, password_hash, roles FROM users WHERE id=$1"
 
 	user := &dto.User{}
 	err := s.db.QueryRowContext(ctx, query, id).
-		Scan(&user.ID, &user.DisplayName, &user.Email, &user.PasswordHash)
+		Scan(&user.ID, &user.DisplayName, &user.NickName, &user.Email, &user.PasswordHash, pq.Array(&user.Roles))
 	if err != nil {
 		return nil, fmt.Errorf("execute query: %w", err)
 	}
@@ -60,13 +76,18 @@ func (s *UserStorage) Find(ctx context.Context, id string) (*dto.User, error) {
 	return user, nil
 }
 
+// FindByEmail returns the user with the email address, nil when there is none.
 func (s *UserStorage) FindByEmail(ctx context.Context, email string) (*dto.User, error) {
-	const query = "SELECT id, name, email, password_hash FROM users WHERE email=$1"
+	const query = "SELECT id, name, nickname, email, password_hash, roles FROM users WHERE email=$1"
 
 	user := &dto.User{}
 	err := s.db.QueryRowContext(ctx, query, email).
-		Scan(&user.ID, &user.DisplayName, &user.Email, &user.PasswordHash)
+		Scan(&user.ID, &user.DisplayName, &user.NickName, &user.Email, &user.PasswordHash, pq.Array(&user.Roles))
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
 		return nil, fmt.Errorf("execute query: %w", err)
 	}
 
@@ -103,9 +124,10 @@ func (s *UserStorage) store(ctx context.Context, tx transaction.Transaction, use
 		return err
 	}
 
-	const query = "INSERT INTO users (name, email, password_hash) VALUES ($1, $2, $3) RETURNING id"
+	const query = "INSERT INTO users (name, nickname, email, password_hash) VALUES ($1, $2, $3, $4) RETURNING id"
 
-	_, err = sqlTx.ExecContext(ctx, query, user.PasswordHash, user.ID)
+	err = sqlTx.QueryRowContext(ctx, query, user.DisplayName, user.NickName, user.Email, user.PasswordHash).
+		Scan(&user.ID)
 	if err != nil {
 		return fmt.Errorf("execute query: %w", err)
 	}
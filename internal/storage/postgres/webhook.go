@@ -0,0 +1,392 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/transaction"
+)
+
+const webhookDeliveryPageSize = 20
+
+const webhookColumns = "id, url, secret, events, active, failures, created_by, created_at, updated_at"
+
+const webhookDeliveryColumns = `id, webhook_id, event, payload, status, attempts, next_attempt_at, response_status,
+	last_error, created_at, delivered_at`
+
+type WebhookStorage struct {
+	db *sql.DB
+}
+
+func NewWebhookStorage(db *sql.DB) *WebhookStorage {
+	return &WebhookStorage{db: db}
+}
+
+func (s *WebhookStorage) Get(ctx context.Context) ([]*dto.Webhook, error) {
+	const query = "SELECT " + webhookColumns + " FROM webhooks ORDER BY created_at, id"
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := make([]*dto.Webhook, 0)
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// Find returns the webhook, nil when there is none.
+func (s *WebhookStorage) Find(ctx context.Context, id string) (*dto.Webhook, error) {
+	const query = "SELECT " + webhookColumns + " FROM webhooks WHERE id=$1"
+
+	webhook, err := scanWebhook(s.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (s *WebhookStorage) Save(ctx context.Context, webhook *dto.Webhook) error {
+	if !webhook.Stored() {
+		const query = `INSERT INTO webhooks (url, secret, events, active, created_by) VALUES ($1, $2, $3, $4, $5)
+			RETURNING id, created_at`
+
+		err := s.db.QueryRowContext(ctx, query,
+			webhook.URL,
+			webhook.Secret,
+			pq.Array(webhook.Events),
+			webhook.Active,
+			webhook.CreatedBy,
+		).Scan(&webhook.ID, &webhook.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("execute query: %w", err)
+		}
+
+		return nil
+	}
+
+	const query = `UPDATE webhooks SET url=$1, events=$2, active=$3, failures=$4, updated_at=CURRENT_TIMESTAMP
+		WHERE id=$5
+		RETURNING updated_at`
+
+	err := s.db.QueryRowContext(ctx, query,
+		webhook.URL,
+		pq.Array(webhook.Events),
+		webhook.Active,
+		webhook.Failures,
+		webhook.ID,
+	).Scan(&webhook.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the webhook together with its deliveries.
+func (s *WebhookStorage) Delete(ctx context.Context, id string) error {
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM webhooks WHERE id=$1", id); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// AddFailure counts a failed attempt of the webhook and deactivates the webhook once the count reaches disableAfter.
+// It returns whether the webhook is still active.
+func (s *WebhookStorage) AddFailure(ctx context.Context, tx transaction.Transaction, id string, disableAfter int) (bool, error) {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return false, err
+	}
+
+	const query = `UPDATE webhooks SET failures=failures+1, active=active AND failures+1<$2
+		WHERE id=$1
+		RETURNING active`
+
+	var active bool
+	if err = sqlTx.QueryRowContext(ctx, query, id, disableAfter).Scan(&active); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("execute query: %w", err)
+	}
+
+	return active, nil
+}
+
+func (s *WebhookStorage) ResetFailures(ctx context.Context, tx transaction.Transaction, id string) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	if _, err = sqlTx.ExecContext(ctx, "UPDATE webhooks SET failures=0 WHERE id=$1 AND failures>0", id); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// Enqueue adds a pending delivery of the payload for every active webhook subscribed to the event.
+func (s *WebhookStorage) Enqueue(ctx context.Context, tx transaction.Transaction, event string, payload []byte) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	const query = `INSERT INTO webhook_deliveries (webhook_id, event, payload)
+		SELECT id, $1, $2 FROM webhooks WHERE active AND $1=ANY(events)`
+
+	if _, err = sqlTx.ExecContext(ctx, query, event, string(payload)); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// CreateDelivery adds a pending delivery.
+func (s *WebhookStorage) CreateDelivery(ctx context.Context, delivery *dto.WebhookDelivery) error {
+	const query = `INSERT INTO webhook_deliveries (webhook_id, event, payload) VALUES ($1, $2, $3)
+		RETURNING id, status, next_attempt_at, created_at`
+
+	err := s.db.QueryRowContext(ctx, query, delivery.WebhookID, delivery.Event, string(delivery.Payload)).
+		Scan(&delivery.ID, &delivery.Status, &delivery.NextAttemptAt, &delivery.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateDelivery stores the outcome of an attempt of the delivery.
+func (s *WebhookStorage) UpdateDelivery(ctx context.Context, tx transaction.Transaction, delivery *dto.WebhookDelivery) error {
+	sqlTx, err := getSQLTxOrBegin(tx, s.db)
+	if err != nil {
+		return err
+	}
+
+	const query = `UPDATE webhook_deliveries SET status=$1, attempts=$2, next_attempt_at=$3, response_status=$4,
+		last_error=$5, delivered_at=$6
+		WHERE id=$7`
+
+	_, err = sqlTx.ExecContext(ctx, query,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.ResponseStatus,
+		delivery.LastError,
+		delivery.DeliveredAt,
+		delivery.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+// FindDelivery returns the delivery, nil when there is none.
+func (s *WebhookStorage) FindDelivery(ctx context.Context, id string) (*dto.WebhookDelivery, error) {
+	const query = "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries WHERE id=$1"
+
+	delivery, err := scanWebhookDelivery(s.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// GetDeliveries returns a page of deliveries of the webhook after the delivery with fromID, newest first.
+func (s *WebhookStorage) GetDeliveries(ctx context.Context, in *dto.GetWebhookDeliveriesIn) (*dto.GetWebhookDeliveriesOut, error) {
+	args := []any{in.WebhookID}
+	query := "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries WHERE webhook_id=$1"
+
+	if in.FromID != nil {
+		args = append(args, *in.FromID)
+		query += fmt.Sprintf(" AND (created_at, id) < (SELECT created_at, id FROM webhook_deliveries WHERE id=$%d)", len(args))
+	}
+
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args)+1)
+	args = append(args, webhookDeliveryPageSize+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*dto.WebhookDelivery, 0)
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	out := &dto.GetWebhookDeliveriesOut{Deliveries: deliveries}
+	if len(deliveries) > webhookDeliveryPageSize {
+		out.Deliveries = deliveries[:webhookDeliveryPageSize]
+		out.HasMore = true
+	}
+
+	return out, nil
+}
+
+// ClaimDeliveries returns up to limit due deliveries of active webhooks, oldest due first, with URLs and secrets
+// of their webhooks. Claimed deliveries are due again after the lease, so other dispatchers skip them meanwhile
+// and deliveries of a dispatcher which stopped in the middle of an attempt are retried.
+func (s *WebhookStorage) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*dto.WebhookDelivery, error) {
+	const query = `UPDATE webhook_deliveries d SET next_attempt_at=CURRENT_TIMESTAMP + make_interval(secs => $2)
+		FROM webhooks w
+		WHERE w.id=d.webhook_id AND d.id IN (
+			SELECT pd.id FROM webhook_deliveries pd JOIN webhooks pw ON pw.id=pd.webhook_id
+			WHERE pd.status=$3 AND pd.next_attempt_at<=CURRENT_TIMESTAMP AND pw.active
+			ORDER BY pd.next_attempt_at
+			LIMIT $1
+			FOR UPDATE OF pd SKIP LOCKED
+		)
+		RETURNING d.id, d.webhook_id, d.event, d.payload, d.status, d.attempts, d.next_attempt_at, d.response_status,
+			d.last_error, d.created_at, d.delivered_at, w.url, w.secret`
+
+	rows, err := s.db.QueryContext(ctx, query, limit, lease.Seconds(), dto.WebhookDeliveryPending)
+	if err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*dto.WebhookDelivery, 0, limit)
+	for rows.Next() {
+		var (
+			delivery    dto.WebhookDelivery
+			deliveredAt sql.NullTime
+		)
+
+		err = rows.Scan(
+			&delivery.ID,
+			&delivery.WebhookID,
+			&delivery.Event,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&delivery.ResponseStatus,
+			&delivery.LastError,
+			&delivery.CreatedAt,
+			&deliveredAt,
+			&delivery.URL,
+			&delivery.Secret,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		if deliveredAt.Valid {
+			delivery.DeliveredAt = &deliveredAt.Time
+		}
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func scanWebhook(row rowScanner) (*dto.Webhook, error) {
+	var (
+		webhook   dto.Webhook
+		createdBy sql.NullString
+		updatedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.URL,
+		&webhook.Secret,
+		pq.Array(&webhook.Events),
+		&webhook.Active,
+		&webhook.Failures,
+		&createdBy,
+		&webhook.CreatedAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.CreatedBy = createdBy.String
+	if updatedAt.Valid {
+		webhook.UpdatedAt = &updatedAt.Time
+	}
+
+	return &webhook, nil
+}
+
+func scanWebhookDelivery(row rowScanner) (*dto.WebhookDelivery, error) {
+	var (
+		delivery    dto.WebhookDelivery
+		deliveredAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.WebhookID,
+		&delivery.Event,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&delivery.ResponseStatus,
+		&delivery.LastError,
+		&delivery.CreatedAt,
+		&deliveredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+
+	return &delivery, nil
+}
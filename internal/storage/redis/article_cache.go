@@ -3,11 +3,17 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"net/url"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/worker"
 
 	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
 
@@ -16,94 +22,458 @@ import (
 	"github.com/art-es/yet-another-service/internal/app/shared/dto"
 )
 
+const (
+	articleCacheKeyPrefix        = "article_query:"
+	articleCacheVersionKey       = "article_query_version"
+	articleCacheSequenceKey      = "article_query_sequence"
+	articleCacheTagPrefix        = "article_query_tag:"
+	articleCacheGenerationPrefix = "article_query_generation:"
+	articleCacheListingTag       = articleCacheTagPrefix + "listing"
+	articleCacheLockKeyPrefix    = "article_query_lock:"
+	articleCacheAwaitInterval    = 50 * time.Millisecond
+)
+
+// articleCacheUnlockScript deletes the lock only if it's still held with the token,
+// since an expired lock may have been taken by another loader.
+var articleCacheUnlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// articleCachePurgeScript pops members of the tag and stamps the tag with the next purge sequence.
+// Generations are set in the script, so a later purge never leaves the tag with a lower generation.
+var articleCachePurgeScript = redis.NewScript(`
+local sequence = redis.call("INCR", KEYS[3])
+redis.call("SET", KEYS[2], sequence, "PX", ARGV[1])
+local members = redis.call("SMEMBERS", KEYS[1])
+redis.call("DEL", KEYS[1])
+return members
+`)
+
+// articleCacheElement is a page queued for writing. The key and purge sequence come from the token
+// taken before the page was loaded, so the page isn't written if the cache was flushed or purged since then.
 type articleCacheElement struct {
-	in  *dto.GetArticlesIn
-	out *dto.GetArticlesOut
+	key      string
+	tags     []string
+	sequence int64
+	out      *dto.GetArticlesOut
 }
 
+type articleCacheEntry struct {
+	FreshUntil time.Time           `json:"fresh_until"`
+	Out        *dto.GetArticlesOut `json:"out"`
+}
+
+type ArticleCacheConfig struct {
+	// Timeout is how long a page is served as fresh.
+	Timeout time.Duration
+	// StaleTimeout is how long a page is still served after it gets stale, while it's being refreshed.
+	StaleTimeout time.Duration
+	// EmptyTimeout is how long a page without articles is served as fresh, zero disables caching of such pages.
+	EmptyTimeout time.Duration
+	// EnrichTimeout limits writing of a page into redis.
+	EnrichTimeout time.Duration
+	// LockTimeout limits how long a page is loaded under the lock and awaited by others.
+	LockTimeout time.Duration
+	// Writer configures the background workers writing pages into redis.
+	Writer worker.PoolConfig
+}
+
+// ArticleCache caches pages of articles.
+// Every page is registered in tag sets of the slugs and authors it contains,
+// so writes can purge exactly the pages they affect. Purging a tag stamps it with the next purge sequence,
+// pages loaded before that are dropped instead of bringing purged data back. Keys are prefixed with a namespace version,
+// bumping it flushes the whole cache without scanning keys, stale pages just expire.
 type ArticleCache struct {
 	db     *redis.Client
 	logger log.Logger
 
-	cacheTimeout  time.Duration
-	enrichTimeout time.Duration
-	elements      chan articleCacheElement
+	config ArticleCacheConfig
+	writer *worker.Pool[articleCacheElement]
+	hits   atomic.Int64
+	misses atomic.Int64
 }
 
 func NewArticleCache(
 	db *redis.Client,
 	logger log.Logger,
-	cacheTimeout time.Duration,
-	enrichTimeout time.Duration,
+	config ArticleCacheConfig,
 ) *ArticleCache {
-	return &ArticleCache{
-		db:            db,
-		logger:        logger,
-		cacheTimeout:  cacheTimeout,
-		enrichTimeout: enrichTimeout,
-		elements:      make(chan articleCacheElement, 5),
+	c := &ArticleCache{
+		db:     db,
+		logger: logger,
+		config: config,
 	}
+	c.writer = worker.NewPool(config.Writer, func(ctx context.Context, element articleCacheElement) error {
+		return c.enrich(ctx, element)
+	}, logger)
+	return c
 }
 
-func (c *ArticleCache) Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
-	b, err := c.db.Get(ctx, c.key(in)).Bytes()
+// Get returns the cached page and whether it is stale and needs to be refreshed.
+func (c *ArticleCache) Get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, bool, error) {
+	out, stale, err := c.get(ctx, in)
+	switch {
+	case err == nil:
+		c.hits.Add(1)
+	case errors.Is(err, apperrors.ErrNoCache):
+		c.misses.Add(1)
+	}
+
+	return out, stale, err
+}
+
+func (c *ArticleCache) Stats() dto.CacheStats {
+	return dto.CacheStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
+
+func (c *ArticleCache) get(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, bool, error) {
+	key, err := c.key(ctx, in)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := c.db.Get(ctx, key).Bytes()
 	if err != nil {
 		if err == redis.Nil {
-			return nil, apperrors.ErrNoCache
+			return nil, false, apperrors.ErrNoCache
 		}
 
-		return nil, fmt.Errorf("execute command: %w", err)
+		return nil, false, fmt.Errorf("execute command: %w", err)
+	}
+
+	entry := &articleCacheEntry{}
+	if err = json.Unmarshal(b, entry); err != nil {
+		return nil, false, fmt.Errorf("unmarshal data: %w", err)
 	}
 
-	out := &dto.GetArticlesOut{}
-	if err = json.Unmarshal(b, out); err != nil {
-		return nil, fmt.Errorf("unmarshal data: %w", err)
+	if entry.Out == nil {
+		return nil, false, apperrors.ErrNoCache
 	}
 
-	return out, nil
+	return entry.Out, time.Now().After(entry.FreshUntil), nil
 }
 
-func (c *ArticleCache) Add(ctx context.Context, in *dto.GetArticlesIn, out *dto.GetArticlesOut) error {
-	element := articleCacheElement{in, out}
+// Lock takes the lock for loading the page, shared by all instances.
+// It returns ErrCacheLocked if the page is already being loaded.
+func (c *ArticleCache) Lock(ctx context.Context, in *dto.GetArticlesIn) (func(), error) {
+	key := c.lockKey(in)
+	token := strconv.FormatUint(rand.Uint64(), 36)
+
+	acquired, err := c.db.SetNX(ctx, key, token, c.config.LockTimeout).Result()
+	if err != nil {
+		return nil, fmt.Errorf("set lock: %w", err)
+	}
+
+	if !acquired {
+		return nil, apperrors.ErrCacheLocked
+	}
 
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case c.elements <- element:
-		return nil
+	unlock := func() {
+		if err := articleCacheUnlockScript.Run(context.WithoutCancel(ctx), c.db, []string{key}, token).Err(); err != nil {
+			c.logger.Error().Err(err).Msg("release article cache lock")
+		}
 	}
+
+	return unlock, nil
 }
 
-func (c *ArticleCache) RunEnricher(ctx context.Context) {
-	for element := range c.elements {
-		if err := c.enrich(ctx, element.in, element.out); err != nil {
-			c.logger.Error().Err(err).Msg("enrich article cache in redis")
+// Await waits for the page loaded by the lock holder.
+// It returns ErrNoCache if the lock is released or expires and the page is still not cached.
+func (c *ArticleCache) Await(ctx context.Context, in *dto.GetArticlesIn) (*dto.GetArticlesOut, error) {
+	awaitCtx, cancel := context.WithTimeout(ctx, c.config.LockTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(articleCacheAwaitInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-awaitCtx.Done():
+			return nil, apperrors.ErrNoCache
+		case <-ticker.C:
+		}
+
+		out, _, err := c.get(awaitCtx, in)
+		switch {
+		case err == nil:
+			return out, nil
+		case awaitCtx.Err() != nil:
+			return nil, apperrors.ErrNoCache
+		case !errors.Is(err, apperrors.ErrNoCache):
+			return nil, err
+		}
+
+		locked, err := c.db.Exists(awaitCtx, c.lockKey(in)).Result()
+		if err != nil {
+			if awaitCtx.Err() != nil {
+				return nil, apperrors.ErrNoCache
+			}
+			return nil, fmt.Errorf("check lock: %w", err)
+		}
+
+		if locked == 0 {
+			return nil, apperrors.ErrNoCache
 		}
 	}
 }
 
-func (c *ArticleCache) enrich(ctx context.Context, in *dto.GetArticlesIn, out *dto.GetArticlesOut) error {
-	enrichCtx, cancel := context.WithTimeout(ctx, c.enrichTimeout)
+// Token returns the namespace version and the purge sequence, it must be taken before the page is loaded.
+func (c *ArticleCache) Token(ctx context.Context) (*dto.ArticleCacheToken, error) {
+	values, err := c.db.MGet(ctx, articleCacheVersionKey, articleCacheSequenceKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("get version and sequence: %w", err)
+	}
+
+	version, err := parseArticleCacheCounter(values[0])
+	if err != nil {
+		return nil, fmt.Errorf("parse version: %w", err)
+	}
+
+	sequence, err := parseArticleCacheCounter(values[1])
+	if err != nil {
+		return nil, fmt.Errorf("parse sequence: %w", err)
+	}
+
+	return &dto.ArticleCacheToken{Version: version, Sequence: sequence}, nil
+}
+
+// Add queues the page loaded after the token was taken to be written by the background writer.
+// Under the drop policy pages that don't fit into the queue are skipped, which is only reported in writer stats.
+func (c *ArticleCache) Add(ctx context.Context, in *dto.GetArticlesIn, token *dto.ArticleCacheToken, out *dto.GetArticlesOut) error {
+	element := articleCacheElement{
+		key:      c.versionedKey(token.Version, in),
+		tags:     c.tags(in, out),
+		sequence: token.Sequence,
+		out:      out,
+	}
+
+	err := c.writer.Push(ctx, element)
+	if err != nil && !errors.Is(err, worker.ErrQueueFull) {
+		return fmt.Errorf("push to writer: %w", err)
+	}
+
+	return nil
+}
+
+// Start starts the background writer.
+func (c *ArticleCache) Start(ctx context.Context) error {
+	return c.writer.Start(ctx)
+}
+
+// Stop stops the background writer after queued pages are written.
+func (c *ArticleCache) Stop(ctx context.Context) error {
+	return c.writer.Stop(ctx)
+}
+
+func (c *ArticleCache) WriterStats() worker.PoolStats {
+	return c.writer.Stats()
+}
+
+func (c *ArticleCache) enrich(ctx context.Context, element articleCacheElement) error {
+	timeout := c.config.Timeout
+	if len(element.out.Articles) == 0 {
+		if c.config.EmptyTimeout <= 0 {
+			return nil
+		}
+		timeout = c.config.EmptyTimeout
+	}
+
+	enrichCtx, cancel := context.WithTimeout(ctx, c.config.EnrichTimeout)
 	defer cancel()
 
-	data, err := json.Marshal(out)
+	data, err := json.Marshal(articleCacheEntry{
+		FreshUntil: time.Now().Add(timeout),
+		Out:        element.out,
+	})
 	if err != nil {
 		return fmt.Errorf("marshal data: %w", err)
 	}
 
-	err = c.db.Set(enrichCtx, c.key(in), data, c.cacheTimeout).Err()
-	if err != nil {
+	generationKeys := make([]string, 0, len(element.tags))
+	for _, tag := range element.tags {
+		generationKeys = append(generationKeys, articleCacheGenerationKey(tag))
+	}
+
+	// generations are watched, so a purge between the check and the write fails the transaction
+	err = c.db.Watch(enrichCtx, func(tx *redis.Tx) error {
+		purged, err := c.purgedSince(enrichCtx, tx, element.tags, element.sequence)
+		if err != nil || purged {
+			return err
+		}
+
+		_, err = tx.TxPipelined(enrichCtx, func(pipe redis.Pipeliner) error {
+			pipe.Set(enrichCtx, element.key, data, timeout+c.config.StaleTimeout)
+			for _, tag := range element.tags {
+				pipe.SAdd(enrichCtx, tag, element.key)
+				// members live no longer than the longest timeout, so the set may expire with the latest of them
+				pipe.Expire(enrichCtx, tag, c.tagTimeout())
+			}
+			return nil
+		})
+		return err
+	}, generationKeys...)
+	if err != nil && !errors.Is(err, redis.TxFailedErr) {
 		return fmt.Errorf("set data: %w", err)
 	}
 
 	return nil
 }
 
-func (c *ArticleCache) key(in *dto.GetArticlesIn) string {
-	vals := url.Values{}
-	if in.FromSlug != nil {
-		vals.Add("from_slug", *in.FromSlug)
+// purgedSince tells whether any of the tags was purged after the sequence.
+// A tag which wasn't purged for longer than pages live has no generation.
+func (c *ArticleCache) purgedSince(ctx context.Context, db redis.Cmdable, tags []string, sequence int64) (bool, error) {
+	keys := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		keys = append(keys, articleCacheGenerationKey(tag))
+	}
+
+	values, err := db.MGet(ctx, keys...).Result()
+	if err != nil {
+		return false, fmt.Errorf("get generations: %w", err)
 	}
 
-	return "article_query:" + vals.Encode()
+	for _, value := range values {
+		generation, err := parseArticleCacheCounter(value)
+		if err != nil {
+			return false, fmt.Errorf("parse generation: %w", err)
+		}
+
+		if generation > sequence {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// PurgeArticles removes cached pages containing any of the articles.
+func (c *ArticleCache) PurgeArticles(ctx context.Context, slugs ...string) error {
+	tags := make([]string, 0, len(slugs))
+	for _, slug := range slugs {
+		tags = append(tags, articleCacheSlugTag(slug))
+	}
+
+	return c.purge(ctx, tags)
+}
+
+// PurgeAuthors removes cached pages containing articles of any of the authors
+// and pages listing articles of the authors.
+func (c *ArticleCache) PurgeAuthors(ctx context.Context, authorIDs ...string) error {
+	tags := make([]string, 0, len(authorIDs))
+	for _, authorID := range authorIDs {
+		tags = append(tags, articleCacheAuthorTag(authorID))
+	}
+
+	return c.purge(ctx, tags)
+}
+
+// PurgeListings removes cached pages of the listing across all authors.
+func (c *ArticleCache) PurgeListings(ctx context.Context) error {
+	return c.purge(ctx, []string{articleCacheListingTag})
+}
+
+// Flush invalidates the whole cache by switching to a new namespace version.
+func (c *ArticleCache) Flush(ctx context.Context) error {
+	if err := c.db.Incr(ctx, articleCacheVersionKey).Err(); err != nil {
+		return fmt.Errorf("increment version: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ArticleCache) purge(ctx context.Context, tags []string) error {
+	for _, tag := range tags {
+		// the set is read and removed atomically, so pages cached meanwhile land in a new set,
+		// and the tag gets a new generation, so pages loaded before the purge are not written.
+		// Pages are loaded and written long before the generation expires.
+		keys, err := articleCachePurgeScript.Run(
+			ctx,
+			c.db,
+			[]string{tag, articleCacheGenerationKey(tag), articleCacheSequenceKey},
+			c.tagTimeout().Milliseconds(),
+		).StringSlice()
+		if err != nil {
+			return fmt.Errorf("pop tag members: %w", err)
+		}
+
+		if len(keys) == 0 {
+			continue
+		}
+
+		if err = c.db.Del(ctx, keys...).Err(); err != nil {
+			return fmt.Errorf("delete tagged keys: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *ArticleCache) tagTimeout() time.Duration {
+	return max(c.config.Timeout, c.config.EmptyTimeout) + c.config.StaleTimeout
+}
+
+func (c *ArticleCache) tags(in *dto.GetArticlesIn, out *dto.GetArticlesOut) []string {
+	tags := make([]string, 0, len(out.Articles)*2+1)
+	switch {
+	case in.Slug != "":
+		// the article may not exist yet, so the page is tagged by the slug even if it's empty
+		tags = append(tags, articleCacheSlugTag(in.Slug))
+	case in.AuthorID != "":
+		tags = append(tags, articleCacheAuthorTag(in.AuthorID))
+	default:
+		tags = append(tags, articleCacheListingTag)
+	}
+
+	for _, article := range out.Articles {
+		tags = append(tags, articleCacheSlugTag(article.Slug), articleCacheAuthorTag(article.AuthorID))
+	}
+
+	slices.Sort(tags)
+	return slices.Compact(tags)
+}
+
+func (c *ArticleCache) key(ctx context.Context, in *dto.GetArticlesIn) (string, error) {
+	version, err := c.db.Get(ctx, articleCacheVersionKey).Int64()
+	if err != nil && err != redis.Nil {
+		return "", fmt.Errorf("get version: %w", err)
+	}
+
+	return c.versionedKey(version, in), nil
+}
+
+func (c *ArticleCache) versionedKey(version int64, in *dto.GetArticlesIn) string {
+	return articleCacheKeyPrefix + "v" + strconv.FormatInt(version, 10) + ":" + in.CacheKey()
+}
+
+// lockKey isn't versioned, loading the page doesn't depend on the namespace.
+func (c *ArticleCache) lockKey(in *dto.GetArticlesIn) string {
+	return articleCacheLockKeyPrefix + in.CacheKey()
+}
+
+// parseArticleCacheCounter parses a counter read with MGET, a missing counter is zero.
+func parseArticleCacheCounter(value any) (int64, error) {
+	s, ok := value.(string)
+	if !ok {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func articleCacheGenerationKey(tag string) string {
+	return articleCacheGenerationPrefix + strings.TrimPrefix(tag, articleCacheTagPrefix)
+}
+
+func articleCacheSlugTag(slug string) string {
+	return articleCacheTagPrefix + "slug:" + slug
+}
+
+func articleCacheAuthorTag(authorID string) string {
+	return articleCacheTagPrefix + "author:" + authorID
 }
@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+const articleCacheInvalidationChannel = "article_query_invalidation"
+
+// ArticleCacheInvalidations broadcasts invalidations of article pages to all instances through pub/sub.
+// Delivery is at most once, messages published while an instance is disconnected are lost.
+type ArticleCacheInvalidations struct {
+	db     *redis.Client
+	logger log.Logger
+}
+
+func NewArticleCacheInvalidations(db *redis.Client, logger log.Logger) *ArticleCacheInvalidations {
+	return &ArticleCacheInvalidations{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (i *ArticleCacheInvalidations) Publish(ctx context.Context, invalidation *dto.ArticleCacheInvalidation) error {
+	data, err := json.Marshal(invalidation)
+	if err != nil {
+		return fmt.Errorf("marshal data: %w", err)
+	}
+
+	if err = i.db.Publish(ctx, articleCacheInvalidationChannel, data).Err(); err != nil {
+		return fmt.Errorf("publish message: %w", err)
+	}
+
+	return nil
+}
+
+// Subscribe passes published invalidations to the handler until the context is done.
+func (i *ArticleCacheInvalidations) Subscribe(ctx context.Context, handle func(invalidation *dto.ArticleCacheInvalidation)) {
+	sub := i.db.Subscribe(ctx, articleCacheInvalidationChannel)
+	defer sub.Close()
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			invalidation := &dto.ArticleCacheInvalidation{}
+			if err := json.Unmarshal([]byte(msg.Payload), invalidation); err != nil {
+				i.logger.Error().Err(err).Msg("unmarshal article cache invalidation")
+				continue
+			}
+
+			handle(invalidation)
+		}
+	}
+}
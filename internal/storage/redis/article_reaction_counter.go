@@ -0,0 +1,124 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+const (
+	articleReactionDeltaKeyPrefix = "article_reaction_delta:"
+	articleReactionDirtyKey       = "article_reaction_dirty"
+	articleReactionPopBatch       = 100
+)
+
+// ArticleReactionCounter accumulates reaction count deltas in redis hashes
+// until they are popped and flushed to the persistent storage.
+type ArticleReactionCounter struct {
+	db *redis.Client
+}
+
+func NewArticleReactionCounter(db *redis.Client) *ArticleReactionCounter {
+	return &ArticleReactionCounter{db: db}
+}
+
+func (c *ArticleReactionCounter) Incr(ctx context.Context, articleID, kind string, delta int64) error {
+	_, err := c.db.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, c.key(articleID), kind, delta)
+		pipe.SAdd(ctx, articleReactionDirtyKey, articleID)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("execute commands: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns deltas which are not flushed yet.
+func (c *ArticleReactionCounter) Get(ctx context.Context, articleIDs []string) (dto.ReactionCounts, error) {
+	cmds := make([]*redis.MapStringStringCmd, 0, len(articleIDs))
+	_, err := c.db.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, articleID := range articleIDs {
+			cmds = append(cmds, pipe.HGetAll(ctx, c.key(articleID)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("execute commands: %w", err)
+	}
+
+	deltas := make(dto.ReactionCounts)
+	for i, cmd := range cmds {
+		if err = addDeltas(deltas, articleIDs[i], cmd.Val()); err != nil {
+			return nil, err
+		}
+	}
+
+	return deltas, nil
+}
+
+// Pop takes a batch of pending deltas and removes them from redis.
+// On error, deltas popped before the failure are returned along with it
+// and the rest of the batch is marked as dirty again.
+func (c *ArticleReactionCounter) Pop(ctx context.Context) (dto.ReactionCounts, error) {
+	articleIDs, err := c.db.SPopN(ctx, articleReactionDirtyKey, articleReactionPopBatch).Result()
+	if err != nil {
+		return nil, fmt.Errorf("pop dirty articles: %w", err)
+	}
+
+	deltas := make(dto.ReactionCounts)
+	for i, articleID := range articleIDs {
+		var cmd *redis.MapStringStringCmd
+		_, err = c.db.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			cmd = pipe.HGetAll(ctx, c.key(articleID))
+			pipe.Del(ctx, c.key(articleID))
+			return nil
+		})
+		if err != nil {
+			c.db.SAdd(ctx, articleReactionDirtyKey, toAnySlice(articleIDs[i:])...)
+			return deltas, fmt.Errorf("pop article deltas: %w", err)
+		}
+
+		// the hash is removed already, so deltas parsed so far are returned rather than lost with the batch
+		if err = addDeltas(deltas, articleID, cmd.Val()); err != nil {
+			if rest := articleIDs[i+1:]; len(rest) > 0 {
+				c.db.SAdd(ctx, articleReactionDirtyKey, toAnySlice(rest)...)
+			}
+			return deltas, err
+		}
+	}
+
+	return deltas, nil
+}
+
+func (c *ArticleReactionCounter) key(articleID string) string {
+	return articleReactionDeltaKeyPrefix + articleID
+}
+
+func addDeltas(deltas dto.ReactionCounts, articleID string, values map[string]string) error {
+	for kind, value := range values {
+		delta, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse delta: %w", err)
+		}
+
+		if delta != 0 {
+			deltas.Add(articleID, kind, delta)
+		}
+	}
+
+	return nil
+}
+
+func toAnySlice(in []string) []any {
+	out := make([]any, 0, len(in))
+	for _, s := range in {
+		out = append(out, s)
+	}
+	return out
+}
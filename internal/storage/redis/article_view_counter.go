@@ -0,0 +1,124 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+const (
+	articleViewVisitorsKeyPrefix = "article_view_visitors:"
+	articleViewDeltaKeyPrefix    = "article_view_delta:"
+	articleViewDirtyKey          = "article_view_dirty"
+	articleViewPopBatch          = 500
+	// visitors of a day are kept a bit longer than the day, so views near midnight aren't counted twice
+	articleViewVisitorsTimeout = 25 * time.Hour
+)
+
+// articleViewAddScript counts the view only if the visitor is new to the article on the day.
+var articleViewAddScript = redis.NewScript(`
+if redis.call("SADD", KEYS[1], ARGV[1]) == 0 then
+	return 0
+end
+redis.call("EXPIRE", KEYS[1], ARGV[2])
+redis.call("INCR", KEYS[2])
+redis.call("SADD", KEYS[3], ARGV[3])
+return 1
+`)
+
+// ArticleViewCounter deduplicates article views by visitors per day
+// and accumulates view count deltas until they are popped and flushed to the persistent storage.
+type ArticleViewCounter struct {
+	db     *redis.Client
+	logger log.Logger
+}
+
+func NewArticleViewCounter(db *redis.Client, logger log.Logger) *ArticleViewCounter {
+	return &ArticleViewCounter{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (c *ArticleViewCounter) Add(ctx context.Context, articleID, visitorID string, day time.Time) (bool, error) {
+	keys := []string{
+		articleViewVisitorsKeyPrefix + day.Format(time.DateOnly) + ":" + articleID,
+		c.key(articleID),
+		articleViewDirtyKey,
+	}
+
+	added, err := articleViewAddScript.Run(ctx, c.db, keys, visitorID, int(articleViewVisitorsTimeout.Seconds()), articleID).Int()
+	if err != nil {
+		return false, fmt.Errorf("execute script: %w", err)
+	}
+
+	return added == 1, nil
+}
+
+func (c *ArticleViewCounter) Incr(ctx context.Context, articleID string, delta int64) error {
+	_, err := c.db.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.IncrBy(ctx, c.key(articleID), delta)
+		pipe.SAdd(ctx, articleViewDirtyKey, articleID)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("execute commands: %w", err)
+	}
+
+	return nil
+}
+
+// Pop takes a batch of pending deltas and removes them from redis.
+// On error, the batch is marked as dirty again. Malformed deltas are logged and skipped,
+// they can't be flushed anyway.
+func (c *ArticleViewCounter) Pop(ctx context.Context) (map[string]int64, error) {
+	articleIDs, err := c.db.SPopN(ctx, articleViewDirtyKey, articleViewPopBatch).Result()
+	if err != nil {
+		return nil, fmt.Errorf("pop dirty articles: %w", err)
+	}
+
+	if len(articleIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+
+	cmds := make([]*redis.StringCmd, 0, len(articleIDs))
+	_, err = c.db.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, articleID := range articleIDs {
+			cmds = append(cmds, pipe.GetDel(ctx, c.key(articleID)))
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		c.db.SAdd(ctx, articleViewDirtyKey, toAnySlice(articleIDs)...)
+		return nil, fmt.Errorf("pop article deltas: %w", err)
+	}
+
+	deltas := make(map[string]int64, len(articleIDs))
+	for i, cmd := range cmds {
+		value, err := cmd.Result()
+		if err == redis.Nil {
+			continue
+		}
+
+		delta, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			c.logger.Error().Err(err).Str("article_id", articleIDs[i]).Msg("parse article view delta error")
+			continue
+		}
+
+		if delta != 0 {
+			deltas[articleIDs[i]] = delta
+		}
+	}
+
+	return deltas, nil
+}
+
+func (c *ArticleViewCounter) key(articleID string) string {
+	return articleViewDeltaKeyPrefix + articleID
+}
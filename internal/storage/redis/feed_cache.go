@@ -0,0 +1,91 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+const feedCacheKeyPrefix = "feed:"
+
+// FeedCache keeps rendered feeds. Keys are derived from the format, the author and the tag,
+// so feeds are purged without keeping track of them.
+type FeedCache struct {
+	db           *redis.Client
+	cacheTimeout time.Duration
+}
+
+func NewFeedCache(db *redis.Client, cacheTimeout time.Duration) *FeedCache {
+	return &FeedCache{
+		db:           db,
+		cacheTimeout: cacheTimeout,
+	}
+}
+
+func (c *FeedCache) Get(ctx context.Context, format, authorID, tag string) (*dto.RenderedFeed, error) {
+	b, err := c.db.Get(ctx, c.key(format, authorID, tag)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, apperrors.ErrNoCache
+		}
+
+		return nil, fmt.Errorf("execute command: %w", err)
+	}
+
+	feed := &dto.RenderedFeed{}
+	if err = json.Unmarshal(b, feed); err != nil {
+		return nil, fmt.Errorf("unmarshal data: %w", err)
+	}
+
+	return feed, nil
+}
+
+func (c *FeedCache) Add(ctx context.Context, format, authorID, tag string, feed *dto.RenderedFeed) error {
+	data, err := json.Marshal(feed)
+	if err != nil {
+		return fmt.Errorf("marshal data: %w", err)
+	}
+
+	if err = c.db.Set(ctx, c.key(format, authorID, tag), data, c.cacheTimeout).Err(); err != nil {
+		return fmt.Errorf("set data: %w", err)
+	}
+
+	return nil
+}
+
+// Purge removes feeds of all articles and feeds of the authors and of the tags in every format.
+func (c *FeedCache) Purge(ctx context.Context, authorIDs, tags []string) error {
+	keys := make([]string, 0, len(dto.FeedContentTypes)*(len(authorIDs)+len(tags)+1))
+	for format := range dto.FeedContentTypes {
+		keys = append(keys, c.key(format, "", ""))
+		for _, authorID := range authorIDs {
+			keys = append(keys, c.key(format, authorID, ""))
+		}
+		for _, tag := range tags {
+			keys = append(keys, c.key(format, "", tag))
+		}
+	}
+
+	if err := c.db.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("delete keys: %w", err)
+	}
+
+	return nil
+}
+
+func (c *FeedCache) key(format, authorID, tag string) string {
+	switch {
+	case authorID != "":
+		return feedCacheKeyPrefix + "author:" + authorID + ":" + format
+	case tag != "":
+		return feedCacheKeyPrefix + "tag:" + tag + ":" + format
+	default:
+		return feedCacheKeyPrefix + "all:" + format
+	}
+}
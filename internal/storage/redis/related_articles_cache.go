@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+const relatedArticlesCacheKeyPrefix = "related_articles:"
+
+// RelatedArticlesCache keeps IDs of related articles by article ID, joined by commas.
+// An empty value is a cached empty list, unlike a missing key.
+type RelatedArticlesCache struct {
+	db           *redis.Client
+	cacheTimeout time.Duration
+}
+
+func NewRelatedArticlesCache(db *redis.Client, cacheTimeout time.Duration) *RelatedArticlesCache {
+	return &RelatedArticlesCache{
+		db:           db,
+		cacheTimeout: cacheTimeout,
+	}
+}
+
+// Get returns IDs of articles related to the article, it fails with ErrNoCache if they aren't cached.
+func (c *RelatedArticlesCache) Get(ctx context.Context, articleID string) ([]string, error) {
+	value, err := c.db.Get(ctx, c.key(articleID)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, apperrors.ErrNoCache
+		}
+
+		return nil, fmt.Errorf("execute command: %w", err)
+	}
+
+	if value == "" {
+		return []string{}, nil
+	}
+
+	return strings.Split(value, ","), nil
+}
+
+func (c *RelatedArticlesCache) Save(ctx context.Context, articleID string, ids []string) error {
+	if err := c.db.Set(ctx, c.key(articleID), strings.Join(ids, ","), c.cacheTimeout).Err(); err != nil {
+		return fmt.Errorf("set data: %w", err)
+	}
+
+	return nil
+}
+
+func (c *RelatedArticlesCache) Delete(ctx context.Context, articleID string) error {
+	if err := c.db.Del(ctx, c.key(articleID)).Err(); err != nil {
+		return fmt.Errorf("delete key: %w", err)
+	}
+
+	return nil
+}
+
+func (c *RelatedArticlesCache) key(articleID string) string {
+	return relatedArticlesCacheKeyPrefix + articleID
+}
@@ -0,0 +1,64 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+const sitemapCacheKeyPrefix = "sitemap:"
+
+// SitemapCache keeps rendered sitemaps by file number, zero is the sitemap index.
+type SitemapCache struct {
+	db           *redis.Client
+	cacheTimeout time.Duration
+}
+
+func NewSitemapCache(db *redis.Client, cacheTimeout time.Duration) *SitemapCache {
+	return &SitemapCache{
+		db:           db,
+		cacheTimeout: cacheTimeout,
+	}
+}
+
+func (c *SitemapCache) Get(ctx context.Context, file int) ([]byte, error) {
+	b, err := c.db.Get(ctx, c.key(file)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, apperrors.ErrNoCache
+		}
+
+		return nil, fmt.Errorf("execute command: %w", err)
+	}
+
+	return b, nil
+}
+
+func (c *SitemapCache) Add(ctx context.Context, file int, sitemap []byte) error {
+	if err := c.db.Set(ctx, c.key(file), sitemap, c.cacheTimeout).Err(); err != nil {
+		return fmt.Errorf("set data: %w", err)
+	}
+
+	return nil
+}
+
+func (c *SitemapCache) Delete(ctx context.Context, file int) error {
+	if err := c.db.Del(ctx, c.key(file)).Err(); err != nil {
+		return fmt.Errorf("delete key: %w", err)
+	}
+
+	return nil
+}
+
+func (c *SitemapCache) key(file int) string {
+	if file == 0 {
+		return sitemapCacheKeyPrefix + "index"
+	}
+
+	return sitemapCacheKeyPrefix + "articles:" + strconv.Itoa(file)
+}
@@ -0,0 +1,167 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+)
+
+const timelineCacheKeyPrefix = "timeline:"
+
+// timelineGetScript returns a page of the timeline after the cursor entry, or nil if there is no timeline.
+// A cursor entry removed from the timeline in the meantime is found by its score.
+var timelineGetScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return false
+end
+redis.call("EXPIRE", KEYS[1], ARGV[1])
+local limit = tonumber(ARGV[2])
+if ARGV[3] == "" then
+	return redis.call("ZREVRANGE", KEYS[1], 0, limit - 1, "WITHSCORES")
+end
+local rank = redis.call("ZREVRANK", KEYS[1], ARGV[3])
+if not rank then
+	return redis.call("ZREVRANGEBYSCORE", KEYS[1], "(" .. ARGV[4], "-inf", "WITHSCORES", "LIMIT", 0, limit)
+end
+return redis.call("ZREVRANGE", KEYS[1], rank + 1, rank + limit, "WITHSCORES")
+`)
+
+// timelineAddScript adds entries to the timeline only if there is one, since missing timelines are rebuilt as a whole.
+var timelineAddScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+for i = 2, #ARGV, 2 do
+	redis.call("ZADD", KEYS[1], ARGV[i], ARGV[i + 1])
+end
+redis.call("ZREMRANGEBYRANK", KEYS[1], 0, -tonumber(ARGV[1]) - 1)
+return 1
+`)
+
+// TimelineCache keeps home timelines of users as sorted sets of article IDs scored by the creation time.
+// Timelines are capped at the size and expire when their users stop reading them.
+type TimelineCache struct {
+	db           *redis.Client
+	size         int
+	cacheTimeout time.Duration
+}
+
+func NewTimelineCache(db *redis.Client, size int, cacheTimeout time.Duration) *TimelineCache {
+	return &TimelineCache{
+		db:           db,
+		size:         size,
+		cacheTimeout: cacheTimeout,
+	}
+}
+
+// Get returns a page of the timeline after the cursor entry, it fails with ErrNoCache if there is no timeline.
+func (c *TimelineCache) Get(ctx context.Context, userID string, cursor *dto.TimelineEntry, limit int) ([]dto.TimelineEntry, error) {
+	var cursorID, cursorScore string
+	if cursor != nil {
+		cursorID = cursor.ArticleID
+		cursorScore = timelineScore(cursor.CreatedAt)
+	}
+
+	args := []any{int(c.cacheTimeout.Seconds()), limit, cursorID, cursorScore}
+	res, err := timelineGetScript.Run(ctx, c.db, []string{c.key(userID)}, args...).StringSlice()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, apperrors.ErrNoCache
+		}
+
+		return nil, fmt.Errorf("execute script: %w", err)
+	}
+
+	entries := make([]dto.TimelineEntry, 0, len(res)/2)
+	for i := 0; i+1 < len(res); i += 2 {
+		// scores may come in the exponent notation
+		score, err := strconv.ParseFloat(res[i+1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse score: %w", err)
+		}
+
+		entries = append(entries, dto.TimelineEntry{
+			ArticleID: res[i],
+			CreatedAt: time.UnixMicro(int64(score)).UTC(),
+		})
+	}
+
+	return entries, nil
+}
+
+// Save replaces the timeline of the user with the entries. Nothing is saved for no entries.
+func (c *TimelineCache) Save(ctx context.Context, userID string, entries []dto.TimelineEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	members := make([]redis.Z, 0, len(entries))
+	for _, entry := range entries {
+		members = append(members, redis.Z{Score: float64(entry.CreatedAt.UnixMicro()), Member: entry.ArticleID})
+	}
+
+	key := c.key(userID)
+	_, err := c.db.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.ZAdd(ctx, key, members...)
+		pipe.ZRemRangeByRank(ctx, key, 0, int64(-c.size-1))
+		pipe.Expire(ctx, key, c.cacheTimeout)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("execute pipeline: %w", err)
+	}
+
+	return nil
+}
+
+// Add adds the entries to existing timelines of the users.
+func (c *TimelineCache) Add(ctx context.Context, userIDs []string, entries []dto.TimelineEntry) error {
+	args := make([]any, 0, len(entries)*2+1)
+	args = append(args, c.size)
+	for _, entry := range entries {
+		args = append(args, timelineScore(entry.CreatedAt), entry.ArticleID)
+	}
+
+	_, err := c.db.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, userID := range userIDs {
+			// scripts are not loaded in pipelines on demand, so they are sent as a whole
+			timelineAddScript.Eval(ctx, pipe, []string{c.key(userID)}, args...)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("execute pipeline: %w", err)
+	}
+
+	return nil
+}
+
+// Remove removes the articles from the timeline of the user.
+func (c *TimelineCache) Remove(ctx context.Context, userID string, articleIDs []string) error {
+	if len(articleIDs) == 0 {
+		return nil
+	}
+
+	if err := c.db.ZRem(ctx, c.key(userID), toAnySlice(articleIDs)...).Err(); err != nil {
+		return fmt.Errorf("execute command: %w", err)
+	}
+
+	return nil
+}
+
+func (c *TimelineCache) key(userID string) string {
+	return timelineCacheKeyPrefix + userID
+}
+
+// timelineScore is the creation time in microseconds, which is the precision of timestamps in postgres
+// and fits into the float64 score without rounding.
+func timelineScore(createdAt time.Time) string {
+	return strconv.FormatInt(createdAt.UnixMicro(), 10)
+}
@@ -0,0 +1,194 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+const (
+	amzDateFormat = "20060102T150405Z"
+	// emptyPayloadHash is the SHA-256 of the empty body of GET and DELETE requests.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+type BlobStorageConfig struct {
+	// Endpoint is the URL of the S3-compatible service, buckets are addressed in the path.
+	Endpoint  url.URL
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// BlobStorage keeps blobs as objects of an S3-compatible service, requests are signed with AWS Signature V4.
+type BlobStorage struct {
+	config BlobStorageConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewBlobStorage(config BlobStorageConfig, client *http.Client) *BlobStorage {
+	return &BlobStorage{
+		config: config,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+func (s *BlobStorage) Put(ctx context.Context, key, contentType string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	hash := sha256.Sum256(data)
+
+	res, err := s.do(req, hex.EncodeToString(hash[:]))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return unexpectedStatus(res)
+	}
+
+	return nil
+}
+
+// Get opens the object, nil is returned if there is no object with the key.
+func (s *BlobStorage) Get(ctx context.Context, key string) (*dto.Blob, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	res, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return &dto.Blob{
+			Body:        res.Body,
+			ContentType: res.Header.Get("Content-Type"),
+			Size:        res.ContentLength,
+		}, nil
+	case http.StatusNotFound:
+		res.Body.Close()
+		return nil, nil
+	default:
+		defer res.Body.Close()
+		return nil, unexpectedStatus(res)
+	}
+}
+
+// Delete removes the object, deleting a missing object is a no-op.
+func (s *BlobStorage) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	res, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return unexpectedStatus(res)
+	}
+}
+
+func (s *BlobStorage) objectURL(key string) string {
+	return s.config.Endpoint.JoinPath(s.config.Bucket, key).String()
+}
+
+func (s *BlobStorage) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	sign(req, payloadHash, s.config, s.now())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+
+	return res, nil
+}
+
+func unexpectedStatus(res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+	return fmt.Errorf("unexpected response status %d: %s", res.StatusCode, body)
+}
+
+// sign adds the AWS Signature V4 authorization to the request, the host and all set headers are signed.
+func sign(req *http.Request, payloadHash string, config BlobStorageConfig, now time.Time) {
+	amzDate := now.UTC().Format(amzDateFormat)
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	canonicalHeaders := &strings.Builder{}
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + config.Region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+config.SecretKey), date)
+	key = hmacSHA256(key, config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		config.AccessKey, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -20,7 +20,7 @@ type authService interface {
 
 type request struct {
 	DisplayName string `json:"displayName" validate:"required,lte=255"`
-	NickName    string `json:"nickName"  validate:"required,lte=255"`
+	NickName    string `json:"nickName" validate:"required,nickname"`
 	Email       string `json:"email" validate:"required,email,lte=255"`
 	Password    string `json:"password" validate:"required,lte=32"`
 }
@@ -60,7 +60,7 @@ func (h *Handler) Handle(ctx http.Context) {
 	switch {
 	case err == nil:
 		util.Respond(ctx, nethttp.StatusOK, struct{}{})
-	case errors.Is(err, apperrors.ErrEmailAlreadyTaken):
+	case errors.Is(err, apperrors.ErrEmailAlreadyTaken), errors.Is(err, apperrors.ErrNickNameAlreadyTaken):
 		util.RespondBadRequest(ctx, err.Error())
 	default:
 		h.logger.Error().Err(err).Msg("signup error on auth service")
@@ -14,6 +14,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
 	mockhttp "github.com/art-es/yet-another-service/internal/core/http/mock"
 	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
 	"github.com/art-es/yet-another-service/internal/driver/zerolog"
@@ -52,10 +53,10 @@ func TestHandler(t *testing.T) {
 		{
 			name: "validation error",
 			setup: func(authSvc *mock.MockauthService, validator *mockvalidation.MockValidator, req *http.Request) {
-				reqBody := `{"name": "dummyName", "email": "dummy@example.com", "password": "dummy123"}`
+				reqBody := `{"displayName": "dummyName", "nickName": "dummy_nick", "email": "dummy@example.com", "password": "dummy123"}`
 				req.Body = io.NopCloser(strings.NewReader(reqBody))
 
-				expParsedReq := &request{DisplayName: "dummyName", Email: "dummy@example.com", Password: "dummy123"}
+				expParsedReq := &request{DisplayName: "dummyName", NickName: "dummy_nick", Email: "dummy@example.com", Password: "dummy123"}
 				validator.EXPECT().
 					Struct(gomock.Eq(expParsedReq)).
 					Return(errors.New("dummy validation error"))
@@ -71,15 +72,15 @@ func TestHandler(t *testing.T) {
 		{
 			name: "auth service error",
 			setup: func(authSvc *mock.MockauthService, validator *mockvalidation.MockValidator, req *http.Request) {
-				reqBody := `{"name": "dummyName", "email": "dummy@example.com", "password": "dummy123"}`
+				reqBody := `{"displayName": "dummyName", "nickName": "dummy_nick", "email": "dummy@example.com", "password": "dummy123"}`
 				req.Body = io.NopCloser(strings.NewReader(reqBody))
 
-				expParsedReq := &request{DisplayName: "dummyName", Email: "dummy@example.com", Password: "dummy123"}
+				expParsedReq := &request{DisplayName: "dummyName", NickName: "dummy_nick", Email: "dummy@example.com", Password: "dummy123"}
 				validator.EXPECT().
 					Struct(gomock.Eq(expParsedReq)).
 					Return(nil)
 
-				expAuthReq := &dto.SignupIn{DisplayName: "dummyName", Email: "dummy@example.com", Password: "dummy123"}
+				expAuthReq := &dto.SignupIn{DisplayName: "dummyName", NickName: "dummy_nick", Email: "dummy@example.com", Password: "dummy123"}
 				authSvc.EXPECT().
 					Signup(gomock.Any(), gomock.Eq(expAuthReq)).
 					Return(errors.New("auth service dummy error"))
@@ -94,18 +95,40 @@ func TestHandler(t *testing.T) {
 				assert.JSONEq(t, expErrorLog, logs[0])
 			},
 		},
+		{
+			name: "nickname is already taken",
+			setup: func(authSvc *mock.MockauthService, validator *mockvalidation.MockValidator, req *http.Request) {
+				reqBody := `{"displayName": "dummyName", "nickName": "dummy_nick", "email": "dummy@example.com", "password": "dummy123"}`
+				req.Body = io.NopCloser(strings.NewReader(reqBody))
+
+				validator.EXPECT().
+					Struct(gomock.Any()).
+					Return(nil)
+
+				authSvc.EXPECT().
+					Signup(gomock.Any(), gomock.Any()).
+					Return(apperrors.ErrNickNameAlreadyTaken)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				expResBody := `{"message": "nickname is already taken"}`
+				assert.JSONEq(t, expResBody, res.Body.String())
+
+				assert.Len(t, logs, 0)
+			},
+		},
 		{
 			name: "ok",
 			setup: func(authSvc *mock.MockauthService, validator *mockvalidation.MockValidator, req *http.Request) {
-				reqBody := `{"name": "dummyName", "email": "dummy@example.com", "password": "dummy123"}`
+				reqBody := `{"displayName": "dummyName", "nickName": "dummy_nick", "email": "dummy@example.com", "password": "dummy123"}`
 				req.Body = io.NopCloser(strings.NewReader(reqBody))
 
-				expParsedReq := &request{DisplayName: "dummyName", Email: "dummy@example.com", Password: "dummy123"}
+				expParsedReq := &request{DisplayName: "dummyName", NickName: "dummy_nick", Email: "dummy@example.com", Password: "dummy123"}
 				validator.EXPECT().
 					Struct(gomock.Eq(expParsedReq)).
 					Return(nil)
 
-				expAuthReq := &dto.SignupIn{DisplayName: "dummyName", Email: "dummy@example.com", Password: "dummy123"}
+				expAuthReq := &dto.SignupIn{DisplayName: "dummyName", NickName: "dummy_nick", Email: "dummy@example.com", Password: "dummy123"}
 				authSvc.EXPECT().
 					Signup(gomock.Any(), gomock.Eq(expAuthReq)).
 					Return(nil)
@@ -0,0 +1,103 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package article_author_create
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type coAuthorService interface {
+	Invite(ctx context.Context, in *dto.InviteArticleAuthorIn) (*dto.ArticleCoAuthor, error)
+}
+
+type request struct {
+	NickName string `json:"nickName" validate:"required"`
+	Role     string `json:"role" validate:"required,oneof=owner contributor"`
+}
+
+type response struct {
+	NickName  string    `json:"nickName"`
+	Role      string    `json:"role"`
+	InvitedAt time.Time `json:"invitedAt"`
+}
+
+type Handler struct {
+	coAuthorService coAuthorService
+	logger          log.Logger
+	validator       validation.Validator
+}
+
+func NewHandler(
+	coAuthorService coAuthorService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		coAuthorService: coAuthorService,
+		logger:          logger,
+		validator:       validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.coAuthorService.Invite(ctx, &dto.InviteArticleAuthorIn{
+		UserID:      userID,
+		ArticleSlug: ctx.Request().PathValue("slug"),
+		NickName:    req.NickName,
+		Role:        req.Role,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusCreated, response{
+			NickName:  out.NickName,
+			Role:      out.Role,
+			InvitedAt: out.InvitedAt,
+		})
+	case errors.Is(err, apperrors.ErrArticleNotFound), errors.Is(err, apperrors.ErrAuthorNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	case errors.Is(err, apperrors.ErrArticleAuthorExists):
+		util.RespondBadRequest(ctx, "The user is already an author of the article.")
+	default:
+		h.logger.Error().Err(err).Msg("invite error on co-author service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
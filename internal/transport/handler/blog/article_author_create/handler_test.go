@@ -0,0 +1,140 @@
+package article_author_create
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/article_author_create/mock"
+)
+
+func TestHandler(t *testing.T) {
+	expectedIn := &dto.InviteArticleAuthorIn{UserID: "user id", ArticleSlug: "foo", NickName: "carol", Role: "contributor"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "validation error",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{NickName: "carol", Role: "contributor"})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				coAuthorSvc.EXPECT().Invite(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "author not found",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				coAuthorSvc.EXPECT().Invite(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrAuthorNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				coAuthorSvc.EXPECT().Invite(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "author exists",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				coAuthorSvc.EXPECT().Invite(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrArticleAuthorExists)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "The user is already an author of the article."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "co-author service error",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				coAuthorSvc.EXPECT().Invite(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"invite error on co-author service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				coAuthorSvc.EXPECT().
+					Invite(gomock.Any(), gomock.Eq(expectedIn)).
+					Return(&dto.ArticleCoAuthor{
+						NickName:        "carol",
+						Role:            "contributor",
+						InvitationToken: "token",
+						InvitedAt:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.JSONEq(t, `{"nickName": "carol", "role": "contributor", "invitedAt": "2024-01-01T00:00:00Z"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			coAuthorSvc := mock.NewMockcoAuthorService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo")
+			req.Body = io.NopCloser(strings.NewReader(`{"nickName": "carol", "role": "contributor"}`))
+
+			tt.setup(coAuthorSvc, validator)
+
+			handler := NewHandler(coAuthorSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockcoAuthorService is a mock of coAuthorService interface.
+type MockcoAuthorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoAuthorServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockcoAuthorServiceMockRecorder is the mock recorder for MockcoAuthorService.
+type MockcoAuthorServiceMockRecorder struct {
+	mock *MockcoAuthorService
+}
+
+// NewMockcoAuthorService creates a new mock instance.
+func NewMockcoAuthorService(ctrl *gomock.Controller) *MockcoAuthorService {
+	mock := &MockcoAuthorService{ctrl: ctrl}
+	mock.recorder = &MockcoAuthorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoAuthorService) EXPECT() *MockcoAuthorServiceMockRecorder {
+	return m.recorder
+}
+
+// Invite mocks base method.
+func (m *MockcoAuthorService) Invite(ctx context.Context, in *dto.InviteArticleAuthorIn) (*dto.ArticleCoAuthor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, in)
+	ret0, _ := ret[0].(*dto.ArticleCoAuthor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockcoAuthorServiceMockRecorder) Invite(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockcoAuthorService)(nil).Invite), ctx, in)
+}
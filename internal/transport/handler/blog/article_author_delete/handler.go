@@ -0,0 +1,59 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package article_author_delete
+
+import (
+	"context"
+	"errors"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type coAuthorService interface {
+	Remove(ctx context.Context, in *dto.ArticleAuthorIn) error
+}
+
+type Handler struct {
+	coAuthorService coAuthorService
+	logger          log.Logger
+}
+
+func NewHandler(
+	coAuthorService coAuthorService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		coAuthorService: coAuthorService,
+		logger:          logger,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	err := h.coAuthorService.Remove(ctx, &dto.ArticleAuthorIn{
+		UserID:      userID,
+		ArticleSlug: ctx.Request().PathValue("slug"),
+		NickName:    ctx.Request().PathValue("nickname"),
+	})
+
+	switch {
+	case err == nil:
+		util.RespondNoContent(ctx)
+	case errors.Is(err, apperrors.ErrArticleNotFound), errors.Is(err, apperrors.ErrArticleAuthorNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("remove error on co-author service")
+		util.RespondInternalError(ctx)
+	}
+}
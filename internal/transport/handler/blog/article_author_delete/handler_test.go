@@ -0,0 +1,99 @@
+package article_author_delete
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/article_author_delete/mock"
+)
+
+func TestHandler(t *testing.T) {
+	expectedIn := &dto.ArticleAuthorIn{UserID: "user id", ArticleSlug: "foo", NickName: "carol"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(coAuthorSvc *mock.MockcoAuthorService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "article not found",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService) {
+				coAuthorSvc.EXPECT().Remove(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "author not found",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService) {
+				coAuthorSvc.EXPECT().Remove(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrArticleAuthorNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService) {
+				coAuthorSvc.EXPECT().Remove(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.JSONEq(t, `{"message": "Forbidden."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "co-author service error",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService) {
+				coAuthorSvc.EXPECT().Remove(gomock.Any(), gomock.Eq(expectedIn)).Return(errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"remove error on co-author service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService) {
+				coAuthorSvc.EXPECT().Remove(gomock.Any(), gomock.Eq(expectedIn)).Return(nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNoContent, res.Code)
+				assert.Empty(t, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			coAuthorSvc := mock.NewMockcoAuthorService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo")
+			req.SetPathValue("nickname", "carol")
+
+			tt.setup(coAuthorSvc)
+
+			handler := NewHandler(coAuthorSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockcoAuthorService is a mock of coAuthorService interface.
+type MockcoAuthorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockcoAuthorServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockcoAuthorServiceMockRecorder is the mock recorder for MockcoAuthorService.
+type MockcoAuthorServiceMockRecorder struct {
+	mock *MockcoAuthorService
+}
+
+// NewMockcoAuthorService creates a new mock instance.
+func NewMockcoAuthorService(ctrl *gomock.Controller) *MockcoAuthorService {
+	mock := &MockcoAuthorService{ctrl: ctrl}
+	mock.recorder = &MockcoAuthorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcoAuthorService) EXPECT() *MockcoAuthorServiceMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockcoAuthorService) Remove(ctx context.Context, in *dto.ArticleAuthorIn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockcoAuthorServiceMockRecorder) Remove(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockcoAuthorService)(nil).Remove), ctx, in)
+}
@@ -0,0 +1,82 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package article_authors_get
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type coAuthorService interface {
+	Get(ctx context.Context, articleSlug, userID string) ([]*dto.ArticleCoAuthor, error)
+}
+
+type response struct {
+	Authors []author `json:"authors"`
+}
+
+type author struct {
+	NickName   string     `json:"nickName"`
+	Role       string     `json:"role"`
+	InvitedAt  time.Time  `json:"invitedAt"`
+	AcceptedAt *time.Time `json:"acceptedAt"`
+}
+
+type Handler struct {
+	coAuthorService coAuthorService
+	logger          log.Logger
+}
+
+func NewHandler(
+	coAuthorService coAuthorService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		coAuthorService: coAuthorService,
+		logger:          logger,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	coAuthors, err := h.coAuthorService.Get(ctx, ctx.Request().PathValue("slug"), userID)
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusOK, convertResponse(coAuthors))
+	case errors.Is(err, apperrors.ErrArticleNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get error on co-author service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func convertResponse(coAuthors []*dto.ArticleCoAuthor) response {
+	res := response{Authors: make([]author, 0, len(coAuthors))}
+	for _, a := range coAuthors {
+		res.Authors = append(res.Authors, author{
+			NickName:   a.NickName,
+			Role:       a.Role,
+			InvitedAt:  a.InvitedAt,
+			AcceptedAt: a.AcceptedAt,
+		})
+	}
+
+	return res
+}
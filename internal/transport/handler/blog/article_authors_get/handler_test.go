@@ -0,0 +1,98 @@
+package article_authors_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/article_authors_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	invited := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	accepted := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(coAuthorSvc *mock.MockcoAuthorService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "article not found",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService) {
+				coAuthorSvc.EXPECT().Get(gomock.Any(), gomock.Eq("foo"), gomock.Eq("user id")).Return(nil, apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService) {
+				coAuthorSvc.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "co-author service error",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService) {
+				coAuthorSvc.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"get error on co-author service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService) {
+				coAuthorSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq("foo"), gomock.Eq("user id")).
+					Return([]*dto.ArticleCoAuthor{
+						{NickName: "alice", Role: dto.ArticleAuthorRoleOwner, InvitedAt: invited, AcceptedAt: &accepted},
+						{NickName: "carol", Role: dto.ArticleAuthorRoleContributor, InvitationToken: "token", InvitedAt: invited},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				expResBody := `{"authors": [
+					{"nickName": "alice", "role": "owner", "invitedAt": "2024-01-01T00:00:00Z", "acceptedAt": "2024-01-02T00:00:00Z"},
+					{"nickName": "carol", "role": "contributor", "invitedAt": "2024-01-01T00:00:00Z", "acceptedAt": null}
+				]}`
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			coAuthorSvc := mock.NewMockcoAuthorService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo")
+
+			tt.setup(coAuthorSvc)
+
+			handler := NewHandler(coAuthorSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
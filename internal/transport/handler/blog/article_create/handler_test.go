@@ -0,0 +1,146 @@
+package article_create
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/article_create/mock"
+)
+
+func TestHandler(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "validation error",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{
+						Slug:       "foo-article",
+						Title:      "Foo",
+						Content:    "foo content",
+						Tags:       []string{"go"},
+						SEO:        seo{MetaDescription: "Foo description", OGImage: "https://example.com/foo.png"},
+						Visibility: "members",
+						Locale:     "pt-br",
+					})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "slug taken",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrArticleSlugTaken)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "article slug is already taken"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "editor service error",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"create error on editor service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					Create(gomock.Any(), gomock.Eq(&dto.CreateArticleIn{
+						UserID:     "user id",
+						Slug:       "foo-article",
+						Title:      "Foo",
+						Content:    "foo content",
+						Tags:       []string{"go"},
+						SEO:        dto.ArticleSEO{MetaDescription: "Foo description", OGImageURL: "https://example.com/foo.png"},
+						Visibility: dto.ArticleVisibilityMembers,
+						Locale:     "pt-br",
+					})).
+					Return(&dto.Article{
+						ID:         "article id",
+						Slug:       "foo-article",
+						Title:      "Foo",
+						Content:    "foo content",
+						Tags:       []string{"go"},
+						SEO:        dto.ArticleSEO{MetaDescription: "Foo description", OGImageURL: "https://example.com/foo.png"},
+						Visibility: dto.ArticleVisibilityMembers,
+						Locale:     "pt-BR",
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				expResBody := `{
+					"id": "article id",
+					"slug": "foo-article",
+					"title": "Foo",
+					"content": "foo content",
+					"tags": ["go"],
+					"seo": {"metaDescription": "Foo description", "ogImage": "https://example.com/foo.png", "canonicalUrl": ""},
+					"visibility": "members",
+					"locale": "pt-BR"
+				}`
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			editorSvc := mock.NewMockeditorService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.Body = io.NopCloser(strings.NewReader(`{
+				"slug": "foo-article",
+				"title": "Foo",
+				"content": "foo content",
+				"tags": ["go"],
+				"seo": {"metaDescription": "Foo description", "ogImage": "https://example.com/foo.png"},
+				"visibility": "members",
+				"locale": "pt-br"
+			}`))
+
+			tt.setup(editorSvc, validator)
+
+			handler := NewHandler(editorSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,224 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package article_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/locale"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type articleService interface {
+	Find(ctx context.Context, in *dto.FindArticleIn) (*dto.Article, error)
+}
+
+type response struct {
+	Slug          string           `json:"slug"`
+	Title         string           `json:"title"`
+	Content       string           `json:"content"`
+	ContentHTML   string           `json:"contentHtml"`
+	TOC           []tocEntry       `json:"toc"`
+	WordCount     int              `json:"wordCount"`
+	ReadingTime   int              `json:"readingTime"`
+	Visibility    string           `json:"visibility"`
+	Locked        bool             `json:"locked"`
+	Locale        string           `json:"locale"`
+	Tags          []string         `json:"tags"`
+	Translations  []translation    `json:"translations,omitempty"`
+	Author        *author          `json:"author,omitempty"`
+	Authors       []author         `json:"authors,omitempty"`
+	Series        *series          `json:"series,omitempty"`
+	CommentsCount int              `json:"commentsCount"`
+	ViewsCount    int64            `json:"viewsCount"`
+	Reactions     map[string]int64 `json:"reactions"`
+	OwnReactions  []string         `json:"ownReactions,omitempty"`
+	SEO           seo              `json:"seo"`
+	CreatedAt     time.Time        `json:"createdAt"`
+	UpdatedAt     *time.Time       `json:"updatedAt"`
+}
+
+type tocEntry struct {
+	Level  int    `json:"level"`
+	Anchor string `json:"anchor"`
+	Title  string `json:"title"`
+}
+
+type author struct {
+	NickName    string `json:"nickName"`
+	DisplayName string `json:"displayName"`
+	Role        string `json:"role,omitempty"`
+}
+
+type translation struct {
+	Locale string `json:"locale"`
+	Slug   string `json:"slug"`
+	Title  string `json:"title"`
+}
+
+type series struct {
+	ID            string         `json:"id"`
+	Title         string         `json:"title"`
+	Position      int            `json:"position"`
+	ArticlesCount int            `json:"articlesCount"`
+	Prev          *seriesArticle `json:"prev"`
+	Next          *seriesArticle `json:"next"`
+}
+
+type seriesArticle struct {
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+}
+
+type seo struct {
+	MetaDescription string `json:"metaDescription"`
+	OGImage         string `json:"ogImage"`
+	CanonicalURL    string `json:"canonicalUrl"`
+}
+
+type Handler struct {
+	articleService articleService
+	logger         log.Logger
+}
+
+func NewHandler(
+	articleService articleService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		articleService: articleService,
+		logger:         logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	req := ctx.Request()
+
+	var languages []string
+	if lang := req.URL.Query().Get("lang"); lang != "" {
+		canonical, err := locale.Canonical(lang)
+		if err != nil {
+			corehttputil.RespondBadRequest(ctx, "lang must be a BCP 47 language tag")
+			return
+		}
+
+		languages = append(languages, canonical)
+	}
+
+	languages = append(languages, locale.ParseAcceptLanguage(req.Header.Get("Accept-Language"))...)
+	userID, _ := contextcore.UserID(ctx)
+
+	out, err := h.articleService.Find(ctx, &dto.FindArticleIn{
+		Slug: req.PathValue("slug"),
+		Visitor: dto.Visitor{
+			UserID:    userID,
+			ClientIP:  corehttputil.GetClientIP(ctx),
+			UserAgent: req.UserAgent(),
+		},
+		Languages: languages,
+	})
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrArticleNotFound):
+		corehttputil.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("find error on article service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
+
+func convertResponse(in *dto.Article) response {
+	out := response{
+		Slug:          in.Slug,
+		Title:         in.Title,
+		Content:       in.Content,
+		ContentHTML:   in.ContentHTML,
+		TOC:           make([]tocEntry, 0, len(in.TOC)),
+		WordCount:     in.WordCount,
+		ReadingTime:   in.ReadingTime,
+		Visibility:    in.Visibility,
+		Locked:        in.Locked,
+		Locale:        in.Locale,
+		Tags:          in.Tags,
+		CommentsCount: in.CommentsCount,
+		ViewsCount:    in.ViewsCount,
+		Reactions:     in.ReactionCounts,
+		OwnReactions:  in.OwnReactions,
+		SEO: seo{
+			MetaDescription: in.SEO.MetaDescription,
+			OGImage:         in.SEO.OGImageURL,
+			CanonicalURL:    in.SEO.CanonicalURL,
+		},
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+	}
+
+	for _, e := range in.TOC {
+		out.TOC = append(out.TOC, tocEntry{
+			Level:  e.Level,
+			Anchor: e.Anchor,
+			Title:  e.Title,
+		})
+	}
+
+	if out.Tags == nil {
+		out.Tags = []string{}
+	}
+
+	if out.Reactions == nil {
+		out.Reactions = map[string]int64{}
+	}
+
+	if in.Author != nil {
+		out.Author = &author{
+			NickName:    in.Author.NickName,
+			DisplayName: in.Author.DisplayName,
+		}
+	}
+
+	for _, a := range in.Authors {
+		out.Authors = append(out.Authors, author{
+			NickName:    a.NickName,
+			DisplayName: a.DisplayName,
+			Role:        a.Role,
+		})
+	}
+
+	for _, a := range in.Alternates {
+		out.Translations = append(out.Translations, translation{
+			Locale: a.Locale,
+			Slug:   a.Slug,
+			Title:  a.Title,
+		})
+	}
+
+	if in.Series != nil {
+		out.Series = &series{
+			ID:            in.Series.ID,
+			Title:         in.Series.Title,
+			Position:      in.Series.Position,
+			ArticlesCount: in.Series.ArticlesCount,
+			Prev:          convertSeriesArticle(in.Series.Prev),
+			Next:          convertSeriesArticle(in.Series.Next),
+		}
+	}
+
+	return out
+}
+
+func convertSeriesArticle(in *dto.SeriesNeighbour) *seriesArticle {
+	if in == nil {
+		return nil
+	}
+
+	return &seriesArticle{Slug: in.Slug, Title: in.Title}
+}
@@ -0,0 +1,203 @@
+package article_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockhttp "github.com/art-es/yet-another-service/internal/core/http/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/article_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "article not found",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				ctx.EXPECT().Value(gomock.Any()).Return(nil).AnyTimes()
+				articleSvc.EXPECT().Find(gomock.Any(), gomock.Any()).Return(nil, apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "article service error",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				ctx.EXPECT().Value(gomock.Any()).Return(nil).AnyTimes()
+				articleSvc.EXPECT().Find(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, []string{`{"level":"error","error":"dummy error","message":"find error on article service"}`}, logs)
+			},
+		},
+		{
+			name: "invalid lang",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				req.URL.RawQuery = "lang=en--US"
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "lang must be a BCP 47 language tag"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "anonymous",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				ctx.EXPECT().Value(gomock.Any()).Return(nil).AnyTimes()
+				articleSvc.EXPECT().
+					Find(gomock.Any(), gomock.Eq(&dto.FindArticleIn{
+						Slug:    "foo-article",
+						Visitor: dto.Visitor{ClientIP: "192.0.2.1", UserAgent: "curl/8.0"},
+					})).
+					Return(&dto.Article{Slug: "foo-article", Title: "Foo"}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, `{
+					"slug": "foo-article",
+					"title": "Foo",
+					"content": "",
+					"contentHtml": "",
+					"toc": [],
+					"wordCount": 0,
+					"readingTime": 0,
+					"visibility": "",
+					"locked": false,
+					"locale": "",
+					"tags": [],
+					"commentsCount": 0,
+					"viewsCount": 0,
+					"reactions": {},
+					"seo": {"metaDescription": "", "ogImage": "", "canonicalUrl": ""},
+					"createdAt": "0001-01-01T00:00:00Z",
+					"updatedAt": null
+				}`, res.Body.String())
+			},
+		},
+		{
+			name: "ok",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+				req.URL.RawQuery = "lang=pt-br"
+				req.Header.Set("Accept-Language", "de-AT, en;q=0.5")
+				articleSvc.EXPECT().
+					Find(gomock.Any(), gomock.Eq(&dto.FindArticleIn{
+						Slug:      "foo-article",
+						Visitor:   dto.Visitor{UserID: "user id", ClientIP: "192.0.2.1", UserAgent: "curl/8.0"},
+						Languages: []string{"pt-BR", "de-AT", "en"},
+					})).
+					Return(&dto.Article{
+						Slug:        "foo-article",
+						Title:       "Foo",
+						Content:     "# Foo",
+						ContentHTML: `<h1 id="foo">Foo</h1>`,
+						TOC:         []dto.TOCEntry{{Level: 1, Anchor: "foo", Title: "Foo"}},
+						WordCount:   1,
+						ReadingTime: 1,
+						Visibility:  dto.ArticleVisibilityUsers,
+						Locale:      "en",
+						Tags:        []string{"go", "sql"},
+						Alternates: []dto.ArticleAlternate{
+							{Locale: "en", Slug: "foo-article", Title: "Foo"},
+							{Locale: "de", Slug: "foo-artikel", Title: "Foo (de)"},
+						},
+						CommentsCount: 2,
+						ViewsCount:    10,
+						SEO:           dto.ArticleSEO{MetaDescription: "Foo description", CanonicalURL: "https://example.com/articles/foo-article"},
+						CreatedAt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:     &updatedAt,
+						Author:        &dto.ArticleAuthor{DisplayName: "Bob", NickName: "bob123"},
+						Authors: []dto.ArticleAuthor{
+							{DisplayName: "Bob", NickName: "bob123", Role: dto.ArticleAuthorRoleOwner},
+							{DisplayName: "Alice", NickName: "alice", Role: dto.ArticleAuthorRoleContributor},
+						},
+						Series: &dto.ArticleSeries{
+							ID:            "series id",
+							Title:         "Go",
+							Position:      2,
+							ArticlesCount: 2,
+							Prev:          &dto.SeriesNeighbour{Slug: "bar-article", Title: "Bar"},
+						},
+						ReactionCounts: map[string]int64{"like": 3},
+						OwnReactions:   []string{"like"},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, `{
+					"slug": "foo-article",
+					"title": "Foo",
+					"content": "# Foo",
+					"contentHtml": "<h1 id=\"foo\">Foo</h1>",
+					"toc": [{"level": 1, "anchor": "foo", "title": "Foo"}],
+					"wordCount": 1,
+					"readingTime": 1,
+					"visibility": "users",
+					"locked": false,
+					"locale": "en",
+					"tags": ["go", "sql"],
+					"translations": [
+						{"locale": "en", "slug": "foo-article", "title": "Foo"},
+						{"locale": "de", "slug": "foo-artikel", "title": "Foo (de)"}
+					],
+					"author": {"nickName": "bob123", "displayName": "Bob"},
+					"authors": [
+						{"nickName": "bob123", "displayName": "Bob", "role": "owner"},
+						{"nickName": "alice", "displayName": "Alice", "role": "contributor"}
+					],
+					"series": {
+						"id": "series id",
+						"title": "Go",
+						"position": 2,
+						"articlesCount": 2,
+						"prev": {"slug": "bar-article", "title": "Bar"},
+						"next": null
+					},
+					"commentsCount": 2,
+					"viewsCount": 10,
+					"reactions": {"like": 3},
+					"ownReactions": ["like"],
+					"seo": {"metaDescription": "Foo description", "ogImage": "", "canonicalUrl": "https://example.com/articles/foo-article"},
+					"createdAt": "2024-01-01T00:00:00Z",
+					"updatedAt": "2024-01-02T00:00:00Z"
+				}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			articleSvc := mock.NewMockarticleService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			req.SetPathValue("slug", "foo-article")
+			req.Header.Set("User-Agent", "curl/8.0")
+
+			tt.setup(ctx, req, articleSvc)
+
+			NewHandler(articleSvc, logger).Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
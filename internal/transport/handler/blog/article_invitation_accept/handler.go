@@ -0,0 +1,93 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package article_invitation_accept
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type coAuthorService interface {
+	Accept(ctx context.Context, in *dto.AcceptArticleAuthorIn) (*dto.ArticleCoAuthor, error)
+}
+
+type request struct {
+	Token string `json:"token" validate:"required,uuid"`
+}
+
+type response struct {
+	ArticleSlug string `json:"articleSlug"`
+	Role        string `json:"role"`
+}
+
+type Handler struct {
+	coAuthorService coAuthorService
+	logger          log.Logger
+	validator       validation.Validator
+}
+
+func NewHandler(
+	coAuthorService coAuthorService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		coAuthorService: coAuthorService,
+		logger:          logger,
+		validator:       validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.coAuthorService.Accept(ctx, &dto.AcceptArticleAuthorIn{
+		UserID: userID,
+		Token:  req.Token,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusOK, response{
+			ArticleSlug: out.ArticleSlug,
+			Role:        out.Role,
+		})
+	case errors.Is(err, apperrors.ErrInvitationNotFound):
+		util.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("accept error on co-author service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
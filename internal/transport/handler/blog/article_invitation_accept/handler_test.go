@@ -0,0 +1,98 @@
+package article_invitation_accept
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/article_invitation_accept/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const token = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+	expectedIn := &dto.AcceptArticleAuthorIn{UserID: "user id", Token: token}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "validation error",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Eq(&request{Token: token})).Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "invitation not found",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				coAuthorSvc.EXPECT().Accept(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrInvitationNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "co-author service error",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				coAuthorSvc.EXPECT().Accept(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"accept error on co-author service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(coAuthorSvc *mock.MockcoAuthorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				coAuthorSvc.EXPECT().
+					Accept(gomock.Any(), gomock.Eq(expectedIn)).
+					Return(&dto.ArticleCoAuthor{ArticleSlug: "foo", Role: "contributor"}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, `{"articleSlug": "foo", "role": "contributor"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			coAuthorSvc := mock.NewMockcoAuthorService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.Body = io.NopCloser(strings.NewReader(`{"token": "18d440f5-2664-42b1-bfaa-1c15f1687885"}`))
+
+			tt.setup(coAuthorSvc, validator)
+
+			handler := NewHandler(coAuthorSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,67 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package article_translation_delete
+
+import (
+	"context"
+	"errors"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/locale"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type editorService interface {
+	DeleteTranslation(ctx context.Context, in *dto.DeleteTranslationIn) error
+}
+
+type Handler struct {
+	editorService editorService
+	logger        log.Logger
+}
+
+func NewHandler(
+	editorService editorService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		editorService: editorService,
+		logger:        logger,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	// a path value which isn't a language tag can't name a translation
+	tag := ctx.Request().PathValue("locale")
+	if _, err := locale.Canonical(tag); err != nil {
+		util.RespondNotFound(ctx)
+		return
+	}
+
+	err := h.editorService.DeleteTranslation(ctx, &dto.DeleteTranslationIn{
+		UserID:      userID,
+		ArticleSlug: ctx.Request().PathValue("slug"),
+		Locale:      tag,
+	})
+
+	switch {
+	case err == nil:
+		util.RespondNoContent(ctx)
+	case errors.Is(err, apperrors.ErrArticleNotFound), errors.Is(err, apperrors.ErrTranslationNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("delete translation error on editor service")
+		util.RespondInternalError(ctx)
+	}
+}
@@ -0,0 +1,114 @@
+package article_translation_delete
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/article_translation_delete/mock"
+)
+
+func TestHandler(t *testing.T) {
+	expectedIn := &dto.DeleteTranslationIn{UserID: "user id", ArticleSlug: "foo", Locale: "pt-BR"}
+
+	for _, tt := range []struct {
+		name   string
+		locale string
+		setup  func(editorSvc *mock.MockeditorService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:   "invalid locale",
+			locale: "en--US",
+			setup:  func(editorSvc *mock.MockeditorService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:   "article not found",
+			locale: "pt-BR",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().DeleteTranslation(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:   "translation not found",
+			locale: "pt-BR",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().DeleteTranslation(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrTranslationNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:   "forbidden",
+			locale: "pt-BR",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().DeleteTranslation(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.JSONEq(t, `{"message": "Forbidden."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:   "editor service error",
+			locale: "pt-BR",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().DeleteTranslation(gomock.Any(), gomock.Eq(expectedIn)).Return(errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"delete translation error on editor service"}`, logs[0])
+			},
+		},
+		{
+			name:   "ok",
+			locale: "pt-BR",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().DeleteTranslation(gomock.Any(), gomock.Eq(expectedIn)).Return(nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNoContent, res.Code)
+				assert.Empty(t, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			editorSvc := mock.NewMockeditorService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo")
+			req.SetPathValue("locale", tt.locale)
+
+			tt.setup(editorSvc)
+
+			handler := NewHandler(editorSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
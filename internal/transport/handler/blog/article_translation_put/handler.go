@@ -0,0 +1,116 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package article_translation_put
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type editorService interface {
+	SaveTranslation(ctx context.Context, in *dto.SaveTranslationIn) (*dto.ArticleTranslation, error)
+}
+
+type request struct {
+	ArticleSlug string `json:"-" validate:"required"`
+	Locale      string `json:"-" validate:"required,bcp47_language_tag"`
+	Slug        string `json:"slug" validate:"required,lte=255,lowercase,excludesall= /?#%"`
+	Title       string `json:"title" validate:"required,lte=255"`
+	Content     string `json:"content" validate:"required"`
+	Status      string `json:"status" validate:"required,oneof=draft published"`
+}
+
+type response struct {
+	Locale  string `json:"locale"`
+	Slug    string `json:"slug"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	Status  string `json:"status"`
+}
+
+type Handler struct {
+	editorService editorService
+	logger        log.Logger
+	validator     validation.Validator
+}
+
+func NewHandler(
+	editorService editorService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		editorService: editorService,
+		logger:        logger,
+		validator:     validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.editorService.SaveTranslation(ctx, &dto.SaveTranslationIn{
+		UserID:      userID,
+		ArticleSlug: req.ArticleSlug,
+		Locale:      req.Locale,
+		Slug:        req.Slug,
+		Title:       req.Title,
+		Content:     req.Content,
+		Status:      req.Status,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusOK, response{
+			Locale:  out.Locale,
+			Slug:    out.Slug,
+			Title:   out.Title,
+			Content: out.Content,
+			Status:  out.Status,
+		})
+	case errors.Is(err, apperrors.ErrArticleSlugTaken), errors.Is(err, apperrors.ErrTranslationLocale):
+		util.RespondBadRequest(ctx, err.Error())
+	case errors.Is(err, apperrors.ErrArticleNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("save translation error on editor service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	req.ArticleSlug = ctx.Request().PathValue("slug")
+	req.Locale = ctx.Request().PathValue("locale")
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,180 @@
+package article_translation_put
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/article_translation_put/mock"
+)
+
+func TestHandler(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "validation error",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{
+						ArticleSlug: "foo-article",
+						Locale:      "pt-BR",
+						Slug:        "foo-artigo",
+						Title:       "Foo",
+						Content:     "conteudo",
+						Status:      "published",
+					})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "slug taken",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					SaveTranslation(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrArticleSlugTaken)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "article slug is already taken"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "locale of the article",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					SaveTranslation(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrTranslationLocale)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "translation locale matches the article locale"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					SaveTranslation(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					SaveTranslation(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.JSONEq(t, `{"message": "Forbidden."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "editor service error",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					SaveTranslation(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"save translation error on editor service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					SaveTranslation(gomock.Any(), gomock.Eq(&dto.SaveTranslationIn{
+						UserID:      "user id",
+						ArticleSlug: "foo-article",
+						Locale:      "pt-BR",
+						Slug:        "foo-artigo",
+						Title:       "Foo",
+						Content:     "conteudo",
+						Status:      dto.TranslationStatusPublished,
+					})).
+					Return(&dto.ArticleTranslation{
+						ID:      "translation id",
+						Locale:  "pt-BR",
+						Slug:    "foo-artigo",
+						Title:   "Foo",
+						Content: "conteudo",
+						Status:  dto.TranslationStatusPublished,
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				expResBody := `{
+					"locale": "pt-BR",
+					"slug": "foo-artigo",
+					"title": "Foo",
+					"content": "conteudo",
+					"status": "published"
+				}`
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			editorSvc := mock.NewMockeditorService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo-article")
+			req.SetPathValue("locale", "pt-BR")
+			req.Body = io.NopCloser(strings.NewReader(`{
+				"slug": "foo-artigo",
+				"title": "Foo",
+				"content": "conteudo",
+				"status": "published"
+			}`))
+
+			tt.setup(editorSvc, validator)
+
+			handler := NewHandler(editorSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,133 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package article_update
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type editorService interface {
+	Update(ctx context.Context, in *dto.UpdateArticleIn) (*dto.Article, error)
+}
+
+type request struct {
+	Slug       string   `json:"-" validate:"required"`
+	Title      string   `json:"title" validate:"required,lte=255"`
+	Content    string   `json:"content" validate:"required"`
+	Tags       []string `json:"tags" validate:"omitempty,dive,required,lte=50"`
+	SEO        seo      `json:"seo"`
+	Visibility string   `json:"visibility" validate:"omitempty,oneof=public users members"`
+}
+
+type seo struct {
+	MetaDescription string `json:"metaDescription" validate:"lte=300"`
+	OGImage         string `json:"ogImage" validate:"omitempty,url,lte=2048"`
+	CanonicalURL    string `json:"canonicalUrl" validate:"omitempty,url,lte=2048"`
+}
+
+type response struct {
+	ID         string   `json:"id"`
+	Slug       string   `json:"slug"`
+	Title      string   `json:"title"`
+	Content    string   `json:"content"`
+	Tags       []string `json:"tags"`
+	SEO        seo      `json:"seo"`
+	Visibility string   `json:"visibility"`
+	Locale     string   `json:"locale"`
+}
+
+type Handler struct {
+	editorService editorService
+	logger        log.Logger
+	validator     validation.Validator
+}
+
+func NewHandler(
+	editorService editorService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		editorService: editorService,
+		logger:        logger,
+		validator:     validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.editorService.Update(ctx, &dto.UpdateArticleIn{
+		Slug:    req.Slug,
+		UserID:  userID,
+		Title:   req.Title,
+		Content: req.Content,
+		Tags:    req.Tags,
+		SEO: dto.ArticleSEO{
+			MetaDescription: req.SEO.MetaDescription,
+			OGImageURL:      req.SEO.OGImage,
+			CanonicalURL:    req.SEO.CanonicalURL,
+		},
+		Visibility: req.Visibility,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusOK, response{
+			ID:      out.ID,
+			Slug:    out.Slug,
+			Title:   out.Title,
+			Content: out.Content,
+			Tags:    out.Tags,
+			SEO: seo{
+				MetaDescription: out.SEO.MetaDescription,
+				OGImage:         out.SEO.OGImageURL,
+				CanonicalURL:    out.SEO.CanonicalURL,
+			},
+			Visibility: out.Visibility,
+			Locale:     out.Locale,
+		})
+	case errors.Is(err, apperrors.ErrArticleNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("update error on editor service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	req.Slug = ctx.Request().PathValue("slug")
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
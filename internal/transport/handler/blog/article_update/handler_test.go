@@ -0,0 +1,151 @@
+package article_update
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/article_update/mock"
+)
+
+func TestHandler(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "validation error",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{
+						Slug:       "foo-article",
+						Title:      "Bar",
+						Content:    "new content",
+						Tags:       []string{"go"},
+						SEO:        seo{CanonicalURL: "https://example.org/bar"},
+						Visibility: "members",
+					})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.JSONEq(t, `{"message": "Forbidden."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "editor service error",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"update error on editor service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(editorSvc *mock.MockeditorService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				editorSvc.EXPECT().
+					Update(gomock.Any(), gomock.Eq(&dto.UpdateArticleIn{
+						Slug:       "foo-article",
+						UserID:     "user id",
+						Title:      "Bar",
+						Content:    "new content",
+						Tags:       []string{"go"},
+						SEO:        dto.ArticleSEO{CanonicalURL: "https://example.org/bar"},
+						Visibility: dto.ArticleVisibilityMembers,
+					})).
+					Return(&dto.Article{
+						ID:         "article id",
+						Slug:       "foo-article",
+						Title:      "Bar",
+						Content:    "new content",
+						Tags:       []string{"go"},
+						SEO:        dto.ArticleSEO{CanonicalURL: "https://example.org/bar"},
+						Visibility: dto.ArticleVisibilityMembers,
+						Locale:     "en",
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				expResBody := `{
+					"id": "article id",
+					"slug": "foo-article",
+					"title": "Bar",
+					"content": "new content",
+					"tags": ["go"],
+					"seo": {"metaDescription": "", "ogImage": "", "canonicalUrl": "https://example.org/bar"},
+					"visibility": "members",
+					"locale": "en"
+				}`
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			editorSvc := mock.NewMockeditorService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo-article")
+			req.Body = io.NopCloser(strings.NewReader(`{"title": "Bar", "content": "new content", "tags": ["go"], "seo": {"canonicalUrl": "https://example.org/bar"}, "visibility": "members"}`))
+
+			tt.setup(editorSvc, validator)
+
+			handler := NewHandler(editorSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockeditorService is a mock of editorService interface.
+type MockeditorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockeditorServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockeditorServiceMockRecorder is the mock recorder for MockeditorService.
+type MockeditorServiceMockRecorder struct {
+	mock *MockeditorService
+}
+
+// NewMockeditorService creates a new mock instance.
+func NewMockeditorService(ctrl *gomock.Controller) *MockeditorService {
+	mock := &MockeditorService{ctrl: ctrl}
+	mock.recorder = &MockeditorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeditorService) EXPECT() *MockeditorServiceMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockeditorService) Update(ctx context.Context, in *dto.UpdateArticleIn) (*dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, in)
+	ret0, _ := ret[0].(*dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockeditorServiceMockRecorder) Update(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockeditorService)(nil).Update), ctx, in)
+}
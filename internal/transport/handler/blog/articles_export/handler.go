@@ -0,0 +1,63 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package articles_export
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type transferService interface {
+	Export(ctx context.Context, userID string) ([]byte, error)
+}
+
+type Handler struct {
+	transferService transferService
+	logger          log.Logger
+}
+
+func NewHandler(
+	transferService transferService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		transferService: transferService,
+		logger:          logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		corehttputil.RespondUnauthorized(ctx)
+		return
+	}
+
+	archive, err := h.transferService.Export(ctx, userID)
+
+	switch {
+	case err == nil:
+		respond(ctx, archive)
+	case errors.Is(err, apperrors.ErrForbidden):
+		corehttputil.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("export error on transfer service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
+
+func respond(ctx corehttp.Context, archive []byte) {
+	w := ctx.ResponseWriter()
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="articles.zip"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(archive)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(archive)
+}
@@ -0,0 +1,76 @@
+package articles_export
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/articles_export/mock"
+)
+
+func TestHandler(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(transferSvc *mock.MocktransferService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "forbidden",
+			setup: func(transferSvc *mock.MocktransferService) {
+				transferSvc.EXPECT().Export(gomock.Any(), gomock.Eq("user id")).Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "transfer service error",
+			setup: func(transferSvc *mock.MocktransferService) {
+				transferSvc.EXPECT().Export(gomock.Any(), gomock.Eq("user id")).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error","error":"dummy error","message":"export error on transfer service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(transferSvc *mock.MocktransferService) {
+				transferSvc.EXPECT().Export(gomock.Any(), gomock.Eq("user id")).Return([]byte("archive"), nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/zip", res.Header().Get("Content-Type"))
+				assert.Equal(t, `attachment; filename="articles.zip"`, res.Header().Get("Content-Disposition"))
+				assert.Equal(t, "7", res.Header().Get("Content-Length"))
+				assert.Equal(t, "archive", res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferSvc := mock.NewMocktransferService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, _, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+
+			tt.setup(transferSvc)
+
+			handler := NewHandler(transferSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
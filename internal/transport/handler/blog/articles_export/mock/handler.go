@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktransferService is a mock of transferService interface.
+type MocktransferService struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransferServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocktransferServiceMockRecorder is the mock recorder for MocktransferService.
+type MocktransferServiceMockRecorder struct {
+	mock *MocktransferService
+}
+
+// NewMocktransferService creates a new mock instance.
+func NewMocktransferService(ctrl *gomock.Controller) *MocktransferService {
+	mock := &MocktransferService{ctrl: ctrl}
+	mock.recorder = &MocktransferServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransferService) EXPECT() *MocktransferServiceMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MocktransferService) Export(ctx context.Context, userID string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, userID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MocktransferServiceMockRecorder) Export(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MocktransferService)(nil).Export), ctx, userID)
+}
@@ -1,62 +1,337 @@
 package articles_get
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/core/locale"
 )
 
+const (
+	formatMarkdown = "markdown"
+	formatHTML     = "html"
+
+	fieldContent = "content"
+
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// cursorIDRegexp matches UUIDs, ids of articles are compared with ids in cursors by the storage.
+var cursorIDRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
 type request struct {
-	FromSlug *string
+	Sort   string
+	Cursor *dto.ArticleCursor
+	Limit  int
+	Format string
+	// WithContent is set when the full content is requested, otherwise only excerpts are returned.
+	WithContent bool
+	// Languages are the preferred languages of the reader, the lang query parameter first.
+	Languages []string
+
+	// AuthorNickName is set when the list is requested as an author's articles.
+	AuthorNickName string
 }
 
 type response struct {
-	Articles []article `json:"articles"`
-	HasMore  bool      `json:"hasMore"`
+	Articles   []article `json:"articles"`
+	HasMore    bool      `json:"hasMore"`
+	NextCursor *string   `json:"nextCursor,omitempty"`
+	PrevCursor *string   `json:"prevCursor,omitempty"`
+}
+
+// cursor is the opaque cursor representation given to clients.
+// It carries the sort order so that a cursor can't be applied to a list sorted by other columns.
+type cursor struct {
+	Sort      string    `json:"s"`
+	Backward  bool      `json:"b,omitempty"`
+	CreatedAt time.Time `json:"c"`
+	Title     string    `json:"t,omitempty"`
+	ID        string    `json:"i"`
 }
 
 type article struct {
-	Slug    string  `json:"slug"`
-	Title   string  `json:"title"`
-	Content string  `json:"content"`
-	Author  *author `json:"author,omitempty"`
+	Slug          string           `json:"slug"`
+	Title         string           `json:"title"`
+	Excerpt       string           `json:"excerpt"`
+	WordCount     int              `json:"wordCount"`
+	ReadingTime   int              `json:"readingTime"`
+	Visibility    string           `json:"visibility"`
+	Locked        bool             `json:"locked"`
+	Locale        string           `json:"locale"`
+	Tags          []string         `json:"tags"`
+	Translations  []translation    `json:"translations,omitempty"`
+	Content       *string          `json:"content,omitempty"`
+	ContentHTML   *string          `json:"contentHtml,omitempty"`
+	TOC           []tocEntry       `json:"toc,omitempty"`
+	CommentsCount int              `json:"commentsCount"`
+	Reactions     map[string]int64 `json:"reactions"`
+	OwnReactions  []string         `json:"ownReactions,omitempty"`
+	Author        *author          `json:"author,omitempty"`
+	Authors       []author         `json:"authors,omitempty"`
+	SEO           seo              `json:"seo"`
+}
+
+type translation struct {
+	Locale string `json:"locale"`
+	Slug   string `json:"slug"`
+	Title  string `json:"title"`
+}
+
+type seo struct {
+	MetaDescription string `json:"metaDescription"`
+	OGImage         string `json:"ogImage"`
+	CanonicalURL    string `json:"canonicalUrl"`
+}
+
+type tocEntry struct {
+	Level  int    `json:"level"`
+	Anchor string `json:"anchor"`
+	Title  string `json:"title"`
 }
 
 type author struct {
 	NickName    string `json:"nickName"`
 	DisplayName string `json:"displayName"`
+	Role        string `json:"role,omitempty"`
 }
 
-func parseRequest(in *http.Request) request {
-	var out request
-
+func parseRequest(in *http.Request) (request, error) {
 	query := in.URL.Query()
-	if fromSlug := query.Get("fromSlug"); fromSlug != "" {
-		out.FromSlug = &fromSlug
+	out := request{
+		Sort:   query.Get("sort"),
+		Limit:  defaultLimit,
+		Format: formatMarkdown,
+
+		AuthorNickName: in.PathValue("nickname"),
 	}
 
-	return out
+	if out.Sort != "" && !slices.Contains(dto.ArticleSorts, out.Sort) {
+		return out, errors.New("sort must be one of: newest, oldest, title")
+	}
+
+	if rawCursor := query.Get("cursor"); rawCursor != "" {
+		c, err := decodeCursor(rawCursor)
+		if err != nil {
+			return out, errInvalidCursor
+		}
+
+		if out.Sort != "" && out.Sort != c.Sort {
+			return out, errInvalidCursor
+		}
+
+		out.Sort = c.Sort
+		out.Cursor = &dto.ArticleCursor{
+			Backward:  c.Backward,
+			CreatedAt: c.CreatedAt,
+			Title:     c.Title,
+			ID:        c.ID,
+		}
+	}
+
+	if out.Sort == "" {
+		out.Sort = dto.ArticleSortNewest
+	}
+
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		limit, err := strconv.Atoi(rawLimit)
+		if err != nil || limit < 1 || limit > maxLimit {
+			return out, errors.New("limit must be between 1 and 100")
+		}
+
+		out.Limit = limit
+	}
+
+	switch format := query.Get("format"); format {
+	case "", formatMarkdown:
+	case formatHTML:
+		out.Format = formatHTML
+	default:
+		return out, errors.New("format must be one of: markdown, html")
+	}
+
+	if rawFields := query.Get("fields"); rawFields != "" {
+		for _, field := range strings.Split(rawFields, ",") {
+			if field != fieldContent {
+				return out, errors.New("fields must be a comma separated list of: content")
+			}
+		}
+
+		out.WithContent = true
+	}
+
+	if lang := query.Get("lang"); lang != "" {
+		canonical, err := locale.Canonical(lang)
+		if err != nil {
+			return out, errors.New("lang must be a BCP 47 language tag")
+		}
+
+		out.Languages = append(out.Languages, canonical)
+	}
+
+	out.Languages = append(out.Languages, locale.ParseAcceptLanguage(in.Header.Get("Accept-Language"))...)
+
+	return out, nil
 }
 
-func convertResponse(out *dto.GetArticlesOut) response {
+func decodeCursor(raw string) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &cursor{}
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(dto.ArticleSorts, c.Sort) || !cursorIDRegexp.MatchString(c.ID) {
+		return nil, errInvalidCursor
+	}
+
+	if c.Sort != dto.ArticleSortTitle && c.CreatedAt.IsZero() {
+		return nil, errInvalidCursor
+	}
+
+	return c, nil
+}
+
+func encodeCursor(sort string, in *dto.ArticleCursor) *string {
+	if in == nil {
+		return nil
+	}
+
+	data, _ := json.Marshal(cursor{
+		Sort:      sort,
+		Backward:  in.Backward,
+		CreatedAt: in.CreatedAt,
+		Title:     in.Title,
+		ID:        in.ID,
+	})
+
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	return &encoded
+}
+
+func convertResponse(out *dto.GetArticlesOut, req request) response {
 	articles := make([]article, 0, len(out.Articles))
 	for _, a := range out.Articles {
-		articles = append(articles, convertArticle(a))
+		articles = append(articles, convertArticle(a, req))
 	}
 
 	return response{
-		Articles: articles,
-		HasMore:  out.HasMore,
+		Articles:   articles,
+		HasMore:    out.NextCursor != nil,
+		NextCursor: encodeCursor(req.Sort, out.NextCursor),
+		PrevCursor: encodeCursor(req.Sort, out.PrevCursor),
 	}
 }
 
-func convertArticle(in dto.Article) article {
-	return article{
-		Slug:    in.Slug,
-		Title:   in.Title,
-		Content: in.Content,
-		Author:  convertAuthor(in.Author),
+func convertArticle(in dto.Article, req request) article {
+	out := article{
+		Slug:          in.Slug,
+		Title:         in.Title,
+		Excerpt:       in.Excerpt,
+		WordCount:     in.WordCount,
+		ReadingTime:   in.ReadingTime,
+		Visibility:    in.Visibility,
+		Locked:        in.Locked,
+		Locale:        in.Locale,
+		Tags:          convertTags(in.Tags),
+		Translations:  convertTranslations(in.Alternates),
+		CommentsCount: in.CommentsCount,
+		Reactions:     convertReactions(in.ReactionCounts),
+		OwnReactions:  in.OwnReactions,
+		Author:        convertAuthor(in.Author),
+		Authors:       convertAuthors(in.Authors),
+		SEO: seo{
+			MetaDescription: in.SEO.MetaDescription,
+			OGImage:         in.SEO.OGImageURL,
+			CanonicalURL:    in.SEO.CanonicalURL,
+		},
 	}
+
+	switch {
+	case !req.WithContent:
+	case req.Format == formatHTML:
+		out.ContentHTML = &in.ContentHTML
+		out.TOC = convertTOC(in.TOC)
+	default:
+		out.Content = &in.Content
+	}
+
+	return out
+}
+
+func convertTOC(in []dto.TOCEntry) []tocEntry {
+	out := make([]tocEntry, 0, len(in))
+	for _, e := range in {
+		out = append(out, tocEntry{
+			Level:  e.Level,
+			Anchor: e.Anchor,
+			Title:  e.Title,
+		})
+	}
+	return out
+}
+
+func convertTranslations(in []dto.ArticleAlternate) []translation {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]translation, 0, len(in))
+	for _, a := range in {
+		out = append(out, translation{
+			Locale: a.Locale,
+			Slug:   a.Slug,
+			Title:  a.Title,
+		})
+	}
+	return out
+}
+
+func convertTags(in []string) []string {
+	if in == nil {
+		return []string{}
+	}
+
+	return in
+}
+
+func convertReactions(in map[string]int64) map[string]int64 {
+	if in == nil {
+		return map[string]int64{}
+	}
+
+	return in
+}
+
+func convertAuthors(in []dto.ArticleAuthor) []author {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]author, 0, len(in))
+	for _, a := range in {
+		out = append(out, author{
+			NickName:    a.NickName,
+			DisplayName: a.DisplayName,
+			Role:        a.Role,
+		})
+	}
+	return out
 }
 
 func convertAuthor(in *dto.ArticleAuthor) *author {
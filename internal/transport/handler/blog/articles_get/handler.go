@@ -3,9 +3,12 @@ package articles_get
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
 	corehttp "github.com/art-es/yet-another-service/internal/core/http"
 	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
 	"github.com/art-es/yet-another-service/internal/core/log"
@@ -31,16 +34,31 @@ func NewHandler(
 }
 
 func (h *Handler) Handle(ctx corehttp.Context) {
-	req := parseRequest(ctx.Request())
+	req, err := parseRequest(ctx.Request())
+	if err != nil {
+		corehttputil.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	userID, _ := contextcore.UserID(ctx)
 
 	out, err := h.articleService.Get(ctx, &dto.GetArticlesIn{
-		FromSlug: req.FromSlug,
+		Sort:      req.Sort,
+		Cursor:    req.Cursor,
+		Limit:     req.Limit,
+		UserID:    userID,
+		Languages: req.Languages,
+
+		AuthorNickName: req.AuthorNickName,
 	})
-	if err != nil {
-		h.logger.Error().Err(err).Msg("get error on article service")
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out, req))
+	case errors.Is(err, apperrors.ErrAuthorNotFound):
+		corehttputil.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get articles error on blog service")
 		corehttputil.RespondInternalError(ctx)
-		return
 	}
-
-	corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
 }
@@ -12,7 +12,8 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/art-es/yet-another-service/internal/app/shared/dto"
-	"github.com/art-es/yet-another-service/internal/core/pointer"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockhttp "github.com/art-es/yet-another-service/internal/core/http/mock"
 	"github.com/art-es/yet-another-service/internal/testutil"
 	"github.com/art-es/yet-another-service/internal/transport/handler/blog/articles_get/mock"
 )
@@ -23,19 +24,36 @@ var (
 
 	//go:embed testdata/ok.json
 	expectedBodyOK []byte
+
+	//go:embed testdata/ok_content.json
+	expectedBodyOKContent []byte
+
+	//go:embed testdata/ok_html.json
+	expectedBodyOKHTML []byte
 )
 
 func TestHandler(t *testing.T) {
+	const (
+		// {"s":"title","c":"0001-01-01T00:00:00Z","t":"foo","i":"00000000-0000-0000-0000-000000000001"}
+		titleCursor = "eyJzIjoidGl0bGUiLCJjIjoiMDAwMS0wMS0wMVQwMDowMDowMFoiLCJ0IjoiZm9vIiwiaSI6IjAwMDAwMDAwLTAwMDAtMDAwMC0wMDAwLTAwMDAwMDAwMDAwMSJ9"
+		// {"s":"title","c":"0001-01-01T00:00:00Z","t":"foo","i":"id0"}
+		invalidIDCursor = "eyJzIjoidGl0bGUiLCJjIjoiMDAwMS0wMS0wMVQwMDowMDowMFoiLCJ0IjoiZm9vIiwiaSI6ImlkMCJ9"
+		// {"s":"newest","c":"0001-01-01T00:00:00Z","i":"00000000-0000-0000-0000-000000000001"}
+		zeroTimeCursor = "eyJzIjoibmV3ZXN0IiwiYyI6IjAwMDEtMDEtMDFUMDA6MDA6MDBaIiwiaSI6IjAwMDAwMDAwLTAwMDAtMDAwMC0wMDAwLTAwMDAwMDAwMDAwMSJ9"
+	)
+
 	for _, tt := range []struct {
 		name   string
-		setup  func(req *http.Request, articleSvc *mock.MockarticleService)
+		setup  func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService)
 		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
 	}{
 		{
 			name: "app error",
-			setup: func(req *http.Request, articleSvc *mock.MockarticleService) {
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				ctx.EXPECT().Value(gomock.Any()).Return(nil).AnyTimes()
+
 				articleSvc.EXPECT().
-					Get(gomock.Any(), gomock.Eq(&dto.GetArticlesIn{})).
+					Get(gomock.Any(), gomock.Eq(&dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20})).
 					Return(nil, errors.New("dummy error"))
 			},
 			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
@@ -47,35 +65,70 @@ func TestHandler(t *testing.T) {
 		},
 		{
 			name: "ok",
-			setup: func(req *http.Request, articleSvc *mock.MockarticleService) {
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+
 				query := url.Values{}
-				query.Set("fromSlug", "foo")
+				query.Set("cursor", titleCursor)
+				query.Set("limit", "2")
+				query.Set("lang", "pt-br")
 				req.URL.RawQuery = query.Encode()
+				req.Header.Set("Accept-Language", "de-AT, en;q=0.5")
 
 				articleSvc.EXPECT().
 					Get(gomock.Any(), gomock.Eq(&dto.GetArticlesIn{
-						FromSlug: pointer.To("foo"),
+						Sort:   dto.ArticleSortTitle,
+						Cursor: &dto.ArticleCursor{Title: "foo", ID: "00000000-0000-0000-0000-000000000001"},
+						Limit:  2,
+						UserID: "user id",
+
+						Languages: []string{"pt-BR", "de-AT", "en"},
 					})).
 					Return(
 						&dto.GetArticlesOut{
 							Articles: []dto.Article{
 								{
-									Slug:    "bar",
-									Title:   "Bar Title",
-									Content: "Bar Content",
+									Slug:        "bar",
+									Title:       "Bar Title",
+									Content:     "Bar Content",
+									Excerpt:     "Bar Content",
+									WordCount:   2,
+									ReadingTime: 1,
+									Visibility:  dto.ArticleVisibilityMembers,
+									Locked:      true,
+									Locale:      "pt-BR",
+									Tags:        []string{"go", "sql"},
+									Alternates: []dto.ArticleAlternate{
+										{Locale: "en", Slug: "bar-en", Title: "Bar"},
+										{Locale: "pt-BR", Slug: "bar", Title: "Bar Title"},
+									},
+									CommentsCount:  3,
+									ReactionCounts: map[string]int64{"like": 2},
+									OwnReactions:   []string{"like"},
 									Author: &dto.ArticleAuthor{
 										DisplayName: "Bob",
 										NickName:    "bob123",
 									},
+									Authors: []dto.ArticleAuthor{
+										{DisplayName: "Bob", NickName: "bob123", Role: dto.ArticleAuthorRoleOwner},
+										{DisplayName: "Alice", NickName: "alice", Role: dto.ArticleAuthorRoleContributor},
+									},
+									SEO: dto.ArticleSEO{
+										MetaDescription: "Bar Description",
+										OGImageURL:      "https://example.com/bar.png",
+										CanonicalURL:    "https://example.com/articles/bar",
+									},
 								},
 								{
 									Slug:    "baz",
 									Title:   "Baz Title",
 									Content: "Baz Content",
+									Locale:  "en",
 									Author:  nil,
 								},
 							},
-							HasMore: true,
+							NextCursor: &dto.ArticleCursor{Title: "Baz Title", ID: "id2"},
+							PrevCursor: &dto.ArticleCursor{Backward: true, Title: "Bar Title", ID: "id1"},
 						},
 						nil,
 					)
@@ -86,6 +139,181 @@ func TestHandler(t *testing.T) {
 				assert.Empty(t, logs)
 			},
 		},
+		{
+			name: "author not found",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				ctx.EXPECT().Value(gomock.Any()).Return(nil).AnyTimes()
+				req.SetPathValue("nickname", "bob")
+
+				articleSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20, AuthorNickName: "bob"})).
+					Return(nil, apperrors.ErrAuthorNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "invalid sort",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				req.URL.RawQuery = "sort=random"
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "sort must be one of: newest, oldest, title"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "malformed cursor",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				req.URL.RawQuery = "cursor=foo"
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "invalid cursor"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "cursor with invalid id",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				req.URL.RawQuery = "cursor=" + invalidIDCursor
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "invalid cursor"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "date cursor without time",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				req.URL.RawQuery = "cursor=" + zeroTimeCursor
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "invalid cursor"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "cursor of another sort",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				req.URL.RawQuery = "sort=newest&cursor=" + titleCursor
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "invalid cursor"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "limit too large",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				req.URL.RawQuery = "limit=101"
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "limit must be between 1 and 100"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "invalid format",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				req.URL.RawQuery = "format=pdf"
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "format must be one of: markdown, html"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "invalid fields",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				req.URL.RawQuery = "fields=content,title"
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "fields must be a comma separated list of: content"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "invalid lang",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				req.URL.RawQuery = "lang=en--US"
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "lang must be a BCP 47 language tag"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "ok content",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				ctx.EXPECT().Value(gomock.Any()).Return(nil).AnyTimes()
+				req.URL.RawQuery = "fields=content"
+
+				articleSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20})).
+					Return(
+						&dto.GetArticlesOut{
+							Articles: []dto.Article{
+								{
+									Slug:        "bar",
+									Title:       "Bar Title",
+									Content:     "# Bar",
+									ContentHTML: `<h1 id="bar">Bar</h1>`,
+									Excerpt:     "Bar",
+									WordCount:   1,
+									ReadingTime: 1,
+								},
+							},
+						},
+						nil,
+					)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, string(expectedBodyOKContent), res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "ok html",
+			setup: func(ctx *mockhttp.MockContext, req *http.Request, articleSvc *mock.MockarticleService) {
+				ctx.EXPECT().Value(gomock.Any()).Return(nil).AnyTimes()
+				req.URL.RawQuery = "format=html&fields=content"
+
+				articleSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetArticlesIn{Sort: dto.ArticleSortNewest, Limit: 20})).
+					Return(
+						&dto.GetArticlesOut{
+							Articles: []dto.Article{
+								{
+									Slug:        "bar",
+									Title:       "Bar Title",
+									Content:     "# Bar",
+									ContentHTML: `<h1 id="bar">Bar</h1>`,
+									TOC:         []dto.TOCEntry{{Level: 1, Anchor: "bar", Title: "Bar"}},
+								},
+							},
+						},
+						nil,
+					)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, string(expectedBodyOKHTML), res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -95,7 +323,7 @@ func TestHandler(t *testing.T) {
 			logger := testutil.NewLogger()
 			ctx, req, res := testutil.NewHTTPContext(ctrl)
 
-			tt.setup(req, articleSvc)
+			tt.setup(ctx, req, articleSvc)
 
 			handler := NewHandler(articleSvc, logger)
 			handler.Handle(ctx)
@@ -0,0 +1,182 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package articles_import
+
+import (
+	"context"
+	"errors"
+	"io"
+	nethttp "net/http"
+	"strconv"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+// formOverhead is allowed on top of the archive size for multipart boundaries and other form fields.
+const formOverhead = 64 << 10
+
+var (
+	errNoFile        = errors.New("multipart form with the file field is expected")
+	errInvalidDryRun = errors.New("dryRun must be a boolean")
+	errTooLarge      = errors.New("archive is too large")
+)
+
+type transferService interface {
+	Import(ctx context.Context, in *dto.ImportArticlesIn) (*dto.ImportArticlesOut, error)
+}
+
+type response struct {
+	DryRun bool           `json:"dryRun"`
+	Counts map[string]int `json:"counts"`
+	Files  []file         `json:"files"`
+}
+
+type file struct {
+	Name   string `json:"name"`
+	Slug   string `json:"slug,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type Handler struct {
+	maxSize         int64
+	transferService transferService
+	logger          log.Logger
+}
+
+// NewHandler creates the import handler, maxSize is the size limit of uploaded archives in bytes.
+func NewHandler(
+	maxSize int64,
+	transferService transferService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		maxSize:         maxSize,
+		transferService: transferService,
+		logger:          logger,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	dryRun, err := parseDryRun(ctx.Request())
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	archive, err := h.readFile(ctx)
+	if err != nil {
+		var maxBytesErr *nethttp.MaxBytesError
+		if errors.As(err, &maxBytesErr) || errors.Is(err, errTooLarge) {
+			util.RespondPayloadTooLarge(ctx, "The archive is too large.")
+			return
+		}
+
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.transferService.Import(ctx, &dto.ImportArticlesIn{
+		UserID:  userID,
+		Archive: archive,
+		DryRun:  dryRun,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusOK, convertResponse(dryRun, out))
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	case errors.Is(err, apperrors.ErrArchiveInvalid):
+		util.RespondBadRequest(ctx, "The archive can't be read, a zip archive is expected.")
+	default:
+		h.logger.Error().Err(err).Msg("import error on transfer service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func parseDryRun(req *nethttp.Request) (bool, error) {
+	raw := req.URL.Query().Get("dryRun")
+	if raw == "" {
+		return false, nil
+	}
+
+	dryRun, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, errInvalidDryRun
+	}
+
+	return dryRun, nil
+}
+
+// readFile reads the "file" field of the multipart form.
+func (h *Handler) readFile(ctx http.Context) ([]byte, error) {
+	req := ctx.Request()
+	req.Body = nethttp.MaxBytesReader(ctx.ResponseWriter(), req.Body, h.maxSize+formOverhead)
+
+	reader, err := req.MultipartReader()
+	if err != nil {
+		return nil, errNoFile
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, errNoFile
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if part.FormName() != "file" {
+			continue
+		}
+
+		data, err := io.ReadAll(io.LimitReader(part, h.maxSize+1))
+		if err != nil {
+			return nil, err
+		}
+
+		if int64(len(data)) > h.maxSize {
+			return nil, errTooLarge
+		}
+
+		return data, nil
+	}
+}
+
+func convertResponse(dryRun bool, out *dto.ImportArticlesOut) response {
+	res := response{
+		DryRun: dryRun,
+		Counts: map[string]int{
+			dto.ImportStatusCreated:   0,
+			dto.ImportStatusUpdated:   0,
+			dto.ImportStatusUnchanged: 0,
+			dto.ImportStatusFailed:    0,
+		},
+		Files: make([]file, 0, len(out.Files)),
+	}
+
+	for _, f := range out.Files {
+		res.Counts[f.Status]++
+		res.Files = append(res.Files, file{
+			Name:   f.Name,
+			Slug:   f.Slug,
+			Status: f.Status,
+			Error:  f.Error,
+		})
+	}
+
+	return res
+}
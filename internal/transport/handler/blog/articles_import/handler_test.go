@@ -0,0 +1,167 @@
+package articles_import
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/articles_import/mock"
+)
+
+func newForm(field string, data []byte) (string, io.Reader) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile(field, "articles.zip")
+	_, _ = part.Write(data)
+	_ = writer.Close()
+
+	return writer.FormDataContentType(), body
+}
+
+func TestHandler(t *testing.T) {
+	expectedIn := &dto.ImportArticlesIn{UserID: "user id", Archive: []byte("archive"), DryRun: true}
+
+	for _, tt := range []struct {
+		name   string
+		query  string
+		field  string
+		data   []byte
+		setup  func(transferSvc *mock.MocktransferService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:  "invalid dry run",
+			query: "dryRun=foo",
+			field: "file",
+			data:  []byte("archive"),
+			setup: func(transferSvc *mock.MocktransferService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dryRun must be a boolean"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "no file",
+			query: "dryRun=true",
+			field: "archive",
+			data:  []byte("archive"),
+			setup: func(transferSvc *mock.MocktransferService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "multipart form with the file field is expected"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "archive too large",
+			query: "dryRun=true",
+			field: "file",
+			data:  []byte(strings.Repeat("a", 11)),
+			setup: func(transferSvc *mock.MocktransferService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+				assert.JSONEq(t, `{"message": "The archive is too large."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "forbidden",
+			query: "dryRun=true",
+			field: "file",
+			data:  []byte("archive"),
+			setup: func(transferSvc *mock.MocktransferService) {
+				transferSvc.EXPECT().Import(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "invalid archive",
+			query: "dryRun=true",
+			field: "file",
+			data:  []byte("archive"),
+			setup: func(transferSvc *mock.MocktransferService) {
+				transferSvc.EXPECT().Import(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrArchiveInvalid)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "The archive can't be read, a zip archive is expected."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "transfer service error",
+			query: "dryRun=true",
+			field: "file",
+			data:  []byte("archive"),
+			setup: func(transferSvc *mock.MocktransferService) {
+				transferSvc.EXPECT().Import(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error","error":"dummy error","message":"import error on transfer service"}`, logs[0])
+			},
+		},
+		{
+			name:  "ok",
+			field: "file",
+			data:  []byte("archive"),
+			setup: func(transferSvc *mock.MocktransferService) {
+				transferSvc.EXPECT().
+					Import(gomock.Any(), gomock.Eq(&dto.ImportArticlesIn{UserID: "user id", Archive: []byte("archive")})).
+					Return(&dto.ImportArticlesOut{Files: []dto.ImportedFile{
+						{Name: "bar.md", Slug: "bar", Status: dto.ImportStatusFailed, Error: "author alice@example.com not found"},
+						{Name: "foo.md", Slug: "foo", Status: dto.ImportStatusCreated},
+					}}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, `{
+					"dryRun": false,
+					"counts": {"created": 1, "updated": 0, "unchanged": 0, "failed": 1},
+					"files": [
+						{"name": "bar.md", "slug": "bar", "status": "failed", "error": "author alice@example.com not found"},
+						{"name": "foo.md", "slug": "foo", "status": "created"}
+					]
+				}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferSvc := mock.NewMocktransferService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			contentType, body := newForm(tt.field, tt.data)
+			req.Header.Set("Content-Type", contentType)
+			req.Body = io.NopCloser(body)
+			req.URL.RawQuery = tt.query
+
+			tt.setup(transferSvc)
+
+			handler := NewHandler(10, transferSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktransferService is a mock of transferService interface.
+type MocktransferService struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransferServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocktransferServiceMockRecorder is the mock recorder for MocktransferService.
+type MocktransferServiceMockRecorder struct {
+	mock *MocktransferService
+}
+
+// NewMocktransferService creates a new mock instance.
+func NewMocktransferService(ctrl *gomock.Controller) *MocktransferService {
+	mock := &MocktransferService{ctrl: ctrl}
+	mock.recorder = &MocktransferServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransferService) EXPECT() *MocktransferServiceMockRecorder {
+	return m.recorder
+}
+
+// Import mocks base method.
+func (m *MocktransferService) Import(ctx context.Context, in *dto.ImportArticlesIn) (*dto.ImportArticlesOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, in)
+	ret0, _ := ret[0].(*dto.ImportArticlesOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MocktransferServiceMockRecorder) Import(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MocktransferService)(nil).Import), ctx, in)
+}
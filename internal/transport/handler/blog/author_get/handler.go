@@ -0,0 +1,68 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package author_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type authorService interface {
+	Get(ctx context.Context, nickName string) (*dto.AuthorProfile, error)
+}
+
+type response struct {
+	NickName       string    `json:"nickName"`
+	DisplayName    string    `json:"displayName"`
+	Bio            string    `json:"bio"`
+	AvatarURL      *string   `json:"avatarUrl"`
+	ArticlesCount  int       `json:"articlesCount"`
+	FollowersCount int       `json:"followersCount"`
+	FollowingCount int       `json:"followingCount"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+type Handler struct {
+	authorService authorService
+	logger        log.Logger
+}
+
+func NewHandler(
+	authorService authorService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		authorService: authorService,
+		logger:        logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	out, err := h.authorService.Get(ctx, ctx.Request().PathValue("nickname"))
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, response{
+			NickName:       out.NickName,
+			DisplayName:    out.DisplayName,
+			Bio:            out.Bio,
+			AvatarURL:      out.AvatarURL,
+			ArticlesCount:  out.ArticlesCount,
+			FollowersCount: out.FollowersCount,
+			FollowingCount: out.FollowingCount,
+			CreatedAt:      out.CreatedAt,
+		})
+	case errors.Is(err, apperrors.ErrAuthorNotFound):
+		corehttputil.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get error on author service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
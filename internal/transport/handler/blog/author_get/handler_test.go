@@ -0,0 +1,103 @@
+package author_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/author_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(authorSvc *mock.MockauthorService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "author not found",
+			setup: func(authorSvc *mock.MockauthorService) {
+				authorSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq("bob")).
+					Return(nil, apperrors.ErrAuthorNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "author service error",
+			setup: func(authorSvc *mock.MockauthorService) {
+				authorSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq("bob")).
+					Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"get error on author service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(authorSvc *mock.MockauthorService) {
+				authorSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq("bob")).
+					Return(&dto.AuthorProfile{
+						ID:             "author id",
+						DisplayName:    "Bob",
+						NickName:       "bob",
+						Bio:            "Writes about Go.",
+						AvatarURL:      pointer.To("https://example.com/bob.png"),
+						ArticlesCount:  2,
+						FollowersCount: 5,
+						FollowingCount: 1,
+						CreatedAt:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				expResBody := `{
+					"nickName": "bob",
+					"displayName": "Bob",
+					"bio": "Writes about Go.",
+					"avatarUrl": "https://example.com/bob.png",
+					"articlesCount": 2,
+					"followersCount": 5,
+					"followingCount": 1,
+					"createdAt": "2024-01-01T00:00:00Z"
+				}`
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorSvc := mock.NewMockauthorService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			req.SetPathValue("nickname", "bob")
+
+			tt.setup(authorSvc)
+
+			handler := NewHandler(authorSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockauthorService is a mock of authorService interface.
+type MockauthorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorServiceMockRecorder is the mock recorder for MockauthorService.
+type MockauthorServiceMockRecorder struct {
+	mock *MockauthorService
+}
+
+// NewMockauthorService creates a new mock instance.
+func NewMockauthorService(ctrl *gomock.Controller) *MockauthorService {
+	mock := &MockauthorService{ctrl: ctrl}
+	mock.recorder = &MockauthorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthorService) EXPECT() *MockauthorServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockauthorService) Get(ctx context.Context, nickName string) (*dto.AuthorProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, nickName)
+	ret0, _ := ret[0].(*dto.AuthorProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockauthorServiceMockRecorder) Get(ctx, nickName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockauthorService)(nil).Get), ctx, nickName)
+}
@@ -0,0 +1,106 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package comment_create
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type commentService interface {
+	Create(ctx context.Context, in *dto.CreateCommentIn) (*dto.Comment, error)
+}
+
+type request struct {
+	ArticleSlug string  `json:"-"`
+	ParentID    *string `json:"parentId" validate:"omitnil,uuid"`
+	Content     string  `json:"content" validate:"required,lte=10000"`
+}
+
+type response struct {
+	ID        string    `json:"id"`
+	ParentID  *string   `json:"parentId,omitempty"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Handler struct {
+	commentService commentService
+	logger         log.Logger
+	validator      validation.Validator
+}
+
+func NewHandler(
+	commentService commentService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		commentService: commentService,
+		logger:         logger,
+		validator:      validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.commentService.Create(ctx, &dto.CreateCommentIn{
+		ArticleSlug: req.ArticleSlug,
+		ParentID:    req.ParentID,
+		UserID:      userID,
+		Content:     req.Content,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusCreated, response{
+			ID:        out.ID,
+			ParentID:  out.ParentID,
+			Content:   out.Content,
+			CreatedAt: out.CreatedAt,
+		})
+	case errors.Is(err, apperrors.ErrArticleNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrCommentNotFound):
+		util.RespondBadRequest(ctx, err.Error())
+	default:
+		h.logger.Error().Err(err).Msg("create error on comment service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	req.ArticleSlug = ctx.Request().PathValue("slug")
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,162 @@
+package comment_create
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/comment_create/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const parentID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator, req *http.Request)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "invalid request body",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator, req *http.Request) {
+				req.Body = io.NopCloser(strings.NewReader(`foo`))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "invalid request body"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "validation error",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator, req *http.Request) {
+				req.Body = io.NopCloser(strings.NewReader(`{"content": ""}`))
+
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{ArticleSlug: "foo"})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator, req *http.Request) {
+				req.Body = io.NopCloser(strings.NewReader(`{"content": "bar"}`))
+
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				commentSvc.EXPECT().
+					Create(gomock.Any(), gomock.Eq(&dto.CreateCommentIn{ArticleSlug: "foo", UserID: "user id", Content: "bar"})).
+					Return(nil, apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "parent comment not found",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator, req *http.Request) {
+				req.Body = io.NopCloser(strings.NewReader(`{"content": "bar", "parentId": "` + parentID + `"}`))
+
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				commentSvc.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrCommentNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "comment not found"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "comment service error",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator, req *http.Request) {
+				req.Body = io.NopCloser(strings.NewReader(`{"content": "bar"}`))
+
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				commentSvc.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.JSONEq(t, `{"message": "An unexpected error occurred. Please try again later."}`, res.Body.String())
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"create error on comment service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator, req *http.Request) {
+				req.Body = io.NopCloser(strings.NewReader(`{"content": "bar", "parentId": "` + parentID + `"}`))
+
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{ArticleSlug: "foo", ParentID: pointer.To(parentID), Content: "bar"})).
+					Return(nil)
+
+				expectedIn := &dto.CreateCommentIn{
+					ArticleSlug: "foo",
+					ParentID:    pointer.To(parentID),
+					UserID:      "user id",
+					Content:     "bar",
+				}
+				commentSvc.EXPECT().
+					Create(gomock.Any(), gomock.Eq(expectedIn)).
+					Return(&dto.Comment{
+						ID:        "comment id",
+						ParentID:  pointer.To(parentID),
+						Content:   "bar",
+						CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				expResBody := `{
+					"id": "comment id",
+					"parentId": "` + parentID + `",
+					"content": "bar",
+					"createdAt": "2024-01-01T00:00:00Z"
+				}`
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentSvc := mock.NewMockcommentService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo")
+
+			tt.setup(commentSvc, validator, req)
+
+			handler := NewHandler(commentSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
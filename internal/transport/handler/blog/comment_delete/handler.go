@@ -0,0 +1,69 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package comment_delete
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type commentService interface {
+	Delete(ctx context.Context, in *dto.DeleteCommentIn) error
+}
+
+type Handler struct {
+	commentService commentService
+	logger         log.Logger
+	validator      validation.Validator
+}
+
+func NewHandler(
+	commentService commentService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		commentService: commentService,
+		logger:         logger,
+		validator:      validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	id := ctx.Request().PathValue("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		util.RespondNotFound(ctx)
+		return
+	}
+
+	err := h.commentService.Delete(ctx, &dto.DeleteCommentIn{
+		ID:     id,
+		UserID: userID,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusOK, struct{}{})
+	case errors.Is(err, apperrors.ErrCommentNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("delete error on comment service")
+		util.RespondInternalError(ctx)
+	}
+}
@@ -0,0 +1,102 @@
+package comment_delete
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/comment_delete/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const commentID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "invalid id",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Var(gomock.Eq(commentID), gomock.Eq("required,uuid")).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				commentSvc.EXPECT().
+					Delete(gomock.Any(), gomock.Any()).
+					Return(apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.JSONEq(t, `{"message": "Forbidden."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "comment service error",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				commentSvc.EXPECT().
+					Delete(gomock.Any(), gomock.Any()).
+					Return(errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"delete error on comment service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				commentSvc.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(&dto.DeleteCommentIn{ID: commentID, UserID: "user id"})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, `{}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentSvc := mock.NewMockcommentService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("id", commentID)
+
+			tt.setup(commentSvc, validator)
+
+			handler := NewHandler(commentSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package comment_update
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/comment_update/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const commentID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "validation error",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{ID: commentID, Content: "bar"})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "comment not found",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				commentSvc.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrCommentNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				commentSvc.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.JSONEq(t, `{"message": "Forbidden."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "edit window expired",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				commentSvc.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrCommentEditWindowExpired)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "comment edit window has expired"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "comment service error",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				commentSvc.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"update error on comment service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(commentSvc *mock.MockcommentService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+
+				createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+				commentSvc.EXPECT().
+					Update(gomock.Any(), gomock.Eq(&dto.UpdateCommentIn{ID: commentID, UserID: "user id", Content: "bar"})).
+					Return(&dto.Comment{
+						ID:        commentID,
+						Content:   "bar",
+						CreatedAt: createdAt,
+						UpdatedAt: pointer.To(createdAt.Add(time.Minute)),
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				expResBody := `{
+					"id": "` + commentID + `",
+					"content": "bar",
+					"createdAt": "2024-01-01T00:00:00Z",
+					"updatedAt": "2024-01-01T00:01:00Z"
+				}`
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentSvc := mock.NewMockcommentService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("id", commentID)
+			req.Body = io.NopCloser(strings.NewReader(`{"content": "bar"}`))
+
+			tt.setup(commentSvc, validator)
+
+			handler := NewHandler(commentSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockcommentService is a mock of commentService interface.
+type MockcommentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockcommentServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockcommentServiceMockRecorder is the mock recorder for MockcommentService.
+type MockcommentServiceMockRecorder struct {
+	mock *MockcommentService
+}
+
+// NewMockcommentService creates a new mock instance.
+func NewMockcommentService(ctrl *gomock.Controller) *MockcommentService {
+	mock := &MockcommentService{ctrl: ctrl}
+	mock.recorder = &MockcommentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcommentService) EXPECT() *MockcommentServiceMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockcommentService) Update(ctx context.Context, in *dto.UpdateCommentIn) (*dto.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, in)
+	ret0, _ := ret[0].(*dto.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockcommentServiceMockRecorder) Update(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockcommentService)(nil).Update), ctx, in)
+}
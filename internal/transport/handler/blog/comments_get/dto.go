@@ -0,0 +1,79 @@
+package comments_get
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+type request struct {
+	ArticleSlug string
+	FromID      *string
+}
+
+type response struct {
+	Comments []comment `json:"comments"`
+	HasMore  bool      `json:"hasMore"`
+}
+
+type comment struct {
+	ID        string     `json:"id"`
+	Content   string     `json:"content"`
+	Deleted   bool       `json:"deleted"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	Author    *author    `json:"author,omitempty"`
+	Replies   []comment  `json:"replies"`
+}
+
+type author struct {
+	NickName    string `json:"nickName"`
+	DisplayName string `json:"displayName"`
+}
+
+func parseRequest(in *http.Request) request {
+	out := request{
+		ArticleSlug: in.PathValue("slug"),
+	}
+
+	if fromID := in.URL.Query().Get("fromId"); fromID != "" {
+		out.FromID = &fromID
+	}
+
+	return out
+}
+
+func convertResponse(out *dto.GetCommentsOut) response {
+	return response{
+		Comments: convertComments(out.Comments),
+		HasMore:  out.HasMore,
+	}
+}
+
+func convertComments(in []*dto.Comment) []comment {
+	out := make([]comment, 0, len(in))
+	for _, c := range in {
+		out = append(out, comment{
+			ID:        c.ID,
+			Content:   c.Content,
+			Deleted:   c.Deleted,
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+			Author:    convertAuthor(c.Author),
+			Replies:   convertComments(c.Replies),
+		})
+	}
+	return out
+}
+
+func convertAuthor(in *dto.ArticleAuthor) *author {
+	if in == nil {
+		return nil
+	}
+
+	return &author{
+		NickName:    in.NickName,
+		DisplayName: in.DisplayName,
+	}
+}
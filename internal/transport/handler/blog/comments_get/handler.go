@@ -0,0 +1,52 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package comments_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type commentService interface {
+	Get(ctx context.Context, in *dto.GetCommentsIn) (*dto.GetCommentsOut, error)
+}
+
+type Handler struct {
+	commentService commentService
+	logger         log.Logger
+}
+
+func NewHandler(
+	commentService commentService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		commentService: commentService,
+		logger:         logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	req := parseRequest(ctx.Request())
+
+	out, err := h.commentService.Get(ctx, &dto.GetCommentsIn{
+		ArticleSlug: req.ArticleSlug,
+		FromID:      req.FromID,
+	})
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrArticleNotFound):
+		corehttputil.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get comments error on comment service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
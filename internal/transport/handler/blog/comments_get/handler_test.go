@@ -0,0 +1,117 @@
+package comments_get
+
+import (
+	_ "embed"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/comments_get/mock"
+)
+
+var (
+	//go:embed testdata/app_error.json
+	expectedBodyAppError []byte
+
+	//go:embed testdata/ok.json
+	expectedBodyOK []byte
+)
+
+func TestHandler(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(req *http.Request, commentSvc *mock.MockcommentService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "app error",
+			setup: func(req *http.Request, commentSvc *mock.MockcommentService) {
+				commentSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetCommentsIn{ArticleSlug: "foo"})).
+					Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.JSONEq(t, string(expectedBodyAppError), res.Body.String())
+				assert.Len(t, logs, 1)
+				assert.Equal(t, `{"level":"error","error":"dummy error","message":"get comments error on comment service"}`, logs[0])
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(req *http.Request, commentSvc *mock.MockcommentService) {
+				commentSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetCommentsIn{ArticleSlug: "foo"})).
+					Return(nil, apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(req *http.Request, commentSvc *mock.MockcommentService) {
+				req.URL.RawQuery = "fromId=bar"
+
+				commentSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetCommentsIn{
+						ArticleSlug: "foo",
+						FromID:      pointer.To("bar"),
+					})).
+					Return(&dto.GetCommentsOut{
+						Comments: []*dto.Comment{
+							{
+								ID:        "1",
+								Content:   "Foo Content",
+								CreatedAt: createdAt,
+								UpdatedAt: pointer.To(createdAt.Add(time.Hour * 24)),
+								Author: &dto.ArticleAuthor{
+									DisplayName: "Bob",
+									NickName:    "bob123",
+								},
+								Replies: []*dto.Comment{
+									{ID: "2", Deleted: true, CreatedAt: createdAt},
+								},
+							},
+						},
+						HasMore: true,
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, string(expectedBodyOK), res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentSvc := mock.NewMockcommentService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			req.SetPathValue("slug", "foo")
+
+			tt.setup(req, commentSvc)
+
+			handler := NewHandler(commentSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
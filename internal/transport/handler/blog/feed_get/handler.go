@@ -0,0 +1,101 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package feed_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type feedService interface {
+	Get(ctx context.Context, in *dto.GetFeedIn) (*dto.RenderedFeed, error)
+}
+
+// Handler serves feeds, the format is taken from the path extension, e.g. /feed.atom.
+type Handler struct {
+	feedService feedService
+	logger      log.Logger
+}
+
+func NewHandler(
+	feedService feedService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		feedService: feedService,
+		logger:      logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	req := ctx.Request()
+
+	format := strings.TrimPrefix(path.Ext(req.URL.Path), ".")
+	if _, ok := dto.FeedContentTypes[format]; !ok {
+		corehttputil.RespondNotFound(ctx)
+		return
+	}
+
+	feed, err := h.feedService.Get(ctx, &dto.GetFeedIn{
+		Format:         format,
+		AuthorNickName: req.PathValue("nickname"),
+		Tag:            req.PathValue("tag"),
+	})
+
+	switch {
+	case err == nil:
+		h.respond(ctx, feed)
+	case errors.Is(err, apperrors.ErrAuthorNotFound):
+		corehttputil.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get error on feed service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) respond(ctx corehttp.Context, feed *dto.RenderedFeed) {
+	w := ctx.ResponseWriter()
+	w.Header().Set("ETag", feed.ETag)
+	if !feed.LastModified.IsZero() {
+		w.Header().Set("Last-Modified", feed.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(ctx.Request(), feed) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", feed.ContentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(feed.Body)
+}
+
+// notModified checks conditional request headers, If-None-Match takes precedence over If-Modified-Since.
+func notModified(req *http.Request, feed *dto.RenderedFeed) bool {
+	if match := req.Header.Get("If-None-Match"); match != "" {
+		for _, etag := range strings.Split(match, ",") {
+			etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+			if etag == feed.ETag || etag == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	since, err := http.ParseTime(req.Header.Get("If-Modified-Since"))
+	if err != nil || feed.LastModified.IsZero() {
+		return false
+	}
+
+	// the header has a precision of seconds
+	return !feed.LastModified.Truncate(time.Second).After(since)
+}
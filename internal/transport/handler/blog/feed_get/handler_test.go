@@ -0,0 +1,155 @@
+package feed_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/feed_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	feed := &dto.RenderedFeed{
+		Body:         []byte("<rss></rss>"),
+		ContentType:  "application/rss+xml; charset=utf-8",
+		ETag:         `"etag"`,
+		LastModified: time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC),
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(req *http.Request, feedSvc *mock.MockfeedService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "unknown format",
+			setup: func(req *http.Request, feedSvc *mock.MockfeedService) {
+				req.URL.Path = "/feed.xml"
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+			},
+		},
+		{
+			name: "author not found",
+			setup: func(req *http.Request, feedSvc *mock.MockfeedService) {
+				req.URL.Path = "/authors/bob/feed.atom"
+				req.SetPathValue("nickname", "bob")
+				feedSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetFeedIn{Format: "atom", AuthorNickName: "bob"})).
+					Return(nil, apperrors.ErrAuthorNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "feed service error",
+			setup: func(req *http.Request, feedSvc *mock.MockfeedService) {
+				req.URL.Path = "/feed.rss"
+				feedSvc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, []string{`{"level":"error","error":"dummy error","message":"get error on feed service"}`}, logs)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(req *http.Request, feedSvc *mock.MockfeedService) {
+				req.URL.Path = "/feed.rss"
+				feedSvc.EXPECT().Get(gomock.Any(), gomock.Eq(&dto.GetFeedIn{Format: "rss"})).Return(feed, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/rss+xml; charset=utf-8", res.Header().Get("Content-Type"))
+				assert.Equal(t, `"etag"`, res.Header().Get("ETag"))
+				assert.Equal(t, "Tue, 02 Jan 2024 03:04:05 GMT", res.Header().Get("Last-Modified"))
+				assert.Equal(t, "<rss></rss>", res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "ok tag",
+			setup: func(req *http.Request, feedSvc *mock.MockfeedService) {
+				req.URL.Path = "/tags/go/feed.json"
+				req.SetPathValue("tag", "go")
+				feedSvc.EXPECT().Get(gomock.Any(), gomock.Eq(&dto.GetFeedIn{Format: "json", Tag: "go"})).Return(feed, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "etag matched",
+			setup: func(req *http.Request, feedSvc *mock.MockfeedService) {
+				req.URL.Path = "/feed.rss"
+				req.Header.Set("If-None-Match", `"other", W/"etag"`)
+				feedSvc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(feed, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotModified, res.Code)
+				assert.Empty(t, res.Body.String())
+			},
+		},
+		{
+			name: "etag not matched",
+			setup: func(req *http.Request, feedSvc *mock.MockfeedService) {
+				req.URL.Path = "/feed.rss"
+				req.Header.Set("If-None-Match", `"other"`)
+				req.Header.Set("If-Modified-Since", "Tue, 02 Jan 2024 03:04:05 GMT")
+				feedSvc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(feed, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name: "not modified since",
+			setup: func(req *http.Request, feedSvc *mock.MockfeedService) {
+				req.URL.Path = "/feed.json"
+				req.Header.Set("If-Modified-Since", "Tue, 02 Jan 2024 03:04:05 GMT")
+				feedSvc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(feed, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotModified, res.Code)
+			},
+		},
+		{
+			name: "modified since",
+			setup: func(req *http.Request, feedSvc *mock.MockfeedService) {
+				req.URL.Path = "/feed.json"
+				req.Header.Set("If-Modified-Since", "Tue, 02 Jan 2024 03:04:04 GMT")
+				feedSvc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(feed, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			feedSvc := mock.NewMockfeedService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+
+			tt.setup(req, feedSvc)
+
+			NewHandler(feedSvc, logger).Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockfeedService is a mock of feedService interface.
+type MockfeedService struct {
+	ctrl     *gomock.Controller
+	recorder *MockfeedServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockfeedServiceMockRecorder is the mock recorder for MockfeedService.
+type MockfeedServiceMockRecorder struct {
+	mock *MockfeedService
+}
+
+// NewMockfeedService creates a new mock instance.
+func NewMockfeedService(ctrl *gomock.Controller) *MockfeedService {
+	mock := &MockfeedService{ctrl: ctrl}
+	mock.recorder = &MockfeedServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfeedService) EXPECT() *MockfeedServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockfeedService) Get(ctx context.Context, in *dto.GetFeedIn) (*dto.RenderedFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, in)
+	ret0, _ := ret[0].(*dto.RenderedFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockfeedServiceMockRecorder) Get(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockfeedService)(nil).Get), ctx, in)
+}
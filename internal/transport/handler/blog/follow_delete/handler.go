@@ -0,0 +1,58 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package follow_delete
+
+import (
+	"context"
+	"errors"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type followService interface {
+	Unfollow(ctx context.Context, in *dto.FollowIn) error
+}
+
+type Handler struct {
+	followService followService
+	logger        log.Logger
+}
+
+func NewHandler(
+	followService followService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		followService: followService,
+		logger:        logger,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	err := h.followService.Unfollow(ctx, &dto.FollowIn{
+		UserID:         userID,
+		AuthorNickName: ctx.Request().PathValue("nickname"),
+	})
+
+	switch {
+	case err == nil:
+		util.RespondNoContent(ctx)
+	case errors.Is(err, apperrors.ErrAuthorNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrFollowSelf):
+		util.RespondBadRequest(ctx, "You can't unfollow yourself.")
+	default:
+		h.logger.Error().Err(err).Msg("unfollow error on follow service")
+		util.RespondInternalError(ctx)
+	}
+}
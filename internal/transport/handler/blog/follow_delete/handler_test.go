@@ -0,0 +1,89 @@
+package follow_delete
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/follow_delete/mock"
+)
+
+func TestHandler(t *testing.T) {
+	expectedIn := &dto.FollowIn{UserID: "user id", AuthorNickName: "bob"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(followSvc *mock.MockfollowService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "author not found",
+			setup: func(followSvc *mock.MockfollowService) {
+				followSvc.EXPECT().Unfollow(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrAuthorNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "unfollow self",
+			setup: func(followSvc *mock.MockfollowService) {
+				followSvc.EXPECT().Unfollow(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrFollowSelf)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "You can't unfollow yourself."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "follow service error",
+			setup: func(followSvc *mock.MockfollowService) {
+				followSvc.EXPECT().Unfollow(gomock.Any(), gomock.Eq(expectedIn)).Return(errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"unfollow error on follow service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(followSvc *mock.MockfollowService) {
+				followSvc.EXPECT().Unfollow(gomock.Any(), gomock.Eq(expectedIn)).Return(nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNoContent, res.Code)
+				assert.Empty(t, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			followSvc := mock.NewMockfollowService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("nickname", "bob")
+
+			tt.setup(followSvc)
+
+			handler := NewHandler(followSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
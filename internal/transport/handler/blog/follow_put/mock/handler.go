@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockfollowService is a mock of followService interface.
+type MockfollowService struct {
+	ctrl     *gomock.Controller
+	recorder *MockfollowServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockfollowServiceMockRecorder is the mock recorder for MockfollowService.
+type MockfollowServiceMockRecorder struct {
+	mock *MockfollowService
+}
+
+// NewMockfollowService creates a new mock instance.
+func NewMockfollowService(ctrl *gomock.Controller) *MockfollowService {
+	mock := &MockfollowService{ctrl: ctrl}
+	mock.recorder = &MockfollowServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfollowService) EXPECT() *MockfollowServiceMockRecorder {
+	return m.recorder
+}
+
+// Follow mocks base method.
+func (m *MockfollowService) Follow(ctx context.Context, in *dto.FollowIn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockfollowServiceMockRecorder) Follow(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockfollowService)(nil).Follow), ctx, in)
+}
@@ -0,0 +1,118 @@
+package follows_get
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+type request struct {
+	AuthorNickName string
+	Cursor         *dto.FollowCursor
+	Limit          int
+}
+
+type response struct {
+	Users      []user  `json:"users"`
+	Count      int     `json:"count"`
+	HasMore    bool    `json:"hasMore"`
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+type user struct {
+	NickName    string    `json:"nickName"`
+	DisplayName string    `json:"displayName"`
+	FollowedAt  time.Time `json:"followedAt"`
+}
+
+// cursor is the opaque cursor representation given to clients.
+type cursor struct {
+	FollowedAt time.Time `json:"f"`
+	UserID     string    `json:"i"`
+}
+
+func parseRequest(in *http.Request) (request, error) {
+	query := in.URL.Query()
+	out := request{
+		AuthorNickName: in.PathValue("nickname"),
+		Limit:          defaultLimit,
+	}
+
+	if rawCursor := query.Get("cursor"); rawCursor != "" {
+		c, err := decodeCursor(rawCursor)
+		if err != nil {
+			return out, errInvalidCursor
+		}
+
+		out.Cursor = &dto.FollowCursor{FollowedAt: c.FollowedAt, UserID: c.UserID}
+	}
+
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		limit, err := strconv.Atoi(rawLimit)
+		if err != nil || limit < 1 || limit > maxLimit {
+			return out, errors.New("limit must be between 1 and 100")
+		}
+
+		out.Limit = limit
+	}
+
+	return out, nil
+}
+
+func decodeCursor(raw string) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &cursor{}
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+
+	if c.UserID == "" {
+		return nil, errInvalidCursor
+	}
+
+	return c, nil
+}
+
+func encodeCursor(in *dto.FollowCursor) *string {
+	if in == nil {
+		return nil
+	}
+
+	data, _ := json.Marshal(cursor{FollowedAt: in.FollowedAt, UserID: in.UserID})
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	return &encoded
+}
+
+func convertResponse(out *dto.GetFollowsOut) response {
+	users := make([]user, 0, len(out.Follows))
+	for _, f := range out.Follows {
+		users = append(users, user{
+			NickName:    f.User.NickName,
+			DisplayName: f.User.DisplayName,
+			FollowedAt:  f.FollowedAt,
+		})
+	}
+
+	return response{
+		Users:      users,
+		Count:      out.Count,
+		HasMore:    out.NextCursor != nil,
+		NextCursor: encodeCursor(out.NextCursor),
+	}
+}
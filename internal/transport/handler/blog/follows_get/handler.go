@@ -0,0 +1,62 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package follows_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type followService interface {
+	Get(ctx context.Context, in *dto.GetFollowsIn) (*dto.GetFollowsOut, error)
+}
+
+// Handler serves one of the follow lists of authors, either dto.FollowListFollowers or dto.FollowListFollowing.
+type Handler struct {
+	list          string
+	followService followService
+	logger        log.Logger
+}
+
+func NewHandler(
+	list string,
+	followService followService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		list:          list,
+		followService: followService,
+		logger:        logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	req, err := parseRequest(ctx.Request())
+	if err != nil {
+		corehttputil.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.followService.Get(ctx, &dto.GetFollowsIn{
+		List:           h.list,
+		AuthorNickName: req.AuthorNickName,
+		Cursor:         req.Cursor,
+		Limit:          req.Limit,
+	})
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrAuthorNotFound):
+		corehttputil.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get error on follow service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
@@ -0,0 +1,117 @@
+package follows_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/follows_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	// {"f":"2024-01-01T00:00:00Z","i":"2"}
+	const followCursor = "eyJmIjoiMjAyNC0wMS0wMVQwMDowMDowMFoiLCJpIjoiMiJ9"
+	followedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		query  url.Values
+		setup  func(followSvc *mock.MockfollowService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:  "invalid cursor",
+			query: url.Values{"cursor": {"foo"}},
+			setup: func(followSvc *mock.MockfollowService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "invalid cursor"}`, res.Body.String())
+			},
+		},
+		{
+			name:  "invalid limit",
+			query: url.Values{"limit": {"101"}},
+			setup: func(followSvc *mock.MockfollowService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "limit must be between 1 and 100"}`, res.Body.String())
+			},
+		},
+		{
+			name: "author not found",
+			setup: func(followSvc *mock.MockfollowService) {
+				followSvc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, apperrors.ErrAuthorNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "follow service error",
+			setup: func(followSvc *mock.MockfollowService) {
+				followSvc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, []string{`{"level":"error","error":"dummy error","message":"get error on follow service"}`}, logs)
+			},
+		},
+		{
+			name:  "ok",
+			query: url.Values{"cursor": {followCursor}, "limit": {"1"}},
+			setup: func(followSvc *mock.MockfollowService) {
+				followSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetFollowsIn{
+						List:           dto.FollowListFollowers,
+						AuthorNickName: "bob",
+						Cursor:         &dto.FollowCursor{FollowedAt: followedAt, UserID: "2"},
+						Limit:          1,
+					})).
+					Return(&dto.GetFollowsOut{
+						Follows: []dto.Follow{
+							{UserID: "2", User: dto.ArticleAuthor{NickName: "alice", DisplayName: "Alice"}, FollowedAt: followedAt},
+						},
+						Count:      3,
+						NextCursor: &dto.FollowCursor{FollowedAt: followedAt, UserID: "2"},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, `{
+					"users": [{"nickName": "alice", "displayName": "Alice", "followedAt": "2024-01-01T00:00:00Z"}],
+					"count": 3,
+					"hasMore": true,
+					"nextCursor": "`+followCursor+`"
+				}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			followSvc := mock.NewMockfollowService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			req.URL.RawQuery = tt.query.Encode()
+			req.SetPathValue("nickname", "bob")
+
+			tt.setup(followSvc)
+
+			NewHandler(dto.FollowListFollowers, followSvc, logger).Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
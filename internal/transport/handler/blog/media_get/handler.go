@@ -0,0 +1,82 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package media_get
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+// cacheControl lets clients and proxies keep media for a year, media files never change once uploaded.
+const cacheControl = "public, max-age=31536000, immutable"
+
+var nameRegexp = regexp.MustCompile(`^(original|w[0-9]+)\.(jpg|png|webp)$`)
+
+type mediaService interface {
+	Get(ctx context.Context, mediaID, name string) (*dto.Blob, error)
+}
+
+type Handler struct {
+	mediaService mediaService
+	logger       log.Logger
+	validator    validation.Validator
+}
+
+func NewHandler(
+	mediaService mediaService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		mediaService: mediaService,
+		logger:       logger,
+		validator:    validator,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	mediaID := ctx.Request().PathValue("id")
+	name := ctx.Request().PathValue("name")
+
+	if err := h.validator.Var(mediaID, "required,uuid"); err != nil || !nameRegexp.MatchString(name) {
+		corehttputil.RespondNotFound(ctx)
+		return
+	}
+
+	blob, err := h.mediaService.Get(ctx, mediaID, name)
+
+	switch {
+	case err == nil:
+		h.respond(ctx, blob)
+	case errors.Is(err, apperrors.ErrMediaNotFound):
+		corehttputil.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get error on media service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) respond(ctx corehttp.Context, blob *dto.Blob) {
+	defer blob.Body.Close()
+
+	w := ctx.ResponseWriter()
+	w.Header().Set("Content-Type", blob.ContentType)
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if blob.Size >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(blob.Size, 10))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.Copy(w, blob.Body)
+}
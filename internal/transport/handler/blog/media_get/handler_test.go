@@ -0,0 +1,117 @@
+package media_get
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/media_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const mediaID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+
+	for _, tt := range []struct {
+		name      string
+		mediaName string
+		setup     func(mediaSvc *mock.MockmediaService, validator *mockvalidation.MockValidator)
+		assert    func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:      "invalid id",
+			mediaName: "original.png",
+			setup: func(mediaSvc *mock.MockmediaService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Eq(mediaID), gomock.Eq("required,uuid")).Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:      "invalid name",
+			mediaName: "..png",
+			setup: func(mediaSvc *mock.MockmediaService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:      "not found",
+			mediaName: "original.png",
+			setup: func(mediaSvc *mock.MockmediaService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				mediaSvc.EXPECT().Get(gomock.Any(), gomock.Eq(mediaID), gomock.Eq("original.png")).Return(nil, apperrors.ErrMediaNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:      "media service error",
+			mediaName: "original.png",
+			setup: func(mediaSvc *mock.MockmediaService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				mediaSvc.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"get error on media service"}`, logs[0])
+			},
+		},
+		{
+			name:      "ok",
+			mediaName: "w320.webp",
+			setup: func(mediaSvc *mock.MockmediaService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				mediaSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(mediaID), gomock.Eq("w320.webp")).
+					Return(&dto.Blob{Body: io.NopCloser(strings.NewReader("webp")), ContentType: "image/webp", Size: 4}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "webp", res.Body.String())
+				assert.Equal(t, "image/webp", res.Header().Get("Content-Type"))
+				assert.Equal(t, "4", res.Header().Get("Content-Length"))
+				assert.Equal(t, "public, max-age=31536000, immutable", res.Header().Get("Cache-Control"))
+				assert.Equal(t, "nosniff", res.Header().Get("X-Content-Type-Options"))
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mediaSvc := mock.NewMockmediaService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			req.SetPathValue("id", mediaID)
+			req.SetPathValue("name", tt.mediaName)
+
+			tt.setup(mediaSvc, validator)
+
+			handler := NewHandler(mediaSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
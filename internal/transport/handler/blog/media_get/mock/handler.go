@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockmediaService is a mock of mediaService interface.
+type MockmediaService struct {
+	ctrl     *gomock.Controller
+	recorder *MockmediaServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockmediaServiceMockRecorder is the mock recorder for MockmediaService.
+type MockmediaServiceMockRecorder struct {
+	mock *MockmediaService
+}
+
+// NewMockmediaService creates a new mock instance.
+func NewMockmediaService(ctrl *gomock.Controller) *MockmediaService {
+	mock := &MockmediaService{ctrl: ctrl}
+	mock.recorder = &MockmediaServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmediaService) EXPECT() *MockmediaServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockmediaService) Get(ctx context.Context, mediaID, name string) (*dto.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, mediaID, name)
+	ret0, _ := ret[0].(*dto.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockmediaServiceMockRecorder) Get(ctx, mediaID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockmediaService)(nil).Get), ctx, mediaID, name)
+}
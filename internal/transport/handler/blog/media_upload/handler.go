@@ -0,0 +1,147 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package media_upload
+
+import (
+	"context"
+	"errors"
+	"io"
+	nethttp "net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+// formOverhead is allowed on top of the file size for multipart boundaries and other form fields.
+const formOverhead = 64 << 10
+
+var errNoFile = errors.New("multipart form with the file field is expected")
+
+type mediaService interface {
+	Upload(ctx context.Context, in *dto.UploadMediaIn) (*dto.Media, error)
+}
+
+type response struct {
+	ID       string    `json:"id"`
+	Width    int       `json:"width"`
+	Height   int       `json:"height"`
+	Variants []variant `json:"variants"`
+}
+
+type variant struct {
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	ContentType string `json:"contentType"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Size        int64  `json:"size"`
+}
+
+type Handler struct {
+	maxSize      int64
+	mediaService mediaService
+	logger       log.Logger
+}
+
+// NewHandler creates the upload handler, maxSize is the size limit of uploaded files in bytes.
+func NewHandler(
+	maxSize int64,
+	mediaService mediaService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		maxSize:      maxSize,
+		mediaService: mediaService,
+		logger:       logger,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	data, err := h.readFile(ctx)
+	if err != nil {
+		var maxBytesErr *nethttp.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			util.RespondPayloadTooLarge(ctx, "The file is too large.")
+			return
+		}
+
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.mediaService.Upload(ctx, &dto.UploadMediaIn{
+		UserID: userID,
+		Data:   data,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusCreated, convertResponse(out))
+	case errors.Is(err, apperrors.ErrMediaTooLarge):
+		util.RespondPayloadTooLarge(ctx, "The file is too large.")
+	case errors.Is(err, apperrors.ErrMediaUnsupportedType):
+		util.RespondBadRequest(ctx, "Only JPEG, PNG, GIF and WebP images are supported.")
+	case errors.Is(err, apperrors.ErrMediaInvalid):
+		util.RespondBadRequest(ctx, "The image can't be decoded.")
+	default:
+		h.logger.Error().Err(err).Msg("upload error on media service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+// readFile reads the "file" field of the multipart form. One byte over the limit is read,
+// so the media service tells files of the exact limit size from larger ones.
+func (h *Handler) readFile(ctx http.Context) ([]byte, error) {
+	req := ctx.Request()
+	req.Body = nethttp.MaxBytesReader(ctx.ResponseWriter(), req.Body, h.maxSize+formOverhead)
+
+	reader, err := req.MultipartReader()
+	if err != nil {
+		return nil, errNoFile
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, errNoFile
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if part.FormName() == "file" {
+			return io.ReadAll(io.LimitReader(part, h.maxSize+1))
+		}
+	}
+}
+
+func convertResponse(out *dto.Media) response {
+	variants := make([]variant, 0, len(out.Variants))
+	for _, v := range out.Variants {
+		variants = append(variants, variant{
+			Name:        v.Name,
+			URL:         v.URL,
+			ContentType: v.ContentType,
+			Width:       v.Width,
+			Height:      v.Height,
+			Size:        v.Size,
+		})
+	}
+
+	return response{
+		ID:       out.ID,
+		Width:    out.Width,
+		Height:   out.Height,
+		Variants: variants,
+	}
+}
@@ -0,0 +1,165 @@
+package media_upload
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/media_upload/mock"
+)
+
+func newForm(field string, data []byte) (string, io.Reader) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile(field, "image.png")
+	_, _ = part.Write(data)
+	_ = writer.Close()
+
+	return writer.FormDataContentType(), body
+}
+
+func TestHandler(t *testing.T) {
+	expectedIn := &dto.UploadMediaIn{UserID: "user id", Data: []byte("image")}
+
+	for _, tt := range []struct {
+		name   string
+		field  string
+		data   []byte
+		setup  func(mediaSvc *mock.MockmediaService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:  "no file",
+			field: "image",
+			data:  []byte("image"),
+			setup: func(mediaSvc *mock.MockmediaService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "multipart form with the file field is expected"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "file too large",
+			field: "file",
+			data:  []byte(strings.Repeat("a", 20)),
+			setup: func(mediaSvc *mock.MockmediaService) {
+				mediaSvc.EXPECT().
+					Upload(gomock.Any(), gomock.Eq(&dto.UploadMediaIn{UserID: "user id", Data: []byte(strings.Repeat("a", 11))})).
+					Return(nil, apperrors.ErrMediaTooLarge)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "unsupported type",
+			field: "file",
+			data:  []byte("image"),
+			setup: func(mediaSvc *mock.MockmediaService) {
+				mediaSvc.EXPECT().Upload(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrMediaUnsupportedType)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "Only JPEG, PNG, GIF and WebP images are supported."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "invalid image",
+			field: "file",
+			data:  []byte("image"),
+			setup: func(mediaSvc *mock.MockmediaService) {
+				mediaSvc.EXPECT().Upload(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrMediaInvalid)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "The image can't be decoded."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "media service error",
+			field: "file",
+			data:  []byte("image"),
+			setup: func(mediaSvc *mock.MockmediaService) {
+				mediaSvc.EXPECT().Upload(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"upload error on media service"}`, logs[0])
+			},
+		},
+		{
+			name:  "ok",
+			field: "file",
+			data:  []byte("image"),
+			setup: func(mediaSvc *mock.MockmediaService) {
+				mediaSvc.EXPECT().Upload(gomock.Any(), gomock.Eq(expectedIn)).Return(&dto.Media{
+					ID:     "media id",
+					Width:  640,
+					Height: 480,
+					Variants: []dto.MediaVariant{{
+						Name:        "original.png",
+						ContentType: "image/png",
+						Width:       640,
+						Height:      480,
+						Size:        1024,
+						URL:         "https://example.com/media/media%20id/original.png",
+					}},
+				}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				expResBody := `{
+					"id": "media id",
+					"width": 640,
+					"height": 480,
+					"variants": [{
+						"name": "original.png",
+						"url": "https://example.com/media/media%20id/original.png",
+						"contentType": "image/png",
+						"width": 640,
+						"height": 480,
+						"size": 1024
+					}]
+				}`
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mediaSvc := mock.NewMockmediaService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			contentType, body := newForm(tt.field, tt.data)
+			req.Header.Set("Content-Type", contentType)
+			req.Body = io.NopCloser(body)
+
+			tt.setup(mediaSvc)
+
+			handler := NewHandler(10, mediaSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,106 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package moderation_action_create
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type moderationService interface {
+	Moderate(ctx context.Context, in *dto.ModerateIn) (*dto.ModerationAction, error)
+}
+
+type request struct {
+	Action     string  `json:"action" validate:"required,oneof=hide restore"`
+	TargetType string  `json:"targetType" validate:"required,oneof=article comment"`
+	TargetID   string  `json:"targetId" validate:"required,uuid"`
+	ReportID   *string `json:"reportId" validate:"omitempty,uuid"`
+	Note       string  `json:"note" validate:"lte=1000"`
+}
+
+type response struct {
+	ID        string    `json:"id"`
+	Action    string    `json:"action"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Handler struct {
+	moderationService moderationService
+	logger            log.Logger
+	validator         validation.Validator
+}
+
+func NewHandler(
+	moderationService moderationService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		moderationService: moderationService,
+		logger:            logger,
+		validator:         validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.moderationService.Moderate(ctx, &dto.ModerateIn{
+		UserID:     userID,
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		ReportID:   req.ReportID,
+		Note:       req.Note,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusCreated, response{
+			ID:        out.ID,
+			Action:    out.Action,
+			CreatedAt: out.CreatedAt,
+		})
+	case errors.Is(err, apperrors.ErrModerationTargetNotFound), errors.Is(err, apperrors.ErrReportNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("moderate error on moderation service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,139 @@
+package moderation_action_create
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/moderation_action_create/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const (
+		articleID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+		reportID  = "5b1f4a5e-9f0e-4a52-8a3e-0d6a3d1a2b7c"
+	)
+	body := `{"action": "hide", "targetType": "article", "targetId": "` + articleID + `", "reportId": "` + reportID + `", "note": "spam"}`
+	expectedIn := &dto.ModerateIn{
+		UserID:     "user id",
+		Action:     "hide",
+		TargetType: "article",
+		TargetID:   articleID,
+		ReportID:   pointer.To(reportID),
+		Note:       "spam",
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "validation error",
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{Action: "hide", TargetType: "article", TargetID: articleID, ReportID: pointer.To(reportID), Note: "spam"})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "target not found",
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				moderationSvc.EXPECT().Moderate(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrModerationTargetNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "report not found",
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				moderationSvc.EXPECT().Moderate(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrReportNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				moderationSvc.EXPECT().Moderate(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "moderation service error",
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				moderationSvc.EXPECT().Moderate(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"moderate error on moderation service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				moderationSvc.EXPECT().
+					Moderate(gomock.Any(), gomock.Eq(expectedIn)).
+					Return(&dto.ModerationAction{
+						ID:        "action id",
+						Action:    "hide",
+						CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.JSONEq(t, `{"id": "action id", "action": "hide", "createdAt": "2024-01-01T00:00:00Z"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			moderationSvc := mock.NewMockmoderationService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.Body = io.NopCloser(strings.NewReader(body))
+
+			tt.setup(moderationSvc, validator)
+
+			handler := NewHandler(moderationSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockmoderationService is a mock of moderationService interface.
+type MockmoderationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockmoderationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockmoderationServiceMockRecorder is the mock recorder for MockmoderationService.
+type MockmoderationServiceMockRecorder struct {
+	mock *MockmoderationService
+}
+
+// NewMockmoderationService creates a new mock instance.
+func NewMockmoderationService(ctrl *gomock.Controller) *MockmoderationService {
+	mock := &MockmoderationService{ctrl: ctrl}
+	mock.recorder = &MockmoderationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmoderationService) EXPECT() *MockmoderationServiceMockRecorder {
+	return m.recorder
+}
+
+// Moderate mocks base method.
+func (m *MockmoderationService) Moderate(ctx context.Context, in *dto.ModerateIn) (*dto.ModerationAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Moderate", ctx, in)
+	ret0, _ := ret[0].(*dto.ModerationAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Moderate indicates an expected call of Moderate.
+func (mr *MockmoderationServiceMockRecorder) Moderate(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Moderate", reflect.TypeOf((*MockmoderationService)(nil).Moderate), ctx, in)
+}
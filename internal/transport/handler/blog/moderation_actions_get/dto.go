@@ -0,0 +1,61 @@
+package moderation_actions_get
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+type request struct {
+	TargetType string `validate:"omitempty,oneof=article comment"`
+	TargetID   string `validate:"omitempty,uuid"`
+	FromID     string `validate:"omitempty,uuid"`
+}
+
+type response struct {
+	Actions []action `json:"actions"`
+	HasMore bool     `json:"hasMore"`
+}
+
+type action struct {
+	ID          string    `json:"id"`
+	ModeratorID string    `json:"moderatorId"`
+	Action      string    `json:"action"`
+	TargetType  string    `json:"targetType"`
+	TargetID    string    `json:"targetId"`
+	ReportID    *string   `json:"reportId,omitempty"`
+	Note        string    `json:"note"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+func parseRequest(in *http.Request) *request {
+	query := in.URL.Query()
+
+	return &request{
+		TargetType: query.Get("targetType"),
+		TargetID:   query.Get("targetId"),
+		FromID:     query.Get("fromId"),
+	}
+}
+
+func convertResponse(out *dto.GetModerationActionsOut) response {
+	actions := make([]action, 0, len(out.Actions))
+	for _, a := range out.Actions {
+		actions = append(actions, action{
+			ID:          a.ID,
+			ModeratorID: a.ModeratorID,
+			Action:      a.Action,
+			TargetType:  a.TargetType,
+			TargetID:    a.TargetID,
+			ReportID:    a.ReportID,
+			Note:        a.Note,
+			CreatedAt:   a.CreatedAt,
+		})
+	}
+
+	return response{
+		Actions: actions,
+		HasMore: out.HasMore,
+	}
+}
@@ -0,0 +1,73 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package moderation_actions_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type moderationService interface {
+	GetActions(ctx context.Context, in *dto.GetModerationActionsIn) (*dto.GetModerationActionsOut, error)
+}
+
+type Handler struct {
+	moderationService moderationService
+	logger            log.Logger
+	validator         validation.Validator
+}
+
+func NewHandler(
+	moderationService moderationService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		moderationService: moderationService,
+		logger:            logger,
+		validator:         validator,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		corehttputil.RespondUnauthorized(ctx)
+		return
+	}
+
+	req := parseRequest(ctx.Request())
+	if err := h.validator.Struct(req); err != nil {
+		corehttputil.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	in := &dto.GetModerationActionsIn{
+		UserID:     userID,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+	}
+	if req.FromID != "" {
+		in.FromID = &req.FromID
+	}
+
+	out, err := h.moderationService.GetActions(ctx, in)
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrForbidden):
+		corehttputil.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get actions error on moderation service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
@@ -0,0 +1,135 @@
+package moderation_actions_get
+
+import (
+	_ "embed"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/moderation_actions_get/mock"
+)
+
+//go:embed testdata/ok.json
+var expectedBodyOK []byte
+
+func TestHandler(t *testing.T) {
+	const articleID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		query  string
+		setup  func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:  "validation error",
+			query: "targetType=foo",
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Eq(&request{TargetType: "foo"})).Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				moderationSvc.EXPECT().
+					GetActions(gomock.Any(), gomock.Eq(&dto.GetModerationActionsIn{UserID: "user id"})).
+					Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "moderation service error",
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				moderationSvc.EXPECT().GetActions(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error","error":"dummy error","message":"get actions error on moderation service"}`, logs[0])
+			},
+		},
+		{
+			name:  "ok",
+			query: "targetType=article&targetId=" + articleID + "&fromId=" + articleID,
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{TargetType: "article", TargetID: articleID, FromID: articleID})).
+					Return(nil)
+				moderationSvc.EXPECT().
+					GetActions(gomock.Any(), gomock.Eq(&dto.GetModerationActionsIn{
+						UserID:     "user id",
+						TargetType: "article",
+						TargetID:   articleID,
+						FromID:     pointer.To(articleID),
+					})).
+					Return(&dto.GetModerationActionsOut{
+						Actions: []*dto.ModerationAction{
+							{
+								ID:          "2",
+								ModeratorID: "moderator id",
+								Action:      "restore",
+								TargetType:  "article",
+								TargetID:    articleID,
+								CreatedAt:   createdAt.Add(time.Hour * 24),
+							},
+							{
+								ID:          "1",
+								ModeratorID: "moderator id",
+								Action:      "hide",
+								TargetType:  "article",
+								TargetID:    articleID,
+								ReportID:    pointer.To("report id"),
+								Note:        "spam",
+								CreatedAt:   createdAt,
+							},
+						},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, string(expectedBodyOK), res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			moderationSvc := mock.NewMockmoderationService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.URL.RawQuery = tt.query
+
+			tt.setup(moderationSvc, validator)
+
+			handler := NewHandler(moderationSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,106 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package moderation_report_put
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type moderationService interface {
+	SetReportState(ctx context.Context, in *dto.SetReportStateIn) (*dto.Report, error)
+}
+
+type request struct {
+	ID    string `json:"-" validate:"required,uuid"`
+	State string `json:"state" validate:"required,oneof=open actioned dismissed"`
+	Note  string `json:"note" validate:"lte=1000"`
+}
+
+type response struct {
+	ID         string     `json:"id"`
+	State      string     `json:"state"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+}
+
+type Handler struct {
+	moderationService moderationService
+	logger            log.Logger
+	validator         validation.Validator
+}
+
+func NewHandler(
+	moderationService moderationService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		moderationService: moderationService,
+		logger:            logger,
+		validator:         validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.moderationService.SetReportState(ctx, &dto.SetReportStateIn{
+		UserID:   userID,
+		ReportID: req.ID,
+		State:    req.State,
+		Note:     req.Note,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusOK, response{
+			ID:         out.ID,
+			State:      out.State,
+			ResolvedAt: out.ResolvedAt,
+		})
+	case errors.Is(err, apperrors.ErrReportNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	case errors.Is(err, apperrors.ErrReportStateTransition):
+		util.RespondBadRequest(ctx, "Report can't be moved to this state.")
+	default:
+		h.logger.Error().Err(err).Msg("set report state error on moderation service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	req.ID = ctx.Request().PathValue("id")
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockmoderationService is a mock of moderationService interface.
+type MockmoderationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockmoderationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockmoderationServiceMockRecorder is the mock recorder for MockmoderationService.
+type MockmoderationServiceMockRecorder struct {
+	mock *MockmoderationService
+}
+
+// NewMockmoderationService creates a new mock instance.
+func NewMockmoderationService(ctrl *gomock.Controller) *MockmoderationService {
+	mock := &MockmoderationService{ctrl: ctrl}
+	mock.recorder = &MockmoderationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmoderationService) EXPECT() *MockmoderationServiceMockRecorder {
+	return m.recorder
+}
+
+// SetReportState mocks base method.
+func (m *MockmoderationService) SetReportState(ctx context.Context, in *dto.SetReportStateIn) (*dto.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReportState", ctx, in)
+	ret0, _ := ret[0].(*dto.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReportState indicates an expected call of SetReportState.
+func (mr *MockmoderationServiceMockRecorder) SetReportState(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReportState", reflect.TypeOf((*MockmoderationService)(nil).SetReportState), ctx, in)
+}
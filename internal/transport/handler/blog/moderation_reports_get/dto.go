@@ -0,0 +1,95 @@
+package moderation_reports_get
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+type request struct {
+	State      string
+	TargetType string
+	FromID     *string
+}
+
+type response struct {
+	Reports []report `json:"reports"`
+	HasMore bool     `json:"hasMore"`
+}
+
+type report struct {
+	ID         string     `json:"id"`
+	TargetType string     `json:"targetType"`
+	TargetID   string     `json:"targetId"`
+	ReporterID string     `json:"reporterId"`
+	Reason     string     `json:"reason"`
+	State      string     `json:"state"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+	Target     *target    `json:"target,omitempty"`
+}
+
+type target struct {
+	AuthorID    string `json:"authorId"`
+	ArticleSlug string `json:"articleSlug"`
+	Hidden      bool   `json:"hidden"`
+}
+
+func parseRequest(in *http.Request) (request, error) {
+	query := in.URL.Query()
+	out := request{
+		State:      query.Get("state"),
+		TargetType: query.Get("targetType"),
+	}
+
+	if out.State != "" && !slices.Contains(dto.ReportStates, out.State) {
+		return request{}, errors.New("state must be one of: open, actioned, dismissed")
+	}
+
+	if out.TargetType != "" && !slices.Contains(dto.ModerationTargets, out.TargetType) {
+		return request{}, errors.New("targetType must be one of: article, comment")
+	}
+
+	if fromID := query.Get("fromId"); fromID != "" {
+		out.FromID = &fromID
+	}
+
+	return out, nil
+}
+
+func convertResponse(out *dto.GetReportsOut) response {
+	reports := make([]report, 0, len(out.Reports))
+	for _, r := range out.Reports {
+		reports = append(reports, report{
+			ID:         r.ID,
+			TargetType: r.TargetType,
+			TargetID:   r.TargetID,
+			ReporterID: r.ReporterID,
+			Reason:     r.Reason,
+			State:      r.State,
+			CreatedAt:  r.CreatedAt,
+			ResolvedAt: r.ResolvedAt,
+			Target:     convertTarget(r.Target),
+		})
+	}
+
+	return response{
+		Reports: reports,
+		HasMore: out.HasMore,
+	}
+}
+
+func convertTarget(in *dto.ModerationTarget) *target {
+	if in == nil {
+		return nil
+	}
+
+	return &target{
+		AuthorID:    in.AuthorID,
+		ArticleSlug: in.ArticleSlug,
+		Hidden:      in.Hidden,
+	}
+}
@@ -0,0 +1,65 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package moderation_reports_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type moderationService interface {
+	GetReports(ctx context.Context, in *dto.GetReportsIn) (*dto.GetReportsOut, error)
+}
+
+type Handler struct {
+	moderationService moderationService
+	logger            log.Logger
+}
+
+func NewHandler(
+	moderationService moderationService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		moderationService: moderationService,
+		logger:            logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		corehttputil.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := parseRequest(ctx.Request())
+	if err != nil {
+		corehttputil.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.moderationService.GetReports(ctx, &dto.GetReportsIn{
+		UserID:     userID,
+		State:      req.State,
+		TargetType: req.TargetType,
+		FromID:     req.FromID,
+	})
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrForbidden):
+		corehttputil.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get reports error on moderation service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
@@ -0,0 +1,144 @@
+package moderation_reports_get
+
+import (
+	_ "embed"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/moderation_reports_get/mock"
+)
+
+//go:embed testdata/ok.json
+var expectedBodyOK []byte
+
+func TestHandler(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		query  string
+		setup  func(moderationSvc *mock.MockmoderationService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:  "invalid state",
+			query: "state=foo",
+			setup: func(moderationSvc *mock.MockmoderationService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "state must be one of: open, actioned, dismissed"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "invalid target type",
+			query: "targetType=foo",
+			setup: func(moderationSvc *mock.MockmoderationService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "targetType must be one of: article, comment"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(moderationSvc *mock.MockmoderationService) {
+				moderationSvc.EXPECT().
+					GetReports(gomock.Any(), gomock.Eq(&dto.GetReportsIn{UserID: "user id"})).
+					Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "moderation service error",
+			setup: func(moderationSvc *mock.MockmoderationService) {
+				moderationSvc.EXPECT().GetReports(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error","error":"dummy error","message":"get reports error on moderation service"}`, logs[0])
+			},
+		},
+		{
+			name:  "ok",
+			query: "state=actioned&targetType=article&fromId=foo",
+			setup: func(moderationSvc *mock.MockmoderationService) {
+				moderationSvc.EXPECT().
+					GetReports(gomock.Any(), gomock.Eq(&dto.GetReportsIn{
+						UserID:     "user id",
+						State:      "actioned",
+						TargetType: "article",
+						FromID:     pointer.To("foo"),
+					})).
+					Return(&dto.GetReportsOut{
+						Reports: []*dto.Report{
+							{
+								ID:         "1",
+								TargetType: "article",
+								TargetID:   "article id",
+								ReporterID: "reporter id",
+								Reason:     "spam",
+								State:      "actioned",
+								CreatedAt:  createdAt,
+								ResolvedAt: pointer.To(createdAt.Add(time.Hour * 24)),
+								Target: &dto.ModerationTarget{
+									Type:        "article",
+									ID:          "article id",
+									AuthorID:    "author id",
+									ArticleSlug: "foo-article",
+									Hidden:      true,
+								},
+							},
+							{
+								ID:         "2",
+								TargetType: "comment",
+								TargetID:   "comment id",
+								ReporterID: "reporter id",
+								Reason:     "rude",
+								State:      "actioned",
+								CreatedAt:  createdAt,
+							},
+						},
+						HasMore: true,
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, string(expectedBodyOK), res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			moderationSvc := mock.NewMockmoderationService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.URL.RawQuery = tt.query
+
+			tt.setup(moderationSvc)
+
+			handler := NewHandler(moderationSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package reaction_delete
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/reaction_delete/mock"
+)
+
+func TestHandler(t *testing.T) {
+	expectedIn := &dto.ReactionIn{ArticleSlug: "foo", UserID: "user id", Kind: "like"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(reactionSvc *mock.MockreactionService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "unknown kind",
+			setup: func(reactionSvc *mock.MockreactionService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Var(gomock.Eq("like"), gomock.Eq("required,oneof=like love celebrate insightful curious")).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(reactionSvc *mock.MockreactionService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				reactionSvc.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(expectedIn)).
+					Return(nil, apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "reaction service error",
+			setup: func(reactionSvc *mock.MockreactionService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				reactionSvc.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(expectedIn)).
+					Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"delete error on reaction service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(reactionSvc *mock.MockreactionService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				reactionSvc.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(expectedIn)).
+					Return(&dto.ArticleReactions{
+						Counts: map[string]int64{"like": 3, "love": 1},
+						Own:    []string{"love"},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, `{"reactions": {"like": 3, "love": 1}, "ownReactions": ["love"]}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reactionSvc := mock.NewMockreactionService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo")
+			req.SetPathValue("kind", "like")
+
+			tt.setup(reactionSvc, validator)
+
+			handler := NewHandler(reactionSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockreactionService is a mock of reactionService interface.
+type MockreactionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockreactionServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockreactionServiceMockRecorder is the mock recorder for MockreactionService.
+type MockreactionServiceMockRecorder struct {
+	mock *MockreactionService
+}
+
+// NewMockreactionService creates a new mock instance.
+func NewMockreactionService(ctrl *gomock.Controller) *MockreactionService {
+	mock := &MockreactionService{ctrl: ctrl}
+	mock.recorder = &MockreactionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreactionService) EXPECT() *MockreactionServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockreactionService) Delete(ctx context.Context, in *dto.ReactionIn) (*dto.ArticleReactions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, in)
+	ret0, _ := ret[0].(*dto.ArticleReactions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockreactionServiceMockRecorder) Delete(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockreactionService)(nil).Delete), ctx, in)
+}
@@ -0,0 +1,89 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package reaction_put
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"strings"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+var kindValidationTag = "required,oneof=" + strings.Join(dto.ReactionKinds, " ")
+
+type reactionService interface {
+	Put(ctx context.Context, in *dto.ReactionIn) (*dto.ArticleReactions, error)
+}
+
+type response struct {
+	Reactions    map[string]int64 `json:"reactions"`
+	OwnReactions []string         `json:"ownReactions"`
+}
+
+type Handler struct {
+	reactionService reactionService
+	logger          log.Logger
+	validator       validation.Validator
+}
+
+func NewHandler(
+	reactionService reactionService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		reactionService: reactionService,
+		logger:          logger,
+		validator:       validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	kind := ctx.Request().PathValue("kind")
+	if err := h.validator.Var(kind, kindValidationTag); err != nil {
+		util.RespondNotFound(ctx)
+		return
+	}
+
+	out, err := h.reactionService.Put(ctx, &dto.ReactionIn{
+		ArticleSlug: ctx.Request().PathValue("slug"),
+		UserID:      userID,
+		Kind:        kind,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrArticleNotFound):
+		util.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("put error on reaction service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func convertResponse(out *dto.ArticleReactions) response {
+	res := response{
+		Reactions:    out.Counts,
+		OwnReactions: out.Own,
+	}
+
+	if res.OwnReactions == nil {
+		res.OwnReactions = []string{}
+	}
+
+	return res
+}
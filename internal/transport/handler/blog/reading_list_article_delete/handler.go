@@ -0,0 +1,67 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package reading_list_article_delete
+
+import (
+	"context"
+	"errors"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type readingListService interface {
+	DeleteArticle(ctx context.Context, in *dto.ReadingListArticleIn) error
+}
+
+type Handler struct {
+	readingListService readingListService
+	logger             log.Logger
+	validator          validation.Validator
+}
+
+func NewHandler(
+	readingListService readingListService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		readingListService: readingListService,
+		logger:             logger,
+		validator:          validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	listID := ctx.Request().PathValue("id")
+	if err := h.validator.Var(listID, "required,uuid"); err != nil {
+		util.RespondNotFound(ctx)
+		return
+	}
+
+	err := h.readingListService.DeleteArticle(ctx, &dto.ReadingListArticleIn{
+		UserID:      userID,
+		ListID:      listID,
+		ArticleSlug: ctx.Request().PathValue("slug"),
+	})
+
+	switch {
+	case err == nil:
+		util.RespondNoContent(ctx)
+	case errors.Is(err, apperrors.ErrReadingListNotFound), errors.Is(err, apperrors.ErrArticleNotFound):
+		util.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("delete article error on reading list service")
+		util.RespondInternalError(ctx)
+	}
+}
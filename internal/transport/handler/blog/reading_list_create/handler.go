@@ -0,0 +1,91 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package reading_list_create
+
+import (
+	"context"
+	nethttp "net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type readingListService interface {
+	Create(ctx context.Context, in *dto.CreateReadingListIn) (*dto.ReadingList, error)
+}
+
+type request struct {
+	Name string `json:"name" validate:"required,lte=100"`
+}
+
+type response struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Handler struct {
+	readingListService readingListService
+	logger             log.Logger
+	validator          validation.Validator
+}
+
+func NewHandler(
+	readingListService readingListService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		readingListService: readingListService,
+		logger:             logger,
+		validator:          validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.readingListService.Create(ctx, &dto.CreateReadingListIn{
+		UserID: userID,
+		Name:   req.Name,
+	})
+	if err != nil {
+		h.logger.Error().Err(err).Msg("create error on reading list service")
+		util.RespondInternalError(ctx)
+		return
+	}
+
+	util.Respond(ctx, nethttp.StatusCreated, response{
+		ID:        out.ID,
+		Name:      out.Name,
+		CreatedAt: out.CreatedAt,
+	})
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
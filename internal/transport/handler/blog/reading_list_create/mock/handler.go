@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockreadingListService is a mock of readingListService interface.
+type MockreadingListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockreadingListServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockreadingListServiceMockRecorder is the mock recorder for MockreadingListService.
+type MockreadingListServiceMockRecorder struct {
+	mock *MockreadingListService
+}
+
+// NewMockreadingListService creates a new mock instance.
+func NewMockreadingListService(ctrl *gomock.Controller) *MockreadingListService {
+	mock := &MockreadingListService{ctrl: ctrl}
+	mock.recorder = &MockreadingListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreadingListService) EXPECT() *MockreadingListServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockreadingListService) Create(ctx context.Context, in *dto.CreateReadingListIn) (*dto.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, in)
+	ret0, _ := ret[0].(*dto.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockreadingListServiceMockRecorder) Create(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockreadingListService)(nil).Create), ctx, in)
+}
@@ -0,0 +1,80 @@
+package reading_list_get
+
+import (
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+type response struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Default   bool      `json:"default"`
+	ShareURL  string    `json:"shareUrl,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	Articles  []article `json:"articles"`
+}
+
+type article struct {
+	Slug          string           `json:"slug"`
+	Title         string           `json:"title"`
+	Content       string           `json:"content"`
+	Locked        bool             `json:"locked"`
+	CommentsCount int              `json:"commentsCount"`
+	Reactions     map[string]int64 `json:"reactions"`
+	OwnReactions  []string         `json:"ownReactions,omitempty"`
+	Author        *author          `json:"author,omitempty"`
+	SEO           seo              `json:"seo"`
+	CreatedAt     time.Time        `json:"createdAt"`
+}
+
+type author struct {
+	NickName    string `json:"nickName"`
+	DisplayName string `json:"displayName"`
+}
+
+type seo struct {
+	MetaDescription string `json:"metaDescription"`
+	OGImage         string `json:"ogImage"`
+	CanonicalURL    string `json:"canonicalUrl"`
+}
+
+func convertResponse(out *dto.GetReadingListOut) response {
+	articles := make([]article, 0, len(out.Articles))
+	for _, a := range out.Articles {
+		converted := article{
+			Slug:          a.Slug,
+			Title:         a.Title,
+			Content:       a.Content,
+			Locked:        a.Locked,
+			CommentsCount: a.CommentsCount,
+			Reactions:     a.ReactionCounts,
+			OwnReactions:  a.OwnReactions,
+			SEO: seo{
+				MetaDescription: a.SEO.MetaDescription,
+				OGImage:         a.SEO.OGImageURL,
+				CanonicalURL:    a.SEO.CanonicalURL,
+			},
+			CreatedAt: a.CreatedAt,
+		}
+
+		if converted.Reactions == nil {
+			converted.Reactions = map[string]int64{}
+		}
+
+		if a.Author != nil {
+			converted.Author = &author{NickName: a.Author.NickName, DisplayName: a.Author.DisplayName}
+		}
+
+		articles = append(articles, converted)
+	}
+
+	return response{
+		ID:        out.List.ID,
+		Name:      out.List.Name,
+		Default:   out.List.Default,
+		ShareURL:  out.List.ShareURL,
+		CreatedAt: out.List.CreatedAt,
+		Articles:  articles,
+	}
+}
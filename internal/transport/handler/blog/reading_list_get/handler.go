@@ -0,0 +1,72 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package reading_list_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type readingListService interface {
+	Get(ctx context.Context, in *dto.GetReadingListIn) (*dto.GetReadingListOut, error)
+}
+
+// Handler serves both own lists by the "id" path value and shared lists by the "token" path value.
+type Handler struct {
+	readingListService readingListService
+	logger             log.Logger
+	validator          validation.Validator
+}
+
+func NewHandler(
+	readingListService readingListService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		readingListService: readingListService,
+		logger:             logger,
+		validator:          validator,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	in := &dto.GetReadingListIn{
+		UserID:     userID,
+		ID:         ctx.Request().PathValue("id"),
+		ShareToken: ctx.Request().PathValue("token"),
+	}
+
+	if in.ShareToken == "" {
+		if !ok {
+			corehttputil.RespondUnauthorized(ctx)
+			return
+		}
+
+		if err := h.validator.Var(in.ID, "required,uuid"); err != nil {
+			corehttputil.RespondNotFound(ctx)
+			return
+		}
+	}
+
+	out, err := h.readingListService.Get(ctx, in)
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrReadingListNotFound):
+		corehttputil.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get error on reading list service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
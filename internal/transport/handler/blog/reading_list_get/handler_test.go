@@ -0,0 +1,180 @@
+package reading_list_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/reading_list_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const listID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name       string
+		userID     any
+		pathValues map[string]string
+		setup      func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator)
+		assert     func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:       "unauthorized",
+			userID:     nil,
+			pathValues: map[string]string{"id": listID},
+			setup:      func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:       "invalid id",
+			userID:     "user id",
+			pathValues: map[string]string{"id": "foo"},
+			setup: func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Eq("foo"), gomock.Eq("required,uuid")).Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:       "list not found",
+			userID:     "user id",
+			pathValues: map[string]string{"id": listID},
+			setup: func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				readingListSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetReadingListIn{UserID: "user id", ID: listID})).
+					Return(nil, apperrors.ErrReadingListNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:       "reading list service error",
+			userID:     "user id",
+			pathValues: map[string]string{"id": listID},
+			setup: func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				readingListSvc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"get error on reading list service"}`, logs[0])
+			},
+		},
+		{
+			name:       "own list",
+			userID:     "user id",
+			pathValues: map[string]string{"id": listID},
+			setup: func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				readingListSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetReadingListIn{UserID: "user id", ID: listID})).
+					Return(&dto.GetReadingListOut{
+						List: &dto.ReadingList{ID: listID, Name: "bookmarks", Default: true, CreatedAt: created},
+						Articles: []dto.Article{{
+							Slug:         "foo",
+							Title:        "Foo",
+							Content:      "foo content",
+							OwnReactions: []string{"like"},
+							Author:       &dto.ArticleAuthor{NickName: "bob", DisplayName: "Bob"},
+							CreatedAt:    created,
+						}},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				expResBody := `{
+					"id": "18d440f5-2664-42b1-bfaa-1c15f1687885",
+					"name": "bookmarks",
+					"default": true,
+					"createdAt": "2024-01-01T00:00:00Z",
+					"articles": [{
+						"slug": "foo",
+						"title": "Foo",
+						"content": "foo content",
+						"locked": false,
+						"commentsCount": 0,
+						"reactions": {},
+						"ownReactions": ["like"],
+						"author": {"nickName": "bob", "displayName": "Bob"},
+						"seo": {"metaDescription": "", "ogImage": "", "canonicalUrl": ""},
+						"createdAt": "2024-01-01T00:00:00Z"
+					}]
+				}`
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:       "shared list",
+			userID:     nil,
+			pathValues: map[string]string{"token": "token"},
+			setup: func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator) {
+				readingListSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetReadingListIn{ShareToken: "token"})).
+					Return(&dto.GetReadingListOut{
+						List: &dto.ReadingList{
+							ID:        listID,
+							Name:      "Go",
+							ShareURL:  "https://example.com/reading-lists/shared/token",
+							CreatedAt: created,
+						},
+						Articles: []dto.Article{},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				expResBody := `{
+					"id": "18d440f5-2664-42b1-bfaa-1c15f1687885",
+					"name": "Go",
+					"default": false,
+					"shareUrl": "https://example.com/reading-lists/shared/token",
+					"createdAt": "2024-01-01T00:00:00Z",
+					"articles": []
+				}`
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			readingListSvc := mock.NewMockreadingListService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return(tt.userID).AnyTimes()
+			for key, value := range tt.pathValues {
+				req.SetPathValue(key, value)
+			}
+
+			tt.setup(readingListSvc, validator)
+
+			handler := NewHandler(readingListSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
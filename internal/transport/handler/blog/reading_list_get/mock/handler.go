@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockreadingListService is a mock of readingListService interface.
+type MockreadingListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockreadingListServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockreadingListServiceMockRecorder is the mock recorder for MockreadingListService.
+type MockreadingListServiceMockRecorder struct {
+	mock *MockreadingListService
+}
+
+// NewMockreadingListService creates a new mock instance.
+func NewMockreadingListService(ctrl *gomock.Controller) *MockreadingListService {
+	mock := &MockreadingListService{ctrl: ctrl}
+	mock.recorder = &MockreadingListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreadingListService) EXPECT() *MockreadingListServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockreadingListService) Get(ctx context.Context, in *dto.GetReadingListIn) (*dto.GetReadingListOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, in)
+	ret0, _ := ret[0].(*dto.GetReadingListOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockreadingListServiceMockRecorder) Get(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockreadingListService)(nil).Get), ctx, in)
+}
@@ -0,0 +1,90 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package reading_list_order_put
+
+import (
+	"context"
+	"errors"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type readingListService interface {
+	Reorder(ctx context.Context, in *dto.ReorderReadingListIn) error
+}
+
+type request struct {
+	ID    string   `json:"-" validate:"required,uuid"`
+	Slugs []string `json:"slugs" validate:"required,lte=500"`
+}
+
+type Handler struct {
+	readingListService readingListService
+	logger             log.Logger
+	validator          validation.Validator
+}
+
+func NewHandler(
+	readingListService readingListService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		readingListService: readingListService,
+		logger:             logger,
+		validator:          validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	err = h.readingListService.Reorder(ctx, &dto.ReorderReadingListIn{
+		UserID:       userID,
+		ListID:       req.ID,
+		ArticleSlugs: req.Slugs,
+	})
+
+	switch {
+	case err == nil:
+		util.RespondNoContent(ctx)
+	case errors.Is(err, apperrors.ErrReadingListNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrReadingListOrderMismatch):
+		util.RespondBadRequest(ctx, "The order must list every article of the reading list once.")
+	default:
+		h.logger.Error().Err(err).Msg("reorder error on reading list service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	req.ID = ctx.Request().PathValue("id")
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
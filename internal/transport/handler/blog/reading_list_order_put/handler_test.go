@@ -0,0 +1,110 @@
+package reading_list_order_put
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/reading_list_order_put/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const listID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+	expectedIn := &dto.ReorderReadingListIn{UserID: "user id", ListID: listID, ArticleSlugs: []string{"bar", "foo"}}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "validation error",
+			setup: func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{ID: listID, Slugs: []string{"bar", "foo"}})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "list not found",
+			setup: func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				readingListSvc.EXPECT().Reorder(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrReadingListNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "order mismatch",
+			setup: func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				readingListSvc.EXPECT().Reorder(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrReadingListOrderMismatch)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "The order must list every article of the reading list once."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "reading list service error",
+			setup: func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				readingListSvc.EXPECT().Reorder(gomock.Any(), gomock.Eq(expectedIn)).Return(errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"reorder error on reading list service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(readingListSvc *mock.MockreadingListService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				readingListSvc.EXPECT().Reorder(gomock.Any(), gomock.Eq(expectedIn)).Return(nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNoContent, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			readingListSvc := mock.NewMockreadingListService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("id", listID)
+			req.Body = io.NopCloser(strings.NewReader(`{"slugs": ["bar", "foo"]}`))
+
+			tt.setup(readingListSvc, validator)
+
+			handler := NewHandler(readingListSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockreadingListService is a mock of readingListService interface.
+type MockreadingListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockreadingListServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockreadingListServiceMockRecorder is the mock recorder for MockreadingListService.
+type MockreadingListServiceMockRecorder struct {
+	mock *MockreadingListService
+}
+
+// NewMockreadingListService creates a new mock instance.
+func NewMockreadingListService(ctrl *gomock.Controller) *MockreadingListService {
+	mock := &MockreadingListService{ctrl: ctrl}
+	mock.recorder = &MockreadingListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreadingListService) EXPECT() *MockreadingListServiceMockRecorder {
+	return m.recorder
+}
+
+// Reorder mocks base method.
+func (m *MockreadingListService) Reorder(ctx context.Context, in *dto.ReorderReadingListIn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockreadingListServiceMockRecorder) Reorder(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockreadingListService)(nil).Reorder), ctx, in)
+}
@@ -0,0 +1,97 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package reading_list_sharing_put
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type readingListService interface {
+	Share(ctx context.Context, in *dto.ShareReadingListIn) (*dto.ReadingList, error)
+}
+
+type request struct {
+	ID     string `json:"-" validate:"required,uuid"`
+	Shared bool   `json:"shared"`
+}
+
+type response struct {
+	Shared   bool   `json:"shared"`
+	ShareURL string `json:"shareUrl,omitempty"`
+}
+
+type Handler struct {
+	readingListService readingListService
+	logger             log.Logger
+	validator          validation.Validator
+}
+
+func NewHandler(
+	readingListService readingListService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		readingListService: readingListService,
+		logger:             logger,
+		validator:          validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.readingListService.Share(ctx, &dto.ShareReadingListIn{
+		UserID: userID,
+		ListID: req.ID,
+		Shared: req.Shared,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusOK, response{
+			Shared:   out.ShareURL != "",
+			ShareURL: out.ShareURL,
+		})
+	case errors.Is(err, apperrors.ErrReadingListNotFound):
+		util.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("share error on reading list service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	req.ID = ctx.Request().PathValue("id")
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,79 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package reading_lists_get
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type readingListService interface {
+	GetLists(ctx context.Context, userID string) ([]*dto.ReadingList, error)
+}
+
+type response struct {
+	Lists []list `json:"lists"`
+}
+
+type list struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Default       bool      `json:"default"`
+	ShareURL      string    `json:"shareUrl,omitempty"`
+	ArticlesCount int       `json:"articlesCount"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type Handler struct {
+	readingListService readingListService
+	logger             log.Logger
+}
+
+func NewHandler(
+	readingListService readingListService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		readingListService: readingListService,
+		logger:             logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		corehttputil.RespondUnauthorized(ctx)
+		return
+	}
+
+	out, err := h.readingListService.GetLists(ctx, userID)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("get lists error on reading list service")
+		corehttputil.RespondInternalError(ctx)
+		return
+	}
+
+	corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+}
+
+func convertResponse(out []*dto.ReadingList) response {
+	lists := make([]list, 0, len(out))
+	for _, l := range out {
+		lists = append(lists, list{
+			ID:            l.ID,
+			Name:          l.Name,
+			Default:       l.Default,
+			ShareURL:      l.ShareURL,
+			ArticlesCount: l.ArticlesCount,
+			CreatedAt:     l.CreatedAt,
+		})
+	}
+
+	return response{Lists: lists}
+}
@@ -0,0 +1,85 @@
+package reading_lists_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/reading_lists_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		userID any
+		setup  func(readingListSvc *mock.MockreadingListService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:   "unauthorized",
+			userID: nil,
+			setup:  func(readingListSvc *mock.MockreadingListService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:   "reading list service error",
+			userID: "user id",
+			setup: func(readingListSvc *mock.MockreadingListService) {
+				readingListSvc.EXPECT().GetLists(gomock.Any(), gomock.Eq("user id")).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"get lists error on reading list service"}`, logs[0])
+			},
+		},
+		{
+			name:   "ok",
+			userID: "user id",
+			setup: func(readingListSvc *mock.MockreadingListService) {
+				readingListSvc.EXPECT().GetLists(gomock.Any(), gomock.Eq("user id")).Return([]*dto.ReadingList{
+					{ID: "default list id", Name: "bookmarks", Default: true, ArticlesCount: 2, CreatedAt: created},
+					{ID: "list id", Name: "Go", ShareURL: "https://example.com/reading-lists/shared/token", CreatedAt: created},
+				}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				expResBody := `{"lists": [
+					{"id": "default list id", "name": "bookmarks", "default": true, "articlesCount": 2, "createdAt": "2024-01-01T00:00:00Z"},
+					{"id": "list id", "name": "Go", "default": false, "shareUrl": "https://example.com/reading-lists/shared/token", "articlesCount": 0, "createdAt": "2024-01-01T00:00:00Z"}
+				]}`
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			readingListSvc := mock.NewMockreadingListService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, _, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return(tt.userID).AnyTimes()
+
+			tt.setup(readingListSvc)
+
+			handler := NewHandler(readingListSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package related_articles_get
+
+import (
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+type response struct {
+	Articles []article `json:"articles"`
+}
+
+type article struct {
+	Slug          string           `json:"slug"`
+	Title         string           `json:"title"`
+	Excerpt       string           `json:"excerpt"`
+	ReadingTime   int              `json:"readingTime"`
+	Tags          []string         `json:"tags"`
+	CommentsCount int              `json:"commentsCount"`
+	Reactions     map[string]int64 `json:"reactions"`
+	OwnReactions  []string         `json:"ownReactions,omitempty"`
+	Authors       []author         `json:"authors"`
+	CreatedAt     time.Time        `json:"createdAt"`
+}
+
+type author struct {
+	NickName    string `json:"nickName"`
+	DisplayName string `json:"displayName"`
+	Role        string `json:"role"`
+}
+
+func convertResponse(in []dto.Article) response {
+	out := response{Articles: make([]article, 0, len(in))}
+	for _, a := range in {
+		converted := article{
+			Slug:          a.Slug,
+			Title:         a.Title,
+			Excerpt:       a.Excerpt,
+			ReadingTime:   a.ReadingTime,
+			Tags:          a.Tags,
+			CommentsCount: a.CommentsCount,
+			Reactions:     a.ReactionCounts,
+			OwnReactions:  a.OwnReactions,
+			Authors:       make([]author, 0, len(a.Authors)),
+			CreatedAt:     a.CreatedAt,
+		}
+
+		if converted.Tags == nil {
+			converted.Tags = []string{}
+		}
+
+		if converted.Reactions == nil {
+			converted.Reactions = map[string]int64{}
+		}
+
+		for _, au := range a.Authors {
+			converted.Authors = append(converted.Authors, author{NickName: au.NickName, DisplayName: au.DisplayName, Role: au.Role})
+		}
+
+		out.Articles = append(out.Articles, converted)
+	}
+
+	return out
+}
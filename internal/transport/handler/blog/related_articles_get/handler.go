@@ -0,0 +1,50 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package related_articles_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type relatedService interface {
+	Get(ctx context.Context, slug, userID string) ([]dto.Article, error)
+}
+
+type Handler struct {
+	relatedService relatedService
+	logger         log.Logger
+}
+
+func NewHandler(
+	relatedService relatedService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		relatedService: relatedService,
+		logger:         logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, _ := contextcore.UserID(ctx)
+
+	out, err := h.relatedService.Get(ctx, ctx.Request().PathValue("slug"), userID)
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrArticleNotFound):
+		corehttputil.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get error on related service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
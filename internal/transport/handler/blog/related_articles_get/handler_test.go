@@ -0,0 +1,120 @@
+package related_articles_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/related_articles_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(relatedSvc *mock.MockrelatedService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "article not found",
+			setup: func(relatedSvc *mock.MockrelatedService) {
+				relatedSvc.EXPECT().Get(gomock.Any(), gomock.Eq("foo"), gomock.Eq("user id")).Return(nil, apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "related service error",
+			setup: func(relatedSvc *mock.MockrelatedService) {
+				relatedSvc.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"get error on related service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(relatedSvc *mock.MockrelatedService) {
+				relatedSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq("foo"), gomock.Eq("user id")).
+					Return([]dto.Article{
+						{
+							Slug:           "bar",
+							Title:          "Bar",
+							Excerpt:        "bar…",
+							ReadingTime:    2,
+							Tags:           []string{"go"},
+							CommentsCount:  1,
+							ReactionCounts: map[string]int64{"like": 1},
+							OwnReactions:   []string{"like"},
+							Authors:        []dto.ArticleAuthor{{NickName: "bob", DisplayName: "Bob", Role: dto.ArticleAuthorRoleOwner}},
+							CreatedAt:      created,
+						},
+						{Slug: "baz", Title: "Baz", CreatedAt: created},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				expResBody := `{"articles": [
+					{
+						"slug": "bar",
+						"title": "Bar",
+						"excerpt": "bar…",
+						"readingTime": 2,
+						"tags": ["go"],
+						"commentsCount": 1,
+						"reactions": {"like": 1},
+						"ownReactions": ["like"],
+						"authors": [{"nickName": "bob", "displayName": "Bob", "role": "owner"}],
+						"createdAt": "2024-01-01T00:00:00Z"
+					},
+					{
+						"slug": "baz",
+						"title": "Baz",
+						"excerpt": "",
+						"readingTime": 0,
+						"tags": [],
+						"commentsCount": 0,
+						"reactions": {},
+						"authors": [],
+						"createdAt": "2024-01-01T00:00:00Z"
+					}
+				]}`
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			relatedSvc := mock.NewMockrelatedService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo")
+
+			tt.setup(relatedSvc)
+
+			handler := NewHandler(relatedSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockrelatedService is a mock of relatedService interface.
+type MockrelatedService struct {
+	ctrl     *gomock.Controller
+	recorder *MockrelatedServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockrelatedServiceMockRecorder is the mock recorder for MockrelatedService.
+type MockrelatedServiceMockRecorder struct {
+	mock *MockrelatedService
+}
+
+// NewMockrelatedService creates a new mock instance.
+func NewMockrelatedService(ctrl *gomock.Controller) *MockrelatedService {
+	mock := &MockrelatedService{ctrl: ctrl}
+	mock.recorder = &MockrelatedServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrelatedService) EXPECT() *MockrelatedServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockrelatedService) Get(ctx context.Context, slug, userID string) ([]dto.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, slug, userID)
+	ret0, _ := ret[0].([]dto.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockrelatedServiceMockRecorder) Get(ctx, slug, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockrelatedService)(nil).Get), ctx, slug, userID)
+}
@@ -0,0 +1,109 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package report_create
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type moderationService interface {
+	Report(ctx context.Context, in *dto.CreateReportIn) (*dto.Report, error)
+}
+
+type request struct {
+	TargetType string `json:"targetType" validate:"required,oneof=article comment"`
+	// Target is the slug of the article or the ID of the comment.
+	Target string `json:"target" validate:"required,lte=255"`
+	Reason string `json:"reason" validate:"required,lte=1000"`
+}
+
+type response struct {
+	ID        string    `json:"id"`
+	State     string    `json:"state"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Handler struct {
+	moderationService moderationService
+	logger            log.Logger
+	validator         validation.Validator
+}
+
+func NewHandler(
+	moderationService moderationService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		moderationService: moderationService,
+		logger:            logger,
+		validator:         validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.moderationService.Report(ctx, &dto.CreateReportIn{
+		UserID:     userID,
+		TargetType: req.TargetType,
+		Target:     req.Target,
+		Reason:     req.Reason,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusCreated, response{
+			ID:        out.ID,
+			State:     out.State,
+			CreatedAt: out.CreatedAt,
+		})
+	case errors.Is(err, apperrors.ErrModerationTargetNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrReportExists):
+		util.RespondBadRequest(ctx, "Content is already reported.")
+	default:
+		h.logger.Error().Err(err).Msg("report error on moderation service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	if req.TargetType == dto.ModerationTargetComment {
+		if err := h.validator.Var(req.Target, "uuid"); err != nil {
+			return nil, errors.New("target must be a comment id")
+		}
+	}
+
+	return req, nil
+}
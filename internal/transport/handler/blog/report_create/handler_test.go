@@ -0,0 +1,138 @@
+package report_create
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/report_create/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const commentID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+	articleBody := `{"targetType": "article", "target": "foo-article", "reason": "spam"}`
+	expectedIn := &dto.CreateReportIn{UserID: "user id", TargetType: "article", Target: "foo-article", Reason: "spam"}
+
+	for _, tt := range []struct {
+		name   string
+		body   string
+		setup  func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "validation error",
+			body: articleBody,
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{TargetType: "article", Target: "foo-article", Reason: "spam"})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "invalid comment id",
+			body: `{"targetType": "comment", "target": "foo", "reason": "spam"}`,
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				validator.EXPECT().Var(gomock.Eq("foo"), gomock.Eq("uuid")).Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "target must be a comment id"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "target not found",
+			body: articleBody,
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				moderationSvc.EXPECT().Report(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrModerationTargetNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "already reported",
+			body: articleBody,
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				moderationSvc.EXPECT().Report(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrReportExists)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "Content is already reported."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "moderation service error",
+			body: articleBody,
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				moderationSvc.EXPECT().Report(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"report error on moderation service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			body: `{"targetType": "comment", "target": "` + commentID + `", "reason": "spam"}`,
+			setup: func(moderationSvc *mock.MockmoderationService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				validator.EXPECT().Var(gomock.Eq(commentID), gomock.Eq("uuid")).Return(nil)
+				moderationSvc.EXPECT().
+					Report(gomock.Any(), gomock.Eq(&dto.CreateReportIn{UserID: "user id", TargetType: "comment", Target: commentID, Reason: "spam"})).
+					Return(&dto.Report{
+						ID:        "report id",
+						State:     dto.ReportStateOpen,
+						CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.JSONEq(t, `{"id": "report id", "state": "open", "createdAt": "2024-01-01T00:00:00Z"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			moderationSvc := mock.NewMockmoderationService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.Body = io.NopCloser(strings.NewReader(tt.body))
+
+			tt.setup(moderationSvc, validator)
+
+			handler := NewHandler(moderationSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
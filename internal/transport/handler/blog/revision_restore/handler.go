@@ -0,0 +1,79 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package revision_restore
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type editorService interface {
+	RestoreRevision(ctx context.Context, in *dto.RestoreRevisionIn) (*dto.Article, error)
+}
+
+type response struct {
+	ID      string `json:"id"`
+	Slug    string `json:"slug"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+type Handler struct {
+	editorService editorService
+	logger        log.Logger
+}
+
+func NewHandler(
+	editorService editorService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		editorService: editorService,
+		logger:        logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		corehttputil.RespondUnauthorized(ctx)
+		return
+	}
+
+	number, err := strconv.Atoi(ctx.Request().PathValue("number"))
+	if err != nil || number < 1 {
+		corehttputil.RespondNotFound(ctx)
+		return
+	}
+
+	out, err := h.editorService.RestoreRevision(ctx, &dto.RestoreRevisionIn{
+		ArticleSlug: ctx.Request().PathValue("slug"),
+		UserID:      userID,
+		Number:      number,
+	})
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, response{
+			ID:      out.ID,
+			Slug:    out.Slug,
+			Title:   out.Title,
+			Content: out.Content,
+		})
+	case errors.Is(err, apperrors.ErrArticleNotFound), errors.Is(err, apperrors.ErrRevisionNotFound):
+		corehttputil.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		corehttputil.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("restore revision error on editor service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
@@ -0,0 +1,102 @@
+package revision_restore
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/revision_restore/mock"
+)
+
+func TestHandler(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		number string
+		setup  func(editorSvc *mock.MockeditorService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:   "invalid number",
+			number: "foo",
+			setup:  func(editorSvc *mock.MockeditorService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:   "forbidden",
+			number: "1",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().
+					RestoreRevision(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.JSONEq(t, `{"message": "Forbidden."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:   "editor service error",
+			number: "1",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().
+					RestoreRevision(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"restore revision error on editor service"}`, logs[0])
+			},
+		},
+		{
+			name:   "ok",
+			number: "1",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().
+					RestoreRevision(gomock.Any(), gomock.Eq(&dto.RestoreRevisionIn{
+						ArticleSlug: "foo-article",
+						UserID:      "user id",
+						Number:      1,
+					})).
+					Return(&dto.Article{ID: "article id", Slug: "foo-article", Title: "Foo", Content: "old content"}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				expResBody := `{"id": "article id", "slug": "foo-article", "title": "Foo", "content": "old content"}`
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			editorSvc := mock.NewMockeditorService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo-article")
+			req.SetPathValue("number", tt.number)
+
+			tt.setup(editorSvc)
+
+			handler := NewHandler(editorSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package revisions_diff
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type editorService interface {
+	DiffRevisions(ctx context.Context, in *dto.DiffRevisionsIn) (*dto.DiffRevisionsOut, error)
+}
+
+type response struct {
+	From  int    `json:"from"`
+	To    int    `json:"to"`
+	Lines []line `json:"lines"`
+}
+
+type line struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+type Handler struct {
+	editorService editorService
+	logger        log.Logger
+}
+
+func NewHandler(
+	editorService editorService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		editorService: editorService,
+		logger:        logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		corehttputil.RespondUnauthorized(ctx)
+		return
+	}
+
+	query := ctx.Request().URL.Query()
+
+	from, err := parseRevisionNumber(query, "from")
+	if err != nil {
+		corehttputil.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	to, err := parseRevisionNumber(query, "to")
+	if err != nil {
+		corehttputil.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.editorService.DiffRevisions(ctx, &dto.DiffRevisionsIn{
+		ArticleSlug: ctx.Request().PathValue("slug"),
+		UserID:      userID,
+		From:        from,
+		To:          to,
+	})
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrArticleNotFound), errors.Is(err, apperrors.ErrRevisionNotFound):
+		corehttputil.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		corehttputil.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("diff revisions error on editor service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
+
+func parseRevisionNumber(query url.Values, key string) (int, error) {
+	number, err := strconv.Atoi(query.Get(key))
+	if err != nil || number < 1 {
+		return 0, errors.New(key + " must be a positive revision number")
+	}
+
+	return number, nil
+}
+
+func convertResponse(in *dto.DiffRevisionsOut) response {
+	out := response{
+		From:  in.From.Number,
+		To:    in.To.Number,
+		Lines: make([]line, 0, len(in.Lines)),
+	}
+	for _, l := range in.Lines {
+		out.Lines = append(out.Lines, line{Op: l.Op, Text: l.Text})
+	}
+	return out
+}
@@ -0,0 +1,126 @@
+package revisions_diff
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/revisions_diff/mock"
+)
+
+func TestHandler(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		query  string
+		setup  func(editorSvc *mock.MockeditorService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:  "invalid from",
+			query: "from=foo&to=2",
+			setup: func(editorSvc *mock.MockeditorService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "from must be a positive revision number"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "missing to",
+			query: "from=1",
+			setup: func(editorSvc *mock.MockeditorService) {},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "to must be a positive revision number"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "revision not found",
+			query: "from=1&to=2",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().
+					DiffRevisions(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrRevisionNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:  "editor service error",
+			query: "from=1&to=2",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().
+					DiffRevisions(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"diff revisions error on editor service"}`, logs[0])
+			},
+		},
+		{
+			name:  "ok",
+			query: "from=1&to=2",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().
+					DiffRevisions(gomock.Any(), gomock.Eq(&dto.DiffRevisionsIn{
+						ArticleSlug: "foo-article",
+						UserID:      "user id",
+						From:        1,
+						To:          2,
+					})).
+					Return(&dto.DiffRevisionsOut{
+						From: &dto.ArticleRevision{Number: 1},
+						To:   &dto.ArticleRevision{Number: 2},
+						Lines: []dto.DiffLine{
+							{Op: "equal", Text: "a"},
+							{Op: "delete", Text: "b"},
+							{Op: "insert", Text: "c"},
+						},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				expResBody := `{"from": 1, "to": 2, "lines": [
+					{"op": "equal", "text": "a"},
+					{"op": "delete", "text": "b"},
+					{"op": "insert", "text": "c"}
+				]}`
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			editorSvc := mock.NewMockeditorService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo-article")
+			req.URL = &url.URL{RawQuery: tt.query}
+
+			tt.setup(editorSvc)
+
+			handler := NewHandler(editorSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
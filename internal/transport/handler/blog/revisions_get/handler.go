@@ -0,0 +1,84 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package revisions_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type editorService interface {
+	GetRevisions(ctx context.Context, in *dto.GetRevisionsIn) ([]*dto.ArticleRevision, error)
+}
+
+type response struct {
+	Revisions []revision `json:"revisions"`
+}
+
+type revision struct {
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	AuthorID  string    `json:"authorId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Handler struct {
+	editorService editorService
+	logger        log.Logger
+}
+
+func NewHandler(
+	editorService editorService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		editorService: editorService,
+		logger:        logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		corehttputil.RespondUnauthorized(ctx)
+		return
+	}
+
+	out, err := h.editorService.GetRevisions(ctx, &dto.GetRevisionsIn{
+		ArticleSlug: ctx.Request().PathValue("slug"),
+		UserID:      userID,
+	})
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrArticleNotFound):
+		corehttputil.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		corehttputil.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get revisions error on editor service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
+
+func convertResponse(in []*dto.ArticleRevision) response {
+	out := response{Revisions: make([]revision, 0, len(in))}
+	for _, r := range in {
+		out.Revisions = append(out.Revisions, revision{
+			Number:    r.Number,
+			Title:     r.Title,
+			AuthorID:  r.AuthorID,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	return out
+}
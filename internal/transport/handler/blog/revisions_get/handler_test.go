@@ -0,0 +1,91 @@
+package revisions_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/revisions_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(editorSvc *mock.MockeditorService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "forbidden",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().
+					GetRevisions(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.JSONEq(t, `{"message": "Forbidden."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "editor service error",
+			setup: func(editorSvc *mock.MockeditorService) {
+				editorSvc.EXPECT().
+					GetRevisions(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"get revisions error on editor service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(editorSvc *mock.MockeditorService) {
+				createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+				editorSvc.EXPECT().
+					GetRevisions(gomock.Any(), gomock.Eq(&dto.GetRevisionsIn{ArticleSlug: "foo-article", UserID: "user id"})).
+					Return([]*dto.ArticleRevision{
+						{Number: 2, Title: "Bar", Content: "new content", AuthorID: "user id", CreatedAt: createdAt.Add(time.Hour)},
+						{Number: 1, Title: "Foo", Content: "old content", AuthorID: "user id", CreatedAt: createdAt},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				expResBody := `{"revisions": [
+					{"number": 2, "title": "Bar", "authorId": "user id", "createdAt": "2024-01-01T01:00:00Z"},
+					{"number": 1, "title": "Foo", "authorId": "user id", "createdAt": "2024-01-01T00:00:00Z"}
+				]}`
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			editorSvc := mock.NewMockeditorService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("slug", "foo-article")
+
+			tt.setup(editorSvc)
+
+			handler := NewHandler(editorSvc, logger)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,69 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package series_article_delete
+
+import (
+	"context"
+	"errors"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type seriesService interface {
+	DeleteArticle(ctx context.Context, in *dto.SeriesArticleIn) error
+}
+
+type Handler struct {
+	seriesService seriesService
+	logger        log.Logger
+	validator     validation.Validator
+}
+
+func NewHandler(
+	seriesService seriesService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		seriesService: seriesService,
+		logger:        logger,
+		validator:     validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	seriesID := ctx.Request().PathValue("id")
+	if err := h.validator.Var(seriesID, "required,uuid"); err != nil {
+		util.RespondNotFound(ctx)
+		return
+	}
+
+	err := h.seriesService.DeleteArticle(ctx, &dto.SeriesArticleIn{
+		UserID:      userID,
+		SeriesID:    seriesID,
+		ArticleSlug: ctx.Request().PathValue("slug"),
+	})
+
+	switch {
+	case err == nil:
+		util.RespondNoContent(ctx)
+	case errors.Is(err, apperrors.ErrSeriesNotFound), errors.Is(err, apperrors.ErrArticleNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("delete article error on series service")
+		util.RespondInternalError(ctx)
+	}
+}
@@ -0,0 +1,73 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package series_article_put
+
+import (
+	"context"
+	"errors"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type seriesService interface {
+	AddArticle(ctx context.Context, in *dto.SeriesArticleIn) error
+}
+
+type Handler struct {
+	seriesService seriesService
+	logger        log.Logger
+	validator     validation.Validator
+}
+
+func NewHandler(
+	seriesService seriesService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		seriesService: seriesService,
+		logger:        logger,
+		validator:     validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	seriesID := ctx.Request().PathValue("id")
+	if err := h.validator.Var(seriesID, "required,uuid"); err != nil {
+		util.RespondNotFound(ctx)
+		return
+	}
+
+	err := h.seriesService.AddArticle(ctx, &dto.SeriesArticleIn{
+		UserID:      userID,
+		SeriesID:    seriesID,
+		ArticleSlug: ctx.Request().PathValue("slug"),
+	})
+
+	switch {
+	case err == nil:
+		util.RespondNoContent(ctx)
+	case errors.Is(err, apperrors.ErrSeriesNotFound), errors.Is(err, apperrors.ErrArticleNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrArticleInOtherSeries):
+		util.RespondBadRequest(ctx, "The article belongs to another series.")
+	case errors.Is(err, apperrors.ErrSeriesFull):
+		util.RespondBadRequest(ctx, "The series is full.")
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("add article error on series service")
+		util.RespondInternalError(ctx)
+	}
+}
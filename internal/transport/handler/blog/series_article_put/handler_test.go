@@ -0,0 +1,142 @@
+package series_article_put
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/series_article_put/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const seriesID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+	expectedIn := &dto.SeriesArticleIn{UserID: "user id", SeriesID: seriesID, ArticleSlug: "foo"}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "invalid id",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Eq(seriesID), gomock.Eq("required,uuid")).Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "series not found",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				seriesSvc.EXPECT().AddArticle(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrSeriesNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "article not found",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				seriesSvc.EXPECT().AddArticle(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrArticleNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				seriesSvc.EXPECT().AddArticle(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.JSONEq(t, `{"message": "Forbidden."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "article in other series",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				seriesSvc.EXPECT().AddArticle(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrArticleInOtherSeries)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "The article belongs to another series."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "series full",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				seriesSvc.EXPECT().AddArticle(gomock.Any(), gomock.Eq(expectedIn)).Return(apperrors.ErrSeriesFull)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "The series is full."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "series service error",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				seriesSvc.EXPECT().AddArticle(gomock.Any(), gomock.Eq(expectedIn)).Return(errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"add article error on series service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				seriesSvc.EXPECT().AddArticle(gomock.Any(), gomock.Eq(expectedIn)).Return(nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNoContent, res.Code)
+				assert.Empty(t, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			seriesSvc := mock.NewMockseriesService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("id", seriesID)
+			req.SetPathValue("slug", "foo")
+
+			tt.setup(seriesSvc, validator)
+
+			handler := NewHandler(seriesSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,95 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package series_create
+
+import (
+	"context"
+	nethttp "net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type seriesService interface {
+	Create(ctx context.Context, in *dto.CreateSeriesIn) (*dto.Series, error)
+}
+
+type request struct {
+	Title       string `json:"title" validate:"required,lte=255"`
+	Description string `json:"description" validate:"lte=1000"`
+}
+
+type response struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type Handler struct {
+	seriesService seriesService
+	logger        log.Logger
+	validator     validation.Validator
+}
+
+func NewHandler(
+	seriesService seriesService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		seriesService: seriesService,
+		logger:        logger,
+		validator:     validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.seriesService.Create(ctx, &dto.CreateSeriesIn{
+		UserID:      userID,
+		Title:       req.Title,
+		Description: req.Description,
+	})
+	if err != nil {
+		h.logger.Error().Err(err).Msg("create error on series service")
+		util.RespondInternalError(ctx)
+		return
+	}
+
+	util.Respond(ctx, nethttp.StatusCreated, response{
+		ID:          out.ID,
+		Title:       out.Title,
+		Description: out.Description,
+		CreatedAt:   out.CreatedAt,
+	})
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,68 @@
+package series_get
+
+import (
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+type response struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Articles    []article `json:"articles"`
+}
+
+type article struct {
+	Slug          string           `json:"slug"`
+	Title         string           `json:"title"`
+	Excerpt       string           `json:"excerpt"`
+	ReadingTime   int              `json:"readingTime"`
+	CommentsCount int              `json:"commentsCount"`
+	Reactions     map[string]int64 `json:"reactions"`
+	OwnReactions  []string         `json:"ownReactions,omitempty"`
+	Authors       []author         `json:"authors"`
+	CreatedAt     time.Time        `json:"createdAt"`
+}
+
+type author struct {
+	NickName    string `json:"nickName"`
+	DisplayName string `json:"displayName"`
+	Role        string `json:"role"`
+}
+
+func convertResponse(out *dto.GetSeriesOut) response {
+	articles := make([]article, 0, len(out.Articles))
+	for _, a := range out.Articles {
+		converted := article{
+			Slug:          a.Slug,
+			Title:         a.Title,
+			Excerpt:       a.Excerpt,
+			ReadingTime:   a.ReadingTime,
+			CommentsCount: a.CommentsCount,
+			Reactions:     a.ReactionCounts,
+			OwnReactions:  a.OwnReactions,
+			Authors:       make([]author, 0, len(a.Authors)),
+			CreatedAt:     a.CreatedAt,
+		}
+
+		if converted.Reactions == nil {
+			converted.Reactions = map[string]int64{}
+		}
+
+		for _, au := range a.Authors {
+			converted.Authors = append(converted.Authors, author{NickName: au.NickName, DisplayName: au.DisplayName, Role: au.Role})
+		}
+
+		articles = append(articles, converted)
+	}
+
+	return response{
+		ID:          out.Series.ID,
+		Title:       out.Series.Title,
+		Description: out.Series.Description,
+		CreatedAt:   out.Series.CreatedAt,
+		Articles:    articles,
+	}
+}
@@ -0,0 +1,60 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package series_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type seriesService interface {
+	Get(ctx context.Context, id, userID string) (*dto.GetSeriesOut, error)
+}
+
+type Handler struct {
+	seriesService seriesService
+	logger        log.Logger
+	validator     validation.Validator
+}
+
+func NewHandler(
+	seriesService seriesService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		seriesService: seriesService,
+		logger:        logger,
+		validator:     validator,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, _ := contextcore.UserID(ctx)
+
+	id := ctx.Request().PathValue("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		corehttputil.RespondNotFound(ctx)
+		return
+	}
+
+	out, err := h.seriesService.Get(ctx, id, userID)
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrSeriesNotFound):
+		corehttputil.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get error on series service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
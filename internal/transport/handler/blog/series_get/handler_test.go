@@ -0,0 +1,129 @@
+package series_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/series_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const seriesID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "invalid id",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Eq(seriesID), gomock.Eq("required,uuid")).Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "series not found",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				seriesSvc.EXPECT().Get(gomock.Any(), gomock.Eq(seriesID), gomock.Eq("user id")).Return(nil, apperrors.ErrSeriesNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.JSONEq(t, `{"message": "Not found."}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "series service error",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				seriesSvc.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"get error on series service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(seriesSvc *mock.MockseriesService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil)
+				seriesSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(seriesID), gomock.Eq("user id")).
+					Return(&dto.GetSeriesOut{
+						Series: &dto.Series{ID: seriesID, Title: "Go", Description: "Learning Go", CreatedAt: created},
+						Articles: []dto.Article{{
+							Slug:        "foo",
+							Title:       "Foo",
+							Excerpt:     "foo…",
+							ReadingTime: 3,
+							Authors: []dto.ArticleAuthor{
+								{NickName: "bob", DisplayName: "Bob", Role: dto.ArticleAuthorRoleOwner},
+								{NickName: "alice", DisplayName: "Alice", Role: dto.ArticleAuthorRoleContributor},
+							},
+							CreatedAt: created,
+						}},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				expResBody := `{
+					"id": "18d440f5-2664-42b1-bfaa-1c15f1687885",
+					"title": "Go",
+					"description": "Learning Go",
+					"createdAt": "2024-01-01T00:00:00Z",
+					"articles": [{
+						"slug": "foo",
+						"title": "Foo",
+						"excerpt": "foo…",
+						"readingTime": 3,
+						"commentsCount": 0,
+						"reactions": {},
+						"authors": [
+							{"nickName": "bob", "displayName": "Bob", "role": "owner"},
+							{"nickName": "alice", "displayName": "Alice", "role": "contributor"}
+						],
+						"createdAt": "2024-01-01T00:00:00Z"
+					}]
+				}`
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, expResBody, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			seriesSvc := mock.NewMockseriesService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("id", seriesID)
+
+			tt.setup(seriesSvc, validator)
+
+			handler := NewHandler(seriesSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockseriesService is a mock of seriesService interface.
+type MockseriesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockseriesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockseriesServiceMockRecorder is the mock recorder for MockseriesService.
+type MockseriesServiceMockRecorder struct {
+	mock *MockseriesService
+}
+
+// NewMockseriesService creates a new mock instance.
+func NewMockseriesService(ctrl *gomock.Controller) *MockseriesService {
+	mock := &MockseriesService{ctrl: ctrl}
+	mock.recorder = &MockseriesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockseriesService) EXPECT() *MockseriesServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockseriesService) Get(ctx context.Context, id, userID string) (*dto.GetSeriesOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, userID)
+	ret0, _ := ret[0].(*dto.GetSeriesOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockseriesServiceMockRecorder) Get(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockseriesService)(nil).Get), ctx, id, userID)
+}
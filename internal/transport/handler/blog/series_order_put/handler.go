@@ -0,0 +1,92 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package series_order_put
+
+import (
+	"context"
+	"errors"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type seriesService interface {
+	Reorder(ctx context.Context, in *dto.ReorderSeriesIn) error
+}
+
+type request struct {
+	ID    string   `json:"-" validate:"required,uuid"`
+	Slugs []string `json:"slugs" validate:"required,lte=500"`
+}
+
+type Handler struct {
+	seriesService seriesService
+	logger        log.Logger
+	validator     validation.Validator
+}
+
+func NewHandler(
+	seriesService seriesService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		seriesService: seriesService,
+		logger:        logger,
+		validator:     validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	err = h.seriesService.Reorder(ctx, &dto.ReorderSeriesIn{
+		UserID:       userID,
+		SeriesID:     req.ID,
+		ArticleSlugs: req.Slugs,
+	})
+
+	switch {
+	case err == nil:
+		util.RespondNoContent(ctx)
+	case errors.Is(err, apperrors.ErrSeriesNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrSeriesOrderMismatch):
+		util.RespondBadRequest(ctx, "The order must list every article of the series once.")
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("reorder error on series service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	req.ID = ctx.Request().PathValue("id")
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
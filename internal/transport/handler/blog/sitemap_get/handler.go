@@ -0,0 +1,61 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package sitemap_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type sitemapService interface {
+	Get(ctx context.Context, file int) ([]byte, error)
+}
+
+// Handler serves the sitemap index when there is no file in the path, e.g. /sitemap.xml,
+// and sitemap files otherwise, e.g. /sitemaps/articles-1.xml.
+type Handler struct {
+	sitemapService sitemapService
+	logger         log.Logger
+}
+
+func NewHandler(
+	sitemapService sitemapService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		sitemapService: sitemapService,
+		logger:         logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	file := dto.SitemapIndexFile
+	if name := ctx.Request().PathValue("file"); name != "" {
+		var ok bool
+		if file, ok = dto.ParseSitemapFileName(name); !ok {
+			corehttputil.RespondNotFound(ctx)
+			return
+		}
+	}
+
+	sitemap, err := h.sitemapService.Get(ctx, file)
+
+	switch {
+	case err == nil:
+		w := ctx.ResponseWriter()
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(sitemap)
+	case errors.Is(err, apperrors.ErrSitemapNotFound):
+		corehttputil.RespondNotFound(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get error on sitemap service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
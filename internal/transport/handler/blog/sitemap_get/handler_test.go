@@ -0,0 +1,101 @@
+package sitemap_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/sitemap_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(req *http.Request, sitemapSvc *mock.MocksitemapService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "invalid file name",
+			setup: func(req *http.Request, sitemapSvc *mock.MocksitemapService) {
+				req.SetPathValue("file", "articles-01.xml")
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+			},
+		},
+		{
+			name: "index file name",
+			setup: func(req *http.Request, sitemapSvc *mock.MocksitemapService) {
+				req.SetPathValue("file", "articles-0.xml")
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+			},
+		},
+		{
+			name: "sitemap not found",
+			setup: func(req *http.Request, sitemapSvc *mock.MocksitemapService) {
+				req.SetPathValue("file", "articles-3.xml")
+				sitemapSvc.EXPECT().Get(gomock.Any(), gomock.Eq(3)).Return(nil, apperrors.ErrSitemapNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "sitemap service error",
+			setup: func(req *http.Request, sitemapSvc *mock.MocksitemapService) {
+				sitemapSvc.EXPECT().Get(gomock.Any(), gomock.Eq(0)).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, []string{`{"level":"error","error":"dummy error","message":"get error on sitemap service"}`}, logs)
+			},
+		},
+		{
+			name: "index",
+			setup: func(req *http.Request, sitemapSvc *mock.MocksitemapService) {
+				sitemapSvc.EXPECT().Get(gomock.Any(), gomock.Eq(0)).Return([]byte("<sitemapindex></sitemapindex>"), nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/xml; charset=utf-8", res.Header().Get("Content-Type"))
+				assert.Equal(t, "<sitemapindex></sitemapindex>", res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "file",
+			setup: func(req *http.Request, sitemapSvc *mock.MocksitemapService) {
+				req.SetPathValue("file", "articles-12.xml")
+				sitemapSvc.EXPECT().Get(gomock.Any(), gomock.Eq(12)).Return([]byte("<urlset></urlset>"), nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "<urlset></urlset>", res.Body.String())
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sitemapSvc := mock.NewMocksitemapService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+
+			tt.setup(req, sitemapSvc)
+
+			NewHandler(sitemapSvc, logger).Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocksitemapService is a mock of sitemapService interface.
+type MocksitemapService struct {
+	ctrl     *gomock.Controller
+	recorder *MocksitemapServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocksitemapServiceMockRecorder is the mock recorder for MocksitemapService.
+type MocksitemapServiceMockRecorder struct {
+	mock *MocksitemapService
+}
+
+// NewMocksitemapService creates a new mock instance.
+func NewMocksitemapService(ctrl *gomock.Controller) *MocksitemapService {
+	mock := &MocksitemapService{ctrl: ctrl}
+	mock.recorder = &MocksitemapServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksitemapService) EXPECT() *MocksitemapServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MocksitemapService) Get(ctx context.Context, file int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, file)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MocksitemapServiceMockRecorder) Get(ctx, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MocksitemapService)(nil).Get), ctx, file)
+}
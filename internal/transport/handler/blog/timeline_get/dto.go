@@ -0,0 +1,150 @@
+package timeline_get
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+type request struct {
+	Cursor *dto.TimelineEntry
+	Limit  int
+}
+
+type response struct {
+	Articles   []article `json:"articles"`
+	HasMore    bool      `json:"hasMore"`
+	NextCursor *string   `json:"nextCursor,omitempty"`
+}
+
+// cursor is the opaque cursor representation given to clients.
+type cursor struct {
+	CreatedAt time.Time `json:"c"`
+	ArticleID string    `json:"i"`
+}
+
+type article struct {
+	Slug          string           `json:"slug"`
+	Title         string           `json:"title"`
+	Content       string           `json:"content"`
+	Locked        bool             `json:"locked"`
+	CommentsCount int              `json:"commentsCount"`
+	Reactions     map[string]int64 `json:"reactions"`
+	OwnReactions  []string         `json:"ownReactions,omitempty"`
+	Author        *author          `json:"author,omitempty"`
+	SEO           seo              `json:"seo"`
+	CreatedAt     time.Time        `json:"createdAt"`
+}
+
+type author struct {
+	NickName    string `json:"nickName"`
+	DisplayName string `json:"displayName"`
+}
+
+type seo struct {
+	MetaDescription string `json:"metaDescription"`
+	OGImage         string `json:"ogImage"`
+	CanonicalURL    string `json:"canonicalUrl"`
+}
+
+func parseRequest(in *http.Request) (request, error) {
+	query := in.URL.Query()
+	out := request{Limit: defaultLimit}
+
+	if rawCursor := query.Get("cursor"); rawCursor != "" {
+		c, err := decodeCursor(rawCursor)
+		if err != nil {
+			return out, errInvalidCursor
+		}
+
+		out.Cursor = &dto.TimelineEntry{ArticleID: c.ArticleID, CreatedAt: c.CreatedAt}
+	}
+
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		limit, err := strconv.Atoi(rawLimit)
+		if err != nil || limit < 1 || limit > maxLimit {
+			return out, errors.New("limit must be between 1 and 100")
+		}
+
+		out.Limit = limit
+	}
+
+	return out, nil
+}
+
+func decodeCursor(raw string) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &cursor{}
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+
+	if c.ArticleID == "" {
+		return nil, errInvalidCursor
+	}
+
+	return c, nil
+}
+
+func encodeCursor(in *dto.TimelineEntry) *string {
+	if in == nil {
+		return nil
+	}
+
+	data, _ := json.Marshal(cursor{CreatedAt: in.CreatedAt, ArticleID: in.ArticleID})
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	return &encoded
+}
+
+func convertResponse(out *dto.GetTimelineOut) response {
+	articles := make([]article, 0, len(out.Articles))
+	for _, a := range out.Articles {
+		converted := article{
+			Slug:          a.Slug,
+			Title:         a.Title,
+			Content:       a.Content,
+			Locked:        a.Locked,
+			CommentsCount: a.CommentsCount,
+			Reactions:     a.ReactionCounts,
+			OwnReactions:  a.OwnReactions,
+			SEO: seo{
+				MetaDescription: a.SEO.MetaDescription,
+				OGImage:         a.SEO.OGImageURL,
+				CanonicalURL:    a.SEO.CanonicalURL,
+			},
+			CreatedAt: a.CreatedAt,
+		}
+
+		if converted.Reactions == nil {
+			converted.Reactions = map[string]int64{}
+		}
+
+		if a.Author != nil {
+			converted.Author = &author{NickName: a.Author.NickName, DisplayName: a.Author.DisplayName}
+		}
+
+		articles = append(articles, converted)
+	}
+
+	return response{
+		Articles:   articles,
+		HasMore:    out.NextCursor != nil,
+		NextCursor: encodeCursor(out.NextCursor),
+	}
+}
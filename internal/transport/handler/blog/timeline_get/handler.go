@@ -0,0 +1,59 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package timeline_get
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type timelineService interface {
+	Get(ctx context.Context, in *dto.GetTimelineIn) (*dto.GetTimelineOut, error)
+}
+
+type Handler struct {
+	timelineService timelineService
+	logger          log.Logger
+}
+
+func NewHandler(
+	timelineService timelineService,
+	logger log.Logger,
+) *Handler {
+	return &Handler{
+		timelineService: timelineService,
+		logger:          logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		corehttputil.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := parseRequest(ctx.Request())
+	if err != nil {
+		corehttputil.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.timelineService.Get(ctx, &dto.GetTimelineIn{
+		UserID: userID,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		h.logger.Error().Err(err).Msg("get error on timeline service")
+		corehttputil.RespondInternalError(ctx)
+		return
+	}
+
+	corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+}
@@ -0,0 +1,123 @@
+package timeline_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	mockhttp "github.com/art-es/yet-another-service/internal/core/http/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/blog/timeline_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	// {"c":"2024-01-01T00:00:00Z","i":"1"}
+	const timelineCursor = "eyJjIjoiMjAyNC0wMS0wMVQwMDowMDowMFoiLCJpIjoiMSJ9"
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		query  url.Values
+		setup  func(ctx *mockhttp.MockContext, timelineSvc *mock.MocktimelineService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "unauthorized",
+			setup: func(ctx *mockhttp.MockContext, timelineSvc *mock.MocktimelineService) {
+				ctx.EXPECT().Value(gomock.Any()).Return(nil).AnyTimes()
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+			},
+		},
+		{
+			name:  "invalid cursor",
+			query: url.Values{"cursor": {"foo"}},
+			setup: func(ctx *mockhttp.MockContext, timelineSvc *mock.MocktimelineService) {
+				ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "invalid cursor"}`, res.Body.String())
+			},
+		},
+		{
+			name: "timeline service error",
+			setup: func(ctx *mockhttp.MockContext, timelineSvc *mock.MocktimelineService) {
+				ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+				timelineSvc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, []string{`{"level":"error","error":"dummy error","message":"get error on timeline service"}`}, logs)
+			},
+		},
+		{
+			name:  "ok",
+			query: url.Values{"cursor": {timelineCursor}, "limit": {"1"}},
+			setup: func(ctx *mockhttp.MockContext, timelineSvc *mock.MocktimelineService) {
+				ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+				timelineSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq(&dto.GetTimelineIn{
+						UserID: "user id",
+						Cursor: &dto.TimelineEntry{ArticleID: "1", CreatedAt: createdAt},
+						Limit:  1,
+					})).
+					Return(&dto.GetTimelineOut{
+						Articles: []dto.Article{{
+							Slug:          "foo",
+							Title:         "Foo",
+							Content:       "foo content",
+							CommentsCount: 2,
+							SEO:           dto.ArticleSEO{CanonicalURL: "https://example.com/articles/foo"},
+							CreatedAt:     createdAt,
+							Author:        &dto.ArticleAuthor{NickName: "bob", DisplayName: "Bob"},
+						}},
+						NextCursor: &dto.TimelineEntry{ArticleID: "1", CreatedAt: createdAt},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, `{
+					"articles": [{
+						"slug": "foo",
+						"title": "Foo",
+						"content": "foo content",
+						"locked": false,
+						"commentsCount": 2,
+						"reactions": {},
+						"author": {"nickName": "bob", "displayName": "Bob"},
+						"seo": {"metaDescription": "", "ogImage": "", "canonicalUrl": "https://example.com/articles/foo"},
+						"createdAt": "2024-01-01T00:00:00Z"
+					}],
+					"hasMore": true,
+					"nextCursor": "`+timelineCursor+`"
+				}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			timelineSvc := mock.NewMocktimelineService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			req.URL.RawQuery = tt.query.Encode()
+
+			tt.setup(ctx, timelineSvc)
+
+			NewHandler(timelineSvc, logger).Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
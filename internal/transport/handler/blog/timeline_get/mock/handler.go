@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktimelineService is a mock of timelineService interface.
+type MocktimelineService struct {
+	ctrl     *gomock.Controller
+	recorder *MocktimelineServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocktimelineServiceMockRecorder is the mock recorder for MocktimelineService.
+type MocktimelineServiceMockRecorder struct {
+	mock *MocktimelineService
+}
+
+// NewMocktimelineService creates a new mock instance.
+func NewMocktimelineService(ctrl *gomock.Controller) *MocktimelineService {
+	mock := &MocktimelineService{ctrl: ctrl}
+	mock.recorder = &MocktimelineServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktimelineService) EXPECT() *MocktimelineServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MocktimelineService) Get(ctx context.Context, in *dto.GetTimelineIn) (*dto.GetTimelineOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, in)
+	ret0, _ := ret[0].(*dto.GetTimelineOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MocktimelineServiceMockRecorder) Get(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MocktimelineService)(nil).Get), ctx, in)
+}
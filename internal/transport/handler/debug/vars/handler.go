@@ -0,0 +1,52 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package vars
+
+import (
+	"context"
+	"errors"
+	"expvar"
+
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type roleService interface {
+	CheckAdmin(ctx context.Context, userID string) error
+}
+
+// Handler serves published runtime variables, such as cache hit counters, as JSON. Admins only,
+// the variables include the command line of the process.
+type Handler struct {
+	roleService roleService
+	logger      log.Logger
+}
+
+func NewHandler(roleService roleService, logger log.Logger) *Handler {
+	return &Handler{
+		roleService: roleService,
+		logger:      logger,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		corehttputil.RespondUnauthorized(ctx)
+		return
+	}
+
+	err := h.roleService.CheckAdmin(ctx, userID)
+
+	switch {
+	case err == nil:
+		expvar.Handler().ServeHTTP(ctx.ResponseWriter(), ctx.Request())
+	case errors.Is(err, apperrors.ErrForbidden):
+		corehttputil.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("check admin error on role service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
@@ -0,0 +1,80 @@
+package vars
+
+import (
+	"encoding/json"
+	"errors"
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/debug/vars/mock"
+)
+
+func TestHandler(t *testing.T) {
+	expvar.NewInt("vars_handler_test").Set(42)
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(roleSvc *mock.MockroleService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "not an admin",
+			setup: func(roleSvc *mock.MockroleService) {
+				roleSvc.EXPECT().CheckAdmin(gomock.Any(), gomock.Eq("user id")).Return(apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.NotContains(t, res.Body.String(), "vars_handler_test")
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "role service error",
+			setup: func(roleSvc *mock.MockroleService) {
+				roleSvc.EXPECT().CheckAdmin(gomock.Any(), gomock.Any()).Return(errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error","error":"dummy error","message":"check admin error on role service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(roleSvc *mock.MockroleService) {
+				roleSvc.EXPECT().CheckAdmin(gomock.Any(), gomock.Eq("user id")).Return(nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+
+				vars := map[string]any{}
+				assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &vars))
+				assert.Equal(t, float64(42), vars["vars_handler_test"])
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			roleSvc := mock.NewMockroleService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, _, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+
+			tt.setup(roleSvc)
+
+			NewHandler(roleSvc, logger).Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockroleService is a mock of roleService interface.
+type MockroleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockroleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockroleServiceMockRecorder is the mock recorder for MockroleService.
+type MockroleServiceMockRecorder struct {
+	mock *MockroleService
+}
+
+// NewMockroleService creates a new mock instance.
+func NewMockroleService(ctrl *gomock.Controller) *MockroleService {
+	mock := &MockroleService{ctrl: ctrl}
+	mock.recorder = &MockroleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockroleService) EXPECT() *MockroleServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckAdmin mocks base method.
+func (m *MockroleService) CheckAdmin(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAdmin", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAdmin indicates an expected call of CheckAdmin.
+func (mr *MockroleServiceMockRecorder) CheckAdmin(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAdmin", reflect.TypeOf((*MockroleService)(nil).CheckAdmin), ctx, userID)
+}
@@ -0,0 +1,105 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package webhook_create
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type webhookService interface {
+	Create(ctx context.Context, in *dto.CreateWebhookIn) (*dto.Webhook, error)
+}
+
+type request struct {
+	URL    string   `json:"url" validate:"required,http_url,lte=2048"`
+	Events []string `json:"events" validate:"required,min=1,dive,oneof=article.published article.updated comment.created user.activated"`
+}
+
+// response carries the secret, it's never shown again.
+type response struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	Secret    string    `json:"secret"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Handler struct {
+	webhookService webhookService
+	logger         log.Logger
+	validator      validation.Validator
+}
+
+func NewHandler(
+	webhookService webhookService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		webhookService: webhookService,
+		logger:         logger,
+		validator:      validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	req, err := h.parseRequest(ctx)
+	if err != nil {
+		util.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	out, err := h.webhookService.Create(ctx, &dto.CreateWebhookIn{
+		UserID: userID,
+		URL:    req.URL,
+		Events: req.Events,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusCreated, response{
+			ID:        out.ID,
+			URL:       out.URL,
+			Events:    out.Events,
+			Active:    out.Active,
+			Secret:    out.Secret,
+			CreatedAt: out.CreatedAt,
+		})
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("create error on webhook service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func (h *Handler) parseRequest(ctx http.Context) (*request, error) {
+	req := &request{}
+
+	if err := util.EnrichRequestBody(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,119 @@
+package webhook_create
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/webhook/webhook_create/mock"
+)
+
+func TestHandler(t *testing.T) {
+	expectedIn := &dto.CreateWebhookIn{
+		UserID: "user id",
+		URL:    "https://example.org/hook",
+		Events: []string{"article.published", "comment.created"},
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "validation error",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{URL: "https://example.org/hook", Events: []string{"article.published", "comment.created"}})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				webhookSvc.EXPECT().Create(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "webhook service error",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				webhookSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"create error on webhook service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				webhookSvc.EXPECT().
+					Create(gomock.Any(), gomock.Eq(expectedIn)).
+					Return(&dto.Webhook{
+						ID:        "webhook id",
+						URL:       "https://example.org/hook",
+						Secret:    "secret",
+						Events:    []string{"article.published", "comment.created"},
+						Active:    true,
+						CreatedBy: "user id",
+						CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.JSONEq(t, `{
+					"id": "webhook id",
+					"url": "https://example.org/hook",
+					"events": ["article.published", "comment.created"],
+					"active": true,
+					"secret": "secret",
+					"createdAt": "2024-01-01T00:00:00Z"
+				}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			webhookSvc := mock.NewMockwebhookService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.Body = io.NopCloser(strings.NewReader(`{"url": "https://example.org/hook", "events": ["article.published", "comment.created"]}`))
+
+			tt.setup(webhookSvc, validator)
+
+			handler := NewHandler(webhookSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package webhook_delete
+
+import (
+	"context"
+	"errors"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type webhookService interface {
+	Delete(ctx context.Context, in *dto.DeleteWebhookIn) error
+}
+
+type Handler struct {
+	webhookService webhookService
+	logger         log.Logger
+	validator      validation.Validator
+}
+
+func NewHandler(
+	webhookService webhookService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		webhookService: webhookService,
+		logger:         logger,
+		validator:      validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	webhookID := ctx.Request().PathValue("id")
+	if err := h.validator.Var(webhookID, "required,uuid"); err != nil {
+		util.RespondNotFound(ctx)
+		return
+	}
+
+	err := h.webhookService.Delete(ctx, &dto.DeleteWebhookIn{
+		UserID:    userID,
+		WebhookID: webhookID,
+	})
+
+	switch {
+	case err == nil:
+		util.RespondNoContent(ctx)
+	case errors.Is(err, apperrors.ErrWebhookNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("delete error on webhook service")
+		util.RespondInternalError(ctx)
+	}
+}
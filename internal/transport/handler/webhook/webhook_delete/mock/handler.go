@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=mock/handler.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/art-es/yet-another-service/internal/app/shared/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookService is a mock of webhookService interface.
+type MockwebhookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookServiceMockRecorder is the mock recorder for MockwebhookService.
+type MockwebhookServiceMockRecorder struct {
+	mock *MockwebhookService
+}
+
+// NewMockwebhookService creates a new mock instance.
+func NewMockwebhookService(ctrl *gomock.Controller) *MockwebhookService {
+	mock := &MockwebhookService{ctrl: ctrl}
+	mock.recorder = &MockwebhookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookService) EXPECT() *MockwebhookServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockwebhookService) Delete(ctx context.Context, in *dto.DeleteWebhookIn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockwebhookServiceMockRecorder) Delete(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockwebhookService)(nil).Delete), ctx, in)
+}
@@ -0,0 +1,62 @@
+package webhook_deliveries_get
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+)
+
+type request struct {
+	WebhookID string `validate:"required,uuid"`
+	FromID    string `validate:"omitempty,uuid"`
+}
+
+type response struct {
+	Deliveries []delivery `json:"deliveries"`
+	HasMore    bool       `json:"hasMore"`
+}
+
+type delivery struct {
+	ID             string          `json:"id"`
+	Event          string          `json:"event"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseStatus int             `json:"responseStatus"`
+	LastError      string          `json:"lastError"`
+	NextAttemptAt  time.Time       `json:"nextAttemptAt"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	DeliveredAt    *time.Time      `json:"deliveredAt"`
+	Payload        json.RawMessage `json:"payload"`
+}
+
+func parseRequest(in *http.Request) *request {
+	return &request{
+		WebhookID: in.PathValue("id"),
+		FromID:    in.URL.Query().Get("fromId"),
+	}
+}
+
+func convertResponse(out *dto.GetWebhookDeliveriesOut) response {
+	deliveries := make([]delivery, 0, len(out.Deliveries))
+	for _, d := range out.Deliveries {
+		deliveries = append(deliveries, delivery{
+			ID:             d.ID,
+			Event:          d.Event,
+			Status:         d.Status,
+			Attempts:       d.Attempts,
+			ResponseStatus: d.ResponseStatus,
+			LastError:      d.LastError,
+			NextAttemptAt:  d.NextAttemptAt,
+			CreatedAt:      d.CreatedAt,
+			DeliveredAt:    d.DeliveredAt,
+			Payload:        d.Payload,
+		})
+	}
+
+	return response{
+		Deliveries: deliveries,
+		HasMore:    out.HasMore,
+	}
+}
@@ -0,0 +1,74 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package webhook_deliveries_get
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	corehttp "github.com/art-es/yet-another-service/internal/core/http"
+	corehttputil "github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type webhookService interface {
+	GetDeliveries(ctx context.Context, in *dto.GetWebhookDeliveriesIn) (*dto.GetWebhookDeliveriesOut, error)
+}
+
+type Handler struct {
+	webhookService webhookService
+	logger         log.Logger
+	validator      validation.Validator
+}
+
+func NewHandler(
+	webhookService webhookService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		webhookService: webhookService,
+		logger:         logger,
+		validator:      validator,
+	}
+}
+
+func (h *Handler) Handle(ctx corehttp.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		corehttputil.RespondUnauthorized(ctx)
+		return
+	}
+
+	req := parseRequest(ctx.Request())
+	if err := h.validator.Struct(req); err != nil {
+		corehttputil.RespondBadRequest(ctx, err.Error())
+		return
+	}
+
+	in := &dto.GetWebhookDeliveriesIn{
+		UserID:    userID,
+		WebhookID: req.WebhookID,
+	}
+	if req.FromID != "" {
+		in.FromID = &req.FromID
+	}
+
+	out, err := h.webhookService.GetDeliveries(ctx, in)
+
+	switch {
+	case err == nil:
+		corehttputil.Respond(ctx, http.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrWebhookNotFound):
+		corehttputil.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		corehttputil.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get deliveries error on webhook service")
+		corehttputil.RespondInternalError(ctx)
+	}
+}
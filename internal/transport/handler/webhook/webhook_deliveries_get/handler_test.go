@@ -0,0 +1,157 @@
+package webhook_deliveries_get
+
+import (
+	_ "embed"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/webhook/webhook_deliveries_get/mock"
+)
+
+//go:embed testdata/ok.json
+var expectedBodyOK []byte
+
+func TestHandler(t *testing.T) {
+	const (
+		webhookID  = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+		deliveryID = "5b0f6f4e-8b8a-4f7e-9a51-2f0c9d7c6a10"
+	)
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expectedIn := &dto.GetWebhookDeliveriesIn{UserID: "user id", WebhookID: webhookID}
+
+	for _, tt := range []struct {
+		name   string
+		query  string
+		setup  func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name:  "validation error",
+			query: "fromId=foo",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{WebhookID: webhookID, FromID: "foo"})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "webhook not found",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				webhookSvc.EXPECT().GetDeliveries(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrWebhookNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				webhookSvc.EXPECT().GetDeliveries(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "webhook service error",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				webhookSvc.EXPECT().GetDeliveries(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error","error":"dummy error","message":"get deliveries error on webhook service"}`, logs[0])
+			},
+		},
+		{
+			name:  "ok",
+			query: "fromId=" + deliveryID,
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{WebhookID: webhookID, FromID: deliveryID})).
+					Return(nil)
+				webhookSvc.EXPECT().
+					GetDeliveries(gomock.Any(), gomock.Eq(&dto.GetWebhookDeliveriesIn{
+						UserID:    "user id",
+						WebhookID: webhookID,
+						FromID:    pointer.To(deliveryID),
+					})).
+					Return(&dto.GetWebhookDeliveriesOut{
+						Deliveries: []*dto.WebhookDelivery{
+							{
+								ID:             "2",
+								WebhookID:      webhookID,
+								Event:          dto.WebhookEventCommentCreated,
+								Payload:        []byte(`{"event":"comment.created"}`),
+								Status:         dto.WebhookDeliveryPending,
+								Attempts:       1,
+								NextAttemptAt:  createdAt.Add(time.Hour + time.Minute),
+								ResponseStatus: http.StatusServiceUnavailable,
+								LastError:      "unexpected response status 503",
+								CreatedAt:      createdAt.Add(time.Hour),
+							},
+							{
+								ID:             "1",
+								WebhookID:      webhookID,
+								Event:          dto.WebhookEventArticlePublished,
+								Payload:        []byte(`{"event":"article.published"}`),
+								Status:         dto.WebhookDeliveryDelivered,
+								Attempts:       1,
+								NextAttemptAt:  createdAt,
+								ResponseStatus: http.StatusOK,
+								CreatedAt:      createdAt,
+								DeliveredAt:    pointer.To(createdAt.Add(time.Second)),
+							},
+						},
+						HasMore: true,
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, string(expectedBodyOK), res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			webhookSvc := mock.NewMockwebhookService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("id", webhookID)
+			req.URL.RawQuery = tt.query
+
+			tt.setup(webhookSvc, validator)
+
+			handler := NewHandler(webhookSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,90 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package webhook_delivery_redeliver
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+	"github.com/art-es/yet-another-service/internal/core/validation"
+)
+
+type webhookService interface {
+	Redeliver(ctx context.Context, in *dto.RedeliverWebhookIn) (*dto.WebhookDelivery, error)
+}
+
+type response struct {
+	ID            string    `json:"id"`
+	Event         string    `json:"event"`
+	Status        string    `json:"status"`
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type Handler struct {
+	webhookService webhookService
+	logger         log.Logger
+	validator      validation.Validator
+}
+
+func NewHandler(
+	webhookService webhookService,
+	logger log.Logger,
+	validator validation.Validator,
+) *Handler {
+	return &Handler{
+		webhookService: webhookService,
+		logger:         logger,
+		validator:      validator,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	webhookID := ctx.Request().PathValue("id")
+	deliveryID := ctx.Request().PathValue("deliveryId")
+	if err := h.validator.Var(webhookID, "required,uuid"); err != nil {
+		util.RespondNotFound(ctx)
+		return
+	}
+	if err := h.validator.Var(deliveryID, "required,uuid"); err != nil {
+		util.RespondNotFound(ctx)
+		return
+	}
+
+	out, err := h.webhookService.Redeliver(ctx, &dto.RedeliverWebhookIn{
+		UserID:     userID,
+		WebhookID:  webhookID,
+		DeliveryID: deliveryID,
+	})
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusAccepted, response{
+			ID:            out.ID,
+			Event:         out.Event,
+			Status:        out.Status,
+			NextAttemptAt: out.NextAttemptAt,
+			CreatedAt:     out.CreatedAt,
+		})
+	case errors.Is(err, apperrors.ErrWebhookNotFound), errors.Is(err, apperrors.ErrWebhookDeliveryNotFound):
+		util.RespondNotFound(ctx)
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("redeliver error on webhook service")
+		util.RespondInternalError(ctx)
+	}
+}
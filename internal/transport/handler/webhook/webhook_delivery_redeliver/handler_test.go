@@ -0,0 +1,148 @@
+package webhook_delivery_redeliver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/webhook/webhook_delivery_redeliver/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const (
+		webhookID  = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+		deliveryID = "5b0f6f4e-8b8a-4f7e-9a51-2f0c9d7c6a10"
+	)
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expectedIn := &dto.RedeliverWebhookIn{UserID: "user id", WebhookID: webhookID, DeliveryID: deliveryID}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "invalid webhook id",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Eq(webhookID), gomock.Eq("required,uuid")).Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "invalid delivery id",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Eq(webhookID), gomock.Eq("required,uuid")).Return(nil)
+				validator.EXPECT().Var(gomock.Eq(deliveryID), gomock.Eq("required,uuid")).Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "webhook not found",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				webhookSvc.EXPECT().Redeliver(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrWebhookNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "delivery not found",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				webhookSvc.EXPECT().Redeliver(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrWebhookDeliveryNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				webhookSvc.EXPECT().Redeliver(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "webhook service error",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				webhookSvc.EXPECT().Redeliver(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"redeliver error on webhook service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Var(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				webhookSvc.EXPECT().
+					Redeliver(gomock.Any(), gomock.Eq(expectedIn)).
+					Return(&dto.WebhookDelivery{
+						ID:            "new delivery id",
+						WebhookID:     webhookID,
+						Event:         dto.WebhookEventUserActivated,
+						Payload:       []byte(`{"event":"user.activated"}`),
+						Status:        dto.WebhookDeliveryPending,
+						NextAttemptAt: createdAt,
+						CreatedAt:     createdAt,
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusAccepted, res.Code)
+				assert.JSONEq(t, `{
+					"id": "new delivery id",
+					"event": "user.activated",
+					"status": "pending",
+					"nextAttemptAt": "2024-01-01T00:00:00Z",
+					"createdAt": "2024-01-01T00:00:00Z"
+				}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			webhookSvc := mock.NewMockwebhookService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.SetPathValue("id", webhookID)
+			req.SetPathValue("deliveryId", deliveryID)
+
+			tt.setup(webhookSvc, validator)
+
+			handler := NewHandler(webhookSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package webhook_update
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/core/pointer"
+	mockvalidation "github.com/art-es/yet-another-service/internal/core/validation/mock"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/webhook/webhook_update/mock"
+)
+
+func TestHandler(t *testing.T) {
+	const webhookID = "18d440f5-2664-42b1-bfaa-1c15f1687885"
+	updatedAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	expectedIn := &dto.UpdateWebhookIn{
+		UserID:    "user id",
+		WebhookID: webhookID,
+		URL:       "https://example.org/hook",
+		Events:    []string{"article.updated"},
+		Active:    true,
+	}
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "validation error",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().
+					Struct(gomock.Eq(&request{
+						ID:     webhookID,
+						URL:    "https://example.org/hook",
+						Events: []string{"article.updated"},
+						Active: pointer.To(true),
+					})).
+					Return(errors.New("dummy validation error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.JSONEq(t, `{"message": "dummy validation error"}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "webhook not found",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				webhookSvc.EXPECT().Update(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrWebhookNotFound)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "forbidden",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				webhookSvc.EXPECT().Update(gomock.Any(), gomock.Eq(expectedIn)).Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "webhook service error",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				webhookSvc.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Len(t, logs, 1)
+				assert.JSONEq(t, `{"level":"error", "error":"dummy error", "message":"update error on webhook service"}`, logs[0])
+			},
+		},
+		{
+			name: "ok",
+			setup: func(webhookSvc *mock.MockwebhookService, validator *mockvalidation.MockValidator) {
+				validator.EXPECT().Struct(gomock.Any()).Return(nil)
+				webhookSvc.EXPECT().
+					Update(gomock.Any(), gomock.Eq(expectedIn)).
+					Return(&dto.Webhook{
+						ID:        webhookID,
+						URL:       "https://example.org/hook",
+						Secret:    "secret",
+						Events:    []string{"article.updated"},
+						Active:    true,
+						CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt: &updatedAt,
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, `{
+					"id": "18d440f5-2664-42b1-bfaa-1c15f1687885",
+					"url": "https://example.org/hook",
+					"events": ["article.updated"],
+					"active": true,
+					"failures": 0,
+					"createdAt": "2024-01-01T00:00:00Z",
+					"updatedAt": "2024-01-02T00:00:00Z"
+				}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			webhookSvc := mock.NewMockwebhookService(ctrl)
+			validator := mockvalidation.NewMockValidator(ctrl)
+			logger := testutil.NewLogger()
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+			req.Body = io.NopCloser(strings.NewReader(`{"url": "https://example.org/hook", "events": ["article.updated"], "active": true}`))
+			req.SetPathValue("id", webhookID)
+
+			tt.setup(webhookSvc, validator)
+
+			handler := NewHandler(webhookSvc, logger, validator)
+			handler.Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -0,0 +1,83 @@
+//go:generate mockgen -source=handler.go -destination=mock/handler.go -package=mock
+package webhooks_get
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"time"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	contextcore "github.com/art-es/yet-another-service/internal/core/context"
+	"github.com/art-es/yet-another-service/internal/core/http"
+	"github.com/art-es/yet-another-service/internal/core/http/util"
+	"github.com/art-es/yet-another-service/internal/core/log"
+)
+
+type webhookService interface {
+	Get(ctx context.Context, userID string) ([]*dto.Webhook, error)
+}
+
+type response struct {
+	Webhooks []webhook `json:"webhooks"`
+}
+
+type webhook struct {
+	ID        string     `json:"id"`
+	URL       string     `json:"url"`
+	Events    []string   `json:"events"`
+	Active    bool       `json:"active"`
+	Failures  int        `json:"failures"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+type Handler struct {
+	webhookService webhookService
+	logger         log.Logger
+}
+
+func NewHandler(webhookService webhookService, logger log.Logger) *Handler {
+	return &Handler{
+		webhookService: webhookService,
+		logger:         logger,
+	}
+}
+
+func (h *Handler) Handle(ctx http.Context) {
+	userID, ok := contextcore.UserID(ctx)
+	if !ok {
+		util.RespondUnauthorized(ctx)
+		return
+	}
+
+	out, err := h.webhookService.Get(ctx, userID)
+
+	switch {
+	case err == nil:
+		util.Respond(ctx, nethttp.StatusOK, convertResponse(out))
+	case errors.Is(err, apperrors.ErrForbidden):
+		util.RespondForbidden(ctx)
+	default:
+		h.logger.Error().Err(err).Msg("get error on webhook service")
+		util.RespondInternalError(ctx)
+	}
+}
+
+func convertResponse(out []*dto.Webhook) response {
+	webhooks := make([]webhook, 0, len(out))
+	for _, w := range out {
+		webhooks = append(webhooks, webhook{
+			ID:        w.ID,
+			URL:       w.URL,
+			Events:    w.Events,
+			Active:    w.Active,
+			Failures:  w.Failures,
+			CreatedAt: w.CreatedAt,
+			UpdatedAt: w.UpdatedAt,
+		})
+	}
+
+	return response{Webhooks: webhooks}
+}
@@ -0,0 +1,114 @@
+package webhooks_get
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/art-es/yet-another-service/internal/app/shared/dto"
+	apperrors "github.com/art-es/yet-another-service/internal/app/shared/errors"
+	"github.com/art-es/yet-another-service/internal/testutil"
+	"github.com/art-es/yet-another-service/internal/transport/handler/webhook/webhooks_get/mock"
+)
+
+func TestHandler(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name   string
+		setup  func(webhookSvc *mock.MockwebhookService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "forbidden",
+			setup: func(webhookSvc *mock.MockwebhookService) {
+				webhookSvc.EXPECT().Get(gomock.Any(), gomock.Eq("user id")).Return(nil, apperrors.ErrForbidden)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "webhook service error",
+			setup: func(webhookSvc *mock.MockwebhookService) {
+				webhookSvc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("dummy error"))
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, []string{`{"level":"error","error":"dummy error","message":"get error on webhook service"}`}, logs)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(webhookSvc *mock.MockwebhookService) {
+				webhookSvc.EXPECT().
+					Get(gomock.Any(), gomock.Eq("user id")).
+					Return([]*dto.Webhook{
+						{
+							ID:        "foo id",
+							URL:       "https://example.org/foo",
+							Secret:    "foo secret",
+							Events:    []string{"article.published"},
+							Active:    true,
+							CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+						{
+							ID:        "bar id",
+							URL:       "https://example.org/bar",
+							Secret:    "bar secret",
+							Events:    []string{"user.activated"},
+							Failures:  20,
+							CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+							UpdatedAt: &updatedAt,
+						},
+					}, nil)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, `{"webhooks": [
+					{
+						"id": "foo id",
+						"url": "https://example.org/foo",
+						"events": ["article.published"],
+						"active": true,
+						"failures": 0,
+						"createdAt": "2024-01-01T00:00:00Z",
+						"updatedAt": null
+					},
+					{
+						"id": "bar id",
+						"url": "https://example.org/bar",
+						"events": ["user.activated"],
+						"active": false,
+						"failures": 20,
+						"createdAt": "2024-01-01T00:00:00Z",
+						"updatedAt": "2024-01-02T00:00:00Z"
+					}
+				]}`, res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			webhookSvc := mock.NewMockwebhookService(ctrl)
+			logger := testutil.NewLogger()
+			ctx, _, res := testutil.NewHTTPContext(ctrl)
+			ctx.EXPECT().Value(gomock.Any()).Return("user id").AnyTimes()
+
+			tt.setup(webhookSvc)
+
+			NewHandler(webhookSvc, logger).Handle(ctx)
+
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}
@@ -34,8 +34,23 @@ func NewMiddleware(
 }
 
 func (m *Middleware) Wrap(handle http.Handler) http.Handler {
+	return m.wrap(handle, false)
+}
+
+// WrapOptional lets requests without the Authorization header through anonymously,
+// while requests with an invalid token are still rejected.
+func (m *Middleware) WrapOptional(handle http.Handler) http.Handler {
+	return m.wrap(handle, true)
+}
+
+func (m *Middleware) wrap(handle http.Handler, optional bool) http.Handler {
 	return func(ctx http.Context) {
 		authHeader := ctx.Request().Header.Get("Authorization")
+		if authHeader == "" && optional {
+			handle(ctx)
+			return
+		}
+
 		if authHeader == "" || !strings.HasPrefix(strings.ToLower(authHeader), headerPrefix) {
 			httputil.RespondUnauthorized(ctx)
 			return
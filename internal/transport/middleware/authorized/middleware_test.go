@@ -134,3 +134,80 @@ func TestMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestMiddlewareOptional(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		setup  func(t *testing.T, ctx *mockcorehttp.MockContext, req *http.Request, authSvc *mock.MockauthService)
+		assert func(t *testing.T, res *httptest.ResponseRecorder, logs []string)
+	}{
+		{
+			name: "no auth header",
+			setup: func(t *testing.T, ctx *mockcorehttp.MockContext, req *http.Request, authSvc *mock.MockauthService) {
+				req.Header.Del("Authorization")
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, string(expectedOKBody), res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "invalid token",
+			setup: func(t *testing.T, ctx *mockcorehttp.MockContext, req *http.Request, authSvc *mock.MockauthService) {
+				req.Header.Set("Authorization", "bearer dummy token")
+
+				authSvc.EXPECT().
+					Authorize(gomock.Any(), gomock.Eq("dummy token")).
+					Return("", apperrors.ErrInvalidAuthToken)
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+				assert.JSONEq(t, string(expectedUnauthorizedBody), res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name: "ok",
+			setup: func(t *testing.T, ctx *mockcorehttp.MockContext, req *http.Request, authSvc *mock.MockauthService) {
+				req.Header.Set("Authorization", "bearer dummy token")
+
+				authSvc.EXPECT().
+					Authorize(gomock.Any(), gomock.Eq("dummy token")).
+					Return("dummy user ID", nil)
+
+				ctx.EXPECT().
+					With(gomock.Any()).
+					DoAndReturn(func(newCtx context.Context) corehttp.Context {
+						userID, ok := contextcore.UserID(newCtx)
+						assert.True(t, ok)
+						assert.Equal(t, userID, "dummy user ID")
+
+						return ctx
+					})
+			},
+			assert: func(t *testing.T, res *httptest.ResponseRecorder, logs []string) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.JSONEq(t, string(expectedOKBody), res.Body.String())
+				assert.Empty(t, logs)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx, req, res := testutil.NewHTTPContext(ctrl)
+			authSvc := mock.NewMockauthService(ctrl)
+			logger := testutil.NewLogger()
+			tt.setup(t, ctx, req, authSvc)
+
+			handle := NewMiddleware(authSvc, logger).WrapOptional(func(ctx corehttp.Context) {
+				corehttputil.Respond(ctx, http.StatusOK, map[string]any{"message": "OK."})
+			})
+
+			handle(ctx)
+			tt.assert(t, res, logger.Logs())
+		})
+	}
+}